@@ -0,0 +1,51 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	v1 "planetpositions/coordinates/grpc/v1"
+	coordinates "planetpositions/coordinates/pkg/v1/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type server struct{}
+
+var cs = coordinates.NewCoordinatesService()
+
+func main() {
+
+	portNum := os.Getenv("PORT_NUM")
+	lis, err := net.Listen("tcp", "0.0.0.0:"+portNum)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	v1.RegisterCoordinatesServiceServer(s, &server{})
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// Transform -
+func (s *server) Transform(ctx context.Context, req *v1.TransformRequest) (*v1.TransformedCoordinates, error) {
+	tc, err := cs.Transform(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return tc, nil
+}
+
+// GetConstellation -
+func (s *server) GetConstellation(ctx context.Context, req *v1.ConstellationRequest) (*v1.Constellation, error) {
+	c, err := cs.GetConstellation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
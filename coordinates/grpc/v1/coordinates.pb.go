@@ -0,0 +1,650 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: coordinates.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CoordinateFrame int32
+
+const (
+	// No frame given, requests must name both
+	CoordinateFrame_COORDINATE_FRAME_UNSPECIFIED CoordinateFrame = 0
+	// Right ascension and declination
+	CoordinateFrame_EQUATORIAL CoordinateFrame = 1
+	// Local hour angle and declination, for an observer and instant
+	CoordinateFrame_HOUR_ANGLE CoordinateFrame = 2
+	// Ecliptic longitude and latitude
+	CoordinateFrame_ECLIPTIC CoordinateFrame = 3
+	// Azimuth, clockwise from north, and altitude, for an observer and
+	// instant
+	CoordinateFrame_HORIZONTAL CoordinateFrame = 4
+	// Galactic longitude and latitude, IAU 1958 system
+	CoordinateFrame_GALACTIC CoordinateFrame = 5
+)
+
+var CoordinateFrame_name = map[int32]string{
+	0: "COORDINATE_FRAME_UNSPECIFIED",
+	1: "EQUATORIAL",
+	2: "HOUR_ANGLE",
+	3: "ECLIPTIC",
+	4: "HORIZONTAL",
+	5: "GALACTIC",
+}
+
+var CoordinateFrame_value = map[string]int32{
+	"COORDINATE_FRAME_UNSPECIFIED": 0,
+	"EQUATORIAL":                   1,
+	"HOUR_ANGLE":                   2,
+	"ECLIPTIC":                     3,
+	"HORIZONTAL":                   4,
+	"GALACTIC":                     5,
+}
+
+func (x CoordinateFrame) String() string {
+	return proto.EnumName(CoordinateFrame_name, int32(x))
+}
+
+func (CoordinateFrame) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_b312ad4d40e1c306, []int{0}
+}
+
+type TransformRequest struct {
+	Api  string          `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	From CoordinateFrame `protobuf:"varint,2,opt,name=from,proto3,enum=v1.CoordinateFrame" json:"from,omitempty"`
+	To   CoordinateFrame `protobuf:"varint,3,opt,name=to,proto3,enum=v1.CoordinateFrame" json:"to,omitempty"`
+	// The coordinates to transform, in degrees, the longitude, right
+	// ascension, hour angle or azimuth first and the latitude, declination
+	// or altitude second
+	First  float64 `protobuf:"fixed64,4,opt,name=first,proto3" json:"first,omitempty"`
+	Second float64 `protobuf:"fixed64,5,opt,name=second,proto3" json:"second,omitempty"`
+	// Equator and equinox the equatorial and ecliptic coordinates are
+	// referred to, a Julian epoch such as J2000 or J2024.5, a Besselian
+	// epoch such as B1950, or "date", defaulting to J2000.0. Hour angles
+	// and horizontal coordinates are always of date, galactic coordinates
+	// have no epoch.
+	FromEpoch string `protobuf:"bytes,6,opt,name=from_epoch,json=fromEpoch,proto3" json:"from_epoch,omitempty"`
+	ToEpoch   string `protobuf:"bytes,7,opt,name=to_epoch,json=toEpoch,proto3" json:"to_epoch,omitempty"`
+	// Observer's longitude, positive east, and latitude, in degrees
+	Longitude float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// UTC instant of observation, needed for the hour angle and horizontal
+	// frames and epochs of date
+	Year                 int32    `protobuf:"varint,10,opt,name=year,proto3" json:"year,omitempty"`
+	Month                int32    `protobuf:"varint,11,opt,name=month,proto3" json:"month,omitempty"`
+	Day                  int32    `protobuf:"varint,12,opt,name=day,proto3" json:"day,omitempty"`
+	Hour                 float64  `protobuf:"fixed64,13,opt,name=hour,proto3" json:"hour,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransformRequest) Reset()         { *m = TransformRequest{} }
+func (m *TransformRequest) String() string { return proto.CompactTextString(m) }
+func (*TransformRequest) ProtoMessage()    {}
+func (*TransformRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b312ad4d40e1c306, []int{0}
+}
+
+func (m *TransformRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransformRequest.Unmarshal(m, b)
+}
+func (m *TransformRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransformRequest.Marshal(b, m, deterministic)
+}
+func (m *TransformRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransformRequest.Merge(m, src)
+}
+func (m *TransformRequest) XXX_Size() int {
+	return xxx_messageInfo_TransformRequest.Size(m)
+}
+func (m *TransformRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransformRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransformRequest proto.InternalMessageInfo
+
+func (m *TransformRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *TransformRequest) GetFrom() CoordinateFrame {
+	if m != nil {
+		return m.From
+	}
+	return CoordinateFrame_COORDINATE_FRAME_UNSPECIFIED
+}
+
+func (m *TransformRequest) GetTo() CoordinateFrame {
+	if m != nil {
+		return m.To
+	}
+	return CoordinateFrame_COORDINATE_FRAME_UNSPECIFIED
+}
+
+func (m *TransformRequest) GetFirst() float64 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetSecond() float64 {
+	if m != nil {
+		return m.Second
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetFromEpoch() string {
+	if m != nil {
+		return m.FromEpoch
+	}
+	return ""
+}
+
+func (m *TransformRequest) GetToEpoch() string {
+	if m != nil {
+		return m.ToEpoch
+	}
+	return ""
+}
+
+func (m *TransformRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *TransformRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+type TransformedCoordinates struct {
+	Api    string          `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Frame  CoordinateFrame `protobuf:"varint,2,opt,name=frame,proto3,enum=v1.CoordinateFrame" json:"frame,omitempty"`
+	First  float64         `protobuf:"fixed64,3,opt,name=first,proto3" json:"first,omitempty"`
+	Second float64         `protobuf:"fixed64,4,opt,name=second,proto3" json:"second,omitempty"`
+	// The epoch the coordinates are referred to, unset for galactic
+	// coordinates
+	Epoch string `protobuf:"bytes,5,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Julian date of the instant of observation, unset when none was needed
+	JulianDate           float64  `protobuf:"fixed64,6,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransformedCoordinates) Reset()         { *m = TransformedCoordinates{} }
+func (m *TransformedCoordinates) String() string { return proto.CompactTextString(m) }
+func (*TransformedCoordinates) ProtoMessage()    {}
+func (*TransformedCoordinates) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b312ad4d40e1c306, []int{1}
+}
+
+func (m *TransformedCoordinates) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransformedCoordinates.Unmarshal(m, b)
+}
+func (m *TransformedCoordinates) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransformedCoordinates.Marshal(b, m, deterministic)
+}
+func (m *TransformedCoordinates) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransformedCoordinates.Merge(m, src)
+}
+func (m *TransformedCoordinates) XXX_Size() int {
+	return xxx_messageInfo_TransformedCoordinates.Size(m)
+}
+func (m *TransformedCoordinates) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransformedCoordinates.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransformedCoordinates proto.InternalMessageInfo
+
+func (m *TransformedCoordinates) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *TransformedCoordinates) GetFrame() CoordinateFrame {
+	if m != nil {
+		return m.Frame
+	}
+	return CoordinateFrame_COORDINATE_FRAME_UNSPECIFIED
+}
+
+func (m *TransformedCoordinates) GetFirst() float64 {
+	if m != nil {
+		return m.First
+	}
+	return 0
+}
+
+func (m *TransformedCoordinates) GetSecond() float64 {
+	if m != nil {
+		return m.Second
+	}
+	return 0
+}
+
+func (m *TransformedCoordinates) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
+}
+
+func (m *TransformedCoordinates) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type ConstellationRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Right ascension and declination, in degrees
+	RightAscension float64 `protobuf:"fixed64,2,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination    float64 `protobuf:"fixed64,3,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Equator and equinox the position is referred to, as for
+	// TransformRequest
+	Epoch string `protobuf:"bytes,4,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// UTC instant, needed for an epoch of date
+	Year                 int32    `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Month                int32    `protobuf:"varint,6,opt,name=month,proto3" json:"month,omitempty"`
+	Day                  int32    `protobuf:"varint,7,opt,name=day,proto3" json:"day,omitempty"`
+	Hour                 float64  `protobuf:"fixed64,8,opt,name=hour,proto3" json:"hour,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConstellationRequest) Reset()         { *m = ConstellationRequest{} }
+func (m *ConstellationRequest) String() string { return proto.CompactTextString(m) }
+func (*ConstellationRequest) ProtoMessage()    {}
+func (*ConstellationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b312ad4d40e1c306, []int{2}
+}
+
+func (m *ConstellationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConstellationRequest.Unmarshal(m, b)
+}
+func (m *ConstellationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConstellationRequest.Marshal(b, m, deterministic)
+}
+func (m *ConstellationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConstellationRequest.Merge(m, src)
+}
+func (m *ConstellationRequest) XXX_Size() int {
+	return xxx_messageInfo_ConstellationRequest.Size(m)
+}
+func (m *ConstellationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConstellationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConstellationRequest proto.InternalMessageInfo
+
+func (m *ConstellationRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *ConstellationRequest) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *ConstellationRequest) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *ConstellationRequest) GetEpoch() string {
+	if m != nil {
+		return m.Epoch
+	}
+	return ""
+}
+
+func (m *ConstellationRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *ConstellationRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *ConstellationRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *ConstellationRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+type Constellation struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// The IAU's three letter abbreviation, such as UMa
+	Abbreviation string `protobuf:"bytes,2,opt,name=abbreviation,proto3" json:"abbreviation,omitempty"`
+	// Latin name, such as Ursa Major
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The position referred to the equinox of B1875.0 the boundaries are
+	// drawn for, in degrees
+	RightAscensionB1875  float64  `protobuf:"fixed64,4,opt,name=right_ascension_b1875,json=rightAscensionB1875,proto3" json:"right_ascension_b1875,omitempty"`
+	DeclinationB1875     float64  `protobuf:"fixed64,5,opt,name=declination_b1875,json=declinationB1875,proto3" json:"declination_b1875,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Constellation) Reset()         { *m = Constellation{} }
+func (m *Constellation) String() string { return proto.CompactTextString(m) }
+func (*Constellation) ProtoMessage()    {}
+func (*Constellation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b312ad4d40e1c306, []int{3}
+}
+
+func (m *Constellation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Constellation.Unmarshal(m, b)
+}
+func (m *Constellation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Constellation.Marshal(b, m, deterministic)
+}
+func (m *Constellation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Constellation.Merge(m, src)
+}
+func (m *Constellation) XXX_Size() int {
+	return xxx_messageInfo_Constellation.Size(m)
+}
+func (m *Constellation) XXX_DiscardUnknown() {
+	xxx_messageInfo_Constellation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Constellation proto.InternalMessageInfo
+
+func (m *Constellation) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Constellation) GetAbbreviation() string {
+	if m != nil {
+		return m.Abbreviation
+	}
+	return ""
+}
+
+func (m *Constellation) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Constellation) GetRightAscensionB1875() float64 {
+	if m != nil {
+		return m.RightAscensionB1875
+	}
+	return 0
+}
+
+func (m *Constellation) GetDeclinationB1875() float64 {
+	if m != nil {
+		return m.DeclinationB1875
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("v1.CoordinateFrame", CoordinateFrame_name, CoordinateFrame_value)
+	proto.RegisterType((*TransformRequest)(nil), "v1.TransformRequest")
+	proto.RegisterType((*TransformedCoordinates)(nil), "v1.TransformedCoordinates")
+	proto.RegisterType((*ConstellationRequest)(nil), "v1.ConstellationRequest")
+	proto.RegisterType((*Constellation)(nil), "v1.Constellation")
+}
+
+func init() { proto.RegisterFile("coordinates.proto", fileDescriptor_b312ad4d40e1c306) }
+
+var fileDescriptor_b312ad4d40e1c306 = []byte{
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x55, 0xc1, 0x72, 0xe3, 0x44,
+	0x10, 0x45, 0xb2, 0x9d, 0xc4, 0x9d, 0x6c, 0x56, 0x99, 0x84, 0x45, 0xb8, 0x42, 0xa1, 0x32, 0x07,
+	0xb2, 0x59, 0x6c, 0xc5, 0x26, 0x14, 0x5b, 0xcb, 0x05, 0xad, 0xe3, 0x04, 0x57, 0x99, 0x78, 0xd1,
+	0x3a, 0x17, 0x2e, 0xae, 0xb1, 0x3c, 0x91, 0x94, 0x92, 0x67, 0xc4, 0xcc, 0xd8, 0x21, 0x65, 0x5c,
+	0x14, 0xf0, 0x07, 0x70, 0xa1, 0xf8, 0x14, 0x7e, 0x83, 0x13, 0xf7, 0xfd, 0x0f, 0xa8, 0x19, 0x39,
+	0x89, 0x1d, 0x7b, 0xf7, 0x94, 0xe9, 0xf7, 0xde, 0xb4, 0xba, 0x5f, 0xf7, 0xc4, 0xb0, 0x13, 0x30,
+	0xc6, 0x07, 0x31, 0xc5, 0x92, 0x88, 0x6a, 0xca, 0x99, 0x64, 0xc8, 0x1c, 0xd7, 0x4a, 0xfb, 0x21,
+	0x63, 0x61, 0x42, 0x5c, 0x9c, 0xc6, 0x2e, 0xa6, 0x94, 0x49, 0x2c, 0x63, 0x46, 0x67, 0x8a, 0xd2,
+	0x67, 0xfa, 0x4f, 0x50, 0x09, 0x09, 0xad, 0x88, 0x6b, 0x1c, 0x86, 0x84, 0xbb, 0x2c, 0xd5, 0x8a,
+	0x65, 0x75, 0xf9, 0x8d, 0x09, 0x56, 0x97, 0x63, 0x2a, 0x2e, 0x19, 0x1f, 0xfa, 0xe4, 0x87, 0x11,
+	0x11, 0x12, 0x59, 0x90, 0xc3, 0x69, 0x6c, 0x1b, 0x8e, 0x71, 0x50, 0xf4, 0xd5, 0x11, 0x7d, 0x0a,
+	0xf9, 0x4b, 0xce, 0x86, 0xb6, 0xe9, 0x18, 0x07, 0xdb, 0xf5, 0xdd, 0xea, 0xb8, 0x56, 0x6d, 0xdc,
+	0xd5, 0x76, 0xca, 0xf1, 0x90, 0xf8, 0x5a, 0x80, 0x3e, 0x01, 0x53, 0x32, 0x3b, 0xf7, 0x76, 0x99,
+	0x29, 0x19, 0xda, 0x83, 0xc2, 0x65, 0xcc, 0x85, 0xb4, 0xf3, 0x8e, 0x71, 0x60, 0xf8, 0x59, 0x80,
+	0x9e, 0xc0, 0x9a, 0x20, 0x01, 0xa3, 0x03, 0xbb, 0xa0, 0xe1, 0x59, 0x84, 0x3e, 0x02, 0x50, 0xa9,
+	0x7b, 0x24, 0x65, 0x41, 0x64, 0xaf, 0xe9, 0xa2, 0x8a, 0x0a, 0x69, 0x2a, 0x00, 0x7d, 0x08, 0x1b,
+	0x92, 0xcd, 0xc8, 0x75, 0x4d, 0xae, 0x4b, 0x96, 0x51, 0xfb, 0x50, 0x4c, 0x18, 0x0d, 0x63, 0x39,
+	0x1a, 0x10, 0x7b, 0x43, 0x27, 0xbd, 0x07, 0x50, 0x09, 0x36, 0x12, 0x2c, 0x33, 0xb2, 0xa8, 0xc9,
+	0xbb, 0x18, 0x21, 0xc8, 0xdf, 0x10, 0xcc, 0x6d, 0x70, 0x8c, 0x83, 0x82, 0xaf, 0xcf, 0xaa, 0xea,
+	0x21, 0xa3, 0x32, 0xb2, 0x37, 0x35, 0x98, 0x05, 0xca, 0xab, 0x01, 0xbe, 0xb1, 0xb7, 0x34, 0xa6,
+	0x8e, 0xea, 0x6e, 0xc4, 0x46, 0xdc, 0x7e, 0xa4, 0x73, 0xea, 0x73, 0xf9, 0x6f, 0x03, 0x9e, 0xdc,
+	0xd9, 0x4c, 0x06, 0xf7, 0xa6, 0x88, 0x15, 0x66, 0x3f, 0x85, 0xc2, 0xa5, 0xf2, 0xea, 0x5d, 0x6e,
+	0x67, 0x8a, 0x7b, 0x27, 0x73, 0xab, 0x9d, 0xcc, 0x2f, 0x38, 0xb9, 0x07, 0x85, 0xcc, 0xa7, 0x82,
+	0xfe, 0x58, 0x16, 0xa0, 0x8f, 0x61, 0xf3, 0x6a, 0x94, 0xc4, 0x98, 0xf6, 0x06, 0x58, 0x12, 0x6d,
+	0xb0, 0xe1, 0x43, 0x06, 0x9d, 0x60, 0x49, 0xca, 0xff, 0x1a, 0xb0, 0xd7, 0x60, 0x54, 0x48, 0x92,
+	0x24, 0x7a, 0x79, 0xde, 0xb5, 0x27, 0x8f, 0x79, 0x1c, 0x46, 0xb2, 0x87, 0x45, 0x40, 0xa8, 0x88,
+	0x19, 0xd5, 0x4d, 0x18, 0xfe, 0xb6, 0x86, 0xbd, 0x5b, 0x14, 0x39, 0xb0, 0x39, 0x20, 0x41, 0xa2,
+	0x3a, 0x52, 0xa2, 0xac, 0xfc, 0x79, 0xe8, 0xbe, 0xd8, 0xfc, 0x7c, 0xb1, 0xb7, 0x83, 0x29, 0xac,
+	0x1a, 0xcc, 0xda, 0x8a, 0xc1, 0xac, 0x2f, 0x0f, 0x66, 0x63, 0x71, 0x30, 0x8f, 0x16, 0x7a, 0x5b,
+	0xd1, 0x54, 0x19, 0xb6, 0x70, 0xbf, 0xcf, 0xc9, 0x38, 0xc6, 0xf2, 0xb6, 0xa3, 0xa2, 0xbf, 0x80,
+	0xa9, 0xdc, 0x54, 0x8d, 0x2c, 0xa7, 0x39, 0x7d, 0x46, 0x75, 0x78, 0xff, 0x81, 0x19, 0xbd, 0x7e,
+	0xed, 0xf9, 0x97, 0x5f, 0xcc, 0xa6, 0xb2, 0xbb, 0x68, 0xc9, 0x4b, 0x45, 0xa1, 0x67, 0xb0, 0x33,
+	0x67, 0xc2, 0x4c, 0x9f, 0xbd, 0x07, 0x6b, 0x8e, 0xd0, 0xe2, 0xc3, 0x9f, 0xe1, 0xf1, 0x83, 0xbd,
+	0x40, 0x0e, 0xec, 0x37, 0x3a, 0x1d, 0xff, 0xa4, 0x75, 0xee, 0x75, 0x9b, 0xbd, 0x53, 0xdf, 0xfb,
+	0xb6, 0xd9, 0xbb, 0x38, 0x7f, 0xfd, 0xaa, 0xd9, 0x68, 0x9d, 0xb6, 0x9a, 0x27, 0xd6, 0x7b, 0x68,
+	0x1b, 0xa0, 0xf9, 0xdd, 0x85, 0xd7, 0xed, 0xf8, 0x2d, 0xaf, 0x6d, 0x19, 0x2a, 0xfe, 0xa6, 0x73,
+	0xe1, 0xf7, 0xbc, 0xf3, 0xb3, 0x76, 0xd3, 0x32, 0xd1, 0x16, 0x6c, 0x34, 0x1b, 0xed, 0xd6, 0xab,
+	0x6e, 0xab, 0x61, 0xe5, 0x32, 0xd6, 0x6f, 0x7d, 0xdf, 0x39, 0xef, 0x7a, 0x6d, 0x2b, 0xaf, 0xd8,
+	0x33, 0xaf, 0xed, 0x35, 0x14, 0x5b, 0xa8, 0xff, 0x62, 0x02, 0x9a, 0xdb, 0xe5, 0xd7, 0x84, 0x8f,
+	0xe3, 0x80, 0xa0, 0x14, 0x8a, 0x77, 0xcb, 0x8e, 0xf6, 0xd4, 0xfa, 0x3e, 0xfc, 0x17, 0x53, 0x2a,
+	0x2d, 0xa0, 0x0b, 0x2f, 0xa2, 0x5c, 0xfb, 0xf5, 0x9f, 0x37, 0x7f, 0x98, 0xcf, 0xd0, 0xd3, 0x71,
+	0xcd, 0x95, 0xb7, 0x12, 0x77, 0xa2, 0x9e, 0xfc, 0xd4, 0x9d, 0x48, 0x36, 0x75, 0x27, 0x7a, 0xcf,
+	0xa7, 0xee, 0x24, 0x5b, 0xec, 0x29, 0xba, 0x01, 0xeb, 0x8c, 0xc8, 0xc5, 0x41, 0xda, 0xd9, 0xbb,
+	0x59, 0xde, 0xdb, 0xd2, 0xce, 0x12, 0x53, 0x7e, 0xae, 0xbf, 0x59, 0x47, 0x47, 0xe3, 0x9a, 0x1b,
+	0xcc, 0x33, 0xee, 0xe4, 0xc1, 0x0c, 0xa7, 0xee, 0x64, 0x6e, 0x10, 0xd3, 0x97, 0xbf, 0x99, 0xbf,
+	0x7b, 0xff, 0x19, 0xe8, 0x4f, 0xa3, 0xfc, 0x13, 0x3a, 0x8a, 0xa4, 0x4c, 0xc5, 0x0b, 0xd7, 0x0d,
+	0x63, 0x19, 0x8d, 0xfa, 0xd5, 0x80, 0x0d, 0x5d, 0x11, 0x61, 0x4a, 0x22, 0x76, 0x4d, 0x30, 0x97,
+	0x91, 0x9b, 0x26, 0x98, 0x12, 0x99, 0x32, 0x11, 0xab, 0xdb, 0xa2, 0xf4, 0x81, 0xa6, 0xbf, 0x5e,
+	0x10, 0xa9, 0x6b, 0xe0, 0x86, 0xac, 0x12, 0xf2, 0x34, 0xa8, 0xa8, 0x94, 0x15, 0x4e, 0x84, 0xac,
+	0x0c, 0xe3, 0x80, 0x33, 0x91, 0x79, 0x5c, 0x91, 0x23, 0xc9, 0x78, 0x8c, 0x13, 0x27, 0xe5, 0xec,
+	0x8a, 0x04, 0x12, 0x76, 0xe7, 0x2c, 0x74, 0x66, 0xaa, 0x7a, 0xae, 0x56, 0x3d, 0x3a, 0x34, 0x8c,
+	0xba, 0x85, 0xd3, 0x34, 0x89, 0x83, 0xac, 0xa3, 0x2b, 0xc1, 0xe8, 0x8b, 0x25, 0xc4, 0xff, 0x0a,
+	0x72, 0xc7, 0x47, 0xc7, 0xe8, 0x18, 0x0e, 0x7d, 0x22, 0x47, 0x9c, 0x92, 0x81, 0x73, 0x1d, 0x11,
+	0xea, 0xc8, 0x88, 0x38, 0x9c, 0x08, 0x36, 0xe2, 0x01, 0x71, 0x06, 0x8c, 0x08, 0x87, 0x32, 0xe9,
+	0x90, 0x1f, 0x63, 0x21, 0xab, 0x68, 0x0d, 0xf2, 0x7f, 0x99, 0xc6, 0x7a, 0x7f, 0x4d, 0xff, 0x9c,
+	0x7c, 0xfe, 0xff, 0x00, 0x60, 0xa3, 0xcb, 0x25, 0xb3, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CoordinatesServiceClient is the client API for CoordinatesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CoordinatesServiceClient interface {
+	// Transform coordinates from one frame and epoch to another
+	Transform(ctx context.Context, in *TransformRequest, opts ...grpc.CallOption) (*TransformedCoordinates, error)
+	// Find the constellation containing a position
+	GetConstellation(ctx context.Context, in *ConstellationRequest, opts ...grpc.CallOption) (*Constellation, error)
+}
+
+type coordinatesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCoordinatesServiceClient(cc *grpc.ClientConn) CoordinatesServiceClient {
+	return &coordinatesServiceClient{cc}
+}
+
+func (c *coordinatesServiceClient) Transform(ctx context.Context, in *TransformRequest, opts ...grpc.CallOption) (*TransformedCoordinates, error) {
+	out := new(TransformedCoordinates)
+	err := c.cc.Invoke(ctx, "/v1.CoordinatesService/Transform", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coordinatesServiceClient) GetConstellation(ctx context.Context, in *ConstellationRequest, opts ...grpc.CallOption) (*Constellation, error) {
+	out := new(Constellation)
+	err := c.cc.Invoke(ctx, "/v1.CoordinatesService/GetConstellation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CoordinatesServiceServer is the server API for CoordinatesService service.
+type CoordinatesServiceServer interface {
+	// Transform coordinates from one frame and epoch to another
+	Transform(context.Context, *TransformRequest) (*TransformedCoordinates, error)
+	// Find the constellation containing a position
+	GetConstellation(context.Context, *ConstellationRequest) (*Constellation, error)
+}
+
+func RegisterCoordinatesServiceServer(s *grpc.Server, srv CoordinatesServiceServer) {
+	s.RegisterService(&_CoordinatesService_serviceDesc, srv)
+}
+
+func _CoordinatesService_Transform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransformRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatesServiceServer).Transform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CoordinatesService/Transform",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatesServiceServer).Transform(ctx, req.(*TransformRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoordinatesService_GetConstellation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConstellationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoordinatesServiceServer).GetConstellation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.CoordinatesService/GetConstellation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoordinatesServiceServer).GetConstellation(ctx, req.(*ConstellationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CoordinatesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.CoordinatesService",
+	HandlerType: (*CoordinatesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Transform",
+			Handler:    _CoordinatesService_Transform_Handler,
+		},
+		{
+			MethodName: "GetConstellation",
+			Handler:    _CoordinatesService_GetConstellation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coordinates.proto",
+}
@@ -0,0 +1,72 @@
+package coordinatesclient
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"planetpositions/coordinates/grpc/v1"
+
+	"google.golang.org/grpc"
+)
+
+// CoordinatesClient -
+type CoordinatesClient struct {
+	Address string
+}
+
+func (c *CoordinatesClient) newConnection() (v1.CoordinatesServiceClient, *grpc.ClientConn) {
+
+	// Set up a connection to the server.
+	conn, err := grpc.Dial(c.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	return v1.NewCoordinatesServiceClient(conn), conn
+}
+
+// Transform -
+func (c *CoordinatesClient) Transform(from, to v1.CoordinateFrame, first, second float64, fromEpoch, toEpoch string, long, lat float64, year, month, day int32, hour float64) (*v1.TransformedCoordinates, error) {
+	cc, conn := c.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.TransformRequest{
+		Api:       "v1",
+		From:      from,
+		To:        to,
+		First:     first,
+		Second:    second,
+		FromEpoch: fromEpoch,
+		ToEpoch:   toEpoch,
+		Longitude: long,
+		Latitude:  lat,
+		Year:      year,
+		Month:     month,
+		Day:       day,
+		Hour:      hour,
+	}
+	return cc.Transform(ctx, &req)
+}
+
+// GetConstellation -
+func (c *CoordinatesClient) GetConstellation(ra, dec float64, epoch string, year, month, day int32, hour float64) (*v1.Constellation, error) {
+	cc, conn := c.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.ConstellationRequest{
+		Api:            "v1",
+		RightAscension: ra,
+		Declination:    dec,
+		Epoch:          epoch,
+		Year:           year,
+		Month:          month,
+		Day:            day,
+		Hour:           hour,
+	}
+	return cc.GetConstellation(ctx, &req)
+}
@@ -0,0 +1,153 @@
+// Package constellation identifies the IAU constellation containing a
+// position on the sky, from the boundaries Delporte drew for the equinox of
+// B1875.0 as tabulated by Roman (1987).
+package constellation
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// B1875 is the Julian ephemeris day of the epoch the boundaries are drawn
+// for
+const B1875 = 2405889.25855
+
+// boundary is a row of the table, the constellation holds the stretch of
+// right ascension from raLow up to raHigh, in hours, north of decLow, in
+// degrees, not taken by an earlier row
+type boundary struct {
+	raLow, raHigh, decLow float64
+	abbreviation          string
+}
+
+// Constellation -
+type Constellation struct {
+	// The IAU's three letter abbreviation, such as UMa
+	Abbreviation string
+	// Latin name, such as Ursa Major
+	Name string
+}
+
+// Find returns the constellation containing the right ascension and
+// declination, in degrees, referred to the mean equator and equinox of the
+// Julian ephemeris day epoch
+func Find(rightAscension, declination, epoch float64) Constellation {
+	ra, dec := precession.Precess(rightAscension, declination, epoch, B1875)
+	return FindB1875(ra, dec)
+}
+
+// FindB1875 returns the constellation containing the right ascension and
+// declination, in degrees, already referred to the mean equator and equinox
+// of B1875.0
+func FindB1875(rightAscension, declination float64) Constellation {
+	return find(rightAscension/15, declination)
+}
+
+// find looks up a right ascension in hours and declination in degrees for
+// the equinox of B1875.0
+func find(ra, dec float64) Constellation {
+	ra = math.Mod(ra, 24)
+	if ra < 0 {
+		ra += 24
+	}
+	for _, b := range boundaries {
+		if dec >= b.decLow && ra >= b.raLow && ra < b.raHigh {
+			return Constellation{Abbreviation: b.abbreviation, Name: names[b.abbreviation]}
+		}
+	}
+	// The last row covers the south celestial pole
+	return Constellation{Abbreviation: "Oct", Name: names["Oct"]}
+}
+
+// names are the Latin names of the constellations by abbreviation
+var names = map[string]string{
+	"And": "Andromeda",
+	"Ant": "Antlia",
+	"Aps": "Apus",
+	"Aqr": "Aquarius",
+	"Aql": "Aquila",
+	"Ara": "Ara",
+	"Ari": "Aries",
+	"Aur": "Auriga",
+	"Boo": "Boötes",
+	"Cae": "Caelum",
+	"Cam": "Camelopardalis",
+	"Cnc": "Cancer",
+	"CVn": "Canes Venatici",
+	"CMa": "Canis Major",
+	"CMi": "Canis Minor",
+	"Cap": "Capricornus",
+	"Car": "Carina",
+	"Cas": "Cassiopeia",
+	"Cen": "Centaurus",
+	"Cep": "Cepheus",
+	"Cet": "Cetus",
+	"Cha": "Chamaeleon",
+	"Cir": "Circinus",
+	"Col": "Columba",
+	"Com": "Coma Berenices",
+	"CrA": "Corona Australis",
+	"CrB": "Corona Borealis",
+	"Crv": "Corvus",
+	"Crt": "Crater",
+	"Cru": "Crux",
+	"Cyg": "Cygnus",
+	"Del": "Delphinus",
+	"Dor": "Dorado",
+	"Dra": "Draco",
+	"Equ": "Equuleus",
+	"Eri": "Eridanus",
+	"For": "Fornax",
+	"Gem": "Gemini",
+	"Gru": "Grus",
+	"Her": "Hercules",
+	"Hor": "Horologium",
+	"Hya": "Hydra",
+	"Hyi": "Hydrus",
+	"Ind": "Indus",
+	"Lac": "Lacerta",
+	"Leo": "Leo",
+	"LMi": "Leo Minor",
+	"Lep": "Lepus",
+	"Lib": "Libra",
+	"Lup": "Lupus",
+	"Lyn": "Lynx",
+	"Lyr": "Lyra",
+	"Men": "Mensa",
+	"Mic": "Microscopium",
+	"Mon": "Monoceros",
+	"Mus": "Musca",
+	"Nor": "Norma",
+	"Oct": "Octans",
+	"Oph": "Ophiuchus",
+	"Ori": "Orion",
+	"Pav": "Pavo",
+	"Peg": "Pegasus",
+	"Per": "Perseus",
+	"Phe": "Phoenix",
+	"Pic": "Pictor",
+	"Psc": "Pisces",
+	"PsA": "Piscis Austrinus",
+	"Pup": "Puppis",
+	"Pyx": "Pyxis",
+	"Ret": "Reticulum",
+	"Sge": "Sagitta",
+	"Sgr": "Sagittarius",
+	"Sco": "Scorpius",
+	"Scl": "Sculptor",
+	"Sct": "Scutum",
+	"Ser": "Serpens",
+	"Sex": "Sextans",
+	"Tau": "Taurus",
+	"Tel": "Telescopium",
+	"Tri": "Triangulum",
+	"TrA": "Triangulum Australe",
+	"Tuc": "Tucana",
+	"UMa": "Ursa Major",
+	"UMi": "Ursa Minor",
+	"Vel": "Vela",
+	"Vir": "Virgo",
+	"Vol": "Volans",
+	"Vul": "Vulpecula",
+}
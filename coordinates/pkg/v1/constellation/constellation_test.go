@@ -0,0 +1,66 @@
+package constellation_test
+
+import (
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/constellation"
+	"planetpositions/coordinates/pkg/v1/precession"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	// The test positions of Roman (1987), referred to the equinox of B1950.0
+	b1950 := 2433282.42346
+	testcases := map[string]struct {
+		ra, dec float64
+	}{
+		"UMa": {9.0000, 65.0000},
+		"Aqr": {23.5000, -20.0000},
+		"Ori": {5.1200, 9.1200},
+		"Hya": {9.4555, -19.9000},
+		"Com": {12.8888, 22.0000},
+		"Lib": {15.6687, -12.1234},
+		"CrA": {19.0000, -40.0000},
+		"Men": {6.2222, -81.1234},
+	}
+	for abbreviation, tc := range testcases {
+		c := constellation.Find(tc.ra*15, tc.dec, b1950)
+		assert.Equal(t, abbreviation, c.Abbreviation, "%v", tc)
+	}
+
+	// Bright stars for the equinox of J2000.0
+	stars := map[string]struct {
+		ra, dec float64
+		name    string
+	}{
+		"Sirius":  {101.287155, -16.716116, "Canis Major"},
+		"Polaris": {37.954561, 89.264109, "Ursa Minor"},
+		"Vega":    {279.234735, 38.783689, "Lyra"},
+		"Acrux":   {186.649563, -63.099093, "Crux"},
+		"Antares": {247.351915, -26.432003, "Scorpius"},
+		"Altair":  {297.695827, 8.868321, "Aquila"},
+	}
+	for star, tc := range stars {
+		c := constellation.Find(tc.ra, tc.dec, 2451545.0)
+		assert.Equal(t, tc.name, c.Name, star)
+	}
+
+	// The poles
+	assert.Equal(t, "UMi", constellation.Find(0, 90, 2451545.0).Abbreviation)
+	assert.Equal(t, "Oct", constellation.Find(0, -90, 2451545.0).Abbreviation)
+}
+
+func TestFindB1875(t *testing.T) {
+	// Roman's test positions precessed to B1875.0 are looked up as they are
+	testcases := map[string]struct {
+		ra, dec float64
+	}{
+		"UMa": {9.0000, 65.0000},
+		"Lib": {15.6687, -12.1234},
+	}
+	for abbreviation, tc := range testcases {
+		ra, dec := precession.Precess(tc.ra*15, tc.dec, 2433282.42346, constellation.B1875)
+		assert.Equal(t, abbreviation, constellation.FindB1875(ra, dec).Abbreviation, "%v", tc)
+	}
+}
@@ -0,0 +1,134 @@
+package precession
+
+import "math"
+
+// Matrix rotates rectangular coordinates, v' = M v
+type Matrix [3][3]float64
+
+// MeanObliquity returns the obliquity of the ecliptic to the mean equator at
+// the Julian ephemeris day jde, IAU 2006, in degrees
+func MeanObliquity(jde float64) float64 {
+	t := (jde - J2000) / 36525
+	seconds := 84381.406 + t*(-46.836769+t*(-0.0001831+t*(0.00200340+t*(-0.000000576+t*(-0.0000000434)))))
+	return seconds * arcsecond
+}
+
+// TrueObliquity returns the obliquity of the ecliptic to the true equator at
+// the Julian ephemeris day jde, the mean obliquity with the nutation in
+// obliquity added, in degrees
+func TrueObliquity(jde float64) float64 {
+	_, obliquity := Nutation(jde)
+	return MeanObliquity(jde) + obliquity
+}
+
+// fromJ2000 returns the rotation from the mean equator and equinox of J2000.0
+// to those of the Julian ephemeris day jde, by the IAU 2006 equatorial
+// precession angles ζ, z and θ
+func fromJ2000(jde float64) Matrix {
+	t := (jde - J2000) / 36525
+	zeta := 2.650545 + t*(2306.083227+t*(0.2988499+t*(0.01801828+t*(-0.000005971+t*(-0.0000003173)))))
+	z := -2.650545 + t*(2306.077181+t*(1.0927348+t*(0.01826837+t*(-0.000028596+t*(-0.0000002904)))))
+	theta := t * (2004.191903 + t*(-0.4294934+t*(-0.04182264+t*(-0.000007089+t*(-0.0000001274)))))
+	return rotateZ(-z * arcsecond).
+		Multiply(rotateY(theta * arcsecond)).
+		Multiply(rotateZ(-zeta * arcsecond))
+}
+
+// PrecessionMatrix returns the rotation from the mean equator and equinox of
+// the Julian ephemeris day from to those of the day to
+func PrecessionMatrix(from, to float64) Matrix {
+	return fromJ2000(to).Multiply(fromJ2000(from).Transpose())
+}
+
+// NutationMatrix returns the rotation from the mean equator and equinox of
+// the Julian ephemeris day jde to the true equator and equinox
+func NutationMatrix(jde float64) Matrix {
+	longitude, obliquity := Nutation(jde)
+	mean := MeanObliquity(jde)
+	return rotateX(-(mean + obliquity)).
+		Multiply(rotateZ(-longitude)).
+		Multiply(rotateX(mean))
+}
+
+// Precess takes a right ascension and declination referred to the mean
+// equinox of the Julian ephemeris day from to that of the day to
+func Precess(rightAscension, declination, from, to float64) (float64, float64) {
+	if from == to {
+		return rightAscension, declination
+	}
+	return PrecessionMatrix(from, to).Spherical(rightAscension, declination)
+}
+
+// Multiply returns the product m n, the rotation n followed by m
+func (m Matrix) Multiply(n Matrix) Matrix {
+	var p Matrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				p[i][j] += m[i][k] * n[k][j]
+			}
+		}
+	}
+	return p
+}
+
+// Transpose returns the inverse rotation
+func (m Matrix) Transpose() Matrix {
+	var p Matrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			p[i][j] = m[j][i]
+		}
+	}
+	return p
+}
+
+// Apply rotates the rectangular coordinates x, y, z
+func (m Matrix) Apply(x, y, z float64) (float64, float64, float64) {
+	return m[0][0]*x + m[0][1]*y + m[0][2]*z,
+		m[1][0]*x + m[1][1]*y + m[1][2]*z,
+		m[2][0]*x + m[2][1]*y + m[2][2]*z
+}
+
+// Spherical rotates a direction given as right ascension and declination,
+// or longitude and latitude, in degrees
+func (m Matrix) Spherical(longitude, latitude float64) (float64, float64) {
+	lon := degreesToRadians(longitude)
+	lat := degreesToRadians(latitude)
+	x, y, z := m.Apply(math.Cos(lat)*math.Cos(lon), math.Cos(lat)*math.Sin(lon), math.Sin(lat))
+	return normalise(radiansToDegrees(math.Atan2(y, x))), radiansToDegrees(math.Atan2(z, math.Hypot(x, y)))
+}
+
+// rotateX, rotateY and rotateZ turn the frame about an axis by an angle in
+// degrees, anticlockwise seen from the positive end of the axis
+func rotateX(angle float64) Matrix {
+	s, c := math.Sincos(degreesToRadians(angle))
+	return Matrix{{1, 0, 0}, {0, c, s}, {0, -s, c}}
+}
+
+func rotateY(angle float64) Matrix {
+	s, c := math.Sincos(degreesToRadians(angle))
+	return Matrix{{c, 0, -s}, {0, 1, 0}, {s, 0, c}}
+}
+
+func rotateZ(angle float64) Matrix {
+	s, c := math.Sincos(degreesToRadians(angle))
+	return Matrix{{c, s, 0}, {-s, c, 0}, {0, 0, 1}}
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
@@ -0,0 +1,67 @@
+package precession_test
+
+import (
+	"math"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const radian = 180 / math.Pi
+
+func TestNutation(t *testing.T) {
+	// SOFA's test of iauNut00b, 2006 January 1
+	psi, eps := precession.Nutation(2453736.5)
+	assert.InDelta(t, -0.9632552291148362783e-5*radian, psi, 1e-11)
+	assert.InDelta(t, 0.4063197106621159367e-4*radian, eps, 1e-11)
+
+	// Meeus, Astronomical Algorithms, example 22.a, by the IAU 1980 theory
+	psi, eps = precession.Nutation(2446895.5)
+	assert.InDelta(t, -3.788, psi*3600, 0.01)
+	assert.InDelta(t, 9.443, eps*3600, 0.01)
+}
+
+func TestObliquity(t *testing.T) {
+	// SOFA's test of iauObl06, 2007 October 31
+	assert.InDelta(t, 0.4090749229387258204*radian, precession.MeanObliquity(2454388.5), 1e-12)
+	assert.InDelta(t, 84381.406/3600, precession.MeanObliquity(precession.J2000), 1e-12)
+
+	// Meeus, Astronomical Algorithms, example 22.a, the IAU 1980 obliquity
+	// at J2000.0 is 0.042 arcseconds larger
+	assert.InDelta(t, 23.443569-0.042/3600, precession.TrueObliquity(2446895.5), 0.000005)
+}
+
+func TestPrecess(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 21.b, theta Persei, by the IAU
+	// 1976 theory
+	ra, dec := precession.Precess(41.054063, 49.227750, precession.J2000, 2462088.69)
+	assert.InDelta(t, 41.547214, ra, 0.00005)
+	assert.InDelta(t, 49.348483, dec, 0.00005)
+
+	// and back again
+	ra, dec = precession.Precess(ra, dec, 2462088.69, precession.J2000)
+	assert.InDelta(t, 41.054063, ra, 0.0000001)
+	assert.InDelta(t, 49.227750, dec, 0.0000001)
+
+	// Precession composes through any intermediate epoch
+	via := precession.PrecessionMatrix(2433282.42346, precession.J2000).Multiply(precession.PrecessionMatrix(2415020.31352, 2433282.42346))
+	direct := precession.PrecessionMatrix(2415020.31352, precession.J2000)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			assert.InDelta(t, direct[i][j], via[i][j], 1e-14)
+		}
+	}
+}
+
+func TestNutationMatrix(t *testing.T) {
+	// The mean equinox lies on the true ecliptic at the nutation in
+	// longitude from the true equinox
+	jde := 2446895.5
+	psi, _ := precession.Nutation(jde)
+	ra, dec := precession.NutationMatrix(jde).Spherical(0, 0)
+	e := precession.TrueObliquity(jde) / radian
+	assert.InDelta(t, psi*math.Cos(e), ra-360, 1e-9)
+	assert.InDelta(t, psi*math.Sin(e), dec, 1e-9)
+}
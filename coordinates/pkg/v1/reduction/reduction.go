@@ -0,0 +1,230 @@
+// Package reduction turns the positions of a body and the Earth into the
+// geometric, astrometric and apparent places tabulated in almanacs, following
+// the Explanatory Supplement to the Astronomical Almanac, chapter 7, and
+// Kaplan, USNO Circular 179, 2005. Positions are heliocentric, in AU, as
+// rectangular equatorial coordinates referred to the mean equator and
+// equinox of J2000.0. Instants are Julian ephemeris days and angles are in
+// degrees.
+package reduction
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// Kind of place
+type Kind int
+
+const (
+	// Geometric is where the body is at the instant, referred to the
+	// equator and equinox of J2000.0
+	Geometric Kind = iota
+	// Astrometric is where the body was when the light now arriving left it,
+	// referred to the equator and equinox of J2000.0, comparable with star
+	// catalogue positions
+	Astrometric
+	// Apparent is where the body is seen, with the light bent by the sun and
+	// the Earth's motion and referred to the true equator and equinox of
+	// date
+	Apparent
+)
+
+const (
+	// SpeedOfLight in AU a day
+	SpeedOfLight = 173.1446326846693
+	// schwarzschild is twice the sun's gravitational radius, 2GM/c², in AU
+	schwarzschild = 1.97412574336e-8
+	// velocityStep is the interval over which the Earth's velocity is
+	// found, in days
+	velocityStep = 0.01
+)
+
+// Vector is a rectangular position or velocity
+type Vector [3]float64
+
+// Source gives the position of a body at a Julian ephemeris day, the sun is
+// the zero vector
+type Source func(jde float64) (Vector, error)
+
+// Place is the position of a body seen from the centre of the Earth
+type Place struct {
+	// Equatorial and ecliptic coordinates, in degrees
+	RightAscension float64
+	Declination    float64
+	Longitude      float64
+	Latitude       float64
+	// Distance from the Earth, in AU, to where the body was when the light
+	// left it except for geometric places
+	Distance float64
+	// Time taken for the light to reach the Earth, in days
+	LightTime float64
+	// Julian ephemeris day of the equator and equinox the place is referred
+	// to
+	Epoch float64
+}
+
+// Reduce returns the place of a body of the kind at the Julian ephemeris day
+// jde
+func Reduce(kind Kind, body, earth Source, jde float64) (Place, error) {
+	e, err := earth(jde)
+	if err != nil {
+		return Place{}, err
+	}
+	b, err := body(jde)
+	if err != nil {
+		return Place{}, err
+	}
+	p := b.Subtract(e)
+	lightTime := p.Length() / SpeedOfLight
+	if kind == Geometric {
+		return place(p, lightTime, precession.J2000, precession.MeanObliquity(precession.J2000)), nil
+	}
+
+	// The body is seen where it was when the light left it
+	for i := 0; i < 10; i++ {
+		if b, err = body(jde - lightTime); err != nil {
+			return Place{}, err
+		}
+		p = b.Subtract(e)
+		previous := lightTime
+		lightTime = p.Length() / SpeedOfLight
+		if math.Abs(lightTime-previous) < 1e-12 {
+			break
+		}
+	}
+	if kind == Astrometric {
+		return place(p, lightTime, precession.J2000, precession.MeanObliquity(precession.J2000)), nil
+	}
+
+	before, err := earth(jde - velocityStep)
+	if err != nil {
+		return Place{}, err
+	}
+	after, err := earth(jde + velocityStep)
+	if err != nil {
+		return Place{}, err
+	}
+	velocity := after.Subtract(before).Scale(1 / (2 * velocityStep))
+
+	u := p.Unit()
+	if b.Length() > 0 {
+		u = Deflection(u, b.Unit(), e)
+	}
+	u = Aberration(u, velocity)
+	x, y, z := TrueEquator(Astrometric, jde).Apply(u[0], u[1], u[2])
+	return place(Vector{x, y, z}.Scale(p.Length()), lightTime, jde, precession.TrueObliquity(jde)), nil
+}
+
+// TrueEquator returns the rotation from the equator and equinox a place of
+// the kind at jde is referred to onto the true equator and equinox of date
+func TrueEquator(kind Kind, jde float64) precession.Matrix {
+	if kind == Apparent {
+		return precession.Matrix{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}
+	}
+	return precession.NutationMatrix(jde).Multiply(precession.PrecessionMatrix(precession.J2000, jde))
+}
+
+// Deflection bends the direction u of a body from the Earth by the sun's
+// gravity, q is the direction of the body from the sun and e the position of
+// the Earth from the sun
+func Deflection(u, q, e Vector) Vector {
+	distance := e.Length()
+	e = e.Unit()
+	g := schwarzschild / distance / (1 + q.Dot(e))
+	return u.Add(e.Scale(g * u.Dot(q))).Subtract(q.Scale(g * e.Dot(u))).Unit()
+}
+
+// Aberration shifts the direction u of a body towards the Earth's velocity,
+// in AU a day, relativistically
+func Aberration(u, velocity Vector) Vector {
+	beta := velocity.Scale(1 / SpeedOfLight)
+	inverseGamma := math.Sqrt(1 - beta.Dot(beta))
+	f := u.Dot(beta)
+	return u.Scale(inverseGamma).Add(beta.Scale(1 + f/(1+inverseGamma))).Scale(1 / (1 + f)).Unit()
+}
+
+// Ecliptic returns the position of a body given by ecliptic longitude,
+// latitude and distance referred to the mean ecliptic and equinox of the
+// Julian ephemeris day epoch
+func Ecliptic(longitude, latitude, distance, epoch float64) Vector {
+	l := degreesToRadians(longitude)
+	b := degreesToRadians(latitude)
+	s, c := math.Sincos(degreesToRadians(precession.MeanObliquity(epoch)))
+	x, y, z := distance*math.Cos(b)*math.Cos(l), distance*math.Cos(b)*math.Sin(l), distance*math.Sin(b)
+	equatorial := Vector{x, y*c - z*s, y*s + z*c}
+	if epoch == precession.J2000 {
+		return equatorial
+	}
+	x, y, z = precession.PrecessionMatrix(epoch, precession.J2000).Apply(equatorial[0], equatorial[1], equatorial[2])
+	return Vector{x, y, z}
+}
+
+// place gives the spherical coordinates of the position p from the Earth
+// referred to the equator of epoch and the ecliptic of obliquity
+func place(p Vector, lightTime, epoch, obliquity float64) Place {
+	s, c := math.Sincos(degreesToRadians(obliquity))
+	ra, dec := p.spherical()
+	lon, lat := Vector{p[0], p[1]*c + p[2]*s, -p[1]*s + p[2]*c}.spherical()
+	return Place{
+		RightAscension: ra,
+		Declination:    dec,
+		Longitude:      lon,
+		Latitude:       lat,
+		Distance:       p.Length(),
+		LightTime:      lightTime,
+		Epoch:          epoch,
+	}
+}
+
+// Add -
+func (v Vector) Add(w Vector) Vector {
+	return Vector{v[0] + w[0], v[1] + w[1], v[2] + w[2]}
+}
+
+// Subtract -
+func (v Vector) Subtract(w Vector) Vector {
+	return Vector{v[0] - w[0], v[1] - w[1], v[2] - w[2]}
+}
+
+// Scale -
+func (v Vector) Scale(k float64) Vector {
+	return Vector{k * v[0], k * v[1], k * v[2]}
+}
+
+// Dot -
+func (v Vector) Dot(w Vector) float64 {
+	return v[0]*w[0] + v[1]*w[1] + v[2]*w[2]
+}
+
+// Length -
+func (v Vector) Length() float64 {
+	return math.Sqrt(v.Dot(v))
+}
+
+// Unit returns the vector scaled to a length of one
+func (v Vector) Unit() Vector {
+	return v.Scale(1 / v.Length())
+}
+
+// spherical returns the longitude and latitude of the vector in degrees
+func (v Vector) spherical() (float64, float64) {
+	return normalise(radiansToDegrees(math.Atan2(v[1], v[0]))), radiansToDegrees(math.Atan2(v[2], math.Hypot(v[0], v[1])))
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
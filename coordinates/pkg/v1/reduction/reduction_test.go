@@ -0,0 +1,141 @@
+package reduction_test
+
+import (
+	"math"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/reduction"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const arcsecond = 1.0 / 3600
+
+// earth follows a circular orbit of radius 1 AU in the ecliptic of J2000.0,
+// at the longitude 100 degrees at J2000.0
+func earth(jde float64) (reduction.Vector, error) {
+	return reduction.Ecliptic(100+(jde-precession.J2000)*360/365.25636, 0, 1, precession.J2000), nil
+}
+
+func sun(jde float64) (reduction.Vector, error) {
+	return reduction.Vector{}, nil
+}
+
+// separation returns the angle between two directions in arcseconds
+func separation(u, v reduction.Vector) float64 {
+	u, v = u.Unit(), v.Unit()
+	cross := reduction.Vector{u[1]*v[2] - u[2]*v[1], u[2]*v[0] - u[0]*v[2], u[0]*v[1] - u[1]*v[0]}
+	return radiansToDegrees(math.Atan2(cross.Length(), u.Dot(v))) / arcsecond
+}
+
+func TestGeometric(t *testing.T) {
+	mars := func(jde float64) (reduction.Vector, error) {
+		return reduction.Vector{1.5, 0, 0}, nil
+	}
+	p, err := reduction.Reduce(reduction.Geometric, mars, earth, precession.J2000)
+	assert.NoError(t, err)
+	e, _ := earth(precession.J2000)
+	assert.InDelta(t, reduction.Vector{1.5, 0, 0}.Subtract(e).Length(), p.Distance, 1e-12)
+	assert.Equal(t, precession.J2000, p.Epoch)
+}
+
+func TestLightTime(t *testing.T) {
+	// A body 10 AU away moving at 0.01 AU a day across the line of sight is
+	// seen where it was about 0.058 days earlier
+	moving := func(jde float64) (reduction.Vector, error) {
+		return reduction.Vector{0, 0.01 * (jde - precession.J2000), 10}, nil
+	}
+	still := func(jde float64) (reduction.Vector, error) {
+		return reduction.Vector{}, nil
+	}
+	p, err := reduction.Reduce(reduction.Astrometric, moving, still, precession.J2000)
+	assert.NoError(t, err)
+	assert.InDelta(t, 10/reduction.SpeedOfLight, p.LightTime, 1e-9)
+	assert.InDelta(t, 270, p.RightAscension, 1e-9)
+	assert.InDelta(t, radiansToDegrees(math.Atan2(0.01*p.LightTime, 10)), 90-p.Declination, 1e-9)
+}
+
+func TestAberration(t *testing.T) {
+	// The constant of aberration for a body at right angles to the Earth's
+	// motion
+	velocity := reduction.Vector{0, 2 * math.Pi / 365.25636, 0}
+	u := reduction.Aberration(reduction.Vector{0, 0, 1}, velocity)
+	assert.InDelta(t, 20.49, separation(u, reduction.Vector{0, 0, 1}), 0.01)
+	assert.True(t, u[1] > 0)
+
+	// Nothing moves along the direction of motion
+	assert.InDelta(t, 0, separation(reduction.Aberration(reduction.Vector{0, 1, 0}, velocity), reduction.Vector{0, 1, 0}), 1e-6)
+}
+
+func TestDeflection(t *testing.T) {
+	// Starlight grazing the sun is bent by 1.75 arcseconds, away from the sun
+	e := reduction.Vector{1, 0, 0}
+	limb := 959.63 * arcsecond * math.Pi / 180
+	u := reduction.Vector{-math.Cos(limb), math.Sin(limb), 0}
+	q := e.Add(u.Scale(1e9)).Unit()
+	d := reduction.Deflection(u, q, e)
+	assert.InDelta(t, 1.75, separation(u, d), 0.01)
+	assert.True(t, d[1] > u[1])
+
+	// and by about 4 milliarcseconds at right angles to the sun
+	u = reduction.Vector{0, 1, 0}
+	q = e.Add(u.Scale(1e9)).Unit()
+	assert.InDelta(t, 0.0041, separation(u, reduction.Deflection(u, q, e)), 0.0002)
+}
+
+func TestApparentSun(t *testing.T) {
+	// The apparent longitude of the sun is the geometric longitude with the
+	// nutation added and the aberration taken away
+	geometric, err := reduction.Reduce(reduction.Geometric, sun, earth, precession.J2000)
+	assert.NoError(t, err)
+	assert.InDelta(t, 280, geometric.Longitude, 1e-9)
+
+	apparent, err := reduction.Reduce(reduction.Apparent, sun, earth, precession.J2000)
+	assert.NoError(t, err)
+	nutation, _ := precession.Nutation(precession.J2000)
+	assert.InDelta(t, 280+nutation-20.49*arcsecond, apparent.Longitude, 0.02*arcsecond)
+	assert.InDelta(t, 0, apparent.Latitude, 0.01*arcsecond)
+	assert.Equal(t, precession.J2000, apparent.Epoch)
+}
+
+func TestTrueEquator(t *testing.T) {
+	// Apparent places are already referred to the true equator of date
+	jde := precession.J2000 + 3652.5
+	apparent, err := reduction.Reduce(reduction.Apparent, sun, earth, jde)
+	assert.NoError(t, err)
+	ra, dec := reduction.TrueEquator(reduction.Apparent, jde).Spherical(apparent.RightAscension, apparent.Declination)
+	assert.InDelta(t, apparent.RightAscension, ra, 1e-12)
+	assert.InDelta(t, apparent.Declination, dec, 1e-12)
+
+	// and an astrometric place taken there differs from the apparent one by
+	// the aberration alone
+	astrometric, err := reduction.Reduce(reduction.Astrometric, sun, earth, jde)
+	assert.NoError(t, err)
+	ra, dec = reduction.TrueEquator(reduction.Astrometric, jde).Spherical(astrometric.RightAscension, astrometric.Declination)
+	assert.InDelta(t, 20.49, separation(direction(ra, dec), direction(apparent.RightAscension, apparent.Declination)), 0.01)
+}
+
+func TestEcliptic(t *testing.T) {
+	// The pole of the ecliptic of J2000.0
+	v := reduction.Ecliptic(0, 90, 1, precession.J2000)
+	assert.InDelta(t, 0, v[0], 1e-12)
+	assert.InDelta(t, 90-precession.MeanObliquity(precession.J2000), radiansToDegrees(math.Asin(v[2])), 1e-9)
+
+	// A position of date is taken back to J2000.0
+	jde := precession.J2000 + 36525
+	v = reduction.Ecliptic(30, 0, 1, jde)
+	assert.InDelta(t, 1, v.Length(), 1e-12)
+	assert.True(t, separation(v, reduction.Ecliptic(30, 0, 1, precession.J2000)) > 4000)
+}
+
+// direction returns the unit vector towards a right ascension and
+// declination in degrees
+func direction(ra, dec float64) reduction.Vector {
+	a, d := ra*math.Pi/180, dec*math.Pi/180
+	return reduction.Vector{math.Cos(d) * math.Cos(a), math.Cos(d) * math.Sin(a), math.Sin(d)}
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
@@ -0,0 +1,164 @@
+// Package refraction models the bending of light by the atmosphere, which
+// lifts a body above its true altitude, Meeus, Astronomical Algorithms,
+// chapter 16. Altitudes are in degrees.
+package refraction
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// Model -
+type Model int
+
+const (
+	// Saemundsson gives the refraction from the true altitude, Sky and
+	// Telescope 72, 70, 1986
+	Saemundsson Model = iota
+	// Bennett gives the refraction from the apparent altitude, Journal of
+	// Navigation 35, 255, 1982
+	Bennett
+)
+
+// ParseModel reads the name of a model, Saemundsson when empty
+func ParseModel(name string) (Model, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "saemundsson":
+		return Saemundsson, nil
+	case "bennett":
+		return Bennett, nil
+	}
+	return 0, fmt.Errorf("unknown refraction model %q, the models are bennett and saemundsson", name)
+}
+
+// Atmosphere is the air at the observer
+type Atmosphere struct {
+	// Temperature in degrees Celsius
+	Temperature float64
+	// Pressure in hPa, or millibars
+	Pressure float64
+}
+
+// Standard is the atmosphere both models are fitted to
+var Standard = Atmosphere{Temperature: 10, Pressure: 1010}
+
+// ConventionalHorizon is the refraction at the horizon by convention, in
+// degrees
+const ConventionalHorizon = 34.0 / 60
+
+// Conventional reports whether a request gives neither a model nor any of
+// the air at the observer, when the refraction at the horizon is taken to be
+// ConventionalHorizon
+func Conventional(model string, temperature, pressure *wrappers.DoubleValue) bool {
+	return model == "" && temperature == nil && pressure == nil
+}
+
+// AtHorizon returns the refraction at the horizon for a request,
+// ConventionalHorizon when it gives no model or conditions
+func AtHorizon(model string, temperature, pressure *wrappers.DoubleValue) (float64, error) {
+	if Conventional(model, temperature, pressure) {
+		return ConventionalHorizon, nil
+	}
+	m, air, err := Conditions(model, temperature, pressure)
+	if err != nil {
+		return 0, err
+	}
+	return m.Horizon(air), nil
+}
+
+// Conditions reads the model and the air at the observer from a request,
+// the temperature and pressure are those of the standard atmosphere when not
+// given
+func Conditions(model string, temperature, pressure *wrappers.DoubleValue) (Model, Atmosphere, error) {
+	m, err := ParseModel(model)
+	if err != nil {
+		return m, Atmosphere{}, err
+	}
+	air := Standard
+	if temperature != nil {
+		if temperature.Value < -90 || temperature.Value > 60 {
+			return m, Atmosphere{}, fmt.Errorf("temperature must be between -90 and 60 degrees Celsius")
+		}
+		air.Temperature = temperature.Value
+	}
+	if pressure != nil {
+		if pressure.Value < 0 || pressure.Value > 1100 {
+			return m, Atmosphere{}, fmt.Errorf("pressure must be between 0 and 1100 hPa")
+		}
+		air.Pressure = pressure.Value
+	}
+	return m, air, nil
+}
+
+// lowest is the altitude below which the models are not used, they diverge a
+// few degrees below the horizon where there is nothing to see
+const lowest = -1.0
+
+// factor scales the refraction in the standard atmosphere to the density of
+// the air at the observer
+func (a Atmosphere) factor() float64 {
+	return a.Pressure / 1010 * 283 / (273 + a.Temperature)
+}
+
+// FromTrue returns the refraction of a body at a true altitude
+func (m Model) FromTrue(altitude float64, a Atmosphere) float64 {
+	if m == Saemundsson {
+		return saemundsson(math.Max(altitude, lowest)) * a.factor()
+	}
+	// The apparent altitude is found by iteration, the refraction changes
+	// slowly with altitude
+	apparent := altitude
+	for i := 0; i < 20; i++ {
+		apparent = altitude + m.FromApparent(apparent, a)
+	}
+	return apparent - altitude
+}
+
+// FromApparent returns the refraction of a body seen at an apparent altitude
+func (m Model) FromApparent(altitude float64, a Atmosphere) float64 {
+	if m == Bennett {
+		return bennett(math.Max(altitude, lowest)) * a.factor()
+	}
+	trueAltitude := altitude
+	for i := 0; i < 20; i++ {
+		trueAltitude = altitude - m.FromTrue(trueAltitude, a)
+	}
+	return altitude - trueAltitude
+}
+
+// Apparent returns the apparent altitude of a body at a true altitude
+func (m Model) Apparent(altitude float64, a Atmosphere) float64 {
+	return altitude + m.FromTrue(altitude, a)
+}
+
+// True returns the true altitude of a body seen at an apparent altitude
+func (m Model) True(altitude float64, a Atmosphere) float64 {
+	return altitude - m.FromApparent(altitude, a)
+}
+
+// Horizon returns the refraction of a body on the horizon, the depth below
+// it of a body that appears to touch it
+func (m Model) Horizon(a Atmosphere) float64 {
+	return m.FromApparent(0, a)
+}
+
+// bennett is Meeus, Astronomical Algorithms, equation 16.3, adjusted to
+// vanish at the zenith
+func bennett(apparent float64) float64 {
+	r := 1 / math.Tan(degreesToRadians(apparent+7.31/(apparent+4.4)))
+	return (r + 0.0013515) / 60
+}
+
+// saemundsson is Meeus, Astronomical Algorithms, equation 16.4, adjusted to
+// vanish at the zenith
+func saemundsson(altitude float64) float64 {
+	r := 1.02 / math.Tan(degreesToRadians(altitude+10.3/(altitude+5.11)))
+	return (r + 0.0019279) / 60
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
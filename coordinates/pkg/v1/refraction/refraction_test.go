@@ -0,0 +1,97 @@
+package refraction_test
+
+import (
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/refraction"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+)
+
+const arcminute = 1.0 / 60
+
+func TestBennett(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 16.a, the limb of the sun seen
+	// at an apparent altitude of half a degree
+	r := refraction.Bennett.FromApparent(0.5, refraction.Standard)
+	assert.InDelta(t, 28.754*arcminute, r, 0.002*arcminute)
+
+	// There is no refraction at the zenith
+	assert.InDelta(t, 0, refraction.Bennett.FromApparent(90, refraction.Standard), 1e-9)
+	assert.InDelta(t, 34.5*arcminute, refraction.Bennett.Horizon(refraction.Standard), 0.1*arcminute)
+}
+
+func TestSaemundsson(t *testing.T) {
+	// The true altitude of example 16.a gives back the refraction
+	r := refraction.Saemundsson.FromTrue(0.5-28.754*arcminute, refraction.Standard)
+	assert.InDelta(t, 28.754*arcminute, r, 0.1*arcminute)
+
+	assert.InDelta(t, 0, refraction.Saemundsson.FromTrue(90, refraction.Standard), 1e-9)
+}
+
+func TestInverse(t *testing.T) {
+	for _, m := range []refraction.Model{refraction.Bennett, refraction.Saemundsson} {
+		for _, h := range []float64{-0.5, 0, 1, 5, 20, 45, 80} {
+			assert.InDelta(t, h, m.True(m.Apparent(h, refraction.Standard), refraction.Standard), 1e-7)
+		}
+	}
+}
+
+func TestAtmosphere(t *testing.T) {
+	// Refraction goes with the density of the air, colder and denser air
+	// bends light more
+	standard := refraction.Saemundsson.Horizon(refraction.Standard)
+	cold := refraction.Saemundsson.Horizon(refraction.Atmosphere{Temperature: -20, Pressure: 1030})
+	assert.True(t, cold > standard)
+
+	thin := refraction.Atmosphere{Temperature: 10, Pressure: 505}
+	assert.InDelta(t, refraction.Saemundsson.FromTrue(1, refraction.Standard)/2, refraction.Saemundsson.FromTrue(1, thin), 1e-12)
+	assert.InDelta(t, refraction.Bennett.FromApparent(1, refraction.Standard)/2, refraction.Bennett.FromApparent(1, thin), 1e-12)
+}
+
+func TestParseModel(t *testing.T) {
+	m, err := refraction.ParseModel("")
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Saemundsson, m)
+
+	m, err = refraction.ParseModel("Bennett")
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Bennett, m)
+
+	_, err = refraction.ParseModel("snell")
+	assert.Error(t, err)
+}
+
+func TestConditions(t *testing.T) {
+	assert.True(t, refraction.Conventional("", nil, nil))
+	assert.False(t, refraction.Conventional("", &wrappers.DoubleValue{}, nil))
+
+	// A temperature of zero is freezing air, not a missing one
+	m, air, err := refraction.Conditions("", &wrappers.DoubleValue{Value: 0}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Saemundsson, m)
+	assert.Equal(t, refraction.Atmosphere{Temperature: 0, Pressure: 1010}, air)
+
+	_, air, err = refraction.Conditions("bennett", nil, &wrappers.DoubleValue{Value: 850})
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Atmosphere{Temperature: 10, Pressure: 850}, air)
+
+	_, air, err = refraction.Conditions("", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Standard, air)
+
+	_, _, err = refraction.Conditions("", &wrappers.DoubleValue{Value: 80}, nil)
+	assert.Error(t, err)
+	_, _, err = refraction.Conditions("", nil, &wrappers.DoubleValue{Value: -1})
+	assert.Error(t, err)
+	_, _, err = refraction.Conditions("snell", nil, nil)
+	assert.Error(t, err)
+
+	r, err := refraction.AtHorizon("", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.ConventionalHorizon, r)
+	r, err = refraction.AtHorizon("bennett", nil, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, refraction.Bennett.Horizon(refraction.Standard), r)
+}
@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/coordinates/grpc/v1"
+	"planetpositions/coordinates/pkg/v1/constellation"
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/transform"
+)
+
+func (s *coordinatesServiceServer) GetConstellation(ctx context.Context, req *v1.ConstellationRequest) (*v1.Constellation, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if req.Declination < -90 || req.Declination > 90 {
+		return nil, fmt.Errorf("unusable input provided: declination must be between -90 and 90")
+	}
+	epoch, err := transform.ParseEpoch(req.Epoch)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	var jd float64
+	if epoch.OfDate {
+		if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		if jd, err = s.julianDate(req.Year, req.Month, req.Day, req.Hour); err != nil {
+			return nil, err
+		}
+	}
+
+	ra, dec := precession.Precess(req.RightAscension, req.Declination, epoch.At(jd), constellation.B1875)
+	c := constellation.FindB1875(ra, dec)
+	return &v1.Constellation{
+		Api:                 apiVersion,
+		Abbreviation:        c.Abbreviation,
+		Name:                c.Name,
+		RightAscensionB1875: ra,
+		DeclinationB1875:    dec,
+	}, nil
+}
@@ -0,0 +1,144 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/coordinates/grpc/v1"
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/transform"
+	jc "planetpositions/julian/pkg/v1/client"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+)
+
+// coordinatesServiceServer is implementation of v1.CoordinatesServiceServer proto interface
+type coordinatesServiceServer struct {
+	jc.JulianClient
+}
+
+// NewCoordinatesService creates Coordinates service
+func NewCoordinatesService() v1.CoordinatesServiceServer {
+	s := coordinatesServiceServer{}
+	s.Address = "julian:5055"
+	return &s
+}
+
+func (s *coordinatesServiceServer) Transform(ctx context.Context, req *v1.TransformRequest) (*v1.TransformedCoordinates, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	for _, frame := range []v1.CoordinateFrame{req.From, req.To} {
+		if frame == v1.CoordinateFrame_COORDINATE_FRAME_UNSPECIFIED {
+			return nil, fmt.Errorf("unusable input provided: no frame given")
+		}
+		if _, ok := v1.CoordinateFrame_name[int32(frame)]; !ok {
+			return nil, fmt.Errorf("unusable input provided: unknown frame %v", frame)
+		}
+	}
+	if req.Second < -90 || req.Second > 90 {
+		return nil, fmt.Errorf("unusable input provided: the latitude, declination or altitude must be between -90 and 90")
+	}
+	fromEpoch, err := transform.ParseEpoch(req.FromEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	toEpoch, err := transform.ParseEpoch(req.ToEpoch)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	transformed := &v1.TransformedCoordinates{
+		Api:   apiVersion,
+		Frame: req.To,
+	}
+	// The instant of observation is only needed by the frames that turn
+	// with the earth and by epochs of date
+	var jd float64
+	if needsInstant(req.From, fromEpoch) || needsInstant(req.To, toEpoch) {
+		if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		if req.Latitude < -90 || req.Latitude > 90 {
+			return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+		}
+		if jd, err = s.julianDate(req.Year, req.Month, req.Day, req.Hour); err != nil {
+			return nil, err
+		}
+		transformed.JulianDate = jd
+	}
+
+	// Everything passes through the equator, precessed from the epoch of
+	// the first frame to that of the second. The difference between
+	// universal and dynamical time changes the precession by a few
+	// microarcseconds, so jd serves for both.
+	var ra, dec, from float64
+	switch req.From {
+	case v1.CoordinateFrame_EQUATORIAL:
+		from = fromEpoch.At(jd)
+		ra, dec = req.First, req.Second
+	case v1.CoordinateFrame_ECLIPTIC:
+		from = fromEpoch.At(jd)
+		ra, dec = transform.EclipticToEquatorial(req.First, req.Second, precession.MeanObliquity(from))
+	case v1.CoordinateFrame_GALACTIC:
+		from = transform.J2000
+		ra, dec = transform.GalacticToEquatorial(req.First, req.Second)
+	case v1.CoordinateFrame_HOUR_ANGLE:
+		from = jd
+		ra, dec = transform.RightAscension(req.First, req.Longitude, jd), req.Second
+	case v1.CoordinateFrame_HORIZONTAL:
+		from = jd
+		h, d := transform.HorizontalToEquatorial(req.First, req.Second, req.Latitude)
+		ra, dec = transform.RightAscension(h, req.Longitude, jd), d
+	}
+
+	switch req.To {
+	case v1.CoordinateFrame_EQUATORIAL:
+		transformed.First, transformed.Second = transform.Precess(ra, dec, from, toEpoch.At(jd))
+		transformed.Epoch = toEpoch.Name
+	case v1.CoordinateFrame_ECLIPTIC:
+		to := toEpoch.At(jd)
+		ra, dec = transform.Precess(ra, dec, from, to)
+		transformed.First, transformed.Second = transform.EquatorialToEcliptic(ra, dec, precession.MeanObliquity(to))
+		transformed.Epoch = toEpoch.Name
+	case v1.CoordinateFrame_GALACTIC:
+		ra, dec = transform.Precess(ra, dec, from, transform.J2000)
+		transformed.First, transformed.Second = transform.EquatorialToGalactic(ra, dec)
+	case v1.CoordinateFrame_HOUR_ANGLE:
+		ra, dec = transform.Precess(ra, dec, from, jd)
+		transformed.First, transformed.Second = transform.HourAngle(ra, req.Longitude, jd), dec
+		transformed.Epoch = "date"
+	case v1.CoordinateFrame_HORIZONTAL:
+		ra, dec = transform.Precess(ra, dec, from, jd)
+		transformed.First, transformed.Second = transform.EquatorialToHorizontal(transform.HourAngle(ra, req.Longitude, jd), dec, req.Latitude)
+		transformed.Epoch = "date"
+	}
+	return transformed, nil
+}
+
+// needsInstant tells whether coordinates in the frame need the instant of
+// observation
+func needsInstant(frame v1.CoordinateFrame, epoch transform.Epoch) bool {
+	switch frame {
+	case v1.CoordinateFrame_HOUR_ANGLE, v1.CoordinateFrame_HORIZONTAL:
+		return true
+	case v1.CoordinateFrame_EQUATORIAL, v1.CoordinateFrame_ECLIPTIC:
+		return epoch.OfDate
+	}
+	return false
+}
+
+// julianDate -
+func (s *coordinatesServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
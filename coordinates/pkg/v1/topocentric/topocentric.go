@@ -0,0 +1,111 @@
+// Package topocentric moves geocentric positions to an observer on the
+// surface of the Earth, modelled as the WGS84 ellipsoid, Meeus, Astronomical
+// Algorithms, chapters 11 and 40. Angles are in degrees, longitudes are
+// positive east of Greenwich and distances are in km.
+package topocentric
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// The WGS84 ellipsoid
+const (
+	// EquatorialRadius of the Earth in km
+	EquatorialRadius = 6378.137
+	// Flattening of the Earth at the poles
+	Flattening = 1 / 298.257223563
+)
+
+// AstronomicalUnit in km, for bodies whose distance is given in AU
+const AstronomicalUnit = 149597870.7
+
+// Observer is a place on the Earth
+type Observer struct {
+	// Geodetic latitude and longitude, in degrees
+	Latitude  float64
+	Longitude float64
+	// Height above the ellipsoid, in metres
+	Height float64
+	// Distance from the plane of the equator and from the axis of the Earth,
+	// ρ sin φ' and ρ cos φ', in equatorial radii
+	RhoSin float64
+	RhoCos float64
+}
+
+// NewObserver places an observer at a geodetic latitude and longitude, and
+// height in metres
+func NewObserver(latitude, longitude, height float64) Observer {
+	lat := degreesToRadians(latitude)
+	u := math.Atan((1 - Flattening) * math.Tan(lat))
+	h := height / 1000 / EquatorialRadius
+	return Observer{
+		Latitude:  latitude,
+		Longitude: longitude,
+		Height:    height,
+		RhoSin:    (1-Flattening)*math.Sin(u) + h*math.Sin(lat),
+		RhoCos:    math.Cos(u) + h*math.Cos(lat),
+	}
+}
+
+// HourAngle moves a geocentric hour angle, declination and distance to those
+// seen by the observer
+func (o Observer) HourAngle(hourAngle, declination, distance float64) (float64, float64, float64) {
+	h := degreesToRadians(hourAngle)
+	dec := degreesToRadians(declination)
+	r := distance / EquatorialRadius
+
+	// Rectangular coordinates in equatorial radii with the x axis on the
+	// observer's meridian, from which the observer is taken away
+	x := r*math.Cos(dec)*math.Cos(h) - o.RhoCos
+	y := r * math.Cos(dec) * math.Sin(h)
+	z := r*math.Sin(dec) - o.RhoSin
+
+	rho := math.Sqrt(x*x + y*y + z*z)
+	return normalise(radiansToDegrees(math.Atan2(y, x))), radiansToDegrees(math.Asin(z / rho)), rho * EquatorialRadius
+}
+
+// Equatorial moves a geocentric right ascension, declination and distance to
+// those seen by the observer when the sidereal time at Greenwich is
+// siderealTime, in degrees
+func (o Observer) Equatorial(rightAscension, declination, distance, siderealTime float64) (float64, float64, float64) {
+	local := siderealTime + o.Longitude
+	h, dec, rho := o.HourAngle(normalise(local-rightAscension), declination, distance)
+	return normalise(local - h), dec, rho
+}
+
+// EquatorialInFrame moves a geocentric right ascension, declination and
+// distance referred to any equator and equinox to those seen by the observer,
+// referred to the same equator and equinox. toTrue rotates them onto the true
+// equator and equinox of date, on which siderealTime, the apparent sidereal
+// time at Greenwich, is measured
+func (o Observer) EquatorialInFrame(rightAscension, declination, distance, siderealTime float64, toTrue precession.Matrix) (float64, float64, float64) {
+	ra, dec := toTrue.Spherical(rightAscension, declination)
+	ra, dec, rho := o.Equatorial(ra, dec, distance, siderealTime)
+	ra, dec = toTrue.Transpose().Spherical(ra, dec)
+	return ra, dec, rho
+}
+
+// HorizontalParallax returns the equatorial horizontal parallax of a body at
+// distance km from the centre of the Earth
+func HorizontalParallax(distance float64) float64 {
+	return radiansToDegrees(math.Asin(EquatorialRadius / distance))
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
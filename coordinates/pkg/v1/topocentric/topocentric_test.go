@@ -0,0 +1,70 @@
+package topocentric_test
+
+import (
+	"math"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// Palomar Observatory, Meeus, Astronomical Algorithms, example 11.a
+var palomar = topocentric.NewObserver(33+21.0/60+22.0/3600, -(7+47.0/60+27.0/3600)*15, 1706)
+
+func TestObserver(t *testing.T) {
+	assert.InDelta(t, 0.546861, palomar.RhoSin, 0.000001)
+	assert.InDelta(t, 0.836339, palomar.RhoCos, 0.000001)
+
+	// The centre of the Earth is an equatorial radius below the equator
+	o := topocentric.NewObserver(0, 0, 0)
+	assert.InDelta(t, 1, o.RhoCos, 1e-12)
+	assert.InDelta(t, 0, o.RhoSin, 1e-12)
+}
+
+func TestEquatorial(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 40.a, Mars from Palomar at an
+	// hour angle of 288.7958 degrees
+	distance := 0.37276 * topocentric.AstronomicalUnit
+	h, dec, _ := palomar.HourAngle(288.7958, -15.771083, distance)
+	assert.InDelta(t, 288.7958-0.005375, h, 0.00003)
+	assert.InDelta(t, -15.775, dec, 0.00003)
+
+	siderealTime := 288.7958 + 339.530208 - palomar.Longitude
+	ra, dec, rho := palomar.Equatorial(339.530208, -15.771083, distance, siderealTime)
+	assert.InDelta(t, 339.535583, ra, 0.00003)
+	assert.InDelta(t, -15.775, dec, 0.00003)
+	// Mars is up in the east, a little nearer Palomar than the centre of the
+	// Earth
+	assert.True(t, rho < distance)
+	assert.True(t, rho > distance-topocentric.EquatorialRadius)
+}
+
+func TestEquatorialInFrame(t *testing.T) {
+	// Example 40.a again with Mars referred to the equator and equinox of
+	// J2000.0, the observer's place comes back in the same frame
+	jde := 2452879.63681
+	toTrue := precession.NutationMatrix(jde).Multiply(precession.PrecessionMatrix(precession.J2000, jde))
+	ra, dec := toTrue.Transpose().Spherical(339.530208, -15.771083)
+	distance := 0.37276 * topocentric.AstronomicalUnit
+	siderealTime := 288.7958 + 339.530208 - palomar.Longitude
+	ra, dec, _ = palomar.EquatorialInFrame(ra, dec, distance, siderealTime, toTrue)
+	ra, dec = toTrue.Spherical(ra, dec)
+	assert.InDelta(t, 339.535583, ra, 0.00003)
+	assert.InDelta(t, -15.775, dec, 0.00003)
+}
+
+func TestMoon(t *testing.T) {
+	// The moon overhead is an Earth radius nearer and its position unchanged
+	o := topocentric.NewObserver(0, 0, 0)
+	h, dec, rho := o.HourAngle(0, 0, 384400)
+	assert.InDelta(t, 0, h, 1e-9)
+	assert.InDelta(t, 0, dec, 1e-9)
+	assert.InDelta(t, 384400-topocentric.EquatorialRadius, rho, 1e-6)
+
+	// and over the pole it is lowered by the angle the Earth's radius
+	// subtends from there
+	_, dec, _ = o.HourAngle(0, 90, 384400)
+	assert.InDelta(t, 90-math.Atan(topocentric.EquatorialRadius/384400)*180/math.Pi, dec, 1e-9)
+}
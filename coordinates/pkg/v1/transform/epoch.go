@@ -0,0 +1,69 @@
+package transform
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// Epoch names the mean equator and equinox coordinates are referred to
+type Epoch struct {
+	// Name as written, J2000.0, B1950.0 or date
+	Name string
+	// OfDate is set for the equinox of the instant of observation, JDE is
+	// then unset
+	OfDate bool
+	// Julian ephemeris day of the epoch
+	JDE float64
+}
+
+// ParseEpoch reads a Julian epoch such as J2000 or J2024.5, a Besselian
+// epoch such as B1950, or "date" for the equinox of the instant of
+// observation. An empty name is J2000.0.
+func ParseEpoch(name string) (Epoch, error) {
+	name = strings.TrimSpace(name)
+	switch {
+	case name == "":
+		return Epoch{Name: "J2000.0", JDE: J2000}, nil
+	case strings.EqualFold(name, "date"):
+		return Epoch{Name: "date", OfDate: true}, nil
+	}
+	year, err := strconv.ParseFloat(name[1:], 64)
+	if err != nil || year < -4000 || year > 8000 {
+		return Epoch{}, fmt.Errorf("malformed epoch %q", name)
+	}
+	switch name[0] {
+	case 'J', 'j':
+		return Epoch{Name: "J" + epochYear(year), JDE: J2000 + (year-2000)*365.25}, nil
+	case 'B', 'b':
+		// Lieske 1979, the Besselian year is the tropical year of 1900
+		return Epoch{Name: "B" + epochYear(year), JDE: 2415020.31352 + (year-1900)*365.242198781}, nil
+	}
+	return Epoch{}, fmt.Errorf("malformed epoch %q, epochs start with J or B, or are \"date\"", name)
+}
+
+// epochYear writes the year of an epoch with at least one decimal place
+func epochYear(year float64) string {
+	y := strconv.FormatFloat(year, 'f', -1, 64)
+	if !strings.Contains(y, ".") {
+		y += ".0"
+	}
+	return y
+}
+
+// At resolves an epoch of date to the instant jde
+func (e Epoch) At(jde float64) float64 {
+	if e.OfDate {
+		return jde
+	}
+	return e.JDE
+}
+
+// Precess takes a right ascension and declination referred to the mean
+// equinox of the Julian ephemeris day from to that of the day to, by the
+// IAU 2006 precession
+func Precess(rightAscension, declination, from, to float64) (float64, float64) {
+	return precession.Precess(rightAscension, declination, from, to)
+}
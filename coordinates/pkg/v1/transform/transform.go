@@ -0,0 +1,150 @@
+// Package transform converts spherical coordinates between the equatorial,
+// ecliptic, horizontal and galactic frames, Meeus, Astronomical Algorithms,
+// chapters 12 and 13, and precesses them by the IAU 2006 model of the
+// precession package. Angles are in degrees throughout, longitudes and right
+// ascensions are returned in the range 0 to 360 degrees, and azimuths are
+// measured clockwise from north.
+package transform
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// J2000 is the Julian ephemeris day of the standard epoch J2000.0
+const J2000 = precession.J2000
+
+// The north galactic pole and the galactic longitude of the north celestial
+// pole referred to the equinox of J2000.0, from the Hipparcos catalogue's
+// rotation of the IAU 1958 system
+const (
+	galacticPoleRA  = 192.85948
+	galacticPoleDec = 27.12825
+	celestialPoleL  = 122.93192
+)
+
+// GreenwichSiderealTime returns the mean sidereal time at Greenwich for the
+// Julian day jd in universal time, Meeus, Astronomical Algorithms, equation
+// 12.4
+func GreenwichSiderealTime(jd float64) float64 {
+	t := (jd - J2000) / 36525
+	return normalise(280.46061837 + 360.98564736629*(jd-J2000) + t*t*(0.000387933-t/38710000))
+}
+
+// ApparentSiderealTime returns the sidereal time at Greenwich for the Julian
+// day jd in universal time measured on the true equator of date, the mean
+// sidereal time corrected by the equation of the equinoxes, jde is the
+// instant jd in dynamical time
+func ApparentSiderealTime(jd, jde float64) float64 {
+	longitude, _ := precession.Nutation(jde)
+	return normalise(GreenwichSiderealTime(jd) + longitude*math.Cos(degreesToRadians(precession.TrueObliquity(jde))))
+}
+
+// EclipticToEquatorial converts ecliptic longitude and latitude to right
+// ascension and declination for the obliquity of the ecliptic, equations
+// 13.3 and 13.4
+func EclipticToEquatorial(longitude, latitude, obliquity float64) (rightAscension, declination float64) {
+	lambda := degreesToRadians(longitude)
+	beta := degreesToRadians(latitude)
+	e := degreesToRadians(obliquity)
+
+	ra := math.Atan2(math.Sin(lambda)*math.Cos(e)-math.Tan(beta)*math.Sin(e), math.Cos(lambda))
+	dec := math.Asin(math.Sin(beta)*math.Cos(e) + math.Cos(beta)*math.Sin(e)*math.Sin(lambda))
+	return normalise(radiansToDegrees(ra)), radiansToDegrees(dec)
+}
+
+// EquatorialToEcliptic converts right ascension and declination to ecliptic
+// longitude and latitude for the obliquity of the ecliptic, equations 13.1
+// and 13.2
+func EquatorialToEcliptic(rightAscension, declination, obliquity float64) (longitude, latitude float64) {
+	alpha := degreesToRadians(rightAscension)
+	delta := degreesToRadians(declination)
+	e := degreesToRadians(obliquity)
+
+	lambda := math.Atan2(math.Sin(alpha)*math.Cos(e)+math.Tan(delta)*math.Sin(e), math.Cos(alpha))
+	beta := math.Asin(math.Sin(delta)*math.Cos(e) - math.Cos(delta)*math.Sin(e)*math.Sin(alpha))
+	return normalise(radiansToDegrees(lambda)), radiansToDegrees(beta)
+}
+
+// HourAngle returns the local hour angle of a right ascension for an
+// observer at longitude, positive east of Greenwich, at the Julian day jd in
+// universal time
+func HourAngle(rightAscension, longitude, jd float64) float64 {
+	return normalise(GreenwichSiderealTime(jd) + longitude - rightAscension)
+}
+
+// RightAscension is the inverse of HourAngle
+func RightAscension(hourAngle, longitude, jd float64) float64 {
+	return normalise(GreenwichSiderealTime(jd) + longitude - hourAngle)
+}
+
+// EquatorialToHorizontal converts local hour angle and declination to
+// azimuth and altitude for an observer at latitude, equations 13.5 and 13.6
+func EquatorialToHorizontal(hourAngle, declination, latitude float64) (azimuth, altitude float64) {
+	h := degreesToRadians(hourAngle)
+	dec := degreesToRadians(declination)
+	lat := degreesToRadians(latitude)
+
+	az := math.Atan2(math.Sin(h), math.Cos(h)*math.Sin(lat)-math.Tan(dec)*math.Cos(lat))
+	alt := math.Asin(math.Sin(lat)*math.Sin(dec) + math.Cos(lat)*math.Cos(dec)*math.Cos(h))
+	// Meeus measures azimuth westward from south
+	return normalise(radiansToDegrees(az) + 180), radiansToDegrees(alt)
+}
+
+// HorizontalToEquatorial converts azimuth and altitude to local hour angle
+// and declination for an observer at latitude
+func HorizontalToEquatorial(azimuth, altitude, latitude float64) (hourAngle, declination float64) {
+	a := degreesToRadians(azimuth - 180)
+	alt := degreesToRadians(altitude)
+	lat := degreesToRadians(latitude)
+
+	h := math.Atan2(math.Sin(a), math.Cos(a)*math.Sin(lat)+math.Tan(alt)*math.Cos(lat))
+	dec := math.Asin(math.Sin(lat)*math.Sin(alt) - math.Cos(lat)*math.Cos(alt)*math.Cos(a))
+	return normalise(radiansToDegrees(h)), radiansToDegrees(dec)
+}
+
+// EquatorialToGalactic converts right ascension and declination referred to
+// the equinox of J2000.0 to galactic longitude and latitude
+func EquatorialToGalactic(rightAscension, declination float64) (longitude, latitude float64) {
+	x, b := aboutPole(rightAscension-galacticPoleRA, declination)
+	return normalise(celestialPoleL - x), b
+}
+
+// GalacticToEquatorial converts galactic longitude and latitude to right
+// ascension and declination referred to the equinox of J2000.0
+func GalacticToEquatorial(longitude, latitude float64) (rightAscension, declination float64) {
+	x, dec := aboutPole(celestialPoleL-longitude, latitude)
+	return normalise(galacticPoleRA + x), dec
+}
+
+// aboutPole turns a position through the rotation between the equatorial
+// and galactic frames, which is its own inverse, angle is measured from the
+// pole of the other frame, Meeus, Astronomical Algorithms, equations 13.7
+// and 13.8
+func aboutPole(angle, latitude float64) (float64, float64) {
+	a := degreesToRadians(angle)
+	b := degreesToRadians(latitude)
+	p := degreesToRadians(galacticPoleDec)
+
+	x := math.Atan2(math.Cos(b)*math.Sin(a), math.Sin(b)*math.Cos(p)-math.Cos(b)*math.Sin(p)*math.Cos(a))
+	l := math.Asin(math.Sin(b)*math.Sin(p) + math.Cos(b)*math.Cos(p)*math.Cos(a))
+	return radiansToDegrees(x), radiansToDegrees(l)
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
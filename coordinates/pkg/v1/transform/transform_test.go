@@ -0,0 +1,104 @@
+package transform_test
+
+import (
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/transform"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEcliptic(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 13.a, Pollux
+	l, b := transform.EquatorialToEcliptic(116.328942, 28.026183, 23.4392911)
+	assert.InDelta(t, 113.215630, l, 0.000001)
+	assert.InDelta(t, 6.684170, b, 0.000001)
+
+	ra, dec := transform.EclipticToEquatorial(l, b, 23.4392911)
+	assert.InDelta(t, 116.328942, ra, 0.000001)
+	assert.InDelta(t, 28.026183, dec, 0.000001)
+}
+
+func TestHorizontal(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 13.b, Venus from the US Naval
+	// Observatory, the azimuth of 68.0337 degrees is measured from south
+	az, alt := transform.EquatorialToHorizontal(64.352133, -6.719892, 38.921389)
+	assert.InDelta(t, 248.0337, az, 0.0001)
+	assert.InDelta(t, 15.1249, alt, 0.0001)
+
+	h, dec := transform.HorizontalToEquatorial(az, alt, 38.921389)
+	assert.InDelta(t, 64.352133, h, 0.000001)
+	assert.InDelta(t, -6.719892, dec, 0.000001)
+
+	// The hour angle runs backwards to the right ascension
+	jd := 2446896.30625
+	assert.InDelta(t, 347.3193, transform.RightAscension(h, -77.065556, jd), 0.001)
+}
+
+func TestSiderealTime(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 12.a, 1987 April 10 at 0h UT,
+	// 13h10m46.3668s mean and 13h10m46.1351s apparent
+	jd := 2446895.5
+	assert.InDelta(t, 197.693195, transform.GreenwichSiderealTime(jd), 0.000001)
+	assert.InDelta(t, 197.692229, transform.ApparentSiderealTime(jd, jd+55.9/86400), 0.00001)
+}
+
+func TestGalactic(t *testing.T) {
+	// The galactic centre and north galactic pole, IAU 1958 system in the
+	// J2000.0 frame
+	l, b := transform.EquatorialToGalactic(266.40500, -28.93617)
+	assert.InDelta(t, 0, l, 0.001)
+	assert.InDelta(t, 0, b, 0.001)
+	_, b = transform.EquatorialToGalactic(192.85948, 27.12825)
+	assert.InDelta(t, 90, b, 0.000001)
+
+	ra, dec := transform.GalacticToEquatorial(120, -30)
+	l, b = transform.EquatorialToGalactic(ra, dec)
+	assert.InDelta(t, 120, l, 0.000001)
+	assert.InDelta(t, -30, b, 0.000001)
+}
+
+func TestPrecess(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 21.b, theta Persei with its
+	// proper motion applied. Meeus precesses by IAU 1976, the IAU 2006
+	// precession differs by about 0.1 arcseconds over the 28 years.
+	ra, dec := transform.Precess(41.054063, 49.227750, transform.J2000, 2462088.69)
+	assert.InDelta(t, 41.547214, ra, 0.00004)
+	assert.InDelta(t, 49.348483, dec, 0.00004)
+
+	// and back again
+	ra, dec = transform.Precess(ra, dec, 2462088.69, transform.J2000)
+	assert.InDelta(t, 41.054063, ra, 0.000000001)
+	assert.InDelta(t, 49.227750, dec, 0.000000001)
+}
+
+func TestParseEpoch(t *testing.T) {
+	testcases := map[string]struct {
+		name   string
+		want   string
+		jde    float64
+		ofDate bool
+		err    bool
+	}{
+		"Default":   {name: "", want: "J2000.0", jde: 2451545.0},
+		"Julian":    {name: "J2024.5", want: "J2024.5", jde: 2451545.0 + 24.5*365.25},
+		"Besselian": {name: "B1950", want: "B1950.0", jde: 2433282.4235},
+		"Of date":   {name: "Date", want: "date", ofDate: true},
+		"Unknown":   {name: "X2000", err: true},
+		"Malformed": {name: "J20x0", err: true},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			e, err := transform.ParseEpoch(tc.name)
+			if tc.err {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, e.Name)
+			assert.Equal(t, tc.ofDate, e.OfDate)
+			assert.InDelta(t, tc.jde, e.JDE, 0.0001)
+		})
+	}
+}
@@ -58,3 +58,10 @@ func (s *server) DayFromJulianDay(ctx context.Context, req *v1.JulianRequest) (*
 	y, m, d := julian.DayFromJulianDay(req.GetJulianDateTime())
 	return &v1.CalendarResponse{Year: y, Month: m, Day: d}, nil
 }
+
+// DeltaT -
+func (s *server) DeltaT(ctx context.Context, req *v1.JulianRequest) (*v1.DeltaTResponse, error) {
+
+	dt := julian.DeltaT(req.GetJulianDateTime())
+	return &v1.DeltaTResponse{Seconds: dt}, nil
+}
@@ -164,6 +164,46 @@ func (m *JulianRequest) GetJulianDateTime() float64 {
 	return 0
 }
 
+type DeltaTResponse struct {
+	// Dynamical time minus universal time, in seconds
+	Seconds              float64  `protobuf:"fixed64,1,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeltaTResponse) Reset()         { *m = DeltaTResponse{} }
+func (m *DeltaTResponse) String() string { return proto.CompactTextString(m) }
+func (*DeltaTResponse) ProtoMessage()    {}
+func (*DeltaTResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_838069e7f4e90ff2, []int{3}
+}
+
+func (m *DeltaTResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeltaTResponse.Unmarshal(m, b)
+}
+func (m *DeltaTResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeltaTResponse.Marshal(b, m, deterministic)
+}
+func (m *DeltaTResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeltaTResponse.Merge(m, src)
+}
+func (m *DeltaTResponse) XXX_Size() int {
+	return xxx_messageInfo_DeltaTResponse.Size(m)
+}
+func (m *DeltaTResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeltaTResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeltaTResponse proto.InternalMessageInfo
+
+func (m *DeltaTResponse) GetSeconds() float64 {
+	if m != nil {
+		return m.Seconds
+	}
+	return 0
+}
+
 type CalendarResponse struct {
 	Year                 int32    `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
 	Month                int32    `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
@@ -177,7 +217,7 @@ func (m *CalendarResponse) Reset()         { *m = CalendarResponse{} }
 func (m *CalendarResponse) String() string { return proto.CompactTextString(m) }
 func (*CalendarResponse) ProtoMessage()    {}
 func (*CalendarResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_838069e7f4e90ff2, []int{3}
+	return fileDescriptor_838069e7f4e90ff2, []int{4}
 }
 
 func (m *CalendarResponse) XXX_Unmarshal(b []byte) error {
@@ -223,30 +263,33 @@ func init() {
 	proto.RegisterType((*ConvertRequest)(nil), "v1.ConvertRequest")
 	proto.RegisterType((*JulianResponse)(nil), "v1.JulianResponse")
 	proto.RegisterType((*JulianRequest)(nil), "v1.JulianRequest")
+	proto.RegisterType((*DeltaTResponse)(nil), "v1.DeltaTResponse")
 	proto.RegisterType((*CalendarResponse)(nil), "v1.CalendarResponse")
 }
 
 func init() { proto.RegisterFile("julian.proto", fileDescriptor_838069e7f4e90ff2) }
 
 var fileDescriptor_838069e7f4e90ff2 = []byte{
-	// 267 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0x41, 0x4b, 0xc3, 0x40,
-	0x10, 0x85, 0xd9, 0xb4, 0x55, 0x18, 0x34, 0xa4, 0x43, 0x0f, 0xa1, 0xa7, 0x92, 0x83, 0xf4, 0x14,
-	0x88, 0x1e, 0x14, 0x3d, 0xa6, 0x78, 0xe8, 0xc1, 0xc3, 0xea, 0x0f, 0x70, 0xb5, 0x03, 0x8d, 0x34,
-	0xbb, 0x75, 0xb3, 0x09, 0xe4, 0xe6, 0x4f, 0x97, 0xcc, 0xb6, 0x62, 0x83, 0x82, 0xd8, 0xdb, 0xec,
-	0x63, 0xbf, 0x9d, 0xf7, 0x1e, 0x0b, 0x67, 0x6f, 0xf5, 0xa6, 0x50, 0x3a, 0xdd, 0x5a, 0xe3, 0x0c,
-	0x06, 0x4d, 0x96, 0x3c, 0x43, 0x98, 0x1b, 0xdd, 0x90, 0x75, 0x92, 0xde, 0x6b, 0xaa, 0x1c, 0x22,
-	0x0c, 0x5b, 0x52, 0x36, 0x16, 0x33, 0x31, 0x1f, 0x49, 0x9e, 0x71, 0x02, 0xa3, 0xd2, 0x68, 0xb7,
-	0x8e, 0x03, 0x16, 0xfd, 0x01, 0x23, 0x18, 0xac, 0x54, 0x1b, 0x0f, 0x58, 0xeb, 0xc6, 0x8e, 0x5d,
-	0x9b, 0xda, 0xc6, 0xc3, 0x99, 0x98, 0x0b, 0xc9, 0x73, 0x72, 0x03, 0xe1, 0x92, 0xb7, 0x4a, 0xaa,
-	0xb6, 0x46, 0x57, 0x84, 0x17, 0x10, 0x7a, 0x1f, 0x0b, 0xe5, 0xe8, 0xa9, 0x28, 0x89, 0x77, 0x09,
-	0xd9, 0x53, 0x93, 0x6b, 0x38, 0xdf, 0x93, 0xde, 0xda, 0x5f, 0xc1, 0x07, 0x88, 0x72, 0xb5, 0x21,
-	0xbd, 0x52, 0xf6, 0x6b, 0xe9, 0x11, 0xb1, 0x2e, 0x3f, 0x82, 0xbd, 0x93, 0x47, 0xb2, 0x4d, 0xf1,
-	0x4a, 0x98, 0xc1, 0xe9, 0xae, 0x36, 0xc4, 0xb4, 0xc9, 0xd2, 0xc3, 0x0e, 0xa7, 0xac, 0xf5, 0x52,
-	0xdf, 0xc2, 0xb8, 0x33, 0xe7, 0xd5, 0x9c, 0xb4, 0xab, 0x6d, 0x8b, 0xe3, 0xef, 0x17, 0x7f, 0x67,
-	0x73, 0x98, 0x2e, 0x77, 0x11, 0xdb, 0x7b, 0x6b, 0xca, 0x7f, 0x3d, 0x72, 0x07, 0xd1, 0x01, 0xbe,
-	0x50, 0x3f, 0xa2, 0x13, 0xce, 0xd3, 0xab, 0xef, 0xe5, 0x84, 0xbf, 0xcc, 0xd5, 0x67, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x64, 0x73, 0x88, 0xab, 0x42, 0x02, 0x00, 0x00,
+	// 293 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xcf, 0x6a, 0x83, 0x40,
+	0x10, 0xc6, 0xd1, 0xfc, 0x83, 0xa1, 0x15, 0x33, 0xe4, 0x20, 0x39, 0x05, 0x0f, 0x25, 0xf4, 0x60,
+	0xb1, 0x3d, 0xb4, 0xb4, 0x47, 0xa5, 0x87, 0x1c, 0x7a, 0xb0, 0x79, 0x80, 0x6e, 0xe3, 0x40, 0x2c,
+	0xba, 0x9b, 0xae, 0xab, 0xe0, 0x63, 0xf5, 0x0d, 0x8b, 0xab, 0x86, 0x2a, 0x0d, 0x94, 0xe6, 0x36,
+	0xf3, 0x31, 0x9f, 0xdf, 0xcc, 0xcf, 0x85, 0x8b, 0x8f, 0x22, 0x4d, 0x18, 0xf7, 0x0e, 0x52, 0x28,
+	0x81, 0x66, 0xe9, 0xbb, 0x6f, 0x60, 0x05, 0x82, 0x97, 0x24, 0x55, 0x44, 0x9f, 0x05, 0xe5, 0x0a,
+	0x11, 0xc6, 0x15, 0x31, 0xe9, 0x18, 0x2b, 0x63, 0x3d, 0x89, 0x74, 0x8d, 0x0b, 0x98, 0x64, 0x82,
+	0xab, 0xbd, 0x63, 0x6a, 0xb1, 0x69, 0xd0, 0x86, 0x51, 0xcc, 0x2a, 0x67, 0xa4, 0xb5, 0xba, 0xac,
+	0xbd, 0x7b, 0x51, 0x48, 0x67, 0xbc, 0x32, 0xd6, 0x46, 0xa4, 0x6b, 0xf7, 0x01, 0xac, 0x8d, 0x4e,
+	0x8d, 0x28, 0x3f, 0x08, 0x9e, 0x13, 0x5e, 0x81, 0xd5, 0xec, 0x11, 0x32, 0x45, 0xdb, 0x24, 0x23,
+	0x9d, 0x65, 0x44, 0x03, 0xd5, 0xbd, 0x87, 0xcb, 0xce, 0xd9, 0xac, 0xf6, 0x57, 0xe3, 0x35, 0x58,
+	0x21, 0xa5, 0x8a, 0x6d, 0x8f, 0x91, 0x0e, 0xcc, 0x72, 0xda, 0x09, 0x1e, 0xe7, 0xad, 0xa5, 0x6b,
+	0xdd, 0x17, 0xb0, 0x03, 0x96, 0x12, 0x8f, 0x99, 0x3c, 0x4e, 0x9f, 0x81, 0xe0, 0xf6, 0xcb, 0xec,
+	0xb6, 0x7e, 0x25, 0x59, 0x26, 0x3b, 0x42, 0x1f, 0x66, 0x2d, 0x62, 0x44, 0xaf, 0xf4, 0xbd, 0x3e,
+	0xef, 0xa5, 0xd6, 0x06, 0x84, 0x1e, 0x61, 0x5e, 0x1f, 0xd2, 0xa8, 0x01, 0x71, 0x55, 0xc8, 0x0a,
+	0xe7, 0x3f, 0x07, 0x4f, 0x7b, 0x03, 0x58, 0x6e, 0x5a, 0x1c, 0xd5, 0xb3, 0x14, 0xd9, 0xbf, 0x3e,
+	0xf2, 0x04, 0x76, 0xcf, 0x1e, 0xb2, 0x5f, 0xad, 0x0b, 0x7d, 0xcf, 0x10, 0xdf, 0x0d, 0x4c, 0x1b,
+	0xfc, 0x27, 0xd3, 0xfa, 0x7f, 0xe7, 0x7d, 0xaa, 0xdf, 0xe3, 0xdd, 0xf7, 0x00, 0x85, 0x29, 0xec,
+	0xc8, 0x9f, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -266,6 +309,7 @@ type JulianServiceClient interface {
 	TimeJulianCentury(ctx context.Context, in *JulianRequest, opts ...grpc.CallOption) (*JulianResponse, error)
 	JulianDayFromJulianCentury(ctx context.Context, in *JulianRequest, opts ...grpc.CallOption) (*JulianResponse, error)
 	DayFromJulianDay(ctx context.Context, in *JulianRequest, opts ...grpc.CallOption) (*CalendarResponse, error)
+	DeltaT(ctx context.Context, in *JulianRequest, opts ...grpc.CallOption) (*DeltaTResponse, error)
 }
 
 type julianServiceClient struct {
@@ -312,6 +356,15 @@ func (c *julianServiceClient) DayFromJulianDay(ctx context.Context, in *JulianRe
 	return out, nil
 }
 
+func (c *julianServiceClient) DeltaT(ctx context.Context, in *JulianRequest, opts ...grpc.CallOption) (*DeltaTResponse, error) {
+	out := new(DeltaTResponse)
+	err := c.cc.Invoke(ctx, "/v1.JulianService/DeltaT", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JulianServiceServer is the server API for JulianService service.
 type JulianServiceServer interface {
 	// Convert to Julian date
@@ -319,6 +372,7 @@ type JulianServiceServer interface {
 	TimeJulianCentury(context.Context, *JulianRequest) (*JulianResponse, error)
 	JulianDayFromJulianCentury(context.Context, *JulianRequest) (*JulianResponse, error)
 	DayFromJulianDay(context.Context, *JulianRequest) (*CalendarResponse, error)
+	DeltaT(context.Context, *JulianRequest) (*DeltaTResponse, error)
 }
 
 func RegisterJulianServiceServer(s *grpc.Server, srv JulianServiceServer) {
@@ -397,6 +451,24 @@ func _JulianService_DayFromJulianDay_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JulianService_DeltaT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JulianRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JulianServiceServer).DeltaT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.JulianService/DeltaT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JulianServiceServer).DeltaT(ctx, req.(*JulianRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _JulianService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.JulianService",
 	HandlerType: (*JulianServiceServer)(nil),
@@ -417,6 +489,10 @@ var _JulianService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DayFromJulianDay",
 			Handler:    _JulianService_DayFromJulianDay_Handler,
 		},
+		{
+			MethodName: "DeltaT",
+			Handler:    _JulianService_DeltaT_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "julian.proto",
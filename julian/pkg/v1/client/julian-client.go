@@ -82,3 +82,17 @@ func (j *JulianClient) DayFromJulianDay(julianDay float64) (*v1.CalendarResponse
 	}
 	return c.DayFromJulianDay(ctx, &req)
 }
+
+// DeltaT -
+func (j *JulianClient) DeltaT(julianDay float64) (*v1.DeltaTResponse, error) {
+	c, conn := j.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// DeltaT
+	req := v1.JulianRequest{
+		JulianDateTime: julianDay,
+	}
+	return c.DeltaT(ctx, &req)
+}
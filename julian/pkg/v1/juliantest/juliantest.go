@@ -0,0 +1,79 @@
+// Package juliantest runs the julian service in process for the tests of the
+// services that call it.
+package juliantest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"testing"
+
+	v1 "planetpositions/julian/grpc/v1"
+	julian "planetpositions/julian/pkg/v1/service"
+
+	"google.golang.org/grpc"
+)
+
+// server answers as the julian service does, but for converting dates and
+// delta T
+type server struct {
+	deltaT func(jd float64) float64
+}
+
+// NewServer starts a julian service on a free local port and returns its
+// address, it stops when the test ends. deltaT, when not nil, replaces the
+// service's delta T in seconds so that tests can work in dynamical time
+func NewServer(t testing.TB, deltaT func(jd float64) float64) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("NewServer could not listen: %v", err)
+	}
+	if deltaT == nil {
+		deltaT = julian.DeltaT
+	}
+	s := grpc.NewServer()
+	v1.RegisterJulianServiceServer(s, &server{deltaT: deltaT})
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+// Convert returns the Julian day number, the Julian date at noon, of a date in
+// the Gregorian calendar by Meeus, Astronomical Algorithms, chapter 7, so that
+// tests have dates known from the book to check against
+func (s *server) Convert(ctx context.Context, req *v1.ConvertRequest) (*v1.JulianResponse, error) {
+	if req.GetMonth() < 1 || req.GetMonth() > 12 || req.GetDay() < 1 || req.GetDay() > 31 {
+		return nil, fmt.Errorf("error getting julian day: no such date %d-%d-%d", req.GetYear(), req.GetMonth(), req.GetDay())
+	}
+	y, m := float64(req.GetYear()), float64(req.GetMonth())
+	if m < 3 {
+		y--
+		m += 12
+	}
+	a := math.Floor(y / 100)
+	b := 2 - a + math.Floor(a/4)
+	jd := math.Floor(365.25*(y+4716)) + math.Floor(30.6001*(m+1)) + float64(req.GetDay()) + b - 1524.5
+	return &v1.JulianResponse{JulianDateTime: jd + 0.5}, nil
+}
+
+// TimeJulianCentury -
+func (s *server) TimeJulianCentury(ctx context.Context, req *v1.JulianRequest) (*v1.JulianResponse, error) {
+	return &v1.JulianResponse{JulianDateTime: julian.TimeJulianCentury(req.GetJulianDateTime())}, nil
+}
+
+// JulianDayFromJulianCentury -
+func (s *server) JulianDayFromJulianCentury(ctx context.Context, req *v1.JulianRequest) (*v1.JulianResponse, error) {
+	return &v1.JulianResponse{JulianDateTime: julian.GetJulianDayFromJulianCentury(req.GetJulianDateTime())}, nil
+}
+
+// DayFromJulianDay -
+func (s *server) DayFromJulianDay(ctx context.Context, req *v1.JulianRequest) (*v1.CalendarResponse, error) {
+	y, m, d := julian.DayFromJulianDay(req.GetJulianDateTime())
+	return &v1.CalendarResponse{Year: y, Month: m, Day: d}, nil
+}
+
+// DeltaT -
+func (s *server) DeltaT(ctx context.Context, req *v1.JulianRequest) (*v1.DeltaTResponse, error) {
+	return &v1.DeltaTResponse{Seconds: s.deltaT(req.GetJulianDateTime())}, nil
+}
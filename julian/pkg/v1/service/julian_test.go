@@ -54,3 +54,27 @@ func TestGetJulianDay(t *testing.T) {
 		assert.Equal(t, tc.output, output, "Test %s did not return the expected output", name)
 	}
 }
+
+func TestDeltaT(t *testing.T) {
+	testcases := map[string]struct {
+		julianDay float64
+		output    float64
+	}{
+		"J2000": {
+			julianDay: 2451545.0,
+			output:    63.86,
+		},
+		"1900": {
+			julianDay: 2415020.5,
+			output:    -2.79,
+		},
+		"Meeus example 10.a, 1977": {
+			julianDay: 2443192.5,
+			output:    48.0,
+		},
+	}
+	for name, tc := range testcases {
+		output := julian.DeltaT(tc.julianDay)
+		assert.InDelta(t, tc.output, output, 1.0, "Test %s did not return the expected output", name)
+	}
+}
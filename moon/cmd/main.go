@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	v1 "planetpositions/moon/grpc/v1"
+	moon "planetpositions/moon/pkg/v1/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type server struct{}
+
+var ms = moon.NewMoonService()
+
+func main() {
+
+	portNum := os.Getenv("PORT_NUM")
+	lis, err := net.Listen("tcp", "0.0.0.0:"+portNum)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	v1.RegisterMoonServiceServer(s, &server{})
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// GetMoonPosition -
+func (s *server) GetMoonPosition(ctx context.Context, req *v1.MoonPositionRequest) (*v1.MoonPosition, error) {
+	mp, err := ms.GetMoonPosition(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
+// GetMoonPhases -
+func (s *server) GetMoonPhases(ctx context.Context, req *v1.MoonPhasesRequest) (*v1.MoonPhases, error) {
+	mp, err := ms.GetMoonPhases(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
+// GetMoonIllumination -
+func (s *server) GetMoonIllumination(ctx context.Context, req *v1.MoonIlluminationRequest) (*v1.MoonIllumination, error) {
+	mi, err := ms.GetMoonIllumination(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mi, nil
+}
+
+// GetMoonRiseSet -
+func (s *server) GetMoonRiseSet(ctx context.Context, req *v1.MoonRiseSetRequest) (*v1.MoonRiseSet, error) {
+	rs, err := ms.GetMoonRiseSet(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
+
+// GetLunarEclipses -
+func (s *server) GetLunarEclipses(ctx context.Context, req *v1.LunarEclipseRequest) (*v1.LunarEclipses, error) {
+	le, err := ms.GetLunarEclipses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return le, nil
+}
+
+// GetLunarOccultations -
+func (s *server) GetLunarOccultations(ctx context.Context, req *v1.LunarOccultationRequest) (*v1.LunarOccultations, error) {
+	lo, err := ms.GetLunarOccultations(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return lo, nil
+}
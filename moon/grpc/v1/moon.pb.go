@@ -0,0 +1,2407 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: moon.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type MoonPositionKind int32
+
+const (
+	// Not given, the apparent position is returned
+	MoonPositionKind_MOON_POSITION_KIND_UNSPECIFIED MoonPositionKind = 0
+	// Corrected for nutation and referred to the true equator and equinox
+	// of date, as in the almanacs
+	MoonPositionKind_APPARENT MoonPositionKind = 1
+	// Where the moon is at the instant, referred to the equator and equinox
+	// of J2000.0
+	MoonPositionKind_GEOMETRIC MoonPositionKind = 2
+	// Corrected for light-time and referred to the equator and equinox of
+	// J2000.0, as star catalogue positions are
+	MoonPositionKind_ASTROMETRIC MoonPositionKind = 3
+)
+
+var MoonPositionKind_name = map[int32]string{
+	0: "MOON_POSITION_KIND_UNSPECIFIED",
+	1: "APPARENT",
+	2: "GEOMETRIC",
+	3: "ASTROMETRIC",
+}
+
+var MoonPositionKind_value = map[string]int32{
+	"MOON_POSITION_KIND_UNSPECIFIED": 0,
+	"APPARENT":                       1,
+	"GEOMETRIC":                      2,
+	"ASTROMETRIC":                    3,
+}
+
+func (x MoonPositionKind) String() string {
+	return proto.EnumName(MoonPositionKind_name, int32(x))
+}
+
+func (MoonPositionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{0}
+}
+
+type MoonPhaseName int32
+
+const (
+	// Never sent, zero is kept for an unset phase
+	MoonPhaseName_MOON_PHASE_NAME_UNSPECIFIED MoonPhaseName = 0
+	MoonPhaseName_NEW_MOON                    MoonPhaseName = 1
+	MoonPhaseName_WAXING_CRESCENT             MoonPhaseName = 2
+	MoonPhaseName_FIRST_QUARTER               MoonPhaseName = 3
+	MoonPhaseName_WAXING_GIBBOUS              MoonPhaseName = 4
+	MoonPhaseName_FULL_MOON                   MoonPhaseName = 5
+	MoonPhaseName_WANING_GIBBOUS              MoonPhaseName = 6
+	MoonPhaseName_LAST_QUARTER                MoonPhaseName = 7
+	MoonPhaseName_WANING_CRESCENT             MoonPhaseName = 8
+)
+
+var MoonPhaseName_name = map[int32]string{
+	0: "MOON_PHASE_NAME_UNSPECIFIED",
+	1: "NEW_MOON",
+	2: "WAXING_CRESCENT",
+	3: "FIRST_QUARTER",
+	4: "WAXING_GIBBOUS",
+	5: "FULL_MOON",
+	6: "WANING_GIBBOUS",
+	7: "LAST_QUARTER",
+	8: "WANING_CRESCENT",
+}
+
+var MoonPhaseName_value = map[string]int32{
+	"MOON_PHASE_NAME_UNSPECIFIED": 0,
+	"NEW_MOON":                    1,
+	"WAXING_CRESCENT":             2,
+	"FIRST_QUARTER":               3,
+	"WAXING_GIBBOUS":              4,
+	"FULL_MOON":                   5,
+	"WANING_GIBBOUS":              6,
+	"LAST_QUARTER":                7,
+	"WANING_CRESCENT":             8,
+}
+
+func (x MoonPhaseName) String() string {
+	return proto.EnumName(MoonPhaseName_name, int32(x))
+}
+
+func (MoonPhaseName) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{1}
+}
+
+type MoonEventStatus int32
+
+const (
+	// Never sent, zero is kept for an unset status
+	MoonEventStatus_MOON_EVENT_STATUS_UNSPECIFIED MoonEventStatus = 0
+	// The event occurs at least once on the date
+	MoonEventStatus_EVENT_OCCURS MoonEventStatus = 1
+	// The event does not occur on the date but does on a neighbouring date
+	MoonEventStatus_NO_EVENT_ON_DATE MoonEventStatus = 2
+	// The moon is above the horizon for the whole date
+	MoonEventStatus_ALWAYS_UP MoonEventStatus = 3
+	// The moon is below the horizon for the whole date
+	MoonEventStatus_ALWAYS_DOWN MoonEventStatus = 4
+)
+
+var MoonEventStatus_name = map[int32]string{
+	0: "MOON_EVENT_STATUS_UNSPECIFIED",
+	1: "EVENT_OCCURS",
+	2: "NO_EVENT_ON_DATE",
+	3: "ALWAYS_UP",
+	4: "ALWAYS_DOWN",
+}
+
+var MoonEventStatus_value = map[string]int32{
+	"MOON_EVENT_STATUS_UNSPECIFIED": 0,
+	"EVENT_OCCURS":                  1,
+	"NO_EVENT_ON_DATE":              2,
+	"ALWAYS_UP":                     3,
+	"ALWAYS_DOWN":                   4,
+}
+
+func (x MoonEventStatus) String() string {
+	return proto.EnumName(MoonEventStatus_name, int32(x))
+}
+
+func (MoonEventStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{2}
+}
+
+type LunarEclipseType int32
+
+const (
+	// Never sent, zero is kept for an unset type
+	LunarEclipseType_LUNAR_ECLIPSE_TYPE_UNSPECIFIED LunarEclipseType = 0
+	LunarEclipseType_PENUMBRAL                      LunarEclipseType = 1
+	LunarEclipseType_PARTIAL                        LunarEclipseType = 2
+	LunarEclipseType_TOTAL                          LunarEclipseType = 3
+)
+
+var LunarEclipseType_name = map[int32]string{
+	0: "LUNAR_ECLIPSE_TYPE_UNSPECIFIED",
+	1: "PENUMBRAL",
+	2: "PARTIAL",
+	3: "TOTAL",
+}
+
+var LunarEclipseType_value = map[string]int32{
+	"LUNAR_ECLIPSE_TYPE_UNSPECIFIED": 0,
+	"PENUMBRAL":                      1,
+	"PARTIAL":                        2,
+	"TOTAL":                          3,
+}
+
+func (x LunarEclipseType) String() string {
+	return proto.EnumName(LunarEclipseType_name, int32(x))
+}
+
+func (LunarEclipseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{3}
+}
+
+type OccultationEphemeris int32
+
+const (
+	// Not given, the analytic theories are used
+	OccultationEphemeris_OCCULTATION_EPHEMERIS_UNSPECIFIED OccultationEphemeris = 0
+	// The moon's own theory, Meeus, Astronomical Algorithms, chapter 47, and
+	// the analytic theory of the planets service
+	OccultationEphemeris_ANALYTIC OccultationEphemeris = 1
+	// The JPL Development Ephemeris loaded by the planets service, for the
+	// moon and the planets
+	OccultationEphemeris_JPL OccultationEphemeris = 2
+)
+
+var OccultationEphemeris_name = map[int32]string{
+	0: "OCCULTATION_EPHEMERIS_UNSPECIFIED",
+	1: "ANALYTIC",
+	2: "JPL",
+}
+
+var OccultationEphemeris_value = map[string]int32{
+	"OCCULTATION_EPHEMERIS_UNSPECIFIED": 0,
+	"ANALYTIC":                          1,
+	"JPL":                               2,
+}
+
+func (x OccultationEphemeris) String() string {
+	return proto.EnumName(OccultationEphemeris_name, int32(x))
+}
+
+func (OccultationEphemeris) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{4}
+}
+
+type MoonLimb int32
+
+const (
+	// Never sent, zero is kept for an unset limb
+	MoonLimb_MOON_LIMB_UNSPECIFIED MoonLimb = 0
+	// The sunlit limb
+	MoonLimb_BRIGHT_LIMB MoonLimb = 1
+	MoonLimb_DARK_LIMB   MoonLimb = 2
+)
+
+var MoonLimb_name = map[int32]string{
+	0: "MOON_LIMB_UNSPECIFIED",
+	1: "BRIGHT_LIMB",
+	2: "DARK_LIMB",
+}
+
+var MoonLimb_value = map[string]int32{
+	"MOON_LIMB_UNSPECIFIED": 0,
+	"BRIGHT_LIMB":           1,
+	"DARK_LIMB":             2,
+}
+
+func (x MoonLimb) String() string {
+	return proto.EnumName(MoonLimb_name, int32(x))
+}
+
+func (MoonLimb) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{5}
+}
+
+type MoonPositionRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC hour of the day
+	Hour float64 `protobuf:"fixed64,7,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Height of the observer above the WGS84 ellipsoid, in metres
+	Height float64 `protobuf:"fixed64,8,opt,name=height,proto3" json:"height,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa. Either of the
+	// temperature and pressure not given is that of the standard atmosphere,
+	// 10 degrees Celsius and 1010 hPa
+	Refraction  string                `protobuf:"bytes,9,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature *wrappers.DoubleValue `protobuf:"bytes,10,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure    *wrappers.DoubleValue `protobuf:"bytes,11,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// The kind of ecliptic, equatorial and topocentric coordinates wanted,
+	// apparent when not given
+	Kind                 MoonPositionKind `protobuf:"varint,12,opt,name=kind,proto3,enum=v1.MoonPositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MoonPositionRequest) Reset()         { *m = MoonPositionRequest{} }
+func (m *MoonPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*MoonPositionRequest) ProtoMessage()    {}
+func (*MoonPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{0}
+}
+
+func (m *MoonPositionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonPositionRequest.Unmarshal(m, b)
+}
+func (m *MoonPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonPositionRequest.Marshal(b, m, deterministic)
+}
+func (m *MoonPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonPositionRequest.Merge(m, src)
+}
+func (m *MoonPositionRequest) XXX_Size() int {
+	return xxx_messageInfo_MoonPositionRequest.Size(m)
+}
+func (m *MoonPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonPositionRequest proto.InternalMessageInfo
+
+func (m *MoonPositionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonPositionRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MoonPositionRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *MoonPositionRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *MoonPositionRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+func (m *MoonPositionRequest) GetKind() MoonPositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return MoonPositionKind_MOON_POSITION_KIND_UNSPECIFIED
+}
+
+type MoonPosition struct {
+	Api        string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	JulianDate float64 `protobuf:"fixed64,2,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Geocentric ecliptic coordinates of the kind asked for, in degrees
+	EclipticLongitude float64 `protobuf:"fixed64,3,opt,name=ecliptic_longitude,json=eclipticLongitude,proto3" json:"ecliptic_longitude,omitempty"`
+	EclipticLatitude  float64 `protobuf:"fixed64,4,opt,name=ecliptic_latitude,json=eclipticLatitude,proto3" json:"ecliptic_latitude,omitempty"`
+	// Distance between the centres of the Earth and the moon, in km
+	Distance           float64 `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	HorizontalParallax float64 `protobuf:"fixed64,6,opt,name=horizontal_parallax,json=horizontalParallax,proto3" json:"horizontal_parallax,omitempty"`
+	// Geocentric equatorial coordinates of the kind asked for, in degrees
+	RightAscension float64 `protobuf:"fixed64,7,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination    float64 `protobuf:"fixed64,8,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Geocentric horizontal coordinates for the observer, in degrees,
+	// azimuth measured clockwise from north, found from the apparent place
+	// whatever the kind
+	Azimuth  float64 `protobuf:"fixed64,9,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Altitude float64 `protobuf:"fixed64,10,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	// Equatorial coordinates of the kind asked for, in degrees, and
+	// distance, in km, seen from the observer's place on the surface of the
+	// Earth
+	TopocentricRightAscension float64 `protobuf:"fixed64,11,opt,name=topocentric_right_ascension,json=topocentricRightAscension,proto3" json:"topocentric_right_ascension,omitempty"`
+	TopocentricDeclination    float64 `protobuf:"fixed64,12,opt,name=topocentric_declination,json=topocentricDeclination,proto3" json:"topocentric_declination,omitempty"`
+	TopocentricDistance       float64 `protobuf:"fixed64,13,opt,name=topocentric_distance,json=topocentricDistance,proto3" json:"topocentric_distance,omitempty"`
+	// Topocentric horizontal coordinates, in degrees, without refraction
+	TopocentricAzimuth  float64 `protobuf:"fixed64,14,opt,name=topocentric_azimuth,json=topocentricAzimuth,proto3" json:"topocentric_azimuth,omitempty"`
+	TopocentricAltitude float64 `protobuf:"fixed64,15,opt,name=topocentric_altitude,json=topocentricAltitude,proto3" json:"topocentric_altitude,omitempty"`
+	// Topocentric altitude lifted by refraction, where the moon is seen
+	ApparentAltitude float64 `protobuf:"fixed64,16,opt,name=apparent_altitude,json=apparentAltitude,proto3" json:"apparent_altitude,omitempty"`
+	// The kind of coordinates returned, never unspecified
+	Kind                 MoonPositionKind `protobuf:"varint,17,opt,name=kind,proto3,enum=v1.MoonPositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *MoonPosition) Reset()         { *m = MoonPosition{} }
+func (m *MoonPosition) String() string { return proto.CompactTextString(m) }
+func (*MoonPosition) ProtoMessage()    {}
+func (*MoonPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{1}
+}
+
+func (m *MoonPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonPosition.Unmarshal(m, b)
+}
+func (m *MoonPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonPosition.Marshal(b, m, deterministic)
+}
+func (m *MoonPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonPosition.Merge(m, src)
+}
+func (m *MoonPosition) XXX_Size() int {
+	return xxx_messageInfo_MoonPosition.Size(m)
+}
+func (m *MoonPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonPosition proto.InternalMessageInfo
+
+func (m *MoonPosition) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonPosition) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetEclipticLongitude() float64 {
+	if m != nil {
+		return m.EclipticLongitude
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetEclipticLatitude() float64 {
+	if m != nil {
+		return m.EclipticLatitude
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetHorizontalParallax() float64 {
+	if m != nil {
+		return m.HorizontalParallax
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetTopocentricRightAscension() float64 {
+	if m != nil {
+		return m.TopocentricRightAscension
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetTopocentricDeclination() float64 {
+	if m != nil {
+		return m.TopocentricDeclination
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetTopocentricDistance() float64 {
+	if m != nil {
+		return m.TopocentricDistance
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetTopocentricAzimuth() float64 {
+	if m != nil {
+		return m.TopocentricAzimuth
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetTopocentricAltitude() float64 {
+	if m != nil {
+		return m.TopocentricAltitude
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetApparentAltitude() float64 {
+	if m != nil {
+		return m.ApparentAltitude
+	}
+	return 0
+}
+
+func (m *MoonPosition) GetKind() MoonPositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return MoonPositionKind_MOON_POSITION_KIND_UNSPECIFIED
+}
+
+type MoonInstant struct {
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Hour of the day, in UTC
+	Hour                 float64  `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	JulianDate           float64  `protobuf:"fixed64,5,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoonInstant) Reset()         { *m = MoonInstant{} }
+func (m *MoonInstant) String() string { return proto.CompactTextString(m) }
+func (*MoonInstant) ProtoMessage()    {}
+func (*MoonInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{2}
+}
+
+func (m *MoonInstant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonInstant.Unmarshal(m, b)
+}
+func (m *MoonInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonInstant.Marshal(b, m, deterministic)
+}
+func (m *MoonInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonInstant.Merge(m, src)
+}
+func (m *MoonInstant) XXX_Size() int {
+	return xxx_messageInfo_MoonInstant.Size(m)
+}
+func (m *MoonInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonInstant proto.InternalMessageInfo
+
+func (m *MoonInstant) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MoonInstant) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *MoonInstant) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *MoonInstant) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *MoonInstant) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type MoonPhaseEvent struct {
+	// One of NEW_MOON, FIRST_QUARTER, FULL_MOON or LAST_QUARTER
+	Phase                MoonPhaseName `protobuf:"varint,1,opt,name=phase,proto3,enum=v1.MoonPhaseName" json:"phase,omitempty"`
+	Time                 *MoonInstant  `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MoonPhaseEvent) Reset()         { *m = MoonPhaseEvent{} }
+func (m *MoonPhaseEvent) String() string { return proto.CompactTextString(m) }
+func (*MoonPhaseEvent) ProtoMessage()    {}
+func (*MoonPhaseEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{3}
+}
+
+func (m *MoonPhaseEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonPhaseEvent.Unmarshal(m, b)
+}
+func (m *MoonPhaseEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonPhaseEvent.Marshal(b, m, deterministic)
+}
+func (m *MoonPhaseEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonPhaseEvent.Merge(m, src)
+}
+func (m *MoonPhaseEvent) XXX_Size() int {
+	return xxx_messageInfo_MoonPhaseEvent.Size(m)
+}
+func (m *MoonPhaseEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonPhaseEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonPhaseEvent proto.InternalMessageInfo
+
+func (m *MoonPhaseEvent) GetPhase() MoonPhaseName {
+	if m != nil {
+		return m.Phase
+	}
+	return MoonPhaseName_MOON_PHASE_NAME_UNSPECIFIED
+}
+
+func (m *MoonPhaseEvent) GetTime() *MoonInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+type MoonPhasesRequest struct {
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	StartYear            int32    `protobuf:"varint,2,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth           int32    `protobuf:"varint,3,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay             int32    `protobuf:"varint,4,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear              int32    `protobuf:"varint,5,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth             int32    `protobuf:"varint,6,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay               int32    `protobuf:"varint,7,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoonPhasesRequest) Reset()         { *m = MoonPhasesRequest{} }
+func (m *MoonPhasesRequest) String() string { return proto.CompactTextString(m) }
+func (*MoonPhasesRequest) ProtoMessage()    {}
+func (*MoonPhasesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{4}
+}
+
+func (m *MoonPhasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonPhasesRequest.Unmarshal(m, b)
+}
+func (m *MoonPhasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonPhasesRequest.Marshal(b, m, deterministic)
+}
+func (m *MoonPhasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonPhasesRequest.Merge(m, src)
+}
+func (m *MoonPhasesRequest) XXX_Size() int {
+	return xxx_messageInfo_MoonPhasesRequest.Size(m)
+}
+func (m *MoonPhasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonPhasesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonPhasesRequest proto.InternalMessageInfo
+
+func (m *MoonPhasesRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonPhasesRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *MoonPhasesRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *MoonPhasesRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *MoonPhasesRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *MoonPhasesRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *MoonPhasesRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+type MoonPhases struct {
+	Api                  string            `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Phases               []*MoonPhaseEvent `protobuf:"bytes,2,rep,name=phases,proto3" json:"phases,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MoonPhases) Reset()         { *m = MoonPhases{} }
+func (m *MoonPhases) String() string { return proto.CompactTextString(m) }
+func (*MoonPhases) ProtoMessage()    {}
+func (*MoonPhases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{5}
+}
+
+func (m *MoonPhases) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonPhases.Unmarshal(m, b)
+}
+func (m *MoonPhases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonPhases.Marshal(b, m, deterministic)
+}
+func (m *MoonPhases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonPhases.Merge(m, src)
+}
+func (m *MoonPhases) XXX_Size() int {
+	return xxx_messageInfo_MoonPhases.Size(m)
+}
+func (m *MoonPhases) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonPhases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonPhases proto.InternalMessageInfo
+
+func (m *MoonPhases) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonPhases) GetPhases() []*MoonPhaseEvent {
+	if m != nil {
+		return m.Phases
+	}
+	return nil
+}
+
+type MoonIlluminationRequest struct {
+	Api   string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Year  int32  `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32  `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32  `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC hour of the day
+	Hour                 float64  `protobuf:"fixed64,5,opt,name=hour,proto3" json:"hour,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoonIlluminationRequest) Reset()         { *m = MoonIlluminationRequest{} }
+func (m *MoonIlluminationRequest) String() string { return proto.CompactTextString(m) }
+func (*MoonIlluminationRequest) ProtoMessage()    {}
+func (*MoonIlluminationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{6}
+}
+
+func (m *MoonIlluminationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonIlluminationRequest.Unmarshal(m, b)
+}
+func (m *MoonIlluminationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonIlluminationRequest.Marshal(b, m, deterministic)
+}
+func (m *MoonIlluminationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonIlluminationRequest.Merge(m, src)
+}
+func (m *MoonIlluminationRequest) XXX_Size() int {
+	return xxx_messageInfo_MoonIlluminationRequest.Size(m)
+}
+func (m *MoonIlluminationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonIlluminationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonIlluminationRequest proto.InternalMessageInfo
+
+func (m *MoonIlluminationRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonIlluminationRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MoonIlluminationRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *MoonIlluminationRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *MoonIlluminationRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+type MoonIllumination struct {
+	Api  string       `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Time *MoonInstant `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	// Fraction of the moon's disk that is illuminated, 0 to 1
+	IlluminatedFraction float64 `protobuf:"fixed64,3,opt,name=illuminated_fraction,json=illuminatedFraction,proto3" json:"illuminated_fraction,omitempty"`
+	// Angle at the moon between the sun and the earth, in degrees
+	PhaseAngle float64 `protobuf:"fixed64,4,opt,name=phase_angle,json=phaseAngle,proto3" json:"phase_angle,omitempty"`
+	// Angle between the sun and the moon seen from the earth, in degrees
+	Elongation float64 `protobuf:"fixed64,5,opt,name=elongation,proto3" json:"elongation,omitempty"`
+	// Days since the previous new moon
+	Age    float64       `protobuf:"fixed64,6,opt,name=age,proto3" json:"age,omitempty"`
+	Phase  MoonPhaseName `protobuf:"varint,7,opt,name=phase,proto3,enum=v1.MoonPhaseName" json:"phase,omitempty"`
+	Waxing bool          `protobuf:"varint,8,opt,name=waxing,proto3" json:"waxing,omitempty"`
+	// The most recent and the next new moon, first quarter, full moon and
+	// last quarter
+	Previous             []*MoonPhaseEvent `protobuf:"bytes,9,rep,name=previous,proto3" json:"previous,omitempty"`
+	Next                 []*MoonPhaseEvent `protobuf:"bytes,10,rep,name=next,proto3" json:"next,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MoonIllumination) Reset()         { *m = MoonIllumination{} }
+func (m *MoonIllumination) String() string { return proto.CompactTextString(m) }
+func (*MoonIllumination) ProtoMessage()    {}
+func (*MoonIllumination) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{7}
+}
+
+func (m *MoonIllumination) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonIllumination.Unmarshal(m, b)
+}
+func (m *MoonIllumination) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonIllumination.Marshal(b, m, deterministic)
+}
+func (m *MoonIllumination) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonIllumination.Merge(m, src)
+}
+func (m *MoonIllumination) XXX_Size() int {
+	return xxx_messageInfo_MoonIllumination.Size(m)
+}
+func (m *MoonIllumination) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonIllumination.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonIllumination proto.InternalMessageInfo
+
+func (m *MoonIllumination) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonIllumination) GetTime() *MoonInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *MoonIllumination) GetIlluminatedFraction() float64 {
+	if m != nil {
+		return m.IlluminatedFraction
+	}
+	return 0
+}
+
+func (m *MoonIllumination) GetPhaseAngle() float64 {
+	if m != nil {
+		return m.PhaseAngle
+	}
+	return 0
+}
+
+func (m *MoonIllumination) GetElongation() float64 {
+	if m != nil {
+		return m.Elongation
+	}
+	return 0
+}
+
+func (m *MoonIllumination) GetAge() float64 {
+	if m != nil {
+		return m.Age
+	}
+	return 0
+}
+
+func (m *MoonIllumination) GetPhase() MoonPhaseName {
+	if m != nil {
+		return m.Phase
+	}
+	return MoonPhaseName_MOON_PHASE_NAME_UNSPECIFIED
+}
+
+func (m *MoonIllumination) GetWaxing() bool {
+	if m != nil {
+		return m.Waxing
+	}
+	return false
+}
+
+func (m *MoonIllumination) GetPrevious() []*MoonPhaseEvent {
+	if m != nil {
+		return m.Previous
+	}
+	return nil
+}
+
+func (m *MoonIllumination) GetNext() []*MoonPhaseEvent {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
+type MoonRiseSetRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Height of the observer above sea level, in metres
+	Height float64 `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	Year   int32   `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Month  int32   `protobuf:"varint,6,opt,name=month,proto3" json:"month,omitempty"`
+	Day    int32   `protobuf:"varint,7,opt,name=day,proto3" json:"day,omitempty"`
+	// Offset of the observer's civil time from UTC, in hours, the date
+	// searched runs from local midnight to midnight
+	UtcOffset float64 `protobuf:"fixed64,8,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa, giving the
+	// refraction at the horizon. Either of the temperature and pressure not
+	// given is that of the standard atmosphere, 10 degrees Celsius and 1010
+	// hPa, and with none of the three the refraction is taken to be 34'
+	Refraction           string                `protobuf:"bytes,9,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature          *wrappers.DoubleValue `protobuf:"bytes,10,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure             *wrappers.DoubleValue `protobuf:"bytes,11,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *MoonRiseSetRequest) Reset()         { *m = MoonRiseSetRequest{} }
+func (m *MoonRiseSetRequest) String() string { return proto.CompactTextString(m) }
+func (*MoonRiseSetRequest) ProtoMessage()    {}
+func (*MoonRiseSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{8}
+}
+
+func (m *MoonRiseSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonRiseSetRequest.Unmarshal(m, b)
+}
+func (m *MoonRiseSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonRiseSetRequest.Marshal(b, m, deterministic)
+}
+func (m *MoonRiseSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonRiseSetRequest.Merge(m, src)
+}
+func (m *MoonRiseSetRequest) XXX_Size() int {
+	return xxx_messageInfo_MoonRiseSetRequest.Size(m)
+}
+func (m *MoonRiseSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonRiseSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonRiseSetRequest proto.InternalMessageInfo
+
+func (m *MoonRiseSetRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonRiseSetRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetUtcOffset() float64 {
+	if m != nil {
+		return m.UtcOffset
+	}
+	return 0
+}
+
+func (m *MoonRiseSetRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *MoonRiseSetRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *MoonRiseSetRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+type MoonRiseSetEvent struct {
+	Time *MoonInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Topocentric horizontal coordinates of the moon's centre, in degrees,
+	// azimuth measured clockwise from north
+	Azimuth              float64  `protobuf:"fixed64,2,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Altitude             float64  `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoonRiseSetEvent) Reset()         { *m = MoonRiseSetEvent{} }
+func (m *MoonRiseSetEvent) String() string { return proto.CompactTextString(m) }
+func (*MoonRiseSetEvent) ProtoMessage()    {}
+func (*MoonRiseSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{9}
+}
+
+func (m *MoonRiseSetEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonRiseSetEvent.Unmarshal(m, b)
+}
+func (m *MoonRiseSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonRiseSetEvent.Marshal(b, m, deterministic)
+}
+func (m *MoonRiseSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonRiseSetEvent.Merge(m, src)
+}
+func (m *MoonRiseSetEvent) XXX_Size() int {
+	return xxx_messageInfo_MoonRiseSetEvent.Size(m)
+}
+func (m *MoonRiseSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonRiseSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonRiseSetEvent proto.InternalMessageInfo
+
+func (m *MoonRiseSetEvent) GetTime() *MoonInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *MoonRiseSetEvent) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *MoonRiseSetEvent) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+type MoonRiseSet struct {
+	Api                  string              `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	RiseStatus           MoonEventStatus     `protobuf:"varint,2,opt,name=rise_status,json=riseStatus,proto3,enum=v1.MoonEventStatus" json:"rise_status,omitempty"`
+	Rises                []*MoonRiseSetEvent `protobuf:"bytes,3,rep,name=rises,proto3" json:"rises,omitempty"`
+	TransitStatus        MoonEventStatus     `protobuf:"varint,4,opt,name=transit_status,json=transitStatus,proto3,enum=v1.MoonEventStatus" json:"transit_status,omitempty"`
+	Transits             []*MoonRiseSetEvent `protobuf:"bytes,5,rep,name=transits,proto3" json:"transits,omitempty"`
+	SetStatus            MoonEventStatus     `protobuf:"varint,6,opt,name=set_status,json=setStatus,proto3,enum=v1.MoonEventStatus" json:"set_status,omitempty"`
+	Sets                 []*MoonRiseSetEvent `protobuf:"bytes,7,rep,name=sets,proto3" json:"sets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *MoonRiseSet) Reset()         { *m = MoonRiseSet{} }
+func (m *MoonRiseSet) String() string { return proto.CompactTextString(m) }
+func (*MoonRiseSet) ProtoMessage()    {}
+func (*MoonRiseSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{10}
+}
+
+func (m *MoonRiseSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoonRiseSet.Unmarshal(m, b)
+}
+func (m *MoonRiseSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoonRiseSet.Marshal(b, m, deterministic)
+}
+func (m *MoonRiseSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoonRiseSet.Merge(m, src)
+}
+func (m *MoonRiseSet) XXX_Size() int {
+	return xxx_messageInfo_MoonRiseSet.Size(m)
+}
+func (m *MoonRiseSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoonRiseSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoonRiseSet proto.InternalMessageInfo
+
+func (m *MoonRiseSet) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MoonRiseSet) GetRiseStatus() MoonEventStatus {
+	if m != nil {
+		return m.RiseStatus
+	}
+	return MoonEventStatus_MOON_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *MoonRiseSet) GetRises() []*MoonRiseSetEvent {
+	if m != nil {
+		return m.Rises
+	}
+	return nil
+}
+
+func (m *MoonRiseSet) GetTransitStatus() MoonEventStatus {
+	if m != nil {
+		return m.TransitStatus
+	}
+	return MoonEventStatus_MOON_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *MoonRiseSet) GetTransits() []*MoonRiseSetEvent {
+	if m != nil {
+		return m.Transits
+	}
+	return nil
+}
+
+func (m *MoonRiseSet) GetSetStatus() MoonEventStatus {
+	if m != nil {
+		return m.SetStatus
+	}
+	return MoonEventStatus_MOON_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *MoonRiseSet) GetSets() []*MoonRiseSetEvent {
+	if m != nil {
+		return m.Sets
+	}
+	return nil
+}
+
+type LunarEclipseRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Height of the observer above sea level, in metres
+	Height               float64  `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	StartYear            int32    `protobuf:"varint,5,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth           int32    `protobuf:"varint,6,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay             int32    `protobuf:"varint,7,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear              int32    `protobuf:"varint,8,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth             int32    `protobuf:"varint,9,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay               int32    `protobuf:"varint,10,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LunarEclipseRequest) Reset()         { *m = LunarEclipseRequest{} }
+func (m *LunarEclipseRequest) String() string { return proto.CompactTextString(m) }
+func (*LunarEclipseRequest) ProtoMessage()    {}
+func (*LunarEclipseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{11}
+}
+
+func (m *LunarEclipseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarEclipseRequest.Unmarshal(m, b)
+}
+func (m *LunarEclipseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarEclipseRequest.Marshal(b, m, deterministic)
+}
+func (m *LunarEclipseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarEclipseRequest.Merge(m, src)
+}
+func (m *LunarEclipseRequest) XXX_Size() int {
+	return xxx_messageInfo_LunarEclipseRequest.Size(m)
+}
+func (m *LunarEclipseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarEclipseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarEclipseRequest proto.InternalMessageInfo
+
+func (m *LunarEclipseRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *LunarEclipseRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *LunarEclipseRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+type LunarEclipseContact struct {
+	Time *MoonInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Altitude of the moon above the observer's horizon, in degrees
+	MoonAltitude         float64  `protobuf:"fixed64,2,opt,name=moon_altitude,json=moonAltitude,proto3" json:"moon_altitude,omitempty"`
+	Visible              bool     `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LunarEclipseContact) Reset()         { *m = LunarEclipseContact{} }
+func (m *LunarEclipseContact) String() string { return proto.CompactTextString(m) }
+func (*LunarEclipseContact) ProtoMessage()    {}
+func (*LunarEclipseContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{12}
+}
+
+func (m *LunarEclipseContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarEclipseContact.Unmarshal(m, b)
+}
+func (m *LunarEclipseContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarEclipseContact.Marshal(b, m, deterministic)
+}
+func (m *LunarEclipseContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarEclipseContact.Merge(m, src)
+}
+func (m *LunarEclipseContact) XXX_Size() int {
+	return xxx_messageInfo_LunarEclipseContact.Size(m)
+}
+func (m *LunarEclipseContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarEclipseContact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarEclipseContact proto.InternalMessageInfo
+
+func (m *LunarEclipseContact) GetTime() *MoonInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *LunarEclipseContact) GetMoonAltitude() float64 {
+	if m != nil {
+		return m.MoonAltitude
+	}
+	return 0
+}
+
+func (m *LunarEclipseContact) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+type LunarEclipse struct {
+	Type LunarEclipseType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.LunarEclipseType" json:"type,omitempty"`
+	// Contacts with the penumbra (p1, p4) and umbra (u1 to u4), those for
+	// phases that do not occur are omitted
+	P1       *LunarEclipseContact `protobuf:"bytes,2,opt,name=p1,proto3" json:"p1,omitempty"`
+	U1       *LunarEclipseContact `protobuf:"bytes,3,opt,name=u1,proto3" json:"u1,omitempty"`
+	U2       *LunarEclipseContact `protobuf:"bytes,4,opt,name=u2,proto3" json:"u2,omitempty"`
+	Greatest *LunarEclipseContact `protobuf:"bytes,5,opt,name=greatest,proto3" json:"greatest,omitempty"`
+	U3       *LunarEclipseContact `protobuf:"bytes,6,opt,name=u3,proto3" json:"u3,omitempty"`
+	U4       *LunarEclipseContact `protobuf:"bytes,7,opt,name=u4,proto3" json:"u4,omitempty"`
+	P4       *LunarEclipseContact `protobuf:"bytes,8,opt,name=p4,proto3" json:"p4,omitempty"`
+	// Fraction of the moon's diameter immersed in each shadow at greatest
+	// eclipse
+	PenumbralMagnitude float64 `protobuf:"fixed64,9,opt,name=penumbral_magnitude,json=penumbralMagnitude,proto3" json:"penumbral_magnitude,omitempty"`
+	UmbralMagnitude    float64 `protobuf:"fixed64,10,opt,name=umbral_magnitude,json=umbralMagnitude,proto3" json:"umbral_magnitude,omitempty"`
+	// Least distance between the moon and the shadow axis, in Earth radii
+	Gamma float64 `protobuf:"fixed64,11,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// Whether any part of the eclipse is visible to the observer
+	Visible              bool     `protobuf:"varint,12,opt,name=visible,proto3" json:"visible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LunarEclipse) Reset()         { *m = LunarEclipse{} }
+func (m *LunarEclipse) String() string { return proto.CompactTextString(m) }
+func (*LunarEclipse) ProtoMessage()    {}
+func (*LunarEclipse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{13}
+}
+
+func (m *LunarEclipse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarEclipse.Unmarshal(m, b)
+}
+func (m *LunarEclipse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarEclipse.Marshal(b, m, deterministic)
+}
+func (m *LunarEclipse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarEclipse.Merge(m, src)
+}
+func (m *LunarEclipse) XXX_Size() int {
+	return xxx_messageInfo_LunarEclipse.Size(m)
+}
+func (m *LunarEclipse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarEclipse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarEclipse proto.InternalMessageInfo
+
+func (m *LunarEclipse) GetType() LunarEclipseType {
+	if m != nil {
+		return m.Type
+	}
+	return LunarEclipseType_LUNAR_ECLIPSE_TYPE_UNSPECIFIED
+}
+
+func (m *LunarEclipse) GetP1() *LunarEclipseContact {
+	if m != nil {
+		return m.P1
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetU1() *LunarEclipseContact {
+	if m != nil {
+		return m.U1
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetU2() *LunarEclipseContact {
+	if m != nil {
+		return m.U2
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetGreatest() *LunarEclipseContact {
+	if m != nil {
+		return m.Greatest
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetU3() *LunarEclipseContact {
+	if m != nil {
+		return m.U3
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetU4() *LunarEclipseContact {
+	if m != nil {
+		return m.U4
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetP4() *LunarEclipseContact {
+	if m != nil {
+		return m.P4
+	}
+	return nil
+}
+
+func (m *LunarEclipse) GetPenumbralMagnitude() float64 {
+	if m != nil {
+		return m.PenumbralMagnitude
+	}
+	return 0
+}
+
+func (m *LunarEclipse) GetUmbralMagnitude() float64 {
+	if m != nil {
+		return m.UmbralMagnitude
+	}
+	return 0
+}
+
+func (m *LunarEclipse) GetGamma() float64 {
+	if m != nil {
+		return m.Gamma
+	}
+	return 0
+}
+
+func (m *LunarEclipse) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+type LunarEclipses struct {
+	Api                  string          `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Eclipses             []*LunarEclipse `protobuf:"bytes,2,rep,name=eclipses,proto3" json:"eclipses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *LunarEclipses) Reset()         { *m = LunarEclipses{} }
+func (m *LunarEclipses) String() string { return proto.CompactTextString(m) }
+func (*LunarEclipses) ProtoMessage()    {}
+func (*LunarEclipses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{14}
+}
+
+func (m *LunarEclipses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarEclipses.Unmarshal(m, b)
+}
+func (m *LunarEclipses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarEclipses.Marshal(b, m, deterministic)
+}
+func (m *LunarEclipses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarEclipses.Merge(m, src)
+}
+func (m *LunarEclipses) XXX_Size() int {
+	return xxx_messageInfo_LunarEclipses.Size(m)
+}
+func (m *LunarEclipses) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarEclipses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarEclipses proto.InternalMessageInfo
+
+func (m *LunarEclipses) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *LunarEclipses) GetEclipses() []*LunarEclipse {
+	if m != nil {
+		return m.Eclipses
+	}
+	return nil
+}
+
+type LunarOccultationRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Height of the observer above sea level, in metres
+	Height     float64 `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	StartYear  int32   `protobuf:"varint,5,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth int32   `protobuf:"varint,6,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay   int32   `protobuf:"varint,7,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear    int32   `protobuf:"varint,8,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth   int32   `protobuf:"varint,9,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay     int32   `protobuf:"varint,10,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// Names or HR or HIP numbers, such as HR1457, of stars in the catalogue
+	// or names of planets, the stars of the catalogue down to the fourth
+	// magnitude and all the planets when empty. Stars without a proper name
+	// are reported by HR number.
+	Targets              []string             `protobuf:"bytes,11,rep,name=targets,proto3" json:"targets,omitempty"`
+	Ephemeris            OccultationEphemeris `protobuf:"varint,12,opt,name=ephemeris,proto3,enum=v1.OccultationEphemeris" json:"ephemeris,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LunarOccultationRequest) Reset()         { *m = LunarOccultationRequest{} }
+func (m *LunarOccultationRequest) String() string { return proto.CompactTextString(m) }
+func (*LunarOccultationRequest) ProtoMessage()    {}
+func (*LunarOccultationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{15}
+}
+
+func (m *LunarOccultationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarOccultationRequest.Unmarshal(m, b)
+}
+func (m *LunarOccultationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarOccultationRequest.Marshal(b, m, deterministic)
+}
+func (m *LunarOccultationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarOccultationRequest.Merge(m, src)
+}
+func (m *LunarOccultationRequest) XXX_Size() int {
+	return xxx_messageInfo_LunarOccultationRequest.Size(m)
+}
+func (m *LunarOccultationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarOccultationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarOccultationRequest proto.InternalMessageInfo
+
+func (m *LunarOccultationRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *LunarOccultationRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+func (m *LunarOccultationRequest) GetTargets() []string {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *LunarOccultationRequest) GetEphemeris() OccultationEphemeris {
+	if m != nil {
+		return m.Ephemeris
+	}
+	return OccultationEphemeris_OCCULTATION_EPHEMERIS_UNSPECIFIED
+}
+
+type LunarOccultationContact struct {
+	Time *MoonInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Direction of the body from the centre of the moon, in degrees from
+	// north through east
+	PositionAngle float64  `protobuf:"fixed64,2,opt,name=position_angle,json=positionAngle,proto3" json:"position_angle,omitempty"`
+	Limb          MoonLimb `protobuf:"varint,3,opt,name=limb,proto3,enum=v1.MoonLimb" json:"limb,omitempty"`
+	// Altitudes above the observer's horizon, in degrees
+	MoonAltitude float64 `protobuf:"fixed64,4,opt,name=moon_altitude,json=moonAltitude,proto3" json:"moon_altitude,omitempty"`
+	SunAltitude  float64 `protobuf:"fixed64,5,opt,name=sun_altitude,json=sunAltitude,proto3" json:"sun_altitude,omitempty"`
+	// Whether the moon is above the horizon in a dark enough sky, the sun
+	// 6 degrees below the horizon for stars to the second magnitude and the
+	// bright planets and 12 degrees for the rest
+	Visible              bool     `protobuf:"varint,6,opt,name=visible,proto3" json:"visible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LunarOccultationContact) Reset()         { *m = LunarOccultationContact{} }
+func (m *LunarOccultationContact) String() string { return proto.CompactTextString(m) }
+func (*LunarOccultationContact) ProtoMessage()    {}
+func (*LunarOccultationContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{16}
+}
+
+func (m *LunarOccultationContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarOccultationContact.Unmarshal(m, b)
+}
+func (m *LunarOccultationContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarOccultationContact.Marshal(b, m, deterministic)
+}
+func (m *LunarOccultationContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarOccultationContact.Merge(m, src)
+}
+func (m *LunarOccultationContact) XXX_Size() int {
+	return xxx_messageInfo_LunarOccultationContact.Size(m)
+}
+func (m *LunarOccultationContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarOccultationContact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarOccultationContact proto.InternalMessageInfo
+
+func (m *LunarOccultationContact) GetTime() *MoonInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *LunarOccultationContact) GetPositionAngle() float64 {
+	if m != nil {
+		return m.PositionAngle
+	}
+	return 0
+}
+
+func (m *LunarOccultationContact) GetLimb() MoonLimb {
+	if m != nil {
+		return m.Limb
+	}
+	return MoonLimb_MOON_LIMB_UNSPECIFIED
+}
+
+func (m *LunarOccultationContact) GetMoonAltitude() float64 {
+	if m != nil {
+		return m.MoonAltitude
+	}
+	return 0
+}
+
+func (m *LunarOccultationContact) GetSunAltitude() float64 {
+	if m != nil {
+		return m.SunAltitude
+	}
+	return 0
+}
+
+func (m *LunarOccultationContact) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+type LunarOccultation struct {
+	// Name of the star or planet
+	Target string `protobuf:"bytes,1,opt,name=target,proto3" json:"target,omitempty"`
+	Planet bool   `protobuf:"varint,2,opt,name=planet,proto3" json:"planet,omitempty"`
+	// Visual magnitude of a star, unset for planets
+	Magnitude float64 `protobuf:"fixed64,3,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// When the body disappears behind the limb and reappears from it
+	Disappearance *LunarOccultationContact `protobuf:"bytes,4,opt,name=disappearance,proto3" json:"disappearance,omitempty"`
+	Reappearance  *LunarOccultationContact `protobuf:"bytes,5,opt,name=reappearance,proto3" json:"reappearance,omitempty"`
+	// Illuminated fraction of the moon at disappearance
+	MoonIllumination     float64  `protobuf:"fixed64,6,opt,name=moon_illumination,json=moonIllumination,proto3" json:"moon_illumination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LunarOccultation) Reset()         { *m = LunarOccultation{} }
+func (m *LunarOccultation) String() string { return proto.CompactTextString(m) }
+func (*LunarOccultation) ProtoMessage()    {}
+func (*LunarOccultation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{17}
+}
+
+func (m *LunarOccultation) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarOccultation.Unmarshal(m, b)
+}
+func (m *LunarOccultation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarOccultation.Marshal(b, m, deterministic)
+}
+func (m *LunarOccultation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarOccultation.Merge(m, src)
+}
+func (m *LunarOccultation) XXX_Size() int {
+	return xxx_messageInfo_LunarOccultation.Size(m)
+}
+func (m *LunarOccultation) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarOccultation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarOccultation proto.InternalMessageInfo
+
+func (m *LunarOccultation) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *LunarOccultation) GetPlanet() bool {
+	if m != nil {
+		return m.Planet
+	}
+	return false
+}
+
+func (m *LunarOccultation) GetMagnitude() float64 {
+	if m != nil {
+		return m.Magnitude
+	}
+	return 0
+}
+
+func (m *LunarOccultation) GetDisappearance() *LunarOccultationContact {
+	if m != nil {
+		return m.Disappearance
+	}
+	return nil
+}
+
+func (m *LunarOccultation) GetReappearance() *LunarOccultationContact {
+	if m != nil {
+		return m.Reappearance
+	}
+	return nil
+}
+
+func (m *LunarOccultation) GetMoonIllumination() float64 {
+	if m != nil {
+		return m.MoonIllumination
+	}
+	return 0
+}
+
+type LunarOccultations struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// In order of disappearance
+	Occultations         []*LunarOccultation `protobuf:"bytes,2,rep,name=occultations,proto3" json:"occultations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *LunarOccultations) Reset()         { *m = LunarOccultations{} }
+func (m *LunarOccultations) String() string { return proto.CompactTextString(m) }
+func (*LunarOccultations) ProtoMessage()    {}
+func (*LunarOccultations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_718e7a6145dba2fc, []int{18}
+}
+
+func (m *LunarOccultations) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LunarOccultations.Unmarshal(m, b)
+}
+func (m *LunarOccultations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LunarOccultations.Marshal(b, m, deterministic)
+}
+func (m *LunarOccultations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LunarOccultations.Merge(m, src)
+}
+func (m *LunarOccultations) XXX_Size() int {
+	return xxx_messageInfo_LunarOccultations.Size(m)
+}
+func (m *LunarOccultations) XXX_DiscardUnknown() {
+	xxx_messageInfo_LunarOccultations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LunarOccultations proto.InternalMessageInfo
+
+func (m *LunarOccultations) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *LunarOccultations) GetOccultations() []*LunarOccultation {
+	if m != nil {
+		return m.Occultations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("v1.MoonPositionKind", MoonPositionKind_name, MoonPositionKind_value)
+	proto.RegisterEnum("v1.MoonPhaseName", MoonPhaseName_name, MoonPhaseName_value)
+	proto.RegisterEnum("v1.MoonEventStatus", MoonEventStatus_name, MoonEventStatus_value)
+	proto.RegisterEnum("v1.LunarEclipseType", LunarEclipseType_name, LunarEclipseType_value)
+	proto.RegisterEnum("v1.OccultationEphemeris", OccultationEphemeris_name, OccultationEphemeris_value)
+	proto.RegisterEnum("v1.MoonLimb", MoonLimb_name, MoonLimb_value)
+	proto.RegisterType((*MoonPositionRequest)(nil), "v1.MoonPositionRequest")
+	proto.RegisterType((*MoonPosition)(nil), "v1.MoonPosition")
+	proto.RegisterType((*MoonInstant)(nil), "v1.MoonInstant")
+	proto.RegisterType((*MoonPhaseEvent)(nil), "v1.MoonPhaseEvent")
+	proto.RegisterType((*MoonPhasesRequest)(nil), "v1.MoonPhasesRequest")
+	proto.RegisterType((*MoonPhases)(nil), "v1.MoonPhases")
+	proto.RegisterType((*MoonIlluminationRequest)(nil), "v1.MoonIlluminationRequest")
+	proto.RegisterType((*MoonIllumination)(nil), "v1.MoonIllumination")
+	proto.RegisterType((*MoonRiseSetRequest)(nil), "v1.MoonRiseSetRequest")
+	proto.RegisterType((*MoonRiseSetEvent)(nil), "v1.MoonRiseSetEvent")
+	proto.RegisterType((*MoonRiseSet)(nil), "v1.MoonRiseSet")
+	proto.RegisterType((*LunarEclipseRequest)(nil), "v1.LunarEclipseRequest")
+	proto.RegisterType((*LunarEclipseContact)(nil), "v1.LunarEclipseContact")
+	proto.RegisterType((*LunarEclipse)(nil), "v1.LunarEclipse")
+	proto.RegisterType((*LunarEclipses)(nil), "v1.LunarEclipses")
+	proto.RegisterType((*LunarOccultationRequest)(nil), "v1.LunarOccultationRequest")
+	proto.RegisterType((*LunarOccultationContact)(nil), "v1.LunarOccultationContact")
+	proto.RegisterType((*LunarOccultation)(nil), "v1.LunarOccultation")
+	proto.RegisterType((*LunarOccultations)(nil), "v1.LunarOccultations")
+}
+
+func init() { proto.RegisterFile("moon.proto", fileDescriptor_718e7a6145dba2fc) }
+
+var fileDescriptor_718e7a6145dba2fc = []byte{
+	// 2442 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcf, 0x73, 0x23, 0x47,
+	0xf5, 0xff, 0x8e, 0x7e, 0xeb, 0x49, 0x96, 0xc7, 0x6d, 0x67, 0x3d, 0xb1, 0xf3, 0x43, 0x51, 0x2a,
+	0xdf, 0x18, 0x27, 0xb6, 0xd6, 0x5a, 0x17, 0x49, 0x2d, 0x54, 0x60, 0x6c, 0x6b, 0x1d, 0xed, 0xca,
+	0x92, 0x18, 0xc9, 0x59, 0xf6, 0x10, 0xa6, 0xda, 0x52, 0xaf, 0x34, 0x8b, 0x34, 0x33, 0x99, 0xe9,
+	0xb1, 0xd7, 0x71, 0x4c, 0x15, 0x9c, 0xb8, 0x70, 0x08, 0x14, 0x17, 0xfe, 0x01, 0xfe, 0x84, 0xfc,
+	0x03, 0x54, 0x71, 0xe2, 0x42, 0xfe, 0x05, 0x0e, 0x70, 0xa0, 0xb8, 0x72, 0x80, 0x2a, 0xaa, 0xbb,
+	0xa7, 0xa5, 0x91, 0x2c, 0xdb, 0x9b, 0x2a, 0x96, 0xa2, 0x38, 0x59, 0xfd, 0xde, 0xa7, 0xfb, 0xfd,
+	0xec, 0xf7, 0x5e, 0x8f, 0x01, 0x46, 0x8e, 0x63, 0x6f, 0xbb, 0x9e, 0x43, 0x1d, 0x14, 0x3b, 0xdd,
+	0x59, 0x7b, 0xad, 0xef, 0x38, 0xfd, 0x21, 0x29, 0x63, 0xd7, 0x2a, 0x63, 0xdb, 0x76, 0x28, 0xa6,
+	0x96, 0x63, 0xfb, 0x02, 0xb1, 0xf6, 0x3e, 0xff, 0xd3, 0xdd, 0xea, 0x13, 0x7b, 0xcb, 0x3f, 0xc3,
+	0xfd, 0x3e, 0xf1, 0xca, 0x8e, 0xcb, 0x11, 0x73, 0xd0, 0x6f, 0x84, 0x67, 0xf1, 0xd5, 0x49, 0xf0,
+	0xb4, 0x7c, 0xe6, 0x61, 0xd7, 0x25, 0x5e, 0xc8, 0x2f, 0xfd, 0x22, 0x0e, 0xcb, 0x47, 0x8e, 0x63,
+	0xb7, 0x1c, 0xdf, 0x62, 0xfb, 0x0c, 0xf2, 0x59, 0x40, 0x7c, 0x8a, 0x54, 0x88, 0x63, 0xd7, 0xd2,
+	0x94, 0xa2, 0xb2, 0x91, 0x35, 0xd8, 0x4f, 0xf4, 0x1a, 0x64, 0x87, 0x8e, 0xdd, 0xb7, 0x68, 0xd0,
+	0x23, 0x5a, 0xac, 0xa8, 0x6c, 0x28, 0xc6, 0x84, 0x80, 0xd6, 0x20, 0x33, 0xc4, 0x54, 0x30, 0xe3,
+	0x9c, 0x39, 0x5e, 0x23, 0x04, 0x89, 0x73, 0x82, 0x3d, 0x2d, 0x51, 0x54, 0x36, 0x92, 0x06, 0xff,
+	0x8d, 0x56, 0x20, 0x39, 0x72, 0x6c, 0x3a, 0xd0, 0x92, 0x9c, 0x28, 0x16, 0x4c, 0x6a, 0x0f, 0x9f,
+	0x6b, 0x29, 0x4e, 0x63, 0x3f, 0xd9, 0xde, 0x81, 0x13, 0x78, 0x5a, 0x9a, 0x9f, 0xc9, 0x7f, 0xa3,
+	0x3b, 0x90, 0x1a, 0x10, 0xab, 0x3f, 0xa0, 0x5a, 0x86, 0x53, 0xc3, 0x15, 0x7a, 0x03, 0xc0, 0x23,
+	0x4f, 0x3d, 0xdc, 0x65, 0x86, 0x68, 0x59, 0xae, 0x7a, 0x84, 0x82, 0x3e, 0x82, 0x1c, 0x25, 0x23,
+	0x97, 0x78, 0x98, 0x06, 0x1e, 0xd1, 0xa0, 0xa8, 0x6c, 0xe4, 0x2a, 0xaf, 0x6d, 0x0b, 0x0f, 0x6d,
+	0x4b, 0x0f, 0x6d, 0x1f, 0x38, 0xc1, 0xc9, 0x90, 0x7c, 0x82, 0x87, 0x01, 0x31, 0xa2, 0x1b, 0xd0,
+	0x87, 0x90, 0x71, 0x3d, 0xe2, 0xfb, 0x6c, 0x73, 0xee, 0x05, 0x36, 0x8f, 0xd1, 0x68, 0x03, 0x12,
+	0x3f, 0xb6, 0xec, 0x9e, 0x96, 0x2f, 0x2a, 0x1b, 0x85, 0xca, 0xca, 0xf6, 0xe9, 0xce, 0x76, 0xd4,
+	0xe9, 0x8f, 0x2c, 0xbb, 0x67, 0x70, 0x44, 0xe9, 0x8f, 0x49, 0xc8, 0x47, 0x59, 0x73, 0x02, 0xf1,
+	0x26, 0xe4, 0x9e, 0x05, 0x43, 0x0b, 0xdb, 0x66, 0x0f, 0x53, 0x19, 0x0a, 0x10, 0xa4, 0x03, 0x4c,
+	0x09, 0xda, 0x02, 0x44, 0xba, 0x43, 0xcb, 0xa5, 0x56, 0xd7, 0x9c, 0x84, 0x4c, 0x44, 0x65, 0x49,
+	0x72, 0xea, 0xe3, 0xd0, 0xbd, 0x07, 0x4b, 0x13, 0xb8, 0x8c, 0x61, 0x82, 0xa3, 0xd5, 0x31, 0x5a,
+	0xc6, 0x72, 0x0d, 0x32, 0x3d, 0xcb, 0xa7, 0xd8, 0xee, 0x12, 0x1e, 0x3a, 0xc5, 0x18, 0xaf, 0x51,
+	0x19, 0x96, 0x07, 0x8e, 0x67, 0x7d, 0xee, 0xd8, 0x14, 0x0f, 0x4d, 0x17, 0x7b, 0x78, 0x38, 0xc4,
+	0xcf, 0x79, 0x34, 0x15, 0x03, 0x4d, 0x58, 0xad, 0x90, 0x83, 0xde, 0x85, 0x45, 0x8f, 0x45, 0xce,
+	0xc4, 0x7e, 0x97, 0xd8, 0x3e, 0x8b, 0x9a, 0x88, 0x73, 0x81, 0x93, 0x75, 0x49, 0x45, 0x45, 0xc8,
+	0xf5, 0x98, 0x2a, 0x36, 0xcf, 0xed, 0x30, 0xec, 0x51, 0x12, 0xd2, 0x20, 0x8d, 0x3f, 0xb7, 0x46,
+	0x01, 0x1d, 0xf0, 0xc0, 0x2b, 0x86, 0x5c, 0x32, 0x8d, 0xf1, 0x30, 0xb4, 0x0a, 0x84, 0xc6, 0x72,
+	0x8d, 0x3e, 0x82, 0x75, 0xea, 0xb8, 0x4e, 0x97, 0xd8, 0xd4, 0xb3, 0xba, 0xe6, 0xac, 0x32, 0x39,
+	0x0e, 0x7f, 0x35, 0x02, 0x31, 0xa6, 0xf5, 0xfa, 0x00, 0x56, 0xa3, 0xfb, 0xa3, 0x3a, 0xe6, 0xf9,
+	0xde, 0x3b, 0x11, 0xf6, 0x41, 0x44, 0xdd, 0x1d, 0x58, 0x99, 0xda, 0x28, 0x5d, 0xba, 0xc0, 0x77,
+	0x2d, 0x47, 0x77, 0x45, 0xbc, 0x1b, 0xdd, 0x22, 0xad, 0x2d, 0x08, 0xef, 0x46, 0x58, 0x7a, 0x68,
+	0xf8, 0x8c, 0x8c, 0xb1, 0x13, 0x16, 0xaf, 0xc8, 0xd0, 0xa5, 0x3f, 0xde, 0x83, 0x25, 0xec, 0xba,
+	0xd8, 0x23, 0x36, 0x9d, 0xe0, 0x55, 0x91, 0x0a, 0x92, 0x31, 0x06, 0xcb, 0xa4, 0x5e, 0xba, 0x35,
+	0xa9, 0x7f, 0x02, 0x39, 0xc6, 0xa9, 0xd9, 0xcc, 0x14, 0x3a, 0xae, 0x07, 0xca, 0xbc, 0x7a, 0x10,
+	0x9b, 0x53, 0x0f, 0xe2, 0x57, 0xeb, 0x41, 0x22, 0x52, 0x0f, 0x66, 0x2e, 0x44, 0x72, 0xf6, 0x42,
+	0x94, 0x7e, 0x04, 0x05, 0xae, 0xd9, 0x00, 0xfb, 0xa4, 0x7a, 0x4a, 0x6c, 0x8a, 0xde, 0x85, 0xa4,
+	0xcb, 0x56, 0x5c, 0x87, 0x42, 0x65, 0x69, 0xac, 0x3c, 0x23, 0x36, 0xf0, 0x88, 0x18, 0x82, 0x8f,
+	0xde, 0x86, 0x04, 0xb5, 0x46, 0xe2, 0x96, 0xe5, 0x2a, 0x8b, 0x12, 0x17, 0x9a, 0x62, 0x70, 0x66,
+	0xe9, 0x6b, 0x05, 0x96, 0xc6, 0xbb, 0xfd, 0xeb, 0x4b, 0xe8, 0xeb, 0x00, 0x3e, 0xc5, 0x1e, 0x35,
+	0xb9, 0xf9, 0xc2, 0xd2, 0x2c, 0xa7, 0x3c, 0x61, 0x3e, 0x78, 0x13, 0x72, 0x82, 0x2d, 0x3c, 0x21,
+	0xac, 0x16, 0x3b, 0x8e, 0xb8, 0x3b, 0xd6, 0x41, 0xa0, 0x4d, 0xe6, 0x14, 0x51, 0x4d, 0x33, 0x9c,
+	0x70, 0x80, 0xcf, 0xd1, 0xab, 0x90, 0x21, 0x76, 0x4f, 0x1c, 0x2d, 0x8a, 0x6a, 0x9a, 0xd8, 0x3d,
+	0x7e, 0xf0, 0x3a, 0x64, 0x19, 0x4b, 0x1c, 0x2b, 0x8a, 0x2b, 0xc3, 0x8a, 0x43, 0x57, 0x81, 0xe1,
+	0xf8, 0x91, 0x69, 0xce, 0x4a, 0x11, 0xbb, 0x77, 0x80, 0xcf, 0x4b, 0x0f, 0x01, 0x26, 0x46, 0xcd,
+	0xb1, 0x66, 0x13, 0x52, 0xdc, 0x47, 0xbe, 0x16, 0x2b, 0xc6, 0x37, 0x72, 0x15, 0x34, 0xe5, 0x44,
+	0xee, 0x67, 0x23, 0x44, 0x94, 0x2e, 0x60, 0x95, 0xbb, 0x6d, 0x38, 0x0c, 0x46, 0xe1, 0x1d, 0xb8,
+	0xde, 0x4d, 0x32, 0x3f, 0x62, 0xf3, 0xf2, 0x23, 0x3e, 0x27, 0x3f, 0x12, 0x57, 0xf3, 0x23, 0x39,
+	0xc9, 0x8f, 0xd2, 0x9f, 0x63, 0xa0, 0xce, 0x4a, 0x9f, 0x23, 0xf6, 0x45, 0x42, 0xcd, 0x2e, 0x95,
+	0x25, 0x8f, 0x21, 0x3d, 0x73, 0xdc, 0x6d, 0x44, 0x75, 0x5d, 0x8e, 0xf0, 0x1e, 0x84, 0x2c, 0x16,
+	0x56, 0xee, 0x05, 0x13, 0xdb, 0xfd, 0xa1, 0xac, 0xac, 0xc0, 0x49, 0x3a, 0xa3, 0xb0, 0xbe, 0x45,
+	0x58, 0x9d, 0x16, 0x85, 0x23, 0x4c, 0xdf, 0x09, 0x85, 0xab, 0xda, 0x27, 0x61, 0x1d, 0x65, 0x3f,
+	0x27, 0xe9, 0x9b, 0xbe, 0x25, 0x7d, 0xef, 0x40, 0xea, 0x0c, 0x3f, 0xb7, 0xec, 0x3e, 0xaf, 0x99,
+	0x19, 0x23, 0x5c, 0xa1, 0x6d, 0xde, 0xca, 0x4e, 0x2d, 0x27, 0xf0, 0xb5, 0xec, 0xb5, 0xd1, 0x1b,
+	0x63, 0xd0, 0xff, 0x43, 0xc2, 0x26, 0xcf, 0xa9, 0x06, 0xd7, 0x62, 0x39, 0xbf, 0xf4, 0xd7, 0x18,
+	0x20, 0xc6, 0x30, 0x2c, 0x9f, 0xb4, 0x09, 0x7d, 0x19, 0xd3, 0xc4, 0xa4, 0xfb, 0x27, 0xa6, 0xba,
+	0xbf, 0xcc, 0x9a, 0xe4, 0xbc, 0xac, 0x49, 0xcd, 0xc9, 0x9a, 0xf4, 0x24, 0x6b, 0x5e, 0x07, 0x08,
+	0x68, 0xd7, 0x74, 0x9e, 0x3e, 0xf5, 0x89, 0x9c, 0x2a, 0xb2, 0x01, 0xed, 0x36, 0x39, 0xe1, 0xbf,
+	0x77, 0xb0, 0x28, 0x8d, 0x40, 0x8d, 0xb8, 0x5b, 0xd4, 0x36, 0x99, 0xc7, 0xca, 0x4d, 0x79, 0x1c,
+	0xe9, 0x97, 0xb1, 0xeb, 0xfb, 0x65, 0x7c, 0xba, 0x5f, 0x96, 0xfe, 0x10, 0x83, 0x5c, 0x44, 0xde,
+	0x9c, 0xb8, 0xee, 0x42, 0xce, 0xb3, 0x7c, 0x62, 0xfa, 0x14, 0xd3, 0xc0, 0xe7, 0x67, 0x17, 0x2a,
+	0xcb, 0x52, 0x07, 0xae, 0x60, 0x9b, 0xb3, 0x0c, 0x60, 0x38, 0xf1, 0x1b, 0x6d, 0x42, 0x92, 0xad,
+	0x7c, 0x2d, 0xce, 0xf3, 0x6b, 0xdc, 0x4b, 0xa2, 0x76, 0x19, 0x02, 0x82, 0xee, 0x43, 0x81, 0x7a,
+	0xd8, 0xf6, 0x2d, 0x2a, 0x85, 0x24, 0xae, 0x17, 0xb2, 0x10, 0x42, 0x43, 0x39, 0x77, 0x21, 0x13,
+	0x12, 0x7c, 0x2d, 0x79, 0x83, 0xa8, 0x31, 0x0a, 0x55, 0x00, 0x7c, 0x32, 0x96, 0x94, 0xba, 0x5e,
+	0x52, 0xd6, 0x27, 0x52, 0xca, 0x06, 0x24, 0x7c, 0x42, 0x7d, 0x2d, 0x7d, 0x83, 0x04, 0x8e, 0x28,
+	0xfd, 0x36, 0x06, 0xcb, 0xf5, 0xc0, 0xc6, 0x5e, 0x95, 0xcd, 0x59, 0x3e, 0xf9, 0x4f, 0xde, 0x97,
+	0xe9, 0x66, 0x94, 0xbc, 0xa5, 0x19, 0xa5, 0x6e, 0x6e, 0x46, 0xe9, 0x1b, 0x9a, 0x51, 0xe6, 0x86,
+	0x66, 0x94, 0xbd, 0xbe, 0x19, 0xc1, 0x54, 0x33, 0x3a, 0x9f, 0x76, 0xd4, 0xbe, 0x63, 0x53, 0xdc,
+	0x7d, 0xc1, 0x5c, 0x7f, 0x1b, 0x16, 0xd8, 0x0b, 0x6b, 0x32, 0xd1, 0x08, 0xff, 0xe5, 0x19, 0x71,
+	0x3c, 0xcd, 0x68, 0x90, 0x3e, 0xb5, 0x7c, 0xeb, 0x64, 0x28, 0x3c, 0x98, 0x31, 0xe4, 0xb2, 0xf4,
+	0xb7, 0x38, 0xe4, 0xa3, 0xb2, 0x59, 0x7c, 0xe9, 0xb9, 0x2b, 0x67, 0x07, 0x1e, 0xdf, 0x28, 0xbf,
+	0x73, 0xee, 0x12, 0x83, 0x23, 0xd0, 0xbb, 0x10, 0x73, 0x77, 0xc2, 0x86, 0xb2, 0x3a, 0x8b, 0x0b,
+	0x6d, 0x30, 0x62, 0xee, 0x0e, 0x03, 0x06, 0x3b, 0x5a, 0xfc, 0x16, 0x60, 0x20, 0x80, 0x15, 0x2d,
+	0x71, 0x1b, 0xb0, 0x82, 0xee, 0x41, 0xa6, 0xef, 0x11, 0x4c, 0x89, 0x4f, 0xb5, 0xe4, 0xcd, 0xf0,
+	0x31, 0x90, 0x9f, 0x7e, 0x4f, 0x4b, 0xdd, 0x0c, 0x8f, 0x05, 0xf7, 0x38, 0x70, 0x57, 0x4b, 0xdf,
+	0x06, 0xdc, 0xe5, 0x1e, 0xd8, 0xd5, 0x32, 0xb7, 0x00, 0xdd, 0x5d, 0x36, 0xde, 0xba, 0xc4, 0x0e,
+	0x46, 0x27, 0x1e, 0x1e, 0x9a, 0x23, 0xdc, 0xb7, 0x45, 0xa8, 0xc4, 0x30, 0x8f, 0xc6, 0xac, 0x23,
+	0xc9, 0x41, 0xdf, 0x02, 0xf5, 0x0a, 0x5a, 0xcc, 0xf7, 0x8b, 0xb3, 0xd0, 0x15, 0x48, 0xf6, 0xf1,
+	0x68, 0x84, 0xc3, 0x81, 0x5e, 0x2c, 0xa2, 0x11, 0xcf, 0x4f, 0x47, 0xbc, 0x09, 0x0b, 0x51, 0x35,
+	0xe7, 0x0d, 0x3f, 0xef, 0x43, 0x86, 0x84, 0xdc, 0x70, 0xfc, 0x51, 0x67, 0xad, 0x33, 0xc6, 0x88,
+	0xd2, 0x3f, 0x63, 0xb0, 0xca, 0x59, 0xcd, 0x6e, 0x37, 0x18, 0x52, 0xfc, 0xb2, 0x5e, 0xda, 0xff,
+	0x13, 0x77, 0x9d, 0x05, 0x86, 0x62, 0xaf, 0xcf, 0x4a, 0x68, 0xae, 0x18, 0xdf, 0xc8, 0x1a, 0x72,
+	0x89, 0xbe, 0x0d, 0x59, 0xe2, 0x0e, 0xc8, 0x88, 0x78, 0x96, 0x1f, 0x3e, 0xa6, 0x35, 0xe6, 0xf6,
+	0x88, 0x5b, 0xab, 0x92, 0x6f, 0x4c, 0xa0, 0xa5, 0xbf, 0x28, 0x57, 0xfd, 0xff, 0x8d, 0x4a, 0xc8,
+	0x3b, 0x50, 0x70, 0xc3, 0x77, 0x4d, 0x38, 0xc6, 0x89, 0xb8, 0x2c, 0x48, 0xaa, 0x98, 0xe4, 0x8a,
+	0x90, 0x18, 0x5a, 0xa3, 0x13, 0x1e, 0x97, 0x42, 0x25, 0x2f, 0xcf, 0xaa, 0x5b, 0xa3, 0x13, 0x83,
+	0x73, 0xae, 0xd6, 0xa2, 0xc4, 0x9c, 0x5a, 0xf4, 0x16, 0xe4, 0xfd, 0x20, 0x82, 0x11, 0x23, 0x61,
+	0xce, 0x0f, 0xe6, 0x96, 0xab, 0xd4, 0x74, 0xf2, 0x7e, 0x19, 0x03, 0x75, 0xd6, 0x56, 0x96, 0x18,
+	0xc2, 0x87, 0x61, 0x9e, 0x85, 0x2b, 0x46, 0x77, 0x87, 0xd8, 0x26, 0x94, 0xdb, 0x93, 0x31, 0xc2,
+	0x15, 0x4b, 0xc1, 0xc9, 0xad, 0x12, 0x59, 0x36, 0x21, 0x20, 0x1d, 0x16, 0x7a, 0x96, 0xcf, 0xbe,
+	0x23, 0x61, 0x8f, 0x3f, 0x5b, 0x45, 0x3d, 0x5a, 0x1f, 0xdf, 0x80, 0xab, 0x6e, 0x36, 0xa6, 0x77,
+	0xa0, 0xef, 0x41, 0xde, 0x23, 0x91, 0x13, 0x92, 0xb7, 0x9f, 0x30, 0xb5, 0x81, 0x3d, 0x55, 0xb9,
+	0x23, 0xad, 0xc8, 0x50, 0x1f, 0x8e, 0xc8, 0xea, 0x68, 0x66, 0xd8, 0x2f, 0x99, 0xb0, 0x34, 0x7b,
+	0xea, 0xbc, 0x4b, 0xfd, 0x21, 0xe4, 0x9d, 0x08, 0x22, 0xbc, 0xd8, 0x2b, 0xf3, 0x94, 0x32, 0xa6,
+	0x90, 0x9b, 0x3d, 0x50, 0x67, 0xdf, 0xbe, 0xa8, 0x04, 0x6f, 0x1c, 0x35, 0x9b, 0x0d, 0xb3, 0xd5,
+	0x6c, 0xd7, 0x3a, 0xb5, 0x66, 0xc3, 0x7c, 0x54, 0x6b, 0x1c, 0x98, 0xc7, 0x8d, 0x76, 0xab, 0xba,
+	0x5f, 0x7b, 0x50, 0xab, 0x1e, 0xa8, 0xff, 0x87, 0xf2, 0x90, 0xd1, 0x5b, 0x2d, 0xdd, 0xa8, 0x36,
+	0x3a, 0xaa, 0x82, 0x16, 0x20, 0x7b, 0x58, 0x6d, 0x1e, 0x55, 0x3b, 0x46, 0x6d, 0x5f, 0x8d, 0xa1,
+	0x45, 0xc8, 0xe9, 0xed, 0x8e, 0x21, 0x09, 0xf1, 0xcd, 0xdf, 0x29, 0xb0, 0x30, 0x35, 0xe6, 0xa3,
+	0x37, 0x61, 0x5d, 0xc8, 0xf8, 0x58, 0x6f, 0x57, 0xcd, 0x86, 0x7e, 0x54, 0xbd, 0x2a, 0xa0, 0x51,
+	0x7d, 0x6c, 0x32, 0x90, 0xaa, 0xa0, 0x65, 0x58, 0x7c, 0xac, 0xff, 0xb0, 0xd6, 0x38, 0x34, 0xf7,
+	0x8d, 0x6a, 0x7b, 0x9f, 0x49, 0x8d, 0xa1, 0x25, 0x58, 0x78, 0x50, 0x33, 0xda, 0x1d, 0xf3, 0x07,
+	0xc7, 0xba, 0xd1, 0xa9, 0x1a, 0x6a, 0x1c, 0x21, 0x28, 0x84, 0xb8, 0xc3, 0xda, 0xde, 0x5e, 0xf3,
+	0xb8, 0xad, 0x26, 0x98, 0x72, 0x0f, 0x8e, 0xeb, 0x75, 0x71, 0x54, 0x52, 0x40, 0x1a, 0x51, 0x48,
+	0x0a, 0xa9, 0x90, 0xaf, 0xeb, 0x91, 0x83, 0xd2, 0x42, 0x60, 0x63, 0x4a, 0x60, 0x66, 0xf3, 0x0b,
+	0x58, 0x9c, 0x99, 0x9e, 0xd0, 0x5b, 0xf0, 0x3a, 0xb7, 0xa3, 0xfa, 0x49, 0xb5, 0xd1, 0x31, 0xdb,
+	0x1d, 0xbd, 0x73, 0xdc, 0x9e, 0xb1, 0x44, 0x85, 0xbc, 0xe0, 0x36, 0xf7, 0xf7, 0x8f, 0x8d, 0xb6,
+	0xaa, 0xa0, 0x15, 0x50, 0x1b, 0xcd, 0x70, 0x4b, 0xb3, 0x61, 0x1e, 0xe8, 0x9d, 0xaa, 0x1a, 0x63,
+	0x7a, 0xea, 0xf5, 0xc7, 0xfa, 0x93, 0xb6, 0x79, 0xdc, 0x52, 0xe3, 0xdc, 0x89, 0x62, 0x79, 0xd0,
+	0x7c, 0xdc, 0x50, 0x13, 0x9b, 0x9f, 0x82, 0x1a, 0xad, 0xd2, 0xac, 0x5b, 0xb3, 0x50, 0xd5, 0x8f,
+	0x1b, 0xba, 0x61, 0x56, 0xf7, 0xeb, 0xb5, 0x56, 0xbb, 0x6a, 0x76, 0x9e, 0xb4, 0x66, 0x3d, 0xb9,
+	0x00, 0xd9, 0x56, 0xb5, 0x71, 0x7c, 0xb4, 0x67, 0xe8, 0x75, 0x55, 0x41, 0x39, 0x48, 0xb7, 0x74,
+	0xa3, 0x53, 0xd3, 0xeb, 0x6a, 0x0c, 0x65, 0x21, 0xd9, 0x69, 0x76, 0xf4, 0xba, 0x1a, 0xdf, 0xec,
+	0xc0, 0xca, 0xbc, 0x6a, 0x84, 0xde, 0x81, 0xb7, 0x98, 0xe2, 0xf5, 0x8e, 0xce, 0x73, 0xa1, 0xda,
+	0xfa, 0xb8, 0x7a, 0x54, 0x35, 0x6a, 0xed, 0x39, 0x09, 0xd1, 0xd0, 0xeb, 0x4f, 0x3a, 0xb5, 0x7d,
+	0x55, 0x41, 0x69, 0x88, 0x3f, 0x6c, 0xd5, 0xd5, 0xd8, 0x66, 0x15, 0x32, 0xb2, 0x90, 0xa0, 0x57,
+	0xe1, 0x15, 0xee, 0xab, 0x7a, 0xed, 0x68, 0x6f, 0x66, 0xf7, 0x22, 0xe4, 0xf6, 0x8c, 0xda, 0xe1,
+	0xc7, 0x1d, 0xce, 0x14, 0x19, 0x75, 0xa0, 0x1b, 0x8f, 0xc4, 0x32, 0x56, 0xf9, 0x2a, 0x2d, 0xe6,
+	0xf7, 0x36, 0xf1, 0x4e, 0xad, 0x2e, 0x41, 0x3f, 0x57, 0x60, 0xf1, 0x90, 0xd0, 0xa9, 0x0f, 0x8e,
+	0xab, 0xb3, 0x1f, 0x72, 0xc2, 0x46, 0xb5, 0xa6, 0xce, 0x32, 0x4a, 0x0f, 0x7f, 0xf6, 0xf5, 0x9f,
+	0x7e, 0x15, 0x3b, 0x40, 0x7b, 0xa7, 0x3b, 0x65, 0x76, 0xe7, 0x64, 0x31, 0x2c, 0x5f, 0x8c, 0xdb,
+	0xd5, 0x65, 0xf9, 0x42, 0x76, 0xa7, 0xcb, 0xf2, 0x05, 0xeb, 0x11, 0x97, 0xe5, 0x0b, 0xde, 0x0f,
+	0x2e, 0xcb, 0x17, 0x3d, 0x7c, 0x7e, 0x59, 0xbe, 0x60, 0x6f, 0xf4, 0x4b, 0xf4, 0x6b, 0x05, 0x16,
+	0xa4, 0x2a, 0xe2, 0x8b, 0xc3, 0x2b, 0x53, 0xaf, 0x4c, 0xf9, 0x59, 0x65, 0xad, 0x30, 0x4d, 0x2e,
+	0x7d, 0xca, 0x95, 0x78, 0x8c, 0x8e, 0xa5, 0x12, 0x9c, 0x5c, 0xbe, 0x98, 0x34, 0xbc, 0x4b, 0xb9,
+	0x90, 0x72, 0xc7, 0xbd, 0xec, 0xb2, 0x7c, 0x21, 0x5b, 0x57, 0xf8, 0x53, 0x42, 0xc2, 0xce, 0x74,
+	0x89, 0x7e, 0xaa, 0xc0, 0x72, 0xa8, 0xd7, 0xd4, 0xf7, 0x83, 0xf5, 0x71, 0xa3, 0xb8, 0xfa, 0x4d,
+	0x63, 0x6d, 0x65, 0x1e, 0xb3, 0xf4, 0x01, 0xd7, 0x74, 0x07, 0x95, 0x43, 0x4d, 0xa3, 0xa5, 0xeb,
+	0x46, 0xdf, 0x5c, 0x42, 0x21, 0x54, 0x41, 0x3e, 0xbc, 0xee, 0xcc, 0x3c, 0x2a, 0xa4, 0xe0, 0xc5,
+	0x19, 0x7a, 0x69, 0x8f, 0xcb, 0xfc, 0x2e, 0xba, 0x1f, 0xca, 0xe4, 0x6f, 0x28, 0x42, 0xbf, 0x49,
+	0x84, 0xd0, 0x57, 0x0a, 0xa8, 0x87, 0x84, 0x4e, 0x8f, 0x44, 0x57, 0x86, 0x39, 0xa9, 0xc2, 0xd2,
+	0x2c, 0xc3, 0x2f, 0x9d, 0x71, 0x25, 0x3e, 0x43, 0xce, 0xe9, 0x4e, 0x79, 0xc8, 0x38, 0x72, 0x30,
+	0xba, 0x56, 0x8d, 0x7f, 0x53, 0xf0, 0x7e, 0xaf, 0xc0, 0x8a, 0xd4, 0x7c, 0xaa, 0xf6, 0xcf, 0x6d,
+	0x34, 0xd2, 0x82, 0x57, 0xe6, 0x31, 0xfd, 0xd2, 0x05, 0xb7, 0x22, 0x40, 0xbe, 0xb4, 0x22, 0xda,
+	0x01, 0x5e, 0xb2, 0x25, 0x7b, 0xff, 0x50, 0x7e, 0xa9, 0xff, 0x5d, 0xa9, 0xb0, 0xaf, 0xb0, 0x43,
+	0xab, 0x2b, 0x52, 0xe6, 0x99, 0xef, 0xd8, 0xf7, 0xaf, 0x50, 0x8c, 0xef, 0x40, 0x7c, 0xf7, 0xee,
+	0x2e, 0xda, 0x85, 0x4d, 0x83, 0xd0, 0xc0, 0xb3, 0x49, 0xaf, 0x78, 0x36, 0x20, 0x76, 0x91, 0x0e,
+	0x48, 0xd1, 0x23, 0xbe, 0x13, 0x78, 0x5d, 0x52, 0xec, 0x39, 0xc4, 0x2f, 0xda, 0x0e, 0x2d, 0x92,
+	0xe7, 0x96, 0x4f, 0xb7, 0x51, 0x0a, 0x12, 0xbf, 0x89, 0x29, 0x69, 0xf4, 0xa5, 0x22, 0xfe, 0xff,
+	0x50, 0xf4, 0x45, 0x89, 0xa8, 0xc4, 0x77, 0xb6, 0xef, 0x96, 0xbe, 0x80, 0x72, 0xdf, 0xd9, 0xea,
+	0x7b, 0x6e, 0x77, 0x6b, 0x40, 0xa9, 0xbb, 0xe5, 0x11, 0x9f, 0x6e, 0x8d, 0xac, 0xae, 0xe7, 0x84,
+	0xb0, 0x2d, 0x1a, 0x50, 0xc7, 0xb3, 0xf0, 0xb0, 0xe8, 0x7a, 0xce, 0x33, 0xd2, 0xa5, 0xe8, 0x2e,
+	0x03, 0xfa, 0xf7, 0xcb, 0xe5, 0xbe, 0x45, 0x07, 0xc1, 0xc9, 0x76, 0xd7, 0x19, 0x95, 0xfd, 0x01,
+	0xb6, 0xc9, 0xc0, 0x39, 0x23, 0xd8, 0xa3, 0x83, 0xb2, 0x18, 0x35, 0x64, 0xcd, 0xf0, 0xd7, 0x56,
+	0x39, 0xfb, 0xfb, 0x53, 0x20, 0xb6, 0x6d, 0x53, 0x51, 0x4e, 0x52, 0xfc, 0x3b, 0xc8, 0xbd, 0x7f,
+	0x0d, 0x00, 0x48, 0x5a, 0xf0, 0xa8, 0x2b, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MoonServiceClient is the client API for MoonService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MoonServiceClient interface {
+	// Get the position of the moon
+	GetMoonPosition(ctx context.Context, in *MoonPositionRequest, opts ...grpc.CallOption) (*MoonPosition, error)
+	// Get the principal phases of the moon between two dates
+	GetMoonPhases(ctx context.Context, in *MoonPhasesRequest, opts ...grpc.CallOption) (*MoonPhases, error)
+	// Get the illumination, age and phase of the moon
+	GetMoonIllumination(ctx context.Context, in *MoonIlluminationRequest, opts ...grpc.CallOption) (*MoonIllumination, error)
+	// Get the times of moonrise, transit and moonset for a location
+	GetMoonRiseSet(ctx context.Context, in *MoonRiseSetRequest, opts ...grpc.CallOption) (*MoonRiseSet, error)
+	// Get the lunar eclipses between two dates
+	GetLunarEclipses(ctx context.Context, in *LunarEclipseRequest, opts ...grpc.CallOption) (*LunarEclipses, error)
+	// Get the occultations of bright stars and planets by the moon between
+	// two dates for a location
+	GetLunarOccultations(ctx context.Context, in *LunarOccultationRequest, opts ...grpc.CallOption) (*LunarOccultations, error)
+}
+
+type moonServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMoonServiceClient(cc *grpc.ClientConn) MoonServiceClient {
+	return &moonServiceClient{cc}
+}
+
+func (c *moonServiceClient) GetMoonPosition(ctx context.Context, in *MoonPositionRequest, opts ...grpc.CallOption) (*MoonPosition, error) {
+	out := new(MoonPosition)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetMoonPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moonServiceClient) GetMoonPhases(ctx context.Context, in *MoonPhasesRequest, opts ...grpc.CallOption) (*MoonPhases, error) {
+	out := new(MoonPhases)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetMoonPhases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moonServiceClient) GetMoonIllumination(ctx context.Context, in *MoonIlluminationRequest, opts ...grpc.CallOption) (*MoonIllumination, error) {
+	out := new(MoonIllumination)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetMoonIllumination", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moonServiceClient) GetMoonRiseSet(ctx context.Context, in *MoonRiseSetRequest, opts ...grpc.CallOption) (*MoonRiseSet, error) {
+	out := new(MoonRiseSet)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetMoonRiseSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moonServiceClient) GetLunarEclipses(ctx context.Context, in *LunarEclipseRequest, opts ...grpc.CallOption) (*LunarEclipses, error) {
+	out := new(LunarEclipses)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetLunarEclipses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moonServiceClient) GetLunarOccultations(ctx context.Context, in *LunarOccultationRequest, opts ...grpc.CallOption) (*LunarOccultations, error) {
+	out := new(LunarOccultations)
+	err := c.cc.Invoke(ctx, "/v1.MoonService/GetLunarOccultations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MoonServiceServer is the server API for MoonService service.
+type MoonServiceServer interface {
+	// Get the position of the moon
+	GetMoonPosition(context.Context, *MoonPositionRequest) (*MoonPosition, error)
+	// Get the principal phases of the moon between two dates
+	GetMoonPhases(context.Context, *MoonPhasesRequest) (*MoonPhases, error)
+	// Get the illumination, age and phase of the moon
+	GetMoonIllumination(context.Context, *MoonIlluminationRequest) (*MoonIllumination, error)
+	// Get the times of moonrise, transit and moonset for a location
+	GetMoonRiseSet(context.Context, *MoonRiseSetRequest) (*MoonRiseSet, error)
+	// Get the lunar eclipses between two dates
+	GetLunarEclipses(context.Context, *LunarEclipseRequest) (*LunarEclipses, error)
+	// Get the occultations of bright stars and planets by the moon between
+	// two dates for a location
+	GetLunarOccultations(context.Context, *LunarOccultationRequest) (*LunarOccultations, error)
+}
+
+func RegisterMoonServiceServer(s *grpc.Server, srv MoonServiceServer) {
+	s.RegisterService(&_MoonService_serviceDesc, srv)
+}
+
+func _MoonService_GetMoonPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoonPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetMoonPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetMoonPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetMoonPosition(ctx, req.(*MoonPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoonService_GetMoonPhases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoonPhasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetMoonPhases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetMoonPhases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetMoonPhases(ctx, req.(*MoonPhasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoonService_GetMoonIllumination_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoonIlluminationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetMoonIllumination(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetMoonIllumination",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetMoonIllumination(ctx, req.(*MoonIlluminationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoonService_GetMoonRiseSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoonRiseSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetMoonRiseSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetMoonRiseSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetMoonRiseSet(ctx, req.(*MoonRiseSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoonService_GetLunarEclipses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LunarEclipseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetLunarEclipses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetLunarEclipses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetLunarEclipses(ctx, req.(*LunarEclipseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MoonService_GetLunarOccultations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LunarOccultationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MoonServiceServer).GetLunarOccultations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.MoonService/GetLunarOccultations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MoonServiceServer).GetLunarOccultations(ctx, req.(*LunarOccultationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MoonService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.MoonService",
+	HandlerType: (*MoonServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetMoonPosition",
+			Handler:    _MoonService_GetMoonPosition_Handler,
+		},
+		{
+			MethodName: "GetMoonPhases",
+			Handler:    _MoonService_GetMoonPhases_Handler,
+		},
+		{
+			MethodName: "GetMoonIllumination",
+			Handler:    _MoonService_GetMoonIllumination_Handler,
+		},
+		{
+			MethodName: "GetMoonRiseSet",
+			Handler:    _MoonService_GetMoonRiseSet_Handler,
+		},
+		{
+			MethodName: "GetLunarEclipses",
+			Handler:    _MoonService_GetLunarEclipses_Handler,
+		},
+		{
+			MethodName: "GetLunarOccultations",
+			Handler:    _MoonService_GetLunarOccultations_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "moon.proto",
+}
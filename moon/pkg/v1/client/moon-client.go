@@ -0,0 +1,160 @@
+package moonclient
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"planetpositions/moon/grpc/v1"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc"
+)
+
+// MoonClient -
+type MoonClient struct {
+	Address string
+}
+
+func (m *MoonClient) newConnection() (v1.MoonServiceClient, *grpc.ClientConn) {
+
+	// Set up a connection to the server.
+	conn, err := grpc.Dial(m.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	return v1.NewMoonServiceClient(conn), conn
+}
+
+// GetMoonPosition -
+func (m *MoonClient) GetMoonPosition(long, lat, height float64, year, month, day int32, hour float64, refraction string, temperature, pressure *wrappers.DoubleValue, kind v1.MoonPositionKind) (*v1.MoonPosition, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.MoonPositionRequest{
+		Api:         "v1",
+		Longitude:   long,
+		Latitude:    lat,
+		Height:      height,
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		Hour:        hour,
+		Refraction:  refraction,
+		Temperature: temperature,
+		Pressure:    pressure,
+		Kind:        kind,
+	}
+	return c.GetMoonPosition(ctx, &req)
+}
+
+// GetMoonPhases -
+func (m *MoonClient) GetMoonPhases(startYear, startMonth, startDay, endYear, endMonth, endDay int32) (*v1.MoonPhases, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.MoonPhasesRequest{
+		Api:        "v1",
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+	}
+	return c.GetMoonPhases(ctx, &req)
+}
+
+// GetMoonIllumination -
+func (m *MoonClient) GetMoonIllumination(year, month, day int32, hour float64) (*v1.MoonIllumination, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.MoonIlluminationRequest{
+		Api:   "v1",
+		Year:  year,
+		Month: month,
+		Day:   day,
+		Hour:  hour,
+	}
+	return c.GetMoonIllumination(ctx, &req)
+}
+
+// GetMoonRiseSet -
+func (m *MoonClient) GetMoonRiseSet(long, lat, height float64, year, month, day int32, utcOffset float64, refraction string, temperature, pressure *wrappers.DoubleValue) (*v1.MoonRiseSet, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.MoonRiseSetRequest{
+		Api:         "v1",
+		Longitude:   long,
+		Latitude:    lat,
+		Height:      height,
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		UtcOffset:   utcOffset,
+		Refraction:  refraction,
+		Temperature: temperature,
+		Pressure:    pressure,
+	}
+	return c.GetMoonRiseSet(ctx, &req)
+}
+
+// GetLunarEclipses -
+func (m *MoonClient) GetLunarEclipses(long, lat, height float64, startYear, startMonth, startDay, endYear, endMonth, endDay int32) (*v1.LunarEclipses, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.LunarEclipseRequest{
+		Api:        "v1",
+		Longitude:  long,
+		Latitude:   lat,
+		Height:     height,
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+	}
+	return c.GetLunarEclipses(ctx, &req)
+}
+
+// GetLunarOccultations -
+func (m *MoonClient) GetLunarOccultations(long, lat, height float64, startYear, startMonth, startDay, endYear, endMonth, endDay int32, targets []string, ephemeris v1.OccultationEphemeris) (*v1.LunarOccultations, error) {
+	c, conn := m.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.LunarOccultationRequest{
+		Api:        "v1",
+		Longitude:  long,
+		Latitude:   lat,
+		Height:     height,
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+		Targets:    targets,
+		Ephemeris:  ephemeris,
+	}
+	return c.GetLunarOccultations(ctx, &req)
+}
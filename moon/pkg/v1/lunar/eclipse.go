@@ -0,0 +1,110 @@
+package lunar
+
+import "math"
+
+// Lunar eclipses, from Jean Meeus, Astronomical Algorithms, chapter 54. The
+// contact times are accurate to a few minutes.
+
+// Eclipse describes a lunar eclipse, distances are in equatorial Earth radii
+// and times in Julian ephemeris days
+type Eclipse struct {
+	// Greatest is the instant the Moon is closest to the shadow axis
+	Greatest float64
+	// Gamma is the least distance between the Moon and the shadow axis,
+	// negative when the Moon passes south of the axis
+	Gamma float64
+	// PenumbralRadius and UmbralRadius are the radii of the shadow at the
+	// distance of the Moon
+	PenumbralRadius float64
+	UmbralRadius    float64
+	// PenumbralMagnitude and UmbralMagnitude are the fractions of the
+	// Moon's diameter immersed in each shadow at greatest eclipse
+	PenumbralMagnitude float64
+	UmbralMagnitude    float64
+	// Semidurations of the penumbral, partial and total phases in days,
+	// zero when the phase does not occur
+	PenumbralSemiduration float64
+	PartialSemiduration   float64
+	TotalSemiduration     float64
+}
+
+// LunarEclipse returns the eclipse at the full moon of lunation k, ok is
+// false when the Moon misses the penumbra
+func LunarEclipse(k float64) (eclipse Eclipse, ok bool) {
+	k = math.Floor(k) + 0.5
+	t := k / 1236.85
+
+	f := degreesToRadians(160.7108 + 390.67050284*k + t*t*(-0.0016118+t*(-0.00000227+t*0.000000011)))
+	if math.Abs(math.Sin(f)) > 0.36 {
+		// The Moon is too far from the node
+		return eclipse, false
+	}
+
+	e := 1 - t*(0.002516+0.0000074*t)
+	m := degreesToRadians(2.5534 + 29.10535670*k + t*t*(-0.0000014-t*0.00000011))
+	mp := degreesToRadians(201.5643 + 385.81693528*k + t*t*(0.0107582+t*(0.00001238-t*0.000000058)))
+	omega := degreesToRadians(124.7746 - 1.56375588*k + t*t*(0.0020672+t*0.00000215))
+	f1 := f - degreesToRadians(0.02665*math.Sin(omega))
+	a1 := degreesToRadians(299.77 + 0.107408*k - 0.009173*t*t)
+
+	mean := 2451550.09766 + SynodicMonth*k + t*t*(0.00015437+t*(-0.000000150+t*0.00000000073))
+	eclipse.Greatest = mean - 0.4065*math.Sin(mp) +
+		0.1727*e*math.Sin(m) +
+		0.0161*math.Sin(2*mp) -
+		0.0097*math.Sin(2*f1) +
+		0.0073*e*math.Sin(mp-m) -
+		0.0050*e*math.Sin(mp+m) -
+		0.0023*math.Sin(mp-2*f1) +
+		0.0021*e*math.Sin(2*m) +
+		0.0012*math.Sin(mp+2*f1) +
+		0.0006*e*math.Sin(2*mp+m) -
+		0.0004*math.Sin(3*mp) -
+		0.0003*e*math.Sin(m+2*f1) +
+		0.0003*math.Sin(a1) -
+		0.0002*e*math.Sin(m-2*f1) -
+		0.0002*e*math.Sin(2*mp-m) -
+		0.0002*math.Sin(omega)
+
+	p := 0.2070*e*math.Sin(m) +
+		0.0024*e*math.Sin(2*m) -
+		0.0392*math.Sin(mp) +
+		0.0116*math.Sin(2*mp) -
+		0.0073*e*math.Sin(mp+m) +
+		0.0067*e*math.Sin(mp-m) +
+		0.0118*math.Sin(2*f1)
+	q := 5.2207 -
+		0.0048*e*math.Cos(m) +
+		0.0020*e*math.Cos(2*m) -
+		0.3299*math.Cos(mp) -
+		0.0060*e*math.Cos(mp+m) +
+		0.0041*e*math.Cos(mp-m)
+	w := math.Abs(math.Cos(f1))
+	eclipse.Gamma = (p*math.Cos(f1) + q*math.Sin(f1)) * (1 - 0.0048*w)
+	u := 0.0059 +
+		0.0046*e*math.Cos(m) -
+		0.0182*math.Cos(mp) +
+		0.0004*math.Cos(2*mp) -
+		0.0005*math.Cos(m+mp)
+
+	eclipse.PenumbralRadius = 1.2848 + u
+	eclipse.UmbralRadius = 0.7403 - u
+	gamma := math.Abs(eclipse.Gamma)
+	eclipse.PenumbralMagnitude = (1.5573 + u - gamma) / 0.5450
+	eclipse.UmbralMagnitude = (1.0128 - u - gamma) / 0.5450
+	if eclipse.PenumbralMagnitude <= 0 {
+		return eclipse, false
+	}
+
+	// n is the Moon's hourly motion relative to the shadow, in Earth radii
+	n := 0.5458 + 0.0400*math.Cos(mp)
+	semiduration := func(radius float64) float64 {
+		if radius <= gamma {
+			return 0
+		}
+		return math.Sqrt(radius*radius-gamma*gamma) / n / 24
+	}
+	eclipse.PenumbralSemiduration = semiduration(1.5573 + u)
+	eclipse.PartialSemiduration = semiduration(1.0128 - u)
+	eclipse.TotalSemiduration = semiduration(0.4678 - u)
+	return eclipse, true
+}
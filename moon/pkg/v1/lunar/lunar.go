@@ -0,0 +1,243 @@
+package lunar
+
+import "math"
+
+// Geocentric position of the Moon, from Jean Meeus, Astronomical Algorithms,
+// chapter 47, which is based on the ELP-2000/82 lunar theory.
+
+// EarthRadius is the equatorial radius of the Earth in km, as used by Meeus
+const EarthRadius = 6378.14
+
+// periodicTerm is a row of tables 47.A and 47.B, the multiples of D, M, M'
+// and F and the coefficients of the sine (longitude, latitude) and cosine
+// (distance) terms
+type periodicTerm struct {
+	d, m, mp, f float64
+	sine        float64
+	cosine      float64
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
+
+// MeanLongitude -
+func MeanLongitude(t float64) float64 {
+	// t is the number of Julian centuries since J2000.0
+	return normalise(218.3164477 + t*(481267.88123421+t*(-0.0015786+t*(1.0/538841-t/65194000))))
+}
+
+// MeanElongation -
+func MeanElongation(t float64) float64 {
+	return normalise(297.8501921 + t*(445267.1114034+t*(-0.0018819+t*(1.0/545868-t/113065000))))
+}
+
+// SunMeanAnomaly -
+func SunMeanAnomaly(t float64) float64 {
+	return normalise(357.5291092 + t*(35999.0502909+t*(-0.0001535+t/24490000)))
+}
+
+// MeanAnomaly -
+func MeanAnomaly(t float64) float64 {
+	return normalise(134.9633964 + t*(477198.8675055+t*(0.0087414+t*(1.0/69699-t/14712000))))
+}
+
+// ArgumentOfLatitude -
+func ArgumentOfLatitude(t float64) float64 {
+	return normalise(93.2720950 + t*(483202.0175233+t*(-0.0036539+t*(-1.0/3526000+t/863310000))))
+}
+
+// MeanAscendingNode -
+func MeanAscendingNode(t float64) float64 {
+	return normalise(125.0445479 + t*(-1934.1362891+t*(0.0020754+t*(1.0/467441-t/60616000))))
+}
+
+// Position returns the geocentric ecliptic longitude and latitude of the
+// Moon in degrees, referred to the mean equinox of date, and the distance
+// between the centres of the Earth and Moon in km. t is the number of Julian
+// centuries since J2000.0 in dynamical time.
+func Position(t float64) (longitude, latitude, distance float64) {
+	lp := degreesToRadians(MeanLongitude(t))
+	d := degreesToRadians(MeanElongation(t))
+	m := degreesToRadians(SunMeanAnomaly(t))
+	mp := degreesToRadians(MeanAnomaly(t))
+	f := degreesToRadians(ArgumentOfLatitude(t))
+
+	// Corrections for the action of Venus (a1), Jupiter (a2) and the
+	// flattening of the Earth (a3)
+	a1 := degreesToRadians(119.75 + 131.849*t)
+	a2 := degreesToRadians(53.09 + 479264.290*t)
+	a3 := degreesToRadians(313.45 + 481266.484*t)
+
+	// The eccentricity of the Earth's orbit is decreasing, terms containing
+	// the Sun's mean anomaly are scaled by e
+	e := 1 - t*(0.002516+0.0000074*t)
+
+	sumL := 3958*math.Sin(a1) + 1962*math.Sin(lp-f) + 318*math.Sin(a2)
+	sumR := 0.0
+	sumB := -2235*math.Sin(lp) + 382*math.Sin(a3) + 175*math.Sin(a1-f) +
+		175*math.Sin(a1+f) + 127*math.Sin(lp-mp) - 115*math.Sin(lp+mp)
+
+	for _, term := range longitudeDistanceTerms {
+		arg := term.d*d + term.m*m + term.mp*mp + term.f*f
+		scale := math.Pow(e, math.Abs(term.m))
+		sumL += term.sine * scale * math.Sin(arg)
+		sumR += term.cosine * scale * math.Cos(arg)
+	}
+	for _, term := range latitudeTerms {
+		arg := term.d*d + term.m*m + term.mp*mp + term.f*f
+		sumB += term.sine * math.Pow(e, math.Abs(term.m)) * math.Sin(arg)
+	}
+
+	longitude = normalise(radiansToDegrees(lp) + sumL/1000000)
+	latitude = sumB / 1000000
+	distance = 385000.56 + sumR/1000
+	return longitude, latitude, distance // In Degrees, Degrees and km
+}
+
+// HorizontalParallax returns the equatorial horizontal parallax of the Moon
+// in degrees for a distance in km
+func HorizontalParallax(distance float64) float64 {
+	return radiansToDegrees(math.Asin(EarthRadius / distance))
+}
+
+// longitudeDistanceTerms is table 47.A
+var longitudeDistanceTerms = []periodicTerm{
+	{0, 0, 1, 0, 6288774, -20905355},
+	{2, 0, -1, 0, 1274027, -3699111},
+	{2, 0, 0, 0, 658314, -2955968},
+	{0, 0, 2, 0, 213618, -569925},
+	{0, 1, 0, 0, -185116, 48888},
+	{0, 0, 0, 2, -114332, -3149},
+	{2, 0, -2, 0, 58793, 246158},
+	{2, -1, -1, 0, 57066, -152138},
+	{2, 0, 1, 0, 53322, -170733},
+	{2, -1, 0, 0, 45758, -204586},
+	{0, 1, -1, 0, -40923, -129620},
+	{1, 0, 0, 0, -34720, 108743},
+	{0, 1, 1, 0, -30383, 104755},
+	{2, 0, 0, -2, 15327, 10321},
+	{0, 0, 1, 2, -12528, 0},
+	{0, 0, 1, -2, 10980, 79661},
+	{4, 0, -1, 0, 10675, -34782},
+	{0, 0, 3, 0, 10034, -23210},
+	{4, 0, -2, 0, 8548, -21636},
+	{2, 1, -1, 0, -7888, 24208},
+	{2, 1, 0, 0, -6766, 30824},
+	{1, 0, -1, 0, -5163, -8379},
+	{1, 1, 0, 0, 4987, -16675},
+	{2, -1, 1, 0, 4036, -12831},
+	{2, 0, 2, 0, 3994, -10445},
+	{4, 0, 0, 0, 3861, -11650},
+	{2, 0, -3, 0, 3665, 14403},
+	{0, 1, -2, 0, -2689, -7003},
+	{2, 0, -1, 2, -2602, 0},
+	{2, -1, -2, 0, 2390, 10056},
+	{1, 0, 1, 0, -2348, 6322},
+	{2, -2, 0, 0, 2236, -9884},
+	{0, 1, 2, 0, -2120, 5751},
+	{0, 2, 0, 0, -2069, 0},
+	{2, -2, -1, 0, 2048, -4950},
+	{2, 0, 1, -2, -1773, 4130},
+	{2, 0, 0, 2, -1595, 0},
+	{4, -1, -1, 0, 1215, -3958},
+	{0, 0, 2, 2, -1110, 0},
+	{3, 0, -1, 0, -892, 3258},
+	{2, 1, 1, 0, -810, 2616},
+	{4, -1, -2, 0, 759, -1897},
+	{0, 2, -1, 0, -713, -2117},
+	{2, 2, -1, 0, -700, 2354},
+	{2, 1, -2, 0, 691, 0},
+	{2, -1, 0, -2, 596, 0},
+	{4, 0, 1, 0, 549, -1423},
+	{0, 0, 4, 0, 537, -1117},
+	{4, -1, 0, 0, 520, -1571},
+	{1, 0, -2, 0, -487, -1739},
+	{2, 1, 0, -2, -399, 0},
+	{0, 0, 2, -2, -381, -4421},
+	{1, 1, 1, 0, 351, 0},
+	{3, 0, -2, 0, -340, 0},
+	{4, 0, -3, 0, 330, 0},
+	{2, -1, 2, 0, 327, 0},
+	{0, 2, 1, 0, -323, 1165},
+	{1, 1, -1, 0, 299, 0},
+	{2, 0, 3, 0, 294, 0},
+	{2, 0, -1, -2, 0, 8752},
+}
+
+// latitudeTerms is table 47.B
+var latitudeTerms = []periodicTerm{
+	{0, 0, 0, 1, 5128122, 0},
+	{0, 0, 1, 1, 280602, 0},
+	{0, 0, 1, -1, 277693, 0},
+	{2, 0, 0, -1, 173237, 0},
+	{2, 0, -1, 1, 55413, 0},
+	{2, 0, -1, -1, 46271, 0},
+	{2, 0, 0, 1, 32573, 0},
+	{0, 0, 2, 1, 17198, 0},
+	{2, 0, 1, -1, 9266, 0},
+	{0, 0, 2, -1, 8822, 0},
+	{2, -1, 0, -1, 8216, 0},
+	{2, 0, -2, -1, 4324, 0},
+	{2, 0, 1, 1, 4200, 0},
+	{2, 1, 0, -1, -3359, 0},
+	{2, -1, -1, 1, 2463, 0},
+	{2, -1, 0, 1, 2211, 0},
+	{2, -1, -1, -1, 2065, 0},
+	{0, 1, -1, -1, -1870, 0},
+	{4, 0, -1, -1, 1828, 0},
+	{0, 1, 0, 1, -1794, 0},
+	{0, 0, 0, 3, -1749, 0},
+	{0, 1, -1, 1, -1565, 0},
+	{1, 0, 0, 1, -1491, 0},
+	{0, 1, 1, 1, -1475, 0},
+	{0, 1, 1, -1, -1410, 0},
+	{0, 1, 0, -1, -1344, 0},
+	{1, 0, 0, -1, -1335, 0},
+	{0, 0, 3, 1, 1107, 0},
+	{4, 0, 0, -1, 1021, 0},
+	{4, 0, -1, 1, 833, 0},
+	{0, 0, 1, -3, 777, 0},
+	{4, 0, -2, 1, 671, 0},
+	{2, 0, 0, -3, 607, 0},
+	{2, 0, 2, -1, 596, 0},
+	{2, -1, 1, -1, 491, 0},
+	{2, 0, -2, 1, -451, 0},
+	{0, 0, 3, -1, 439, 0},
+	{2, 0, 2, 1, 422, 0},
+	{2, 0, -3, -1, 421, 0},
+	{2, 1, -1, 1, -366, 0},
+	{2, 1, 0, 1, -351, 0},
+	{4, 0, 0, 1, 331, 0},
+	{2, -1, 1, 1, 315, 0},
+	{2, -2, 0, -1, 302, 0},
+	{0, 0, 1, 3, -283, 0},
+	{2, 1, 1, -1, -229, 0},
+	{1, 1, 0, -1, 223, 0},
+	{1, 1, 0, 1, 223, 0},
+	{0, 1, -2, -1, -220, 0},
+	{2, 1, -1, -1, -220, 0},
+	{1, 0, 1, 1, -185, 0},
+	{2, -1, -2, -1, 181, 0},
+	{0, 1, 2, 1, -177, 0},
+	{4, 0, -2, -1, 176, 0},
+	{4, -1, -1, -1, 166, 0},
+	{1, 0, 1, -1, -164, 0},
+	{4, 0, 1, -1, 132, 0},
+	{1, 0, -1, -1, -119, 0},
+	{4, -1, 0, -1, 115, 0},
+	{2, -2, 0, 1, 107, 0},
+}
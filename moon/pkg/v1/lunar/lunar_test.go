@@ -0,0 +1,125 @@
+package lunar_test
+
+import (
+	"testing"
+
+	"planetpositions/moon/pkg/v1/lunar"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPosition(t *testing.T) {
+	testcases := map[string]struct {
+		jde       float64
+		longitude float64
+		latitude  float64
+		distance  float64
+	}{
+		"Meeus example 47.a": {
+			jde:       2448724.5,
+			longitude: 133.162655,
+			latitude:  -3.229126,
+			distance:  368409.7,
+		},
+	}
+	for name, tc := range testcases {
+		long, lat, dist := lunar.Position((tc.jde - 2451545.0) / 36525)
+		assert.InDelta(t, tc.longitude, long, 0.000001, "Test %s did not return the expected longitude", name)
+		assert.InDelta(t, tc.latitude, lat, 0.000001, "Test %s did not return the expected latitude", name)
+		assert.InDelta(t, tc.distance, dist, 0.1, "Test %s did not return the expected distance", name)
+	}
+}
+
+func TestPhaseTime(t *testing.T) {
+	testcases := map[string]struct {
+		k     float64
+		phase lunar.Phase
+		jde   float64
+	}{
+		"Meeus example 49.a": {
+			k:     -283,
+			phase: lunar.NewMoon,
+			jde:   2443192.65118,
+		},
+		"Meeus example 49.b": {
+			k:     544,
+			phase: lunar.LastQuarter,
+			jde:   2467636.49186,
+		},
+	}
+	for name, tc := range testcases {
+		jde := lunar.PhaseTime(tc.k, tc.phase)
+		assert.InDelta(t, tc.jde, jde, 0.00001, "Test %s did not return the expected time", name)
+	}
+}
+
+func TestIlluminatedFraction(t *testing.T) {
+	// Meeus example 48.a using the approximation of equation 48.4
+	i := lunar.PhaseAngle((2448724.5 - 2451545.0) / 36525)
+	assert.InDelta(t, 68.88, i, 0.01, "PhaseAngle did not return the expected angle")
+	assert.InDelta(t, 0.6802, lunar.IlluminatedFraction(i), 0.0001, "IlluminatedFraction did not return the expected fraction")
+}
+
+func TestLunarEclipse(t *testing.T) {
+	testcases := map[string]struct {
+		k                  float64
+		greatest           float64
+		gamma              float64
+		penumbralMagnitude float64
+		umbralMagnitude    float64
+		total              float64
+		partial            float64
+		penumbral          float64
+	}{
+		"Meeus example 54.c": {
+			k:                  -329,
+			greatest:           2441849.3687,
+			gamma:              -1.3249,
+			penumbralMagnitude: 0.4625,
+			penumbral:          101,
+		},
+		"Meeus example 54.d": {
+			k:               -29,
+			greatest:        2450708.2835,
+			gamma:           -0.3791,
+			umbralMagnitude: 1.1868,
+			total:           30,
+			partial:         98,
+			penumbral:       153,
+		},
+	}
+	for name, tc := range testcases {
+		e, ok := lunar.LunarEclipse(tc.k)
+		assert.True(t, ok, "Test %s did not find an eclipse", name)
+		assert.InDelta(t, tc.greatest, e.Greatest, 0.0001, "Test %s did not return the expected greatest eclipse", name)
+		assert.InDelta(t, tc.gamma, e.Gamma, 0.0001, "Test %s did not return the expected gamma", name)
+		if tc.umbralMagnitude > 0 {
+			assert.InDelta(t, tc.umbralMagnitude, e.UmbralMagnitude, 0.0001, "Test %s did not return the expected umbral magnitude", name)
+		} else {
+			assert.InDelta(t, tc.penumbralMagnitude, e.PenumbralMagnitude, 0.0001, "Test %s did not return the expected penumbral magnitude", name)
+		}
+		// Semidurations in minutes
+		assert.InDelta(t, tc.total, e.TotalSemiduration*1440, 0.5, "Test %s did not return the expected total semiduration", name)
+		assert.InDelta(t, tc.partial, e.PartialSemiduration*1440, 0.5, "Test %s did not return the expected partial semiduration", name)
+		assert.InDelta(t, tc.penumbral, e.PenumbralSemiduration*1440, 0.5, "Test %s did not return the expected penumbral semiduration", name)
+	}
+}
+
+func TestBrightLimbAngle(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 48.a, 1992 April 12 at 0h TD
+	chi := lunar.BrightLimbAngle(134.6885, 13.7684, 20.6579, 8.6964)
+	assert.InDelta(t, 285.0, chi, 0.1)
+
+	assert.True(t, lunar.OnBrightLimb(300, chi))
+	assert.True(t, lunar.OnBrightLimb(10, chi))
+	assert.False(t, lunar.OnBrightLimb(100, chi))
+}
+
+func TestSeparation(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 17.a, Arcturus and Spica
+	assert.InDelta(t, 32.7930, lunar.Separation(213.9154, 19.1825, 201.2983, -11.1614), 0.0001)
+	// A star one arcsecond north of the Moon's centre
+	assert.InDelta(t, 1.0/3600, lunar.Separation(100, 20, 100, 20+1.0/3600), 1e-12)
+	assert.InDelta(t, 0, lunar.PositionAngle(100, 20, 100, 20+1.0/3600), 1e-9)
+	assert.InDelta(t, 90, lunar.PositionAngle(100, 20, 100.001, 20), 0.001)
+}
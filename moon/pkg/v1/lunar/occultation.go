@@ -0,0 +1,39 @@
+package lunar
+
+import "math"
+
+// The geometry of occultations, the angles between bodies on the sky and the
+// orientation of the Moon's limb. Positions are right ascension and
+// declination in degrees.
+
+// Separation returns the angle between two positions in degrees
+func Separation(ra1, dec1, ra2, dec2 float64) float64 {
+	a1, d1 := degreesToRadians(ra1), degreesToRadians(dec1)
+	a2, d2 := degreesToRadians(ra2), degreesToRadians(dec2)
+	// The haversine formula keeps its precision for small angles
+	h := math.Pow(math.Sin((d2-d1)/2), 2) + math.Cos(d1)*math.Cos(d2)*math.Pow(math.Sin((a2-a1)/2), 2)
+	return radiansToDegrees(2 * math.Asin(math.Min(1, math.Sqrt(h))))
+}
+
+// PositionAngle returns the direction of the second position from the
+// first, in degrees measured from north through east
+func PositionAngle(ra1, dec1, ra2, dec2 float64) float64 {
+	d1, d2 := degreesToRadians(dec1), degreesToRadians(dec2)
+	da := degreesToRadians(ra2 - ra1)
+	return normalise(radiansToDegrees(math.Atan2(math.Sin(da), math.Cos(d1)*math.Tan(d2)-math.Sin(d1)*math.Cos(da))))
+}
+
+// BrightLimbAngle returns the position angle of the midpoint of the Moon's
+// bright limb, the direction of the sun from the Moon, Meeus, Astronomical
+// Algorithms, equation 48.5
+func BrightLimbAngle(moonRA, moonDec, sunRA, sunDec float64) float64 {
+	return PositionAngle(moonRA, moonDec, sunRA, sunDec)
+}
+
+// OnBrightLimb reports whether the point of the limb at the position angle
+// is sunlit, the bright limb stretches 90 degrees either side of its
+// midpoint
+func OnBrightLimb(positionAngle, brightLimbAngle float64) bool {
+	difference := normalise(positionAngle - brightLimbAngle)
+	return difference < 90 || difference > 270
+}
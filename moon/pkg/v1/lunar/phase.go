@@ -0,0 +1,186 @@
+package lunar
+
+import "math"
+
+// Phases of the Moon, from Jean Meeus, Astronomical Algorithms, chapters 48
+// and 49.
+
+// SynodicMonth is the mean length of a lunation in days
+const SynodicMonth = 29.530588861
+
+// Phase -
+type Phase int
+
+const (
+	// NewMoon -
+	NewMoon Phase = iota
+	// FirstQuarter -
+	FirstQuarter
+	// FullMoon -
+	FullMoon
+	// LastQuarter -
+	LastQuarter
+)
+
+// Lunation returns the number of lunations since the new moon of 6 January
+// 2000 at the Julian ephemeris day jde, the integer part counts new moons
+func Lunation(jde float64) float64 {
+	return (jde - 2451550.09766) / SynodicMonth
+}
+
+// PhaseTime returns the Julian ephemeris day of the phase p in lunation k,
+// accurate to a few seconds
+func PhaseTime(k float64, p Phase) float64 {
+	k = math.Floor(k) + float64(p)/4
+	t := k / 1236.85
+
+	jde := 2451550.09766 + SynodicMonth*k + t*t*(0.00015437+t*(-0.000000150+t*0.00000000073))
+
+	e := 1 - t*(0.002516+0.0000074*t)
+	m := degreesToRadians(2.5534 + 29.10535670*k + t*t*(-0.0000014-t*0.00000011))
+	mp := degreesToRadians(201.5643 + 385.81693528*k + t*t*(0.0107582+t*(0.00001238-t*0.000000058)))
+	f := degreesToRadians(160.7108 + 390.67050284*k + t*t*(-0.0016118+t*(-0.00000227+t*0.000000011)))
+	omega := degreesToRadians(124.7746 - 1.56375588*k + t*t*(0.0020672+t*0.00000215))
+
+	switch p {
+	case NewMoon, FullMoon:
+		c := newMoonCorrections
+		if p == FullMoon {
+			c = fullMoonCorrections
+		}
+		jde += c[0]*math.Sin(mp) +
+			c[1]*e*math.Sin(m) +
+			c[2]*math.Sin(2*mp) +
+			c[3]*math.Sin(2*f) +
+			c[4]*e*math.Sin(mp-m) +
+			c[5]*e*math.Sin(mp+m) +
+			c[6]*e*e*math.Sin(2*m) +
+			c[7]*math.Sin(mp-2*f) +
+			c[8]*math.Sin(mp+2*f) +
+			c[9]*e*math.Sin(2*mp+m) +
+			c[10]*math.Sin(3*mp) +
+			c[11]*e*math.Sin(m+2*f) +
+			c[12]*e*math.Sin(m-2*f) +
+			c[13]*e*math.Sin(2*mp-m) +
+			c[14]*math.Sin(omega) +
+			c[15]*math.Sin(mp+2*m) +
+			c[16]*math.Sin(2*mp-2*f) +
+			c[17]*math.Sin(3*m) +
+			c[18]*math.Sin(mp+m-2*f) +
+			c[19]*math.Sin(2*mp+2*f) +
+			c[20]*math.Sin(mp+m+2*f) +
+			c[21]*math.Sin(mp-m+2*f) +
+			c[22]*math.Sin(mp-m-2*f) +
+			c[23]*math.Sin(3*mp+m) +
+			c[24]*math.Sin(4*mp)
+	default:
+		jde += -0.62801*math.Sin(mp) +
+			0.17172*e*math.Sin(m) -
+			0.01183*e*math.Sin(mp+m) +
+			0.00862*math.Sin(2*mp) +
+			0.00804*math.Sin(2*f) +
+			0.00454*e*math.Sin(mp-m) +
+			0.00204*e*e*math.Sin(2*m) -
+			0.00180*math.Sin(mp-2*f) -
+			0.00070*math.Sin(mp+2*f) -
+			0.00040*math.Sin(3*mp) -
+			0.00034*e*math.Sin(2*mp-m) +
+			0.00032*e*math.Sin(m+2*f) +
+			0.00032*e*math.Sin(m-2*f) -
+			0.00028*e*e*math.Sin(mp+2*m) +
+			0.00027*e*math.Sin(2*mp+m) -
+			0.00017*math.Sin(omega) -
+			0.00005*math.Sin(mp-m-2*f) +
+			0.00004*math.Sin(2*mp+2*f) -
+			0.00004*math.Sin(mp+m+2*f) +
+			0.00004*math.Sin(mp-2*m) +
+			0.00003*math.Sin(mp+m-2*f) +
+			0.00003*math.Sin(3*m) +
+			0.00002*math.Sin(2*mp-2*f) +
+			0.00002*math.Sin(mp-m+2*f) -
+			0.00002*math.Sin(3*mp+m)
+
+		w := 0.00306 - 0.00038*e*math.Cos(m) + 0.00026*math.Cos(mp) -
+			0.00002*math.Cos(mp-m) + 0.00002*math.Cos(mp+m) + 0.00002*math.Cos(2*f)
+		if p == FirstQuarter {
+			jde += w
+		} else {
+			jde -= w
+		}
+	}
+
+	// Planetary arguments
+	for i, a := range planetaryArguments {
+		arg := a[0] + a[1]*k
+		if i == 0 {
+			arg -= 0.009173 * t * t
+		}
+		jde += a[2] * math.Sin(degreesToRadians(arg))
+	}
+	return jde
+}
+
+// PhaseAngle returns the angle at the Moon between the Sun and the Earth in
+// degrees, 0 at full moon and 180 at new moon, from the approximation of
+// Meeus equation 48.4
+func PhaseAngle(t float64) float64 {
+	d := MeanElongation(t)
+	m := degreesToRadians(SunMeanAnomaly(t))
+	mp := degreesToRadians(MeanAnomaly(t))
+	dr := degreesToRadians(d)
+
+	i := 180 - d -
+		6.289*math.Sin(mp) +
+		2.100*math.Sin(m) -
+		1.274*math.Sin(2*dr-mp) -
+		0.658*math.Sin(2*dr) -
+		0.214*math.Sin(2*mp) -
+		0.110*math.Sin(dr)
+	// The equation gives a negative angle while the Moon is waning
+	i = normalise(i)
+	if i > 180 {
+		i = 360 - i
+	}
+	return i
+}
+
+// IlluminatedFraction returns the fraction of the Moon's disk that is
+// illuminated for a phase angle in degrees
+func IlluminatedFraction(phaseAngle float64) float64 {
+	return (1 + math.Cos(degreesToRadians(phaseAngle))) / 2
+}
+
+// newMoonCorrections and fullMoonCorrections are the coefficients of the
+// periodic terms for the true phases, in days
+var newMoonCorrections = [25]float64{
+	-0.40720, 0.17241, 0.01608, 0.01039, 0.00739, -0.00514, 0.00208,
+	-0.00111, -0.00057, 0.00056, -0.00042, 0.00042, 0.00038, -0.00024,
+	-0.00017, -0.00007, 0.00004, 0.00004, 0.00003, 0.00003, -0.00003,
+	0.00003, -0.00002, -0.00002, 0.00002,
+}
+
+var fullMoonCorrections = [25]float64{
+	-0.40614, 0.17302, 0.01614, 0.01043, 0.00734, -0.00515, 0.00209,
+	-0.00111, -0.00057, 0.00056, -0.00042, 0.00042, 0.00038, -0.00024,
+	-0.00017, -0.00007, 0.00004, 0.00004, 0.00003, 0.00003, -0.00003,
+	0.00003, -0.00002, -0.00002, 0.00002,
+}
+
+// planetaryArguments are the arguments A1 to A14, in degrees and degrees per
+// lunation, and the coefficients of the additional corrections in days
+var planetaryArguments = [14][3]float64{
+	{299.77, 0.107408, 0.000325},
+	{251.88, 0.016321, 0.000165},
+	{251.83, 26.651886, 0.000164},
+	{349.42, 36.412478, 0.000126},
+	{84.66, 18.206239, 0.000110},
+	{141.74, 53.303771, 0.000062},
+	{207.14, 2.453732, 0.000060},
+	{154.84, 7.306860, 0.000056},
+	{34.52, 27.261239, 0.000047},
+	{207.19, 0.121824, 0.000042},
+	{291.34, 1.844379, 0.000040},
+	{161.72, 24.198154, 0.000037},
+	{239.56, 25.513099, 0.000035},
+	{331.55, 3.592518, 0.000023},
+}
@@ -0,0 +1,139 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/moon/grpc/v1"
+	"planetpositions/moon/pkg/v1/lunar"
+)
+
+// maxEclipseSearchDays limits the length of the date range searched
+const maxEclipseSearchDays = 36525
+
+func (s *moonServiceServer) GetLunarEclipses(ctx context.Context, req *v1.LunarEclipseRequest) (*v1.LunarEclipses, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return nil, fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return nil, fmt.Errorf("unusable end date provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return nil, err
+	}
+	if end <= start {
+		return nil, fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxEclipseSearchDays {
+		return nil, fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxEclipseSearchDays)
+	}
+
+	o := topocentric.NewObserver(req.Latitude, req.Longitude, req.Height)
+	eclipses := &v1.LunarEclipses{Api: apiVersion}
+	// Greatest eclipse is within a few hours of full moon
+	for k := math.Floor(lunar.Lunation(start)) - 1; k <= math.Ceil(lunar.Lunation(end)); k++ {
+		e, ok := lunar.LunarEclipse(k)
+		if !ok {
+			continue
+		}
+		dt, err := s.DeltaT(e.Greatest)
+		if err != nil {
+			return nil, err
+		}
+		greatest := e.Greatest - dt.Seconds/86400
+		if greatest < start || greatest >= end {
+			continue
+		}
+		eclipse, err := s.lunarEclipse(e, greatest, dt.Seconds, o)
+		if err != nil {
+			return nil, err
+		}
+		eclipses.Eclipses = append(eclipses.Eclipses, eclipse)
+	}
+	return eclipses, nil
+}
+
+// lunarEclipse returns the circumstances of the eclipse e for the observer,
+// greatest is the instant of greatest eclipse in universal time
+func (s *moonServiceServer) lunarEclipse(e lunar.Eclipse, greatest, deltaT float64, o topocentric.Observer) (*v1.LunarEclipse, error) {
+	eclipse := &v1.LunarEclipse{
+		Type:               v1.LunarEclipseType_PENUMBRAL,
+		PenumbralMagnitude: e.PenumbralMagnitude,
+		UmbralMagnitude:    e.UmbralMagnitude,
+		Gamma:              e.Gamma,
+	}
+	switch {
+	case e.TotalSemiduration > 0:
+		eclipse.Type = v1.LunarEclipseType_TOTAL
+	case e.PartialSemiduration > 0:
+		eclipse.Type = v1.LunarEclipseType_PARTIAL
+	}
+
+	var err error
+	contact := func(offset float64) *v1.LunarEclipseContact {
+		if err != nil {
+			return nil
+		}
+		var c *v1.LunarEclipseContact
+		c, err = s.eclipseContact(greatest+offset, deltaT, o)
+		if c != nil && c.Visible {
+			eclipse.Visible = true
+		}
+		return c
+	}
+	eclipse.P1 = contact(-e.PenumbralSemiduration)
+	eclipse.Greatest = contact(0)
+	eclipse.P4 = contact(e.PenumbralSemiduration)
+	if e.PartialSemiduration > 0 {
+		eclipse.U1 = contact(-e.PartialSemiduration)
+		eclipse.U4 = contact(e.PartialSemiduration)
+	}
+	if e.TotalSemiduration > 0 {
+		eclipse.U2 = contact(-e.TotalSemiduration)
+		eclipse.U3 = contact(e.TotalSemiduration)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The moon may rise or set part way through the eclipse
+	if !eclipse.Visible {
+		for jd := greatest - e.PenumbralSemiduration; jd < greatest+e.PenumbralSemiduration; jd += 1.0 / riseSetSamples {
+			if s.upperLimb(s.horizontal(jd, julianCentury(jd+deltaT/86400), o), refraction.ConventionalHorizon) >= 0 {
+				eclipse.Visible = true
+				break
+			}
+		}
+	}
+	return eclipse, nil
+}
+
+func (s *moonServiceServer) eclipseContact(jd, deltaT float64, o topocentric.Observer) (*v1.LunarEclipseContact, error) {
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	h := s.horizontal(jd, julianCentury(jd+deltaT/86400), o)
+	return &v1.LunarEclipseContact{
+		Time:         time,
+		MoonAltitude: h.altitude,
+		Visible:      s.upperLimb(h, refraction.ConventionalHorizon) >= 0,
+	}, nil
+}
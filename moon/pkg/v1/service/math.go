@@ -0,0 +1,48 @@
+package v1
+
+import "math"
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
+
+// bisect returns the root of f between lo and hi, f(lo) and f(hi) must have
+// opposite signs
+func bisect(f func(float64) float64, lo, hi float64) float64 {
+	flo := f(lo)
+	for i := 0; i < 40; i++ {
+		mid := (lo + hi) / 2
+		fmid := f(mid)
+		if (fmid < 0) == (flo < 0) {
+			lo, flo = mid, fmid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// julianCentury mirrors the julian service's TimeJulianCentury, for loops
+// that would otherwise make thousands of calls to the julian service
+func julianCentury(julianDay float64) float64 {
+	return (julianDay - 2451545.0) / 36525.0
+}
+
+// julianEphemerisDay is the inverse of julianCentury, for the coordinates
+// packages that take instants as Julian ephemeris days
+func julianEphemerisDay(t float64) float64 {
+	return 2451545.0 + t*36525.0
+}
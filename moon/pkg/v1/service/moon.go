@@ -0,0 +1,198 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/coordinates/pkg/v1/transform"
+	jc "planetpositions/julian/pkg/v1/client"
+	"planetpositions/moon/grpc/v1"
+	"planetpositions/moon/pkg/v1/lunar"
+	pc "planetpositions/planets/pkg/v1/client"
+	sc "planetpositions/stars/pkg/v1/client"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+)
+
+// moonServiceServer is implementation of v1.MoonServiceServer proto interface
+type moonServiceServer struct {
+	jc.JulianClient
+	// The planets and stars services give the places of the bodies the moon
+	// occults
+	planets pc.PlanetsClient
+	stars   sc.StarsClient
+}
+
+// NewMoonService creates Moon service
+func NewMoonService() v1.MoonServiceServer {
+	s := moonServiceServer{}
+	s.Address = "julian:5055"
+	s.planets.Address = "planets:5055"
+	s.stars.Address = "stars:5055"
+	return &s
+}
+
+func (s *moonServiceServer) GetMoonPosition(ctx context.Context, req *v1.MoonPositionRequest) (*v1.MoonPosition, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	model, air, err := refraction.Conditions(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	kind := req.Kind
+	if kind == v1.MoonPositionKind_MOON_POSITION_KIND_UNSPECIFIED {
+		kind = v1.MoonPositionKind_APPARENT
+	}
+	reduced, ok := kinds[kind]
+	if !ok && kind != v1.MoonPositionKind_APPARENT {
+		return nil, fmt.Errorf("unusable input provided: unknown kind of position %v", req.Kind)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	t, _, err := s.dynamicalCentury(jd)
+	if err != nil {
+		return nil, err
+	}
+	o := topocentric.NewObserver(req.Latitude, req.Longitude, req.Height)
+	p := s.position(jd, t, o)
+	p.ApparentAltitude = model.Apparent(p.TopocentricAltitude, air)
+	p.Kind = kind
+	if kind == v1.MoonPositionKind_APPARENT {
+		return p, nil
+	}
+
+	// The horizontal coordinates stay those of the apparent place, the
+	// others are replaced by those of the kind, the parallax found on the
+	// true equator of date
+	jde := julianEphemerisDay(t)
+	place, err := reduction.Reduce(reduced, moonSource, centre, jde)
+	if err != nil {
+		return nil, err
+	}
+	p.EclipticLongitude, p.EclipticLatitude = place.Longitude, place.Latitude
+	p.RightAscension, p.Declination = place.RightAscension, place.Declination
+	p.Distance = place.Distance * topocentric.AstronomicalUnit
+	p.HorizontalParallax = lunar.HorizontalParallax(p.Distance)
+	p.TopocentricRightAscension, p.TopocentricDeclination, p.TopocentricDistance = o.EquatorialInFrame(p.RightAscension, p.Declination, p.Distance, transform.ApparentSiderealTime(jd, jde), reduction.TrueEquator(reduced, jde))
+	return p, nil
+}
+
+// kinds maps the kinds of position in requests other than apparent onto those
+// of the reduction
+var kinds = map[v1.MoonPositionKind]reduction.Kind{
+	v1.MoonPositionKind_GEOMETRIC:   reduction.Geometric,
+	v1.MoonPositionKind_ASTROMETRIC: reduction.Astrometric,
+}
+
+// moonSource gives the geocentric position of the Moon to the reduction
+func moonSource(jde float64) (reduction.Vector, error) {
+	longitude, latitude, distance := lunar.Position((jde - precession.J2000) / 36525)
+	return reduction.Ecliptic(longitude, latitude, distance/topocentric.AstronomicalUnit, jde), nil
+}
+
+// centre is the Earth, from which the positions given to the reduction are
+// measured
+func centre(jde float64) (reduction.Vector, error) {
+	return reduction.Vector{}, nil
+}
+
+// julianDate -
+func (s *moonServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
+
+// dynamicalCentury returns the Julian centuries since J2000.0 in dynamical
+// time for the instant jd, in universal time, and the value of delta T in
+// seconds used to convert it
+func (s *moonServiceServer) dynamicalCentury(jd float64) (float64, float64, error) {
+	deltaT, err := s.DeltaT(jd)
+	if err != nil {
+		return 0, 0, fmt.Errorf("dynamicalCentury encountered the following error when executing DeltaT: %v", err)
+	}
+	t, err := s.TimeJulianCentury(jd + deltaT.Seconds/86400.0)
+	if err != nil {
+		return 0, 0, fmt.Errorf("dynamicalCentury encountered the following error when executing TimeJulianCentury: %v", err)
+	}
+	return t.JulianDateTime, deltaT.Seconds, nil
+}
+
+// instant returns the calendar date and UTC hour of jd
+func (s *moonServiceServer) instant(jd float64) (*v1.MoonInstant, error) {
+	cal, err := s.DayFromJulianDay(jd)
+	if err != nil {
+		return nil, fmt.Errorf("instant encountered the following error when executing DayFromJulianDay: %v", err)
+	}
+	return &v1.MoonInstant{
+		Year:       cal.Year,
+		Month:      cal.Month,
+		Day:        cal.Day,
+		Hour:       24 * (jd + 0.5 - math.Floor(jd+0.5)),
+		JulianDate: jd,
+	}, nil
+}
+
+// position returns the apparent geocentric position of the Moon at the
+// instant jd in universal time, t is the same instant in dynamical time
+func (s *moonServiceServer) position(jd, t float64, o topocentric.Observer) *v1.MoonPosition {
+	lambda, beta, distance := s.apparentEcliptic(t)
+	ra, dec := transform.EclipticToEquatorial(lambda, beta, precession.TrueObliquity(julianEphemerisDay(t)))
+	siderealTime := transform.ApparentSiderealTime(jd, julianEphemerisDay(t))
+	// longitude is positive east of Greenwich
+	hourAngle := normalise(siderealTime + o.Longitude - ra)
+	azimuth, altitude := transform.EquatorialToHorizontal(hourAngle, dec, o.Latitude)
+
+	topoRA, topoDec, topoDistance := o.Equatorial(ra, dec, distance, siderealTime)
+	topoAzimuth, topoAltitude := transform.EquatorialToHorizontal(normalise(siderealTime+o.Longitude-topoRA), topoDec, o.Latitude)
+
+	return &v1.MoonPosition{
+		Api:                apiVersion,
+		JulianDate:         jd,
+		EclipticLongitude:  lambda,
+		EclipticLatitude:   beta,
+		Distance:           distance,
+		HorizontalParallax: lunar.HorizontalParallax(distance),
+		RightAscension:     ra,
+		Declination:        dec,
+		Azimuth:            azimuth,
+		Altitude:           altitude,
+
+		TopocentricRightAscension: topoRA,
+		TopocentricDeclination:    topoDec,
+		TopocentricDistance:       topoDistance,
+		TopocentricAzimuth:        topoAzimuth,
+		TopocentricAltitude:       topoAltitude,
+	}
+}
+
+// apparentEcliptic returns the apparent geocentric ecliptic longitude and
+// latitude of the Moon in degrees, and its distance in km
+func (s *moonServiceServer) apparentEcliptic(t float64) (longitude, latitude, distance float64) {
+	longitude, latitude, distance = lunar.Position(t)
+	deltaPsi, _ := precession.Nutation(julianEphemerisDay(t))
+	return normalise(longitude + deltaPsi), latitude, distance
+}
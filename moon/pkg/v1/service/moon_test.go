@@ -0,0 +1,73 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/moon/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestService returns the service calling a julian service in process,
+// with dynamical and universal time taken to be the same
+func newTestService(t *testing.T) *moonServiceServer {
+	s := &moonServiceServer{}
+	s.Address = juliantest.NewServer(t, func(float64) float64 { return 0 })
+	return s
+}
+
+func TestGetMoonPosition(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 47.a, the moon on 1992 April
+	// 12 at 0h TD, seen from Palomar
+	s := newTestService(t)
+	req := &v1.MoonPositionRequest{
+		Api:       apiVersion,
+		Longitude: -(116 + 51.0/60 + 45.0/3600),
+		Latitude:  33 + 21.0/60 + 22.0/3600,
+		Height:    1706,
+		Year:      1992,
+		Month:     4,
+		Day:       12,
+	}
+	apparent, err := s.GetMoonPosition(context.Background(), req)
+	assert.NoError(t, err)
+	assert.InDelta(t, 133.167265, apparent.EclipticLongitude, 0.0001)
+	assert.InDelta(t, -3.229126, apparent.EclipticLatitude, 0.0001)
+	assert.InDelta(t, 368409.7, apparent.Distance, 0.1)
+	assert.InDelta(t, 134.688470, apparent.RightAscension, 0.0001)
+	assert.InDelta(t, 13.768368, apparent.Declination, 0.0001)
+	assert.Equal(t, v1.MoonPositionKind_APPARENT, apparent.Kind)
+
+	// The geometric place referred to J2000.0 comes back to the apparent one
+	// on the true equator of date, and so does the parallax, but for the
+	// nutation being found by a different series
+	req.Kind = v1.MoonPositionKind_GEOMETRIC
+	geometric, err := s.GetMoonPosition(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.MoonPositionKind_GEOMETRIC, geometric.Kind)
+	assert.InDelta(t, apparent.Distance, geometric.Distance, 1e-6)
+	assert.Equal(t, apparent.Altitude, geometric.Altitude)
+
+	toTrue := reduction.TrueEquator(reduction.Geometric, 2448724.5)
+	ra, dec := toTrue.Spherical(geometric.RightAscension, geometric.Declination)
+	assert.InDelta(t, apparent.RightAscension, ra, 0.5/3600)
+	assert.InDelta(t, apparent.Declination, dec, 0.5/3600)
+	ra, dec = toTrue.Spherical(geometric.TopocentricRightAscension, geometric.TopocentricDeclination)
+	assert.InDelta(t, apparent.TopocentricRightAscension, ra, 0.5/3600)
+	assert.InDelta(t, apparent.TopocentricDeclination, dec, 0.5/3600)
+	assert.InDelta(t, apparent.TopocentricDistance, geometric.TopocentricDistance, 0.01)
+
+	// The light takes 1.2 seconds to come from the moon, in which it moves
+	// about 0.7"
+	req.Kind = v1.MoonPositionKind_ASTROMETRIC
+	astrometric, err := s.GetMoonPosition(context.Background(), req)
+	assert.NoError(t, err)
+	assert.InDelta(t, -0.7/3600, astrometric.EclipticLongitude-geometric.EclipticLongitude, 0.1/3600)
+
+	req.Kind = 4
+	_, err = s.GetMoonPosition(context.Background(), req)
+	assert.Error(t, err)
+}
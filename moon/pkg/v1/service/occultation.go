@@ -0,0 +1,537 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/moon/grpc/v1"
+	"planetpositions/moon/pkg/v1/lunar"
+	planetsv1 "planetpositions/planets/grpc/v1"
+	starsv1 "planetpositions/stars/grpc/v1"
+)
+
+const (
+	// maxOccultationSearchDays limits the length of the date range searched
+	maxOccultationSearchDays = 366
+	// occultationCandidate is the geocentric separation, in degrees, within
+	// which a body is followed minute by minute. It covers the parallax of
+	// up to a degree, the moon's semidiameter and an hour of its motion.
+	occultationCandidate = 2.0
+	// occultationStep is the step, in days, used when searching for the
+	// contacts
+	occultationStep = 1.0 / 1440
+	// occultationWindow is how long, in days, either side of the moon's
+	// conjunction with a planet the contacts are searched for. Parallax
+	// can move them a couple of hours from the conjunction, and more when
+	// the turning Earth carries the observer along with the moon.
+	occultationWindow = 0.25
+	// occultationMagnitude is the faintest star searched for when no
+	// targets are named
+	occultationMagnitude = 4.0
+	// brightMagnitude is the faintest star seen occulted in twilight
+	brightMagnitude = 2.0
+	// twilight and darkness are the altitudes of the sun, in degrees, below
+	// which the occultations of bright and faint bodies can be seen
+	twilight = -6.0
+	darkness = -12.0
+	// kmPerAU converts the distances of the planets into km
+	kmPerAU = 149597870.7
+	// aberrationConstant is the constant of annual aberration, in degrees
+	aberrationConstant = 20.49552 / 3600
+)
+
+// occultationPlanets are the planets that can be occulted, in order from the
+// sun, with the altitude of the sun below which their occultations are seen
+var occultationPlanets = []struct {
+	name        string
+	body        planetsv1.Planet
+	sunAltitude float64
+}{
+	{"Mercury", planetsv1.Planet_MERCURY, twilight},
+	{"Venus", planetsv1.Planet_VENUS, twilight},
+	{"Mars", planetsv1.Planet_MARS, twilight},
+	{"Jupiter", planetsv1.Planet_JUPITER, twilight},
+	{"Saturn", planetsv1.Planet_SATURN, twilight},
+	{"Uranus", planetsv1.Planet_URANUS, darkness},
+	{"Neptune", planetsv1.Planet_NEPTUNE, darkness},
+	{"Pluto", planetsv1.Planet_PLUTO, darkness},
+}
+
+// occultationEphemerides maps the ephemerides in requests onto those of the
+// planets service
+var occultationEphemerides = map[v1.OccultationEphemeris]planetsv1.PlanetEphemeris{
+	v1.OccultationEphemeris_OCCULTATION_EPHEMERIS_UNSPECIFIED: planetsv1.PlanetEphemeris_ANALYTIC,
+	v1.OccultationEphemeris_ANALYTIC:                          planetsv1.PlanetEphemeris_ANALYTIC,
+	v1.OccultationEphemeris_JPL:                               planetsv1.PlanetEphemeris_JPL,
+}
+
+// occultationTarget is a star from the catalogue or a planet
+type occultationTarget struct {
+	name   string
+	planet bool
+	star   *starsv1.Star
+	body   planetsv1.Planet
+	// The altitude of the sun, in degrees, below which the occultation can
+	// be seen
+	sunAltitude float64
+}
+
+// candidate is a stretch of time, lo to hi in universal time, in which the
+// moon may occult the target
+type candidate struct {
+	target occultationTarget
+	lo, hi float64
+}
+
+// place is an apparent right ascension and declination in degrees, with the
+// distance in km, zero for a star
+type place struct {
+	ra, dec  float64
+	distance float64
+}
+
+// track gives the apparent geocentric place of a body at the instant jd, in
+// universal time
+type track func(jd float64) place
+
+func (s *moonServiceServer) GetLunarOccultations(ctx context.Context, req *v1.LunarOccultationRequest) (*v1.LunarOccultations, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return nil, fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return nil, fmt.Errorf("unusable end date provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	ephemeris, ok := occultationEphemerides[req.Ephemeris]
+	if !ok {
+		return nil, fmt.Errorf("unusable input provided: unknown ephemeris %v", req.Ephemeris)
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return nil, err
+	}
+	if end <= start {
+		return nil, fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxOccultationSearchDays {
+		return nil, fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxOccultationSearchDays)
+	}
+	targets, err := s.occultationTargets(req.Targets)
+	if err != nil {
+		return nil, err
+	}
+
+	// delta T changes by well under a second across the longest range
+	deltaT, err := s.DeltaT((start + end) / 2)
+	if err != nil {
+		return nil, err
+	}
+	dt := deltaT.Seconds / 86400
+	o := topocentric.NewObserver(req.Latitude, req.Longitude, req.Height)
+
+	candidates, err := s.planetCandidates(targets, start, end)
+	if err != nil {
+		return nil, err
+	}
+	candidates = append(candidates, s.starCandidates(targets, start, end, dt)...)
+
+	occultations := &v1.LunarOccultations{Api: apiVersion}
+	for _, c := range candidates {
+		found, err := s.occultations(c, ephemeris, dt, o)
+		if err != nil {
+			return nil, err
+		}
+		for _, occultation := range found {
+			if t := occultation.Disappearance.Time.JulianDate; t >= start && t < end {
+				occultations.Occultations = append(occultations.Occultations, occultation)
+			}
+		}
+	}
+	sort.SliceStable(occultations.Occultations, func(i, j int) bool {
+		return occultations.Occultations[i].Disappearance.Time.JulianDate < occultations.Occultations[j].Disappearance.Time.JulianDate
+	})
+	return occultations, nil
+}
+
+// occultationTargets resolves the names of stars and planets, the stars to
+// occultationMagnitude and all the planets when there are none
+func (s *moonServiceServer) occultationTargets(names []string) ([]occultationTarget, error) {
+	targets := []occultationTarget{}
+	if len(names) == 0 {
+		stars, err := s.stars.GetStars(occultationMagnitude)
+		if err != nil {
+			return nil, fmt.Errorf("occultationTargets encountered the following error when executing GetStars: %v", err)
+		}
+		for _, star := range stars.Stars {
+			targets = append(targets, starTarget(star))
+		}
+		for _, p := range occultationPlanets {
+			targets = append(targets, occultationTarget{name: p.name, planet: true, body: p.body, sunAltitude: p.sunAltitude})
+		}
+		return targets, nil
+	}
+
+	seen := map[string]bool{}
+	for _, name := range names {
+		target, ok := occultationTarget{}, false
+		for _, p := range occultationPlanets {
+			if strings.EqualFold(strings.TrimSpace(name), p.name) {
+				target, ok = occultationTarget{name: p.name, planet: true, body: p.body, sunAltitude: p.sunAltitude}, true
+			}
+		}
+		if !ok {
+			star, err := s.stars.GetStar(starIdentifier(name))
+			if err != nil {
+				return nil, fmt.Errorf("unusable input provided: unknown star or planet %s: %v", name, err)
+			}
+			target = starTarget(star)
+		}
+		if seen[target.name] {
+			return nil, fmt.Errorf("unusable input provided: %s requested more than once", target.name)
+		}
+		seen[target.name] = true
+		targets = append(targets, target)
+	}
+	return targets, nil
+}
+
+// starIdentifier splits a star given as a name, an HR number such as HR7001
+// or a HIP number such as HIP91262
+func starIdentifier(star string) (name string, hr, hip int32) {
+	upper := strings.ToUpper(strings.Replace(star, " ", "", -1))
+	for prefix, number := range map[string]*int32{"HIP": &hip, "HR": &hr} {
+		if !strings.HasPrefix(upper, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(upper[len(prefix):]); err == nil {
+			*number = int32(n)
+			return "", hr, hip
+		}
+	}
+	return star, 0, 0
+}
+
+// starTarget returns the target for a star from the stars service, named by
+// its HR number when it has no proper name
+func starTarget(star *starsv1.Star) occultationTarget {
+	target := occultationTarget{name: star.Name, star: star, sunAltitude: twilight}
+	if target.name == "" {
+		target.name = fmt.Sprintf("HR%d", star.Hr)
+	}
+	if star.Magnitude > brightMagnitude {
+		target.sunAltitude = darkness
+	}
+	return target
+}
+
+// planetCandidates returns the times the moon passes close to the planets
+// among the targets between start and end, found from the planets service's
+// conjunctions in longitude
+func (s *moonServiceServer) planetCandidates(targets []occultationTarget, start, end float64) ([]candidate, error) {
+	bodies := []planetsv1.Planet{}
+	planets := map[planetsv1.Planet]occultationTarget{}
+	for _, target := range targets {
+		if target.planet {
+			bodies = append(bodies, target.body)
+			planets[target.body] = target
+		}
+	}
+	if len(bodies) == 0 {
+		return nil, nil
+	}
+
+	// A day either side for the occultations near the ends of the range
+	from, err := s.instant(start - 1)
+	if err != nil {
+		return nil, err
+	}
+	to, err := s.instant(end + 1)
+	if err != nil {
+		return nil, err
+	}
+	events, err := s.planets.GetPlanetaryEvents(bodies, from.Year, from.Month, from.Day, to.Year, to.Month, to.Day)
+	if err != nil {
+		return nil, fmt.Errorf("planetCandidates encountered the following error when executing GetPlanetaryEvents: %v", err)
+	}
+	candidates := []candidate{}
+	for _, e := range events {
+		if e.Type != planetsv1.PlanetaryEventType_MOON_CONJUNCTION || e.Separation > occultationCandidate {
+			continue
+		}
+		jd := e.Time.JulianDate
+		candidates = append(candidates, candidate{target: planets[e.Body], lo: jd - occultationWindow, hi: jd + occultationWindow})
+	}
+	return candidates, nil
+}
+
+// starCandidates returns the times the moon passes close to the stars among
+// the targets between start and end, followed two hours past the end for the
+// reappearances
+func (s *moonServiceServer) starCandidates(targets []occultationTarget, start, end, dt float64) []candidate {
+	// The geocentric moon every hour, the stars barely move over the range
+	hours := int(math.Ceil((end-start)*24)) + 2
+	moon := make([]place, hours+1)
+	for i := range moon {
+		moon[i] = s.moonPlace(julianCentury(start + float64(i)/24 + dt))
+	}
+	middle := (start+end)/2 + dt
+
+	candidates := []candidate{}
+	for _, target := range targets {
+		if target.planet {
+			continue
+		}
+		ra, dec := meanPlace(target.star, middle)
+		near := -1
+		for i := range moon {
+			nearby := lunar.Separation(moon[i].ra, moon[i].dec, ra, dec) < occultationCandidate
+			if nearby && near < 0 {
+				near = i
+			}
+			if nearby && i < len(moon)-1 || near < 0 {
+				continue
+			}
+			// The hour before the star came near to the one after it left
+			candidates = append(candidates, candidate{target: target, lo: start + float64(near-1)/24, hi: start + float64(i)/24})
+			near = -1
+		}
+	}
+	return candidates
+}
+
+// occultations returns the occultations of the candidate's target seen by
+// the observer
+func (s *moonServiceServer) occultations(c candidate, ephemeris planetsv1.PlanetEphemeris, dt float64, o topocentric.Observer) ([]*v1.LunarOccultation, error) {
+	moon := func(jd float64) place {
+		return s.moonPlace(julianCentury(jd + dt))
+	}
+	if ephemeris == planetsv1.PlanetEphemeris_JPL {
+		var err error
+		if moon, err = s.planetTrack(planetsv1.Planet_MOON, c.lo, c.hi, ephemeris); err != nil {
+			return nil, err
+		}
+	}
+	var body track
+	if c.target.planet {
+		var err error
+		if body, err = s.planetTrack(c.target.body, c.lo, c.hi, ephemeris); err != nil {
+			return nil, err
+		}
+	} else {
+		// A star's apparent place changes by far less than an arcsecond in
+		// the hours the moon takes to pass
+		star := s.starPlace(c.target.star, julianCentury((c.lo+c.hi)/2+dt))
+		body = func(float64) place { return star }
+	}
+
+	// The distance of the body inside the limb, negative while it is hidden
+	inside := func(jd float64) float64 {
+		m, b := s.topocentricPlaces(moon, body, jd, dt, o)
+		return lunar.Separation(m.ra, m.dec, b.ra, b.dec) - 358473400/m.distance/3600
+	}
+
+	found := []*v1.LunarOccultation{}
+	var occultation *v1.LunarOccultation
+	prev := inside(c.lo)
+	for jd := c.lo + occultationStep; jd < c.hi+occultationStep; jd += occultationStep {
+		next := inside(jd)
+		if (prev < 0) == (next < 0) {
+			prev = next
+			continue
+		}
+		contact, err := s.occultationContact(c.target, moon, body, bisect(inside, jd-occultationStep, jd), dt, o)
+		if err != nil {
+			return nil, err
+		}
+		if next < 0 {
+			occultation = &v1.LunarOccultation{
+				Target:           c.target.name,
+				Planet:           c.target.planet,
+				Disappearance:    contact,
+				MoonIllumination: lunar.IlluminatedFraction(lunar.PhaseAngle(julianCentury(contact.Time.JulianDate + dt))),
+			}
+			if !c.target.planet {
+				occultation.Magnitude = c.target.star.Magnitude
+			}
+		} else if occultation != nil {
+			occultation.Reappearance = contact
+			found = append(found, occultation)
+			occultation = nil
+		}
+		prev = next
+	}
+	return found, nil
+}
+
+// occultationContact describes the contact at the instant jd, in universal
+// time
+func (s *moonServiceServer) occultationContact(target occultationTarget, moon, body track, jd, dt float64, o topocentric.Observer) (*v1.LunarOccultationContact, error) {
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	m, b := s.topocentricPlaces(moon, body, jd, dt, o)
+	t := julianCentury(jd + dt)
+	sun := s.sunPlace(t)
+
+	positionAngle := lunar.PositionAngle(m.ra, m.dec, b.ra, b.dec)
+	limb := v1.MoonLimb_DARK_LIMB
+	if lunar.OnBrightLimb(positionAngle, lunar.BrightLimbAngle(m.ra, m.dec, sun.ra, sun.dec)) {
+		limb = v1.MoonLimb_BRIGHT_LIMB
+	}
+	moonAltitude := s.horizontal(jd, t, o).altitude
+	_, sunAltitude := transform.EquatorialToHorizontal(normalise(transform.ApparentSiderealTime(jd, julianEphemerisDay(t))+o.Longitude-sun.ra), sun.dec, o.Latitude)
+	return &v1.LunarOccultationContact{
+		Time:          time,
+		PositionAngle: positionAngle,
+		Limb:          limb,
+		MoonAltitude:  moonAltitude,
+		SunAltitude:   sunAltitude,
+		Visible:       moonAltitude > 0 && sunAltitude < target.sunAltitude,
+	}, nil
+}
+
+// topocentricPlaces returns the places of the moon and the body seen by the
+// observer at the instant jd, in universal time
+func (s *moonServiceServer) topocentricPlaces(moon, body track, jd, dt float64, o topocentric.Observer) (place, place) {
+	siderealTime := transform.ApparentSiderealTime(jd, jd+dt)
+	m := moon(jd)
+	m.ra, m.dec, m.distance = o.Equatorial(m.ra, m.dec, m.distance, siderealTime)
+	b := body(jd)
+	if b.distance > 0 {
+		b.ra, b.dec, b.distance = o.Equatorial(b.ra, b.dec, b.distance, siderealTime)
+	}
+	return m, b
+}
+
+// planetTrack asks the planets service for the apparent places of the body
+// every hour from an hour before lo to an hour after hi, and interpolates
+// between them
+func (s *moonServiceServer) planetTrack(body planetsv1.Planet, lo, hi float64, ephemeris planetsv1.PlanetEphemeris) (track, error) {
+	first := lo - 1.0/24
+	samples := make([]place, int(math.Ceil((hi-lo)*24))+3)
+	for i := range samples {
+		time, err := s.instant(first + float64(i)/24)
+		if err != nil {
+			return nil, err
+		}
+		p, err := s.planets.GetPlanetPosition(body, time.Year, time.Month, time.Day, time.Hour, false, 0, 0, 0, planetsv1.PositionKind_APPARENT, ephemeris)
+		if err != nil {
+			return nil, fmt.Errorf("planetTrack encountered the following error when executing GetPlanetPosition: %v", err)
+		}
+		samples[i] = place{ra: p.RightAscension, dec: p.Declination, distance: p.Distance * kmPerAU}
+	}
+	return func(jd float64) place {
+		return interpolate(samples, (jd-first)*24)
+	}, nil
+}
+
+// interpolate returns the place at n hourly intervals after the first of
+// the samples, from the three nearest, Meeus, Astronomical Algorithms,
+// equation 3.3
+func interpolate(samples []place, n float64) place {
+	i := int(math.Floor(n + 0.5))
+	if i < 1 {
+		i = 1
+	}
+	if i > len(samples)-2 {
+		i = len(samples) - 2
+	}
+	n -= float64(i)
+	p, q, r := samples[i-1], samples[i], samples[i+1]
+	quadratic := func(y1, y2, y3 float64) float64 {
+		a, b := y2-y1, y3-y2
+		return y2 + n/2*(a+b+n*(b-a))
+	}
+	// Right ascensions are taken relative to the middle one in case they
+	// pass through 0 degrees
+	relative := func(ra float64) float64 {
+		return normalise(ra-q.ra+180) - 180
+	}
+	return place{
+		ra:       normalise(q.ra + quadratic(relative(p.ra), 0, relative(r.ra))),
+		dec:      quadratic(p.dec, q.dec, r.dec),
+		distance: quadratic(p.distance, q.distance, r.distance),
+	}
+}
+
+// moonPlace returns the apparent geocentric place of the moon, t is in
+// Julian centuries of dynamical time
+func (s *moonServiceServer) moonPlace(t float64) place {
+	lambda, beta, distance := s.apparentEcliptic(t)
+	ra, dec := transform.EclipticToEquatorial(lambda, beta, precession.TrueObliquity(julianEphemerisDay(t)))
+	return place{ra: ra, dec: dec, distance: distance}
+}
+
+// meanPlace returns the right ascension and declination of a star moved by
+// its proper motion and referred to the mean equator and equinox of the
+// Julian ephemeris day jde
+func meanPlace(star *starsv1.Star, jde float64) (float64, float64) {
+	years := (jde - precession.J2000) / 365.25
+	ra := star.RightAscension + star.ProperMotionRa*years/math.Cos(degreesToRadians(star.Declination))/3600000
+	dec := star.Declination + star.ProperMotionDec*years/3600000
+	return precession.PrecessionMatrix(precession.J2000, jde).Spherical(ra, dec)
+}
+
+// starPlace returns the apparent geocentric place of a star, allowing for
+// nutation and aberration, Meeus, Astronomical Algorithms, chapter 23, t is
+// in Julian centuries of dynamical time
+func (s *moonServiceServer) starPlace(star *starsv1.Star, t float64) place {
+	ra, dec := meanPlace(star, julianEphemerisDay(t))
+	lambda, beta := transform.EquatorialToEcliptic(ra, dec, precession.MeanObliquity(julianEphemerisDay(t)))
+
+	sun, _ := sunEcliptic(t)
+	e := 0.016708634 - t*(0.000042037+0.0000001267*t)
+	perihelion := degreesToRadians(102.93735 + t*(1.71946+0.00046*t))
+	l, b := degreesToRadians(lambda), degreesToRadians(beta)
+	fromSun := degreesToRadians(sun) - l
+	deltaPsi, _ := precession.Nutation(julianEphemerisDay(t))
+	lambda += deltaPsi - aberrationConstant*(math.Cos(fromSun)-e*math.Cos(perihelion-l))/math.Cos(b)
+	beta -= aberrationConstant * math.Sin(b) * (math.Sin(fromSun) - e*math.Sin(perihelion-l))
+
+	ra, dec = transform.EclipticToEquatorial(normalise(lambda), beta, precession.TrueObliquity(julianEphemerisDay(t)))
+	return place{ra: ra, dec: dec}
+}
+
+// sunPlace returns the apparent geocentric place of the sun to about a
+// hundredth of a degree, Meeus, Astronomical Algorithms, chapter 25, t is in
+// Julian centuries of dynamical time
+func (s *moonServiceServer) sunPlace(t float64) place {
+	longitude, distance := sunEcliptic(t)
+	omega := degreesToRadians(125.04 - 1934.136*t)
+	lambda := normalise(longitude - 0.00569 - 0.00478*math.Sin(omega))
+	ra, dec := transform.EclipticToEquatorial(lambda, 0, precession.TrueObliquity(julianEphemerisDay(t)))
+	return place{ra: ra, dec: dec, distance: distance * kmPerAU}
+}
+
+// sunEcliptic returns the true geometric longitude of the sun referred to the
+// mean equinox of date, in degrees, and its distance in AU, Meeus,
+// Astronomical Algorithms, chapter 25, t is in Julian centuries of dynamical
+// time
+func sunEcliptic(t float64) (longitude, distance float64) {
+	l0 := 280.46646 + t*(36000.76983+0.0003032*t)
+	m := degreesToRadians(357.52911 + t*(35999.05029-0.0001537*t))
+	e := 0.016708634 - t*(0.000042037+0.0000001267*t)
+	c := (1.914602-t*(0.004817+0.000014*t))*math.Sin(m) + (0.019993-0.000101*t)*math.Sin(2*m) + 0.000289*math.Sin(3*m)
+	nu := m + degreesToRadians(c)
+	return normalise(l0 + c), 1.000001018 * (1 - e*e) / (1 + e*math.Cos(nu))
+}
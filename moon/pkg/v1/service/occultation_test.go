@@ -0,0 +1,278 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/moon/grpc/v1"
+	planetsv1 "planetpositions/planets/grpc/v1"
+	starsv1 "planetpositions/stars/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+// aldebaran and regulus as the stars service gives them, Hipparcos places
+// and proper motions for J2000.0
+var (
+	aldebaran = &starsv1.Star{Hr: 1457, Hip: 21421, Name: "Aldebaran", RightAscension: 68.980167, Declination: 16.509306, ProperMotionRa: 63.45, ProperMotionDec: -188.94, Magnitude: 0.86}
+	regulus   = &starsv1.Star{Hr: 3982, Hip: 49669, Name: "Regulus", RightAscension: 152.092958, Declination: 11.967222, ProperMotionRa: -248.73, ProperMotionDec: 5.59, Magnitude: 1.40}
+)
+
+// fakeStars answers for the stars service from a few stars, the embedded
+// interface is left nil for the calls the moon service never makes. It keeps
+// the faintest magnitude it was asked to list to.
+type fakeStars struct {
+	starsv1.StarsServiceServer
+	stars        []*starsv1.Star
+	maxMagnitude float64
+}
+
+func (f *fakeStars) GetStar(ctx context.Context, req *starsv1.StarRequest) (*starsv1.Star, error) {
+	for _, star := range f.stars {
+		if req.Hr == star.Hr && req.Hr > 0 || req.Hip == star.Hip && req.Hip > 0 || req.Name != "" && strings.EqualFold(star.Name, req.Name) {
+			return star, nil
+		}
+	}
+	return nil, fmt.Errorf("unusable input provided: the star is not in the catalogue")
+}
+
+func (f *fakeStars) GetStars(ctx context.Context, req *starsv1.StarsRequest) (*starsv1.Stars, error) {
+	f.maxMagnitude = req.MaxMagnitude
+	stars := &starsv1.Stars{Api: apiVersion}
+	for _, star := range f.stars {
+		if star.Magnitude <= req.MaxMagnitude {
+			stars.Stars = append(stars.Stars, star)
+		}
+	}
+	return stars, nil
+}
+
+// fakePlanets answers for the planets service with a planet standing still
+// at place, close to the moon at conjunction, in universal time. It keeps
+// the ephemeris each body was asked for in.
+type fakePlanets struct {
+	planetsv1.PlanetsServiceServer
+	place       place
+	conjunction *planetsv1.PlanetInstant
+	asked       map[planetsv1.Planet]planetsv1.PlanetEphemeris
+}
+
+func (f *fakePlanets) GetPlanetPosition(ctx context.Context, req *planetsv1.PlanetPositionRequest) (*planetsv1.PlanetPosition, error) {
+	if f.asked == nil {
+		f.asked = map[planetsv1.Planet]planetsv1.PlanetEphemeris{}
+	}
+	f.asked[req.Body] = req.Ephemeris
+	return &planetsv1.PlanetPosition{Api: apiVersion, Body: req.Body, RightAscension: f.place.ra, Declination: f.place.dec, Distance: f.place.distance / kmPerAU}, nil
+}
+
+func (f *fakePlanets) GetPlanetaryEvents(req *planetsv1.PlanetaryEventsRequest, stream planetsv1.PlanetsService_GetPlanetaryEventsServer) error {
+	if f.conjunction == nil {
+		return nil
+	}
+	for _, body := range req.Bodies {
+		if err := stream.Send(&planetsv1.PlanetaryEvent{Api: apiVersion, Type: planetsv1.PlanetaryEventType_MOON_CONJUNCTION, Time: f.conjunction, Body: body, Separation: 0.5}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// serve starts a gRPC server on a free local port for the test and returns
+// its address
+func serve(t *testing.T, register func(*grpc.Server)) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("serve could not listen: %v", err)
+	}
+	s := grpc.NewServer()
+	register(s)
+	go s.Serve(lis)
+	t.Cleanup(s.Stop)
+	return lis.Addr().String()
+}
+
+// newOccultationService returns the service calling julian, stars and
+// planets services in process
+func newOccultationService(t *testing.T, planets *fakePlanets) *moonServiceServer {
+	s := &moonServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	s.stars.Address = serve(t, func(g *grpc.Server) {
+		starsv1.RegisterStarsServiceServer(g, &fakeStars{stars: []*starsv1.Star{aldebaran, regulus}})
+	})
+	s.planets.Address = serve(t, func(g *grpc.Server) { planetsv1.RegisterPlanetsServiceServer(g, planets) })
+	return s
+}
+
+// chicago is where the occultations below are seen from
+var chicago = [2]float64{-87.63, 41.88}
+
+// occultationsOf returns the occultations seen from Chicago in the month
+func occultationsOf(t *testing.T, s *moonServiceServer, year, month int32, targets ...string) []*v1.LunarOccultation {
+	occultations, err := s.GetLunarOccultations(context.Background(), &v1.LunarOccultationRequest{
+		Api:        apiVersion,
+		Longitude:  chicago[0],
+		Latitude:   chicago[1],
+		StartYear:  year,
+		StartMonth: month,
+		StartDay:   1,
+		EndYear:    year,
+		EndMonth:   month,
+		EndDay:     28,
+		Targets:    targets,
+	})
+	if err != nil {
+		t.Fatalf("GetLunarOccultations returned an error: %v", err)
+	}
+	return occultations.Occultations
+}
+
+func TestGetLunarOccultations(t *testing.T) {
+	// The occultation of Aldebaran on the evening of 2017 March 4, local
+	// time, one of the 2015 to 2018 series, seen across North America with
+	// the star going behind the dark limb of the waxing moon a little before
+	// 4h UT from Chicago
+	s := newOccultationService(t, &fakePlanets{})
+	occultations := occultationsOf(t, s, 2017, 3, "Aldebaran", "Regulus")
+	assert.Len(t, occultations, 1)
+	if len(occultations) != 1 {
+		return
+	}
+	o := occultations[0]
+	assert.Equal(t, "Aldebaran", o.Target)
+	assert.False(t, o.Planet)
+	assert.Equal(t, int32(5), o.Disappearance.Time.Day)
+	assert.InDelta(t, 4, o.Disappearance.Time.Hour, 0.25)
+	assert.Equal(t, v1.MoonLimb_DARK_LIMB, o.Disappearance.Limb)
+	assert.Equal(t, v1.MoonLimb_BRIGHT_LIMB, o.Reappearance.Limb)
+	assert.True(t, o.Reappearance.Time.JulianDate > o.Disappearance.Time.JulianDate)
+	assert.True(t, o.MoonIllumination > 0.3 && o.MoonIllumination < 0.7)
+	assert.True(t, o.Disappearance.Visible)
+	assert.True(t, o.Reappearance.Visible)
+}
+
+func TestGetLunarOccultationsCatalogue(t *testing.T) {
+	// With no targets every star of the catalogue to the fourth magnitude
+	// is searched, those without a proper name known by their HR number.
+	// Aldebaran's place stands in for such a star, with a fainter one at
+	// the same place left out.
+	unnamed := *aldebaran
+	unnamed.Name = ""
+	faint := unnamed
+	faint.Hr, faint.Hip, faint.Magnitude = 1458, 0, 4.5
+	stars := &fakeStars{stars: []*starsv1.Star{&unnamed, &faint, regulus}}
+	s := newOccultationService(t, &fakePlanets{})
+	s.stars.Address = serve(t, func(g *grpc.Server) { starsv1.RegisterStarsServiceServer(g, stars) })
+
+	occultations := occultationsOf(t, s, 2017, 3)
+	assert.Equal(t, occultationMagnitude, stars.maxMagnitude)
+	assert.Len(t, occultations, 1)
+	if len(occultations) != 1 {
+		return
+	}
+	assert.Equal(t, "HR1457", occultations[0].Target)
+	assert.Equal(t, int32(5), occultations[0].Disappearance.Time.Day)
+
+	// and it can be asked for by that number
+	occultations = occultationsOf(t, s, 2017, 3, "HR 1457")
+	assert.Len(t, occultations, 1)
+}
+
+func TestGetLunarOccultationsDaylight(t *testing.T) {
+	// Aldebaran is occulted on 2017 April 28 with the moon well up from
+	// Chicago, but in the afternoon
+	s := newOccultationService(t, &fakePlanets{})
+	occultations := occultationsOf(t, s, 2017, 4, "Aldebaran")
+	assert.Len(t, occultations, 1)
+	if len(occultations) != 1 {
+		return
+	}
+	o := occultations[0]
+	assert.Equal(t, int32(28), o.Disappearance.Time.Day)
+	assert.True(t, o.Disappearance.MoonAltitude > 0)
+	assert.True(t, o.Disappearance.SunAltitude > 0)
+	assert.False(t, o.Disappearance.Visible)
+	assert.False(t, o.Reappearance.Visible)
+}
+
+func TestGetLunarOccultationsPlanet(t *testing.T) {
+	// A planet standing where Aldebaran was on 2017 March 5 at 4h UT, far
+	// enough away for its parallax to be small, is occulted within a minute
+	// of the star
+	s := newOccultationService(t, &fakePlanets{})
+	jd := 2457817.5 + 4.0/24
+	deltaT, err := s.DeltaT(jd)
+	assert.NoError(t, err)
+	star := s.starPlace(aldebaran, julianCentury(jd+deltaT.Seconds/86400))
+	star.distance = 5 * kmPerAU
+	planets := &fakePlanets{place: star, conjunction: &planetsv1.PlanetInstant{Year: 2017, Month: 3, Day: 5, Hour: 4, JulianDate: jd}}
+	s = newOccultationService(t, planets)
+
+	occultations := occultationsOf(t, s, 2017, 3, "Aldebaran", "jupiter")
+	assert.Len(t, occultations, 2)
+	if len(occultations) != 2 {
+		return
+	}
+	planet, aldebaran := occultations[0], occultations[1]
+	if !planet.Planet {
+		planet, aldebaran = aldebaran, planet
+	}
+	assert.Equal(t, "Jupiter", planet.Target)
+	assert.True(t, planet.Planet)
+	assert.InDelta(t, aldebaran.Disappearance.Time.JulianDate, planet.Disappearance.Time.JulianDate, 1.0/1440)
+	assert.InDelta(t, aldebaran.Reappearance.Time.JulianDate, planet.Reappearance.Time.JulianDate, 1.0/1440)
+	assert.Equal(t, aldebaran.Disappearance.Limb, planet.Disappearance.Limb)
+	assert.True(t, planet.Disappearance.Visible)
+
+	// The moon comes from the planets service too when the JPL ephemeris is
+	// asked for, the fake one puts it with the planet so there is nothing
+	// to see
+	assert.Equal(t, map[planetsv1.Planet]planetsv1.PlanetEphemeris{planetsv1.Planet_JUPITER: planetsv1.PlanetEphemeris_ANALYTIC}, planets.asked)
+	planets.asked = nil
+	_, err = s.GetLunarOccultations(context.Background(), &v1.LunarOccultationRequest{
+		Api:        apiVersion,
+		Longitude:  chicago[0],
+		Latitude:   chicago[1],
+		StartYear:  2017,
+		StartMonth: 3,
+		StartDay:   1,
+		EndYear:    2017,
+		EndMonth:   3,
+		EndDay:     28,
+		Targets:    []string{"Jupiter"},
+		Ephemeris:  v1.OccultationEphemeris_JPL,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, map[planetsv1.Planet]planetsv1.PlanetEphemeris{planetsv1.Planet_JUPITER: planetsv1.PlanetEphemeris_JPL, planetsv1.Planet_MOON: planetsv1.PlanetEphemeris_JPL}, planets.asked)
+}
+
+func TestGetLunarOccultationsInput(t *testing.T) {
+	s := newOccultationService(t, &fakePlanets{})
+	req := &v1.LunarOccultationRequest{
+		Api:        apiVersion,
+		Longitude:  chicago[0],
+		Latitude:   chicago[1],
+		StartYear:  2017,
+		StartMonth: 3,
+		StartDay:   1,
+		EndYear:    2017,
+		EndMonth:   3,
+		EndDay:     28,
+		Targets:    []string{"Vega"},
+	}
+	_, err := s.GetLunarOccultations(context.Background(), req)
+	assert.Error(t, err)
+
+	req.Targets = []string{"Aldebaran", "aldebaran"}
+	_, err = s.GetLunarOccultations(context.Background(), req)
+	assert.Error(t, err)
+
+	req.Targets = nil
+	req.Ephemeris = 3
+	_, err = s.GetLunarOccultations(context.Background(), req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,170 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/moon/grpc/v1"
+	"planetpositions/moon/pkg/v1/lunar"
+)
+
+// maxPhaseSearchDays limits the length of the date range searched
+const maxPhaseSearchDays = 3653
+
+// phaseNames maps the principal phases onto their names
+var phaseNames = map[lunar.Phase]v1.MoonPhaseName{
+	lunar.NewMoon:      v1.MoonPhaseName_NEW_MOON,
+	lunar.FirstQuarter: v1.MoonPhaseName_FIRST_QUARTER,
+	lunar.FullMoon:     v1.MoonPhaseName_FULL_MOON,
+	lunar.LastQuarter:  v1.MoonPhaseName_LAST_QUARTER,
+}
+
+// phaseEvent is a principal phase at the instant jd, in universal time
+type phaseEvent struct {
+	phase lunar.Phase
+	jd    float64
+}
+
+func (s *moonServiceServer) GetMoonPhases(ctx context.Context, req *v1.MoonPhasesRequest) (*v1.MoonPhases, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return nil, fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return nil, fmt.Errorf("unusable end date provided: %v", err)
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return nil, err
+	}
+	if end <= start {
+		return nil, fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxPhaseSearchDays {
+		return nil, fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxPhaseSearchDays)
+	}
+
+	dt, err := s.DeltaT((start + end) / 2)
+	if err != nil {
+		return nil, err
+	}
+	phases := &v1.MoonPhases{Api: apiVersion}
+	for _, e := range phaseEvents(start, end, dt.Seconds) {
+		event, err := s.phaseEvent(e)
+		if err != nil {
+			return nil, err
+		}
+		phases.Phases = append(phases.Phases, event)
+	}
+	return phases, nil
+}
+
+func (s *moonServiceServer) GetMoonIllumination(ctx context.Context, req *v1.MoonIlluminationRequest) (*v1.MoonIllumination, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	t, deltaT, err := s.dynamicalCentury(jd)
+	if err != nil {
+		return nil, err
+	}
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+
+	i := lunar.PhaseAngle(t)
+	waxing := lunar.MeanElongation(t) < 180
+	illumination := &v1.MoonIllumination{
+		Api:                 apiVersion,
+		Time:                time,
+		IlluminatedFraction: lunar.IlluminatedFraction(i),
+		PhaseAngle:          i,
+		Elongation:          180 - i,
+		Phase:               phaseName(i, waxing),
+		Waxing:              waxing,
+	}
+
+	// Each principal phase occurs once a lunation, so a lunation either side
+	// holds the previous and next of each
+	previous := map[lunar.Phase]phaseEvent{}
+	next := map[lunar.Phase]phaseEvent{}
+	for _, e := range phaseEvents(jd-lunar.SynodicMonth-1, jd+lunar.SynodicMonth+1, deltaT) {
+		if e.jd <= jd {
+			previous[e.phase] = e
+		} else if _, ok := next[e.phase]; !ok {
+			next[e.phase] = e
+		}
+	}
+	illumination.Age = jd - previous[lunar.NewMoon].jd
+	for _, p := range []lunar.Phase{lunar.NewMoon, lunar.FirstQuarter, lunar.FullMoon, lunar.LastQuarter} {
+		event, err := s.phaseEvent(previous[p])
+		if err != nil {
+			return nil, err
+		}
+		illumination.Previous = append(illumination.Previous, event)
+		if event, err = s.phaseEvent(next[p]); err != nil {
+			return nil, err
+		}
+		illumination.Next = append(illumination.Next, event)
+	}
+	return illumination, nil
+}
+
+// phaseEvents returns the principal phases between start and end in order,
+// deltaT is in seconds
+func phaseEvents(start, end, deltaT float64) []phaseEvent {
+	events := []phaseEvent{}
+	first := math.Floor(lunar.Lunation(start+deltaT/86400)) - 1
+	last := math.Ceil(lunar.Lunation(end + deltaT/86400))
+	for k := first; k <= last; k++ {
+		for _, p := range []lunar.Phase{lunar.NewMoon, lunar.FirstQuarter, lunar.FullMoon, lunar.LastQuarter} {
+			jd := lunar.PhaseTime(k, p) - deltaT/86400
+			if jd >= start && jd < end {
+				events = append(events, phaseEvent{phase: p, jd: jd})
+			}
+		}
+	}
+	return events
+}
+
+func (s *moonServiceServer) phaseEvent(e phaseEvent) (*v1.MoonPhaseEvent, error) {
+	time, err := s.instant(e.jd)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.MoonPhaseEvent{
+		Phase: phaseNames[e.phase],
+		Time:  time,
+	}, nil
+}
+
+// phaseName returns the name of the phase, each principal phase covers the
+// 45 degrees of elongation centred on it, the names follow each other from
+// NEW_MOON
+func phaseName(phaseAngle float64, waxing bool) v1.MoonPhaseName {
+	elongation := 180 - phaseAngle
+	if !waxing {
+		elongation = 180 + phaseAngle
+	}
+	return v1.MoonPhaseName_NEW_MOON + v1.MoonPhaseName(int(normalise(elongation+22.5)/45)%8)
+}
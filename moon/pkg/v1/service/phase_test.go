@@ -0,0 +1,31 @@
+package v1
+
+import (
+	"testing"
+
+	"planetpositions/moon/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPhaseName(t *testing.T) {
+	testcases := map[string]struct {
+		phaseAngle float64
+		waxing     bool
+		name       v1.MoonPhaseName
+	}{
+		"New":             {phaseAngle: 180, waxing: true, name: v1.MoonPhaseName_NEW_MOON},
+		"Waxing crescent": {phaseAngle: 135, waxing: true, name: v1.MoonPhaseName_WAXING_CRESCENT},
+		"First quarter":   {phaseAngle: 90, waxing: true, name: v1.MoonPhaseName_FIRST_QUARTER},
+		"Full":            {phaseAngle: 5, waxing: true, name: v1.MoonPhaseName_FULL_MOON},
+		"Waning gibbous":  {phaseAngle: 45, waxing: false, name: v1.MoonPhaseName_WANING_GIBBOUS},
+		"Last quarter":    {phaseAngle: 90, waxing: false, name: v1.MoonPhaseName_LAST_QUARTER},
+		"Waning crescent": {phaseAngle: 135, waxing: false, name: v1.MoonPhaseName_WANING_CRESCENT},
+		"Old":             {phaseAngle: 170, waxing: false, name: v1.MoonPhaseName_NEW_MOON},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.name, phaseName(tc.phaseAngle, tc.waxing))
+		})
+	}
+}
@@ -0,0 +1,164 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/moon/grpc/v1"
+)
+
+// riseSetSamples is the number of times a day the moon's altitude is
+// sampled when searching for events
+const riseSetSamples = 144
+
+// horizontal is the topocentric position of the moon for an observer
+type horizontal struct {
+	azimuth   float64
+	altitude  float64
+	hourAngle float64
+	distance  float64
+}
+
+func (s *moonServiceServer) GetMoonRiseSet(ctx context.Context, req *v1.MoonRiseSetRequest) (*v1.MoonRiseSet, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	if req.UtcOffset < -14 || req.UtcOffset > 14 {
+		return nil, fmt.Errorf("unusable input provided: utc offset must be between -14 and 14 hours")
+	}
+	horizon, err := refraction.AtHorizon(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, 0)
+	if err != nil {
+		return nil, err
+	}
+	start := jd - req.UtcOffset/24
+	t0, _, err := s.dynamicalCentury(start)
+	if err != nil {
+		return nil, err
+	}
+	o := topocentric.NewObserver(req.Latitude, req.Longitude, req.Height)
+	at := func(jd float64) horizontal {
+		// delta T barely changes over a day
+		return s.horizontal(jd, t0+(jd-start)/36525, o)
+	}
+	upperLimb := func(jd float64) float64 {
+		return s.upperLimb(at(jd), horizon)
+	}
+	// meridian is the topocentric hour angle in the range -180 to 180
+	meridian := func(jd float64) float64 {
+		h := at(jd).hourAngle
+		if h > 180 {
+			h -= 360
+		}
+		return h
+	}
+
+	riseSet := &v1.MoonRiseSet{Api: apiVersion}
+	rises, transits, sets := []float64{}, []float64{}, []float64{}
+	prevLimb, prevHA := upperLimb(start), meridian(start)
+	up, down := prevLimb >= 0, prevLimb < 0
+	for i := 0; i < riseSetSamples; i++ {
+		jd := start + float64(i)/riseSetSamples
+		next := start + float64(i+1)/riseSetSamples
+		limb, ha := upperLimb(next), meridian(next)
+
+		up = up || limb >= 0
+		down = down || limb < 0
+		if prevLimb < 0 && limb >= 0 {
+			rises = append(rises, bisect(upperLimb, jd, next))
+		}
+		if prevLimb >= 0 && limb < 0 {
+			sets = append(sets, bisect(upperLimb, jd, next))
+		}
+		// The hour angle also changes sign when it wraps from 180 to -180
+		if prevHA < 0 && ha >= 0 && ha-prevHA < 90 {
+			transits = append(transits, bisect(meridian, jd, next))
+		}
+		prevLimb, prevHA = limb, ha
+	}
+
+	riseSet.RiseStatus = eventStatus(len(rises), up, down)
+	riseSet.TransitStatus = eventStatus(len(transits), true, true)
+	riseSet.SetStatus = eventStatus(len(sets), up, down)
+	if riseSet.Rises, err = s.riseSetEvents(rises, at); err != nil {
+		return nil, err
+	}
+	if riseSet.Transits, err = s.riseSetEvents(transits, at); err != nil {
+		return nil, err
+	}
+	if riseSet.Sets, err = s.riseSetEvents(sets, at); err != nil {
+		return nil, err
+	}
+	return riseSet, nil
+}
+
+// horizontal returns the topocentric position of the moon at the instant jd
+// in universal time, t is the same instant in dynamical time
+func (s *moonServiceServer) horizontal(jd, t float64, o topocentric.Observer) horizontal {
+	lambda, beta, distance := s.apparentEcliptic(t)
+	ra, dec := transform.EclipticToEquatorial(lambda, beta, precession.TrueObliquity(julianEphemerisDay(t)))
+	hourAngle := normalise(transform.ApparentSiderealTime(jd, julianEphemerisDay(t)) + o.Longitude - ra)
+
+	hourAngle, dec = s.Topocentric(hourAngle, dec, distance, o)
+	azimuth, altitude := transform.EquatorialToHorizontal(hourAngle, dec, o.Latitude)
+	return horizontal{
+		azimuth:   azimuth,
+		altitude:  altitude,
+		hourAngle: hourAngle,
+		distance:  distance,
+	}
+}
+
+// upperLimb returns the altitude of the moon's upper limb above the apparent
+// horizon when the refraction there is as given, the semidiameter is around
+// 0.25 degrees
+func (s *moonServiceServer) upperLimb(h horizontal, refraction float64) float64 {
+	return h.altitude + s.Semidiameter(h.distance, h.altitude) + refraction
+}
+
+// eventStatus returns the status of an event that occurred count times on a
+// date during which the moon was up and/or down
+func eventStatus(count int, up, down bool) v1.MoonEventStatus {
+	switch {
+	case count > 0:
+		return v1.MoonEventStatus_EVENT_OCCURS
+	case !down:
+		return v1.MoonEventStatus_ALWAYS_UP
+	case !up:
+		return v1.MoonEventStatus_ALWAYS_DOWN
+	}
+	return v1.MoonEventStatus_NO_EVENT_ON_DATE
+}
+
+func (s *moonServiceServer) riseSetEvents(times []float64, at func(float64) horizontal) ([]*v1.MoonRiseSetEvent, error) {
+	events := []*v1.MoonRiseSetEvent{}
+	for _, jd := range times {
+		time, err := s.instant(jd)
+		if err != nil {
+			return nil, err
+		}
+		h := at(jd)
+		events = append(events, &v1.MoonRiseSetEvent{
+			Time:     time,
+			Azimuth:  h.azimuth,
+			Altitude: h.altitude,
+		})
+	}
+	return events, nil
+}
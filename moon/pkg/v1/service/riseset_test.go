@@ -0,0 +1,106 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/moon/grpc/v1"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetMoonRiseSet(t *testing.T) {
+	// Times as the USNO defines them, the upper limb on a horizon lowered
+	// 34' by refraction and seen from the surface, worked independently
+	// with the Meeus chapter 47 moon of github.com/soniakeys/meeus and delta
+	// T of 69.2 seconds
+	s := &moonServiceServer{}
+	s.Address = juliantest.NewServer(t, func(float64) float64 { return 69.2 })
+
+	washington := []float64{38.8895, -77.0353}
+	tromso := []float64{69.6492, 18.9553}
+	tests := []struct {
+		name       string
+		place      []float64
+		year       int32
+		month      int32
+		day        int32
+		utcOffset  float64
+		riseStatus v1.MoonEventStatus
+		rises      []float64
+		setStatus  v1.MoonEventStatus
+		sets       []float64
+	}{
+		// Full moon, rising at 19:10 and setting at 06:38 EDT
+		{"normal", washington, 2020, 10, 1, -4, v1.MoonEventStatus_EVENT_OCCURS, []float64{2459124.465398}, v1.MoonEventStatus_EVENT_OCCURS, []float64{2459123.942963}},
+		// The moon rises at 23:49 EDT on the 9th and 00:50 on the 11th
+		{"no moonrise", washington, 2020, 10, 10, -4, v1.MoonEventStatus_NO_EVENT_ON_DATE, nil, v1.MoonEventStatus_EVENT_OCCURS, []float64{2459133.298417}},
+		// In universal time the 10th has the rise at 03:49
+		{"universal time", washington, 2020, 10, 10, 0, v1.MoonEventStatus_EVENT_OCCURS, []float64{2459132.659254}, v1.MoonEventStatus_EVENT_OCCURS, []float64{2459133.298417}},
+		// At declinations of +28 and -28 degrees near the major standstill
+		{"always up", tromso, 2025, 1, 12, 1, v1.MoonEventStatus_ALWAYS_UP, nil, v1.MoonEventStatus_ALWAYS_UP, nil},
+		{"always down", tromso, 2025, 1, 26, 1, v1.MoonEventStatus_ALWAYS_DOWN, nil, v1.MoonEventStatus_ALWAYS_DOWN, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.GetMoonRiseSet(context.Background(), &v1.MoonRiseSetRequest{
+				Api:       apiVersion,
+				Latitude:  tt.place[0],
+				Longitude: tt.place[1],
+				Year:      tt.year,
+				Month:     tt.month,
+				Day:       tt.day,
+				UtcOffset: tt.utcOffset,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.riseStatus, res.RiseStatus)
+			assert.Equal(t, tt.setStatus, res.SetStatus)
+			require.Len(t, res.Rises, len(tt.rises))
+			for i, jd := range tt.rises {
+				assert.InDelta(t, jd, res.Rises[i].Time.JulianDate, 1.0/1440)
+			}
+			require.Len(t, res.Sets, len(tt.sets))
+			for i, jd := range tt.sets {
+				assert.InDelta(t, jd, res.Sets[i].Time.JulianDate, 1.0/1440)
+			}
+		})
+	}
+}
+
+func TestGetMoonRiseSetHorizon(t *testing.T) {
+	s := &moonServiceServer{}
+	s.Address = juliantest.NewServer(t, func(float64) float64 { return 69.2 })
+	req := &v1.MoonRiseSetRequest{
+		Api:       apiVersion,
+		Latitude:  38.8895,
+		Longitude: -77.0353,
+		Year:      2020,
+		Month:     10,
+		Day:       1,
+		UtcOffset: -4,
+	}
+	conventional, err := s.GetMoonRiseSet(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, conventional.Rises, 1)
+
+	// The centre is a semidiameter of about 0.25 degrees and the 34' of
+	// refraction below the horizon as the upper limb rises
+	assert.InDelta(t, -0.82, conventional.Rises[0].Altitude, 0.03)
+
+	// Without air the upper limb rises on the true horizon, some minutes
+	// later
+	req.Pressure = &wrappers.DoubleValue{Value: 0}
+	airless, err := s.GetMoonRiseSet(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, airless.Rises, 1)
+	assert.InDelta(t, -0.25, airless.Rises[0].Altitude, 0.03)
+	assert.True(t, airless.Rises[0].Time.JulianDate > conventional.Rises[0].Time.JulianDate+2.0/1440)
+
+	req.Pressure = nil
+	req.UtcOffset = 15
+	_, err = s.GetMoonRiseSet(context.Background(), req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/moon/pkg/v1/lunar"
+)
+
+// Topocentric -
+func (s *moonServiceServer) Topocentric(hourAngle, declination, distance float64, o topocentric.Observer) (topoHourAngle, topoDeclination float64) {
+	topoHourAngle, topoDeclination, _ = o.HourAngle(hourAngle, declination, distance)
+	return topoHourAngle, topoDeclination // In Degrees
+}
+
+// Semidiameter -
+func (s *moonServiceServer) Semidiameter(distance, altitude float64) float64 {
+	// Meeus, Astronomical Algorithms, chapter 55, the moon appears larger as
+	// it rises because the observer is closer to it
+	geocentric := 358473400 / distance / 3600
+	sinPi := math.Sin(degreesToRadians(lunar.HorizontalParallax(distance)))
+	return geocentric * (1 + math.Sin(degreesToRadians(altitude))*sinPi) // In Degrees
+}
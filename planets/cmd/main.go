@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	v1 "planetpositions/planets/grpc/v1"
+	planets "planetpositions/planets/pkg/v1/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type server struct{}
+
+var ps = planets.NewPlanetsService()
+
+func main() {
+
+	portNum := os.Getenv("PORT_NUM")
+	lis, err := net.Listen("tcp", "0.0.0.0:"+portNum)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	v1.RegisterPlanetsServiceServer(s, &server{})
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// GetPlanetPosition -
+func (s *server) GetPlanetPosition(ctx context.Context, req *v1.PlanetPositionRequest) (*v1.PlanetPosition, error) {
+	pp, err := ps.GetPlanetPosition(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return pp, nil
+}
+
+// GetPlanetVisibility -
+func (s *server) GetPlanetVisibility(ctx context.Context, req *v1.PlanetVisibilityRequest) (*v1.PlanetVisibility, error) {
+	pv, err := ps.GetPlanetVisibility(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return pv, nil
+}
+
+// GetPlanetaryEvents -
+func (s *server) GetPlanetaryEvents(req *v1.PlanetaryEventsRequest, stream v1.PlanetsService_GetPlanetaryEventsServer) error {
+	return ps.GetPlanetaryEvents(req, stream)
+}
+
+// GetMinorBodyPosition -
+func (s *server) GetMinorBodyPosition(ctx context.Context, req *v1.MinorBodyPositionRequest) (*v1.MinorBodyPosition, error) {
+	mp, err := ps.GetMinorBodyPosition(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return mp, nil
+}
+
+// GetGalileanMoons -
+func (s *server) GetGalileanMoons(ctx context.Context, req *v1.GalileanMoonsRequest) (*v1.GalileanMoons, error) {
+	gm, err := ps.GetGalileanMoons(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return gm, nil
+}
+
+// GetGalileanEvents -
+func (s *server) GetGalileanEvents(req *v1.GalileanEventsRequest, stream v1.PlanetsService_GetGalileanEventsServer) error {
+	return ps.GetGalileanEvents(req, stream)
+}
@@ -0,0 +1,2531 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: planets.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type Planet int32
+
+const (
+	// No planet given, requests must name one
+	Planet_PLANET_UNSPECIFIED Planet = 0
+	Planet_MERCURY            Planet = 1
+	Planet_VENUS              Planet = 2
+	Planet_MARS               Planet = 3
+	Planet_JUPITER            Planet = 4
+	Planet_SATURN             Planet = 5
+	Planet_URANUS             Planet = 6
+	Planet_NEPTUNE            Planet = 7
+	Planet_PLUTO              Planet = 8
+	// Positions of the moon are only given from a JPL ephemeris
+	Planet_MOON Planet = 9
+)
+
+var Planet_name = map[int32]string{
+	0: "PLANET_UNSPECIFIED",
+	1: "MERCURY",
+	2: "VENUS",
+	3: "MARS",
+	4: "JUPITER",
+	5: "SATURN",
+	6: "URANUS",
+	7: "NEPTUNE",
+	8: "PLUTO",
+	9: "MOON",
+}
+
+var Planet_value = map[string]int32{
+	"PLANET_UNSPECIFIED": 0,
+	"MERCURY":            1,
+	"VENUS":              2,
+	"MARS":               3,
+	"JUPITER":            4,
+	"SATURN":             5,
+	"URANUS":             6,
+	"NEPTUNE":            7,
+	"PLUTO":              8,
+	"MOON":               9,
+}
+
+func (x Planet) String() string {
+	return proto.EnumName(Planet_name, int32(x))
+}
+
+func (Planet) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{0}
+}
+
+type PlanetEphemeris int32
+
+const (
+	// Not given, the analytic theory is used
+	PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED PlanetEphemeris = 0
+	// The VSOP87 theory, truncated by Meeus unless the full series are loaded
+	PlanetEphemeris_ANALYTIC PlanetEphemeris = 1
+	// The JPL Development Ephemeris loaded at startup
+	PlanetEphemeris_JPL PlanetEphemeris = 2
+)
+
+var PlanetEphemeris_name = map[int32]string{
+	0: "PLANET_EPHEMERIS_UNSPECIFIED",
+	1: "ANALYTIC",
+	2: "JPL",
+}
+
+var PlanetEphemeris_value = map[string]int32{
+	"PLANET_EPHEMERIS_UNSPECIFIED": 0,
+	"ANALYTIC":                     1,
+	"JPL":                          2,
+}
+
+func (x PlanetEphemeris) String() string {
+	return proto.EnumName(PlanetEphemeris_name, int32(x))
+}
+
+func (PlanetEphemeris) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{1}
+}
+
+type PositionKind int32
+
+const (
+	// Not given, the apparent position is returned
+	PositionKind_POSITION_KIND_UNSPECIFIED PositionKind = 0
+	// Corrected for light-time and referred to the mean ecliptic and
+	// equinox of date
+	PositionKind_MEAN_OF_DATE PositionKind = 1
+	// Where the body is at the instant, referred to the equator and equinox
+	// of J2000.0
+	PositionKind_GEOMETRIC PositionKind = 2
+	// Corrected for light-time and referred to the equator and equinox of
+	// J2000.0, as star catalogue positions are
+	PositionKind_ASTROMETRIC PositionKind = 3
+	// Corrected for light-time, the deflection of light by the sun and
+	// annual aberration, and referred to the true equator and equinox of
+	// date, as in the almanacs
+	PositionKind_APPARENT PositionKind = 4
+)
+
+var PositionKind_name = map[int32]string{
+	0: "POSITION_KIND_UNSPECIFIED",
+	1: "MEAN_OF_DATE",
+	2: "GEOMETRIC",
+	3: "ASTROMETRIC",
+	4: "APPARENT",
+}
+
+var PositionKind_value = map[string]int32{
+	"POSITION_KIND_UNSPECIFIED": 0,
+	"MEAN_OF_DATE":              1,
+	"GEOMETRIC":                 2,
+	"ASTROMETRIC":               3,
+	"APPARENT":                  4,
+}
+
+func (x PositionKind) String() string {
+	return proto.EnumName(PositionKind_name, int32(x))
+}
+
+func (PositionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{2}
+}
+
+type PlanetEventStatus int32
+
+const (
+	// Never sent, zero is kept for an unset status
+	PlanetEventStatus_PLANET_EVENT_STATUS_UNSPECIFIED PlanetEventStatus = 0
+	// The event occurs on the date
+	PlanetEventStatus_EVENT_OCCURS PlanetEventStatus = 1
+	// The event does not occur on the date but does on a neighbouring date
+	PlanetEventStatus_NO_EVENT_ON_DATE PlanetEventStatus = 2
+	// The planet is above the horizon for the whole date
+	PlanetEventStatus_ALWAYS_UP PlanetEventStatus = 3
+	// The planet is below the horizon for the whole date
+	PlanetEventStatus_ALWAYS_DOWN PlanetEventStatus = 4
+)
+
+var PlanetEventStatus_name = map[int32]string{
+	0: "PLANET_EVENT_STATUS_UNSPECIFIED",
+	1: "EVENT_OCCURS",
+	2: "NO_EVENT_ON_DATE",
+	3: "ALWAYS_UP",
+	4: "ALWAYS_DOWN",
+}
+
+var PlanetEventStatus_value = map[string]int32{
+	"PLANET_EVENT_STATUS_UNSPECIFIED": 0,
+	"EVENT_OCCURS":                    1,
+	"NO_EVENT_ON_DATE":                2,
+	"ALWAYS_UP":                       3,
+	"ALWAYS_DOWN":                     4,
+}
+
+func (x PlanetEventStatus) String() string {
+	return proto.EnumName(PlanetEventStatus_name, int32(x))
+}
+
+func (PlanetEventStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{3}
+}
+
+type PlanetaryEventType int32
+
+const (
+	// Never sent, zero is kept for an unset type
+	PlanetaryEventType_PLANETARY_EVENT_UNSPECIFIED PlanetaryEventType = 0
+	// Two planets share the same geocentric ecliptic longitude
+	PlanetaryEventType_CONJUNCTION PlanetaryEventType = 1
+	// The Moon shares the planet's geocentric ecliptic longitude
+	PlanetaryEventType_MOON_CONJUNCTION PlanetaryEventType = 2
+	// A superior planet is 180 degrees from the sun
+	PlanetaryEventType_OPPOSITION PlanetaryEventType = 3
+	// The planet shares the sun's longitude on the far side of the sun
+	PlanetaryEventType_SUPERIOR_CONJUNCTION PlanetaryEventType = 4
+	// Mercury or Venus shares the sun's longitude between the sun and the
+	// earth
+	PlanetaryEventType_INFERIOR_CONJUNCTION PlanetaryEventType = 5
+	// Mercury or Venus is furthest from the sun in the evening sky
+	PlanetaryEventType_GREATEST_EASTERN_ELONGATION PlanetaryEventType = 6
+	// Mercury or Venus is furthest from the sun in the morning sky
+	PlanetaryEventType_GREATEST_WESTERN_ELONGATION PlanetaryEventType = 7
+	// The planet stops moving east and begins its retrograde motion
+	PlanetaryEventType_STATION_RETROGRADE PlanetaryEventType = 8
+	// The planet stops moving west and resumes its direct motion
+	PlanetaryEventType_STATION_DIRECT PlanetaryEventType = 9
+)
+
+var PlanetaryEventType_name = map[int32]string{
+	0: "PLANETARY_EVENT_UNSPECIFIED",
+	1: "CONJUNCTION",
+	2: "MOON_CONJUNCTION",
+	3: "OPPOSITION",
+	4: "SUPERIOR_CONJUNCTION",
+	5: "INFERIOR_CONJUNCTION",
+	6: "GREATEST_EASTERN_ELONGATION",
+	7: "GREATEST_WESTERN_ELONGATION",
+	8: "STATION_RETROGRADE",
+	9: "STATION_DIRECT",
+}
+
+var PlanetaryEventType_value = map[string]int32{
+	"PLANETARY_EVENT_UNSPECIFIED": 0,
+	"CONJUNCTION":                 1,
+	"MOON_CONJUNCTION":            2,
+	"OPPOSITION":                  3,
+	"SUPERIOR_CONJUNCTION":        4,
+	"INFERIOR_CONJUNCTION":        5,
+	"GREATEST_EASTERN_ELONGATION": 6,
+	"GREATEST_WESTERN_ELONGATION": 7,
+	"STATION_RETROGRADE":          8,
+	"STATION_DIRECT":              9,
+}
+
+func (x PlanetaryEventType) String() string {
+	return proto.EnumName(PlanetaryEventType_name, int32(x))
+}
+
+func (PlanetaryEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{4}
+}
+
+type MinorBodyOrbit int32
+
+const (
+	// Never sent, zero is kept for an unset orbit
+	MinorBodyOrbit_MINOR_BODY_ORBIT_UNSPECIFIED MinorBodyOrbit = 0
+	MinorBodyOrbit_ELLIPTIC                     MinorBodyOrbit = 1
+	MinorBodyOrbit_PARABOLIC                    MinorBodyOrbit = 2
+	MinorBodyOrbit_HYPERBOLIC                   MinorBodyOrbit = 3
+)
+
+var MinorBodyOrbit_name = map[int32]string{
+	0: "MINOR_BODY_ORBIT_UNSPECIFIED",
+	1: "ELLIPTIC",
+	2: "PARABOLIC",
+	3: "HYPERBOLIC",
+}
+
+var MinorBodyOrbit_value = map[string]int32{
+	"MINOR_BODY_ORBIT_UNSPECIFIED": 0,
+	"ELLIPTIC":                     1,
+	"PARABOLIC":                    2,
+	"HYPERBOLIC":                   3,
+}
+
+func (x MinorBodyOrbit) String() string {
+	return proto.EnumName(MinorBodyOrbit_name, int32(x))
+}
+
+func (MinorBodyOrbit) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{5}
+}
+
+type GalileanMoon int32
+
+const (
+	// No satellite given
+	GalileanMoon_GALILEAN_MOON_UNSPECIFIED GalileanMoon = 0
+	GalileanMoon_IO                        GalileanMoon = 1
+	GalileanMoon_EUROPA                    GalileanMoon = 2
+	GalileanMoon_GANYMEDE                  GalileanMoon = 3
+	GalileanMoon_CALLISTO                  GalileanMoon = 4
+)
+
+var GalileanMoon_name = map[int32]string{
+	0: "GALILEAN_MOON_UNSPECIFIED",
+	1: "IO",
+	2: "EUROPA",
+	3: "GANYMEDE",
+	4: "CALLISTO",
+}
+
+var GalileanMoon_value = map[string]int32{
+	"GALILEAN_MOON_UNSPECIFIED": 0,
+	"IO":                        1,
+	"EUROPA":                    2,
+	"GANYMEDE":                  3,
+	"CALLISTO":                  4,
+}
+
+func (x GalileanMoon) String() string {
+	return proto.EnumName(GalileanMoon_name, int32(x))
+}
+
+func (GalileanMoon) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{6}
+}
+
+type GalileanEventType int32
+
+const (
+	// Never sent, zero is kept for an unset type
+	GalileanEventType_GALILEAN_EVENT_UNSPECIFIED GalileanEventType = 0
+	// The satellite crosses the disc of Jupiter
+	GalileanEventType_TRANSIT GalileanEventType = 1
+	// The satellite's shadow crosses the disc
+	GalileanEventType_SHADOW_TRANSIT GalileanEventType = 2
+	// The satellite is hidden behind the disc
+	GalileanEventType_OCCULTATION GalileanEventType = 3
+	// The satellite is in Jupiter's shadow
+	GalileanEventType_ECLIPSE GalileanEventType = 4
+)
+
+var GalileanEventType_name = map[int32]string{
+	0: "GALILEAN_EVENT_UNSPECIFIED",
+	1: "TRANSIT",
+	2: "SHADOW_TRANSIT",
+	3: "OCCULTATION",
+	4: "ECLIPSE",
+}
+
+var GalileanEventType_value = map[string]int32{
+	"GALILEAN_EVENT_UNSPECIFIED": 0,
+	"TRANSIT":                    1,
+	"SHADOW_TRANSIT":             2,
+	"OCCULTATION":                3,
+	"ECLIPSE":                    4,
+}
+
+func (x GalileanEventType) String() string {
+	return proto.EnumName(GalileanEventType_name, int32(x))
+}
+
+func (GalileanEventType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{7}
+}
+
+type PlanetPositionRequest struct {
+	Api   string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Body  Planet `protobuf:"varint,2,opt,name=body,proto3,enum=v1.Planet" json:"body,omitempty"`
+	Year  int32  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32  `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32  `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC hour of the day
+	Hour float64 `protobuf:"fixed64,6,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Whether to also give the position seen from an observer at longitude,
+	// positive east of Greenwich, and latitude, in degrees, and height above
+	// the WGS84 ellipsoid, in metres
+	Topocentric bool    `protobuf:"varint,7,opt,name=topocentric,proto3" json:"topocentric,omitempty"`
+	Longitude   float64 `protobuf:"fixed64,8,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude    float64 `protobuf:"fixed64,9,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Height      float64 `protobuf:"fixed64,10,opt,name=height,proto3" json:"height,omitempty"`
+	// The kind of geocentric position wanted, apparent when not given
+	Kind                 PositionKind    `protobuf:"varint,11,opt,name=kind,proto3,enum=v1.PositionKind" json:"kind,omitempty"`
+	Ephemeris            PlanetEphemeris `protobuf:"varint,12,opt,name=ephemeris,proto3,enum=v1.PlanetEphemeris" json:"ephemeris,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *PlanetPositionRequest) Reset()         { *m = PlanetPositionRequest{} }
+func (m *PlanetPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanetPositionRequest) ProtoMessage()    {}
+func (*PlanetPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{0}
+}
+
+func (m *PlanetPositionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetPositionRequest.Unmarshal(m, b)
+}
+func (m *PlanetPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetPositionRequest.Marshal(b, m, deterministic)
+}
+func (m *PlanetPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetPositionRequest.Merge(m, src)
+}
+func (m *PlanetPositionRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanetPositionRequest.Size(m)
+}
+func (m *PlanetPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetPositionRequest proto.InternalMessageInfo
+
+func (m *PlanetPositionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetPositionRequest) GetBody() Planet {
+	if m != nil {
+		return m.Body
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetPositionRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetTopocentric() bool {
+	if m != nil {
+		return m.Topocentric
+	}
+	return false
+}
+
+func (m *PlanetPositionRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PlanetPositionRequest) GetKind() PositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PositionKind_POSITION_KIND_UNSPECIFIED
+}
+
+func (m *PlanetPositionRequest) GetEphemeris() PlanetEphemeris {
+	if m != nil {
+		return m.Ephemeris
+	}
+	return PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED
+}
+
+type PlanetPosition struct {
+	Api        string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Body       Planet  `protobuf:"varint,2,opt,name=body,proto3,enum=v1.Planet" json:"body,omitempty"`
+	JulianDate float64 `protobuf:"fixed64,3,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Heliocentric ecliptic coordinates, in degrees, and distance from the
+	// sun, in AU
+	HeliocentricLongitude float64 `protobuf:"fixed64,4,opt,name=heliocentric_longitude,json=heliocentricLongitude,proto3" json:"heliocentric_longitude,omitempty"`
+	HeliocentricLatitude  float64 `protobuf:"fixed64,5,opt,name=heliocentric_latitude,json=heliocentricLatitude,proto3" json:"heliocentric_latitude,omitempty"`
+	RadiusVector          float64 `protobuf:"fixed64,6,opt,name=radius_vector,json=radiusVector,proto3" json:"radius_vector,omitempty"`
+	// Geocentric ecliptic and equatorial coordinates, in degrees, of the
+	// kind requested
+	EclipticLongitude float64 `protobuf:"fixed64,7,opt,name=ecliptic_longitude,json=eclipticLongitude,proto3" json:"ecliptic_longitude,omitempty"`
+	EclipticLatitude  float64 `protobuf:"fixed64,8,opt,name=ecliptic_latitude,json=eclipticLatitude,proto3" json:"ecliptic_latitude,omitempty"`
+	RightAscension    float64 `protobuf:"fixed64,9,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination       float64 `protobuf:"fixed64,10,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Distance from the earth, in AU, to where the planet was when the light
+	// left it except for geometric positions
+	Distance float64 `protobuf:"fixed64,11,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Time taken for light to travel from the planet to the earth, in days
+	LightTime float64 `protobuf:"fixed64,12,opt,name=light_time,json=lightTime,proto3" json:"light_time,omitempty"`
+	// The planetary theory used
+	Theory string `protobuf:"bytes,13,opt,name=theory,proto3" json:"theory,omitempty"`
+	// Equatorial coordinates, in degrees, and distance, in AU, seen from the
+	// observer when a topocentric position was requested
+	TopocentricRightAscension float64 `protobuf:"fixed64,14,opt,name=topocentric_right_ascension,json=topocentricRightAscension,proto3" json:"topocentric_right_ascension,omitempty"`
+	TopocentricDeclination    float64 `protobuf:"fixed64,15,opt,name=topocentric_declination,json=topocentricDeclination,proto3" json:"topocentric_declination,omitempty"`
+	TopocentricDistance       float64 `protobuf:"fixed64,16,opt,name=topocentric_distance,json=topocentricDistance,proto3" json:"topocentric_distance,omitempty"`
+	// The constellation the planet is in
+	Constellation string `protobuf:"bytes,17,opt,name=constellation,proto3" json:"constellation,omitempty"`
+	// The kind of geocentric position returned, never unspecified
+	Kind                 PositionKind `protobuf:"varint,18,opt,name=kind,proto3,enum=v1.PositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *PlanetPosition) Reset()         { *m = PlanetPosition{} }
+func (m *PlanetPosition) String() string { return proto.CompactTextString(m) }
+func (*PlanetPosition) ProtoMessage()    {}
+func (*PlanetPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{1}
+}
+
+func (m *PlanetPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetPosition.Unmarshal(m, b)
+}
+func (m *PlanetPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetPosition.Marshal(b, m, deterministic)
+}
+func (m *PlanetPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetPosition.Merge(m, src)
+}
+func (m *PlanetPosition) XXX_Size() int {
+	return xxx_messageInfo_PlanetPosition.Size(m)
+}
+func (m *PlanetPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetPosition proto.InternalMessageInfo
+
+func (m *PlanetPosition) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetPosition) GetBody() Planet {
+	if m != nil {
+		return m.Body
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetPosition) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetHeliocentricLongitude() float64 {
+	if m != nil {
+		return m.HeliocentricLongitude
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetHeliocentricLatitude() float64 {
+	if m != nil {
+		return m.HeliocentricLatitude
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetRadiusVector() float64 {
+	if m != nil {
+		return m.RadiusVector
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetEclipticLongitude() float64 {
+	if m != nil {
+		return m.EclipticLongitude
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetEclipticLatitude() float64 {
+	if m != nil {
+		return m.EclipticLatitude
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetLightTime() float64 {
+	if m != nil {
+		return m.LightTime
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetTheory() string {
+	if m != nil {
+		return m.Theory
+	}
+	return ""
+}
+
+func (m *PlanetPosition) GetTopocentricRightAscension() float64 {
+	if m != nil {
+		return m.TopocentricRightAscension
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetTopocentricDeclination() float64 {
+	if m != nil {
+		return m.TopocentricDeclination
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetTopocentricDistance() float64 {
+	if m != nil {
+		return m.TopocentricDistance
+	}
+	return 0
+}
+
+func (m *PlanetPosition) GetConstellation() string {
+	if m != nil {
+		return m.Constellation
+	}
+	return ""
+}
+
+func (m *PlanetPosition) GetKind() PositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PositionKind_POSITION_KIND_UNSPECIFIED
+}
+
+type PlanetInstant struct {
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Hour of the day, in UTC
+	Hour                 float64  `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	JulianDate           float64  `protobuf:"fixed64,5,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanetInstant) Reset()         { *m = PlanetInstant{} }
+func (m *PlanetInstant) String() string { return proto.CompactTextString(m) }
+func (*PlanetInstant) ProtoMessage()    {}
+func (*PlanetInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{2}
+}
+
+func (m *PlanetInstant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetInstant.Unmarshal(m, b)
+}
+func (m *PlanetInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetInstant.Marshal(b, m, deterministic)
+}
+func (m *PlanetInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetInstant.Merge(m, src)
+}
+func (m *PlanetInstant) XXX_Size() int {
+	return xxx_messageInfo_PlanetInstant.Size(m)
+}
+func (m *PlanetInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetInstant proto.InternalMessageInfo
+
+func (m *PlanetInstant) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *PlanetInstant) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *PlanetInstant) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *PlanetInstant) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *PlanetInstant) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type PlanetVisibilityRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Body      Planet  `protobuf:"varint,2,opt,name=body,proto3,enum=v1.Planet" json:"body,omitempty"`
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,5,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,6,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,7,opt,name=day,proto3" json:"day,omitempty"`
+	// Offset of the observer's civil time from UTC, in hours, the date
+	// searched runs from local midnight to midnight
+	UtcOffset float64 `protobuf:"fixed64,8,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa, giving the
+	// refraction at the horizon. Either of the temperature and pressure not
+	// given is that of the standard atmosphere, 10 degrees Celsius and 1010
+	// hPa, and with none of the three the refraction is taken to be 34'
+	Refraction           string                `protobuf:"bytes,9,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature          *wrappers.DoubleValue `protobuf:"bytes,10,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure             *wrappers.DoubleValue `protobuf:"bytes,11,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PlanetVisibilityRequest) Reset()         { *m = PlanetVisibilityRequest{} }
+func (m *PlanetVisibilityRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanetVisibilityRequest) ProtoMessage()    {}
+func (*PlanetVisibilityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{3}
+}
+
+func (m *PlanetVisibilityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetVisibilityRequest.Unmarshal(m, b)
+}
+func (m *PlanetVisibilityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetVisibilityRequest.Marshal(b, m, deterministic)
+}
+func (m *PlanetVisibilityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetVisibilityRequest.Merge(m, src)
+}
+func (m *PlanetVisibilityRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanetVisibilityRequest.Size(m)
+}
+func (m *PlanetVisibilityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetVisibilityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetVisibilityRequest proto.InternalMessageInfo
+
+func (m *PlanetVisibilityRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetVisibilityRequest) GetBody() Planet {
+	if m != nil {
+		return m.Body
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetVisibilityRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetUtcOffset() float64 {
+	if m != nil {
+		return m.UtcOffset
+	}
+	return 0
+}
+
+func (m *PlanetVisibilityRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *PlanetVisibilityRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *PlanetVisibilityRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+type PlanetEvent struct {
+	Time *PlanetInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Horizontal coordinates, in degrees, azimuth measured clockwise from
+	// north
+	Azimuth              float64  `protobuf:"fixed64,2,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Altitude             float64  `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanetEvent) Reset()         { *m = PlanetEvent{} }
+func (m *PlanetEvent) String() string { return proto.CompactTextString(m) }
+func (*PlanetEvent) ProtoMessage()    {}
+func (*PlanetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{4}
+}
+
+func (m *PlanetEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetEvent.Unmarshal(m, b)
+}
+func (m *PlanetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetEvent.Marshal(b, m, deterministic)
+}
+func (m *PlanetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetEvent.Merge(m, src)
+}
+func (m *PlanetEvent) XXX_Size() int {
+	return xxx_messageInfo_PlanetEvent.Size(m)
+}
+func (m *PlanetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetEvent proto.InternalMessageInfo
+
+func (m *PlanetEvent) GetTime() *PlanetInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *PlanetEvent) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *PlanetEvent) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+type PlanetVisibility struct {
+	Api           string            `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Body          Planet            `protobuf:"varint,2,opt,name=body,proto3,enum=v1.Planet" json:"body,omitempty"`
+	RiseStatus    PlanetEventStatus `protobuf:"varint,3,opt,name=rise_status,json=riseStatus,proto3,enum=v1.PlanetEventStatus" json:"rise_status,omitempty"`
+	Rise          *PlanetEvent      `protobuf:"bytes,4,opt,name=rise,proto3" json:"rise,omitempty"`
+	TransitStatus PlanetEventStatus `protobuf:"varint,5,opt,name=transit_status,json=transitStatus,proto3,enum=v1.PlanetEventStatus" json:"transit_status,omitempty"`
+	Transit       *PlanetEvent      `protobuf:"bytes,6,opt,name=transit,proto3" json:"transit,omitempty"`
+	SetStatus     PlanetEventStatus `protobuf:"varint,7,opt,name=set_status,json=setStatus,proto3,enum=v1.PlanetEventStatus" json:"set_status,omitempty"`
+	Set           *PlanetEvent      `protobuf:"bytes,8,opt,name=set,proto3" json:"set,omitempty"`
+	// Physical ephemeris at the start of the date
+	Magnitude           float64 `protobuf:"fixed64,9,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	IlluminatedFraction float64 `protobuf:"fixed64,10,opt,name=illuminated_fraction,json=illuminatedFraction,proto3" json:"illuminated_fraction,omitempty"`
+	// Angle at the planet between the sun and the earth, in degrees
+	PhaseAngle float64 `protobuf:"fixed64,11,opt,name=phase_angle,json=phaseAngle,proto3" json:"phase_angle,omitempty"`
+	// Apparent equatorial diameter, in arcseconds
+	ApparentDiameter float64 `protobuf:"fixed64,12,opt,name=apparent_diameter,json=apparentDiameter,proto3" json:"apparent_diameter,omitempty"`
+	// Angle between the sun and the planet seen from the earth, in degrees,
+	// positive east of the sun (evening sky) and negative west of it
+	Elongation float64 `protobuf:"fixed64,13,opt,name=elongation,proto3" json:"elongation,omitempty"`
+	// The end of evening and start of morning civil twilight bounding the
+	// night that follows the date, omitted when the sun does not set that
+	// far
+	Dusk *PlanetInstant `protobuf:"bytes,14,opt,name=dusk,proto3" json:"dusk,omitempty"`
+	Dawn *PlanetInstant `protobuf:"bytes,15,opt,name=dawn,proto3" json:"dawn,omitempty"`
+	// Whether the planet is above 5 degrees altitude in a dark sky during
+	// the night and bright enough to see with the naked eye
+	VisibleTonight       bool     `protobuf:"varint,16,opt,name=visible_tonight,json=visibleTonight,proto3" json:"visible_tonight,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanetVisibility) Reset()         { *m = PlanetVisibility{} }
+func (m *PlanetVisibility) String() string { return proto.CompactTextString(m) }
+func (*PlanetVisibility) ProtoMessage()    {}
+func (*PlanetVisibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{5}
+}
+
+func (m *PlanetVisibility) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetVisibility.Unmarshal(m, b)
+}
+func (m *PlanetVisibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetVisibility.Marshal(b, m, deterministic)
+}
+func (m *PlanetVisibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetVisibility.Merge(m, src)
+}
+func (m *PlanetVisibility) XXX_Size() int {
+	return xxx_messageInfo_PlanetVisibility.Size(m)
+}
+func (m *PlanetVisibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetVisibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetVisibility proto.InternalMessageInfo
+
+func (m *PlanetVisibility) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetVisibility) GetBody() Planet {
+	if m != nil {
+		return m.Body
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetVisibility) GetRiseStatus() PlanetEventStatus {
+	if m != nil {
+		return m.RiseStatus
+	}
+	return PlanetEventStatus_PLANET_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *PlanetVisibility) GetRise() *PlanetEvent {
+	if m != nil {
+		return m.Rise
+	}
+	return nil
+}
+
+func (m *PlanetVisibility) GetTransitStatus() PlanetEventStatus {
+	if m != nil {
+		return m.TransitStatus
+	}
+	return PlanetEventStatus_PLANET_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *PlanetVisibility) GetTransit() *PlanetEvent {
+	if m != nil {
+		return m.Transit
+	}
+	return nil
+}
+
+func (m *PlanetVisibility) GetSetStatus() PlanetEventStatus {
+	if m != nil {
+		return m.SetStatus
+	}
+	return PlanetEventStatus_PLANET_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *PlanetVisibility) GetSet() *PlanetEvent {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func (m *PlanetVisibility) GetMagnitude() float64 {
+	if m != nil {
+		return m.Magnitude
+	}
+	return 0
+}
+
+func (m *PlanetVisibility) GetIlluminatedFraction() float64 {
+	if m != nil {
+		return m.IlluminatedFraction
+	}
+	return 0
+}
+
+func (m *PlanetVisibility) GetPhaseAngle() float64 {
+	if m != nil {
+		return m.PhaseAngle
+	}
+	return 0
+}
+
+func (m *PlanetVisibility) GetApparentDiameter() float64 {
+	if m != nil {
+		return m.ApparentDiameter
+	}
+	return 0
+}
+
+func (m *PlanetVisibility) GetElongation() float64 {
+	if m != nil {
+		return m.Elongation
+	}
+	return 0
+}
+
+func (m *PlanetVisibility) GetDusk() *PlanetInstant {
+	if m != nil {
+		return m.Dusk
+	}
+	return nil
+}
+
+func (m *PlanetVisibility) GetDawn() *PlanetInstant {
+	if m != nil {
+		return m.Dawn
+	}
+	return nil
+}
+
+func (m *PlanetVisibility) GetVisibleTonight() bool {
+	if m != nil {
+		return m.VisibleTonight
+	}
+	return false
+}
+
+type PlanetaryEventsRequest struct {
+	Api        string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	StartYear  int32  `protobuf:"varint,2,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth int32  `protobuf:"varint,3,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay   int32  `protobuf:"varint,4,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear    int32  `protobuf:"varint,5,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth   int32  `protobuf:"varint,6,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay     int32  `protobuf:"varint,7,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// The planets searched, all of them when empty
+	Bodies               []Planet `protobuf:"varint,8,rep,packed,name=bodies,proto3,enum=v1.Planet" json:"bodies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanetaryEventsRequest) Reset()         { *m = PlanetaryEventsRequest{} }
+func (m *PlanetaryEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*PlanetaryEventsRequest) ProtoMessage()    {}
+func (*PlanetaryEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{6}
+}
+
+func (m *PlanetaryEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetaryEventsRequest.Unmarshal(m, b)
+}
+func (m *PlanetaryEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetaryEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *PlanetaryEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetaryEventsRequest.Merge(m, src)
+}
+func (m *PlanetaryEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_PlanetaryEventsRequest.Size(m)
+}
+func (m *PlanetaryEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetaryEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetaryEventsRequest proto.InternalMessageInfo
+
+func (m *PlanetaryEventsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetaryEventsRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+func (m *PlanetaryEventsRequest) GetBodies() []Planet {
+	if m != nil {
+		return m.Bodies
+	}
+	return nil
+}
+
+type PlanetaryEvent struct {
+	Api  string             `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Type PlanetaryEventType `protobuf:"varint,2,opt,name=type,proto3,enum=v1.PlanetaryEventType" json:"type,omitempty"`
+	Time *PlanetInstant     `protobuf:"bytes,3,opt,name=time,proto3" json:"time,omitempty"`
+	Body Planet             `protobuf:"varint,4,opt,name=body,proto3,enum=v1.Planet" json:"body,omitempty"`
+	// The second planet of a CONJUNCTION, PLANET_UNSPECIFIED for other events
+	Other Planet `protobuf:"varint,5,opt,name=other,proto3,enum=v1.Planet" json:"other,omitempty"`
+	// Angular separation, in degrees, from the other planet or the Moon for
+	// conjunctions and from the sun for the rest
+	Separation float64 `protobuf:"fixed64,6,opt,name=separation,proto3" json:"separation,omitempty"`
+	// Geocentric ecliptic longitude of the planet, in degrees
+	EclipticLongitude    float64  `protobuf:"fixed64,7,opt,name=ecliptic_longitude,json=eclipticLongitude,proto3" json:"ecliptic_longitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PlanetaryEvent) Reset()         { *m = PlanetaryEvent{} }
+func (m *PlanetaryEvent) String() string { return proto.CompactTextString(m) }
+func (*PlanetaryEvent) ProtoMessage()    {}
+func (*PlanetaryEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{7}
+}
+
+func (m *PlanetaryEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlanetaryEvent.Unmarshal(m, b)
+}
+func (m *PlanetaryEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlanetaryEvent.Marshal(b, m, deterministic)
+}
+func (m *PlanetaryEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanetaryEvent.Merge(m, src)
+}
+func (m *PlanetaryEvent) XXX_Size() int {
+	return xxx_messageInfo_PlanetaryEvent.Size(m)
+}
+func (m *PlanetaryEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanetaryEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlanetaryEvent proto.InternalMessageInfo
+
+func (m *PlanetaryEvent) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *PlanetaryEvent) GetType() PlanetaryEventType {
+	if m != nil {
+		return m.Type
+	}
+	return PlanetaryEventType_PLANETARY_EVENT_UNSPECIFIED
+}
+
+func (m *PlanetaryEvent) GetTime() *PlanetInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *PlanetaryEvent) GetBody() Planet {
+	if m != nil {
+		return m.Body
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetaryEvent) GetOther() Planet {
+	if m != nil {
+		return m.Other
+	}
+	return Planet_PLANET_UNSPECIFIED
+}
+
+func (m *PlanetaryEvent) GetSeparation() float64 {
+	if m != nil {
+		return m.Separation
+	}
+	return 0
+}
+
+func (m *PlanetaryEvent) GetEclipticLongitude() float64 {
+	if m != nil {
+		return m.EclipticLongitude
+	}
+	return 0
+}
+
+type MinorBodyPositionRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Osculating elements in either of the Minor Planet Center's one line
+	// formats, MPCORB.DAT for asteroids or CometEls.txt for comets
+	Elements string `protobuf:"bytes,2,opt,name=elements,proto3" json:"elements,omitempty"`
+	Year     int32  `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Month    int32  `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	Day      int32  `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC hour of the day
+	Hour float64 `protobuf:"fixed64,6,opt,name=hour,proto3" json:"hour,omitempty"`
+	// The kind of geocentric position wanted, apparent when not given
+	Kind PositionKind `protobuf:"varint,7,opt,name=kind,proto3,enum=v1.PositionKind" json:"kind,omitempty"`
+	// The ephemeris giving the position of the Earth
+	Ephemeris            PlanetEphemeris `protobuf:"varint,8,opt,name=ephemeris,proto3,enum=v1.PlanetEphemeris" json:"ephemeris,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MinorBodyPositionRequest) Reset()         { *m = MinorBodyPositionRequest{} }
+func (m *MinorBodyPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*MinorBodyPositionRequest) ProtoMessage()    {}
+func (*MinorBodyPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{8}
+}
+
+func (m *MinorBodyPositionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinorBodyPositionRequest.Unmarshal(m, b)
+}
+func (m *MinorBodyPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinorBodyPositionRequest.Marshal(b, m, deterministic)
+}
+func (m *MinorBodyPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinorBodyPositionRequest.Merge(m, src)
+}
+func (m *MinorBodyPositionRequest) XXX_Size() int {
+	return xxx_messageInfo_MinorBodyPositionRequest.Size(m)
+}
+func (m *MinorBodyPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinorBodyPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinorBodyPositionRequest proto.InternalMessageInfo
+
+func (m *MinorBodyPositionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MinorBodyPositionRequest) GetElements() string {
+	if m != nil {
+		return m.Elements
+	}
+	return ""
+}
+
+func (m *MinorBodyPositionRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MinorBodyPositionRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *MinorBodyPositionRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *MinorBodyPositionRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *MinorBodyPositionRequest) GetKind() PositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PositionKind_POSITION_KIND_UNSPECIFIED
+}
+
+func (m *MinorBodyPositionRequest) GetEphemeris() PlanetEphemeris {
+	if m != nil {
+		return m.Ephemeris
+	}
+	return PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED
+}
+
+type MinorBodyPosition struct {
+	Api         string         `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Designation string         `protobuf:"bytes,2,opt,name=designation,proto3" json:"designation,omitempty"`
+	Name        string         `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Comet       bool           `protobuf:"varint,4,opt,name=comet,proto3" json:"comet,omitempty"`
+	Orbit       MinorBodyOrbit `protobuf:"varint,5,opt,name=orbit,proto3,enum=v1.MinorBodyOrbit" json:"orbit,omitempty"`
+	JulianDate  float64        `protobuf:"fixed64,6,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Heliocentric ecliptic coordinates, in degrees, and distance from the
+	// sun, in AU
+	HeliocentricLongitude float64 `protobuf:"fixed64,7,opt,name=heliocentric_longitude,json=heliocentricLongitude,proto3" json:"heliocentric_longitude,omitempty"`
+	HeliocentricLatitude  float64 `protobuf:"fixed64,8,opt,name=heliocentric_latitude,json=heliocentricLatitude,proto3" json:"heliocentric_latitude,omitempty"`
+	RadiusVector          float64 `protobuf:"fixed64,9,opt,name=radius_vector,json=radiusVector,proto3" json:"radius_vector,omitempty"`
+	// Geocentric ecliptic and equatorial coordinates, in degrees, of the
+	// kind requested
+	EclipticLongitude float64 `protobuf:"fixed64,10,opt,name=ecliptic_longitude,json=eclipticLongitude,proto3" json:"ecliptic_longitude,omitempty"`
+	EclipticLatitude  float64 `protobuf:"fixed64,11,opt,name=ecliptic_latitude,json=eclipticLatitude,proto3" json:"ecliptic_latitude,omitempty"`
+	RightAscension    float64 `protobuf:"fixed64,12,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination       float64 `protobuf:"fixed64,13,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Distance from the earth, in AU
+	Distance float64 `protobuf:"fixed64,14,opt,name=distance,proto3" json:"distance,omitempty"`
+	// Time taken for light to travel from the body to the earth, in days
+	LightTime float64 `protobuf:"fixed64,15,opt,name=light_time,json=lightTime,proto3" json:"light_time,omitempty"`
+	// Angular distance from the sun, in degrees, negative west of the sun,
+	// and the sun-body-earth angle, in degrees
+	Elongation float64 `protobuf:"fixed64,16,opt,name=elongation,proto3" json:"elongation,omitempty"`
+	PhaseAngle float64 `protobuf:"fixed64,17,opt,name=phase_angle,json=phaseAngle,proto3" json:"phase_angle,omitempty"`
+	// Estimated visual magnitude, from H and G for an asteroid or the total
+	// magnitude parameters for a comet, unset when has_magnitude is false
+	Magnitude    float64 `protobuf:"fixed64,18,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	HasMagnitude bool    `protobuf:"varint,19,opt,name=has_magnitude,json=hasMagnitude,proto3" json:"has_magnitude,omitempty"`
+	// The constellation the body is in
+	Constellation string `protobuf:"bytes,20,opt,name=constellation,proto3" json:"constellation,omitempty"`
+	// The kind of geocentric position returned, never unspecified
+	Kind                 PositionKind `protobuf:"varint,21,opt,name=kind,proto3,enum=v1.PositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *MinorBodyPosition) Reset()         { *m = MinorBodyPosition{} }
+func (m *MinorBodyPosition) String() string { return proto.CompactTextString(m) }
+func (*MinorBodyPosition) ProtoMessage()    {}
+func (*MinorBodyPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{9}
+}
+
+func (m *MinorBodyPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MinorBodyPosition.Unmarshal(m, b)
+}
+func (m *MinorBodyPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MinorBodyPosition.Marshal(b, m, deterministic)
+}
+func (m *MinorBodyPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinorBodyPosition.Merge(m, src)
+}
+func (m *MinorBodyPosition) XXX_Size() int {
+	return xxx_messageInfo_MinorBodyPosition.Size(m)
+}
+func (m *MinorBodyPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinorBodyPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinorBodyPosition proto.InternalMessageInfo
+
+func (m *MinorBodyPosition) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MinorBodyPosition) GetDesignation() string {
+	if m != nil {
+		return m.Designation
+	}
+	return ""
+}
+
+func (m *MinorBodyPosition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MinorBodyPosition) GetComet() bool {
+	if m != nil {
+		return m.Comet
+	}
+	return false
+}
+
+func (m *MinorBodyPosition) GetOrbit() MinorBodyOrbit {
+	if m != nil {
+		return m.Orbit
+	}
+	return MinorBodyOrbit_MINOR_BODY_ORBIT_UNSPECIFIED
+}
+
+func (m *MinorBodyPosition) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetHeliocentricLongitude() float64 {
+	if m != nil {
+		return m.HeliocentricLongitude
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetHeliocentricLatitude() float64 {
+	if m != nil {
+		return m.HeliocentricLatitude
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetRadiusVector() float64 {
+	if m != nil {
+		return m.RadiusVector
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetEclipticLongitude() float64 {
+	if m != nil {
+		return m.EclipticLongitude
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetEclipticLatitude() float64 {
+	if m != nil {
+		return m.EclipticLatitude
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetLightTime() float64 {
+	if m != nil {
+		return m.LightTime
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetElongation() float64 {
+	if m != nil {
+		return m.Elongation
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetPhaseAngle() float64 {
+	if m != nil {
+		return m.PhaseAngle
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetMagnitude() float64 {
+	if m != nil {
+		return m.Magnitude
+	}
+	return 0
+}
+
+func (m *MinorBodyPosition) GetHasMagnitude() bool {
+	if m != nil {
+		return m.HasMagnitude
+	}
+	return false
+}
+
+func (m *MinorBodyPosition) GetConstellation() string {
+	if m != nil {
+		return m.Constellation
+	}
+	return ""
+}
+
+func (m *MinorBodyPosition) GetKind() PositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return PositionKind_POSITION_KIND_UNSPECIFIED
+}
+
+type GalileanMoonsRequest struct {
+	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Year                 int32    `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	Month                int32    `protobuf:"varint,3,opt,name=month,proto3" json:"month,omitempty"`
+	Day                  int32    `protobuf:"varint,4,opt,name=day,proto3" json:"day,omitempty"`
+	Hour                 float64  `protobuf:"fixed64,5,opt,name=hour,proto3" json:"hour,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GalileanMoonsRequest) Reset()         { *m = GalileanMoonsRequest{} }
+func (m *GalileanMoonsRequest) String() string { return proto.CompactTextString(m) }
+func (*GalileanMoonsRequest) ProtoMessage()    {}
+func (*GalileanMoonsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{10}
+}
+
+func (m *GalileanMoonsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GalileanMoonsRequest.Unmarshal(m, b)
+}
+func (m *GalileanMoonsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GalileanMoonsRequest.Marshal(b, m, deterministic)
+}
+func (m *GalileanMoonsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GalileanMoonsRequest.Merge(m, src)
+}
+func (m *GalileanMoonsRequest) XXX_Size() int {
+	return xxx_messageInfo_GalileanMoonsRequest.Size(m)
+}
+func (m *GalileanMoonsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GalileanMoonsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GalileanMoonsRequest proto.InternalMessageInfo
+
+func (m *GalileanMoonsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GalileanMoonsRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *GalileanMoonsRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *GalileanMoonsRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *GalileanMoonsRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+type GalileanMoonPosition struct {
+	Moon GalileanMoon `protobuf:"varint,1,opt,name=moon,proto3,enum=v1.GalileanMoon" json:"moon,omitempty"`
+	// Position relative to the centre of Jupiter, in equatorial radii of the
+	// planet, x westward along its equator, y northward along its axis and z
+	// towards the earth
+	X float64 `protobuf:"fixed64,2,opt,name=x,proto3" json:"x,omitempty"`
+	Y float64 `protobuf:"fixed64,3,opt,name=y,proto3" json:"y,omitempty"`
+	Z float64 `protobuf:"fixed64,4,opt,name=z,proto3" json:"z,omitempty"`
+	// Position seen from the sun, where the satellite's shadow falls
+	ShadowX float64 `protobuf:"fixed64,5,opt,name=shadow_x,json=shadowX,proto3" json:"shadow_x,omitempty"`
+	ShadowY float64 `protobuf:"fixed64,6,opt,name=shadow_y,json=shadowY,proto3" json:"shadow_y,omitempty"`
+	ShadowZ float64 `protobuf:"fixed64,7,opt,name=shadow_z,json=shadowZ,proto3" json:"shadow_z,omitempty"`
+	// The events under way
+	Events               []GalileanEventType `protobuf:"varint,8,rep,packed,name=events,proto3,enum=v1.GalileanEventType" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GalileanMoonPosition) Reset()         { *m = GalileanMoonPosition{} }
+func (m *GalileanMoonPosition) String() string { return proto.CompactTextString(m) }
+func (*GalileanMoonPosition) ProtoMessage()    {}
+func (*GalileanMoonPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{11}
+}
+
+func (m *GalileanMoonPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GalileanMoonPosition.Unmarshal(m, b)
+}
+func (m *GalileanMoonPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GalileanMoonPosition.Marshal(b, m, deterministic)
+}
+func (m *GalileanMoonPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GalileanMoonPosition.Merge(m, src)
+}
+func (m *GalileanMoonPosition) XXX_Size() int {
+	return xxx_messageInfo_GalileanMoonPosition.Size(m)
+}
+func (m *GalileanMoonPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_GalileanMoonPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GalileanMoonPosition proto.InternalMessageInfo
+
+func (m *GalileanMoonPosition) GetMoon() GalileanMoon {
+	if m != nil {
+		return m.Moon
+	}
+	return GalileanMoon_GALILEAN_MOON_UNSPECIFIED
+}
+
+func (m *GalileanMoonPosition) GetX() float64 {
+	if m != nil {
+		return m.X
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetY() float64 {
+	if m != nil {
+		return m.Y
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetZ() float64 {
+	if m != nil {
+		return m.Z
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetShadowX() float64 {
+	if m != nil {
+		return m.ShadowX
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetShadowY() float64 {
+	if m != nil {
+		return m.ShadowY
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetShadowZ() float64 {
+	if m != nil {
+		return m.ShadowZ
+	}
+	return 0
+}
+
+func (m *GalileanMoonPosition) GetEvents() []GalileanEventType {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
+type GalileanMoons struct {
+	Api   string                  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Time  *PlanetInstant          `protobuf:"bytes,2,opt,name=time,proto3" json:"time,omitempty"`
+	Moons []*GalileanMoonPosition `protobuf:"bytes,3,rep,name=moons,proto3" json:"moons,omitempty"`
+	// Declinations of the earth and the sun referred to Jupiter's equator,
+	// in degrees
+	EarthDeclination     float64  `protobuf:"fixed64,4,opt,name=earth_declination,json=earthDeclination,proto3" json:"earth_declination,omitempty"`
+	SunDeclination       float64  `protobuf:"fixed64,5,opt,name=sun_declination,json=sunDeclination,proto3" json:"sun_declination,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GalileanMoons) Reset()         { *m = GalileanMoons{} }
+func (m *GalileanMoons) String() string { return proto.CompactTextString(m) }
+func (*GalileanMoons) ProtoMessage()    {}
+func (*GalileanMoons) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{12}
+}
+
+func (m *GalileanMoons) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GalileanMoons.Unmarshal(m, b)
+}
+func (m *GalileanMoons) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GalileanMoons.Marshal(b, m, deterministic)
+}
+func (m *GalileanMoons) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GalileanMoons.Merge(m, src)
+}
+func (m *GalileanMoons) XXX_Size() int {
+	return xxx_messageInfo_GalileanMoons.Size(m)
+}
+func (m *GalileanMoons) XXX_DiscardUnknown() {
+	xxx_messageInfo_GalileanMoons.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GalileanMoons proto.InternalMessageInfo
+
+func (m *GalileanMoons) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GalileanMoons) GetTime() *PlanetInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *GalileanMoons) GetMoons() []*GalileanMoonPosition {
+	if m != nil {
+		return m.Moons
+	}
+	return nil
+}
+
+func (m *GalileanMoons) GetEarthDeclination() float64 {
+	if m != nil {
+		return m.EarthDeclination
+	}
+	return 0
+}
+
+func (m *GalileanMoons) GetSunDeclination() float64 {
+	if m != nil {
+		return m.SunDeclination
+	}
+	return 0
+}
+
+type GalileanEventsRequest struct {
+	Api        string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	StartYear  int32  `protobuf:"varint,2,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth int32  `protobuf:"varint,3,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay   int32  `protobuf:"varint,4,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear    int32  `protobuf:"varint,5,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth   int32  `protobuf:"varint,6,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay     int32  `protobuf:"varint,7,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// The satellites searched, all of them when empty
+	Moons                []GalileanMoon `protobuf:"varint,8,rep,packed,name=moons,proto3,enum=v1.GalileanMoon" json:"moons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GalileanEventsRequest) Reset()         { *m = GalileanEventsRequest{} }
+func (m *GalileanEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*GalileanEventsRequest) ProtoMessage()    {}
+func (*GalileanEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{13}
+}
+
+func (m *GalileanEventsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GalileanEventsRequest.Unmarshal(m, b)
+}
+func (m *GalileanEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GalileanEventsRequest.Marshal(b, m, deterministic)
+}
+func (m *GalileanEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GalileanEventsRequest.Merge(m, src)
+}
+func (m *GalileanEventsRequest) XXX_Size() int {
+	return xxx_messageInfo_GalileanEventsRequest.Size(m)
+}
+func (m *GalileanEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GalileanEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GalileanEventsRequest proto.InternalMessageInfo
+
+func (m *GalileanEventsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GalileanEventsRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+func (m *GalileanEventsRequest) GetMoons() []GalileanMoon {
+	if m != nil {
+		return m.Moons
+	}
+	return nil
+}
+
+type GalileanEvent struct {
+	Api  string            `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Type GalileanEventType `protobuf:"varint,2,opt,name=type,proto3,enum=v1.GalileanEventType" json:"type,omitempty"`
+	Moon GalileanMoon      `protobuf:"varint,3,opt,name=moon,proto3,enum=v1.GalileanMoon" json:"moon,omitempty"`
+	// When the centre of the satellite, or of its shadow, crosses the limb
+	// of Jupiter
+	Start *PlanetInstant `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	End   *PlanetInstant `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	// Length of the event, in hours
+	Duration             float64  `protobuf:"fixed64,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GalileanEvent) Reset()         { *m = GalileanEvent{} }
+func (m *GalileanEvent) String() string { return proto.CompactTextString(m) }
+func (*GalileanEvent) ProtoMessage()    {}
+func (*GalileanEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d83cbef893dcf94, []int{14}
+}
+
+func (m *GalileanEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GalileanEvent.Unmarshal(m, b)
+}
+func (m *GalileanEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GalileanEvent.Marshal(b, m, deterministic)
+}
+func (m *GalileanEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GalileanEvent.Merge(m, src)
+}
+func (m *GalileanEvent) XXX_Size() int {
+	return xxx_messageInfo_GalileanEvent.Size(m)
+}
+func (m *GalileanEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_GalileanEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GalileanEvent proto.InternalMessageInfo
+
+func (m *GalileanEvent) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *GalileanEvent) GetType() GalileanEventType {
+	if m != nil {
+		return m.Type
+	}
+	return GalileanEventType_GALILEAN_EVENT_UNSPECIFIED
+}
+
+func (m *GalileanEvent) GetMoon() GalileanMoon {
+	if m != nil {
+		return m.Moon
+	}
+	return GalileanMoon_GALILEAN_MOON_UNSPECIFIED
+}
+
+func (m *GalileanEvent) GetStart() *PlanetInstant {
+	if m != nil {
+		return m.Start
+	}
+	return nil
+}
+
+func (m *GalileanEvent) GetEnd() *PlanetInstant {
+	if m != nil {
+		return m.End
+	}
+	return nil
+}
+
+func (m *GalileanEvent) GetDuration() float64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("v1.Planet", Planet_name, Planet_value)
+	proto.RegisterEnum("v1.PlanetEphemeris", PlanetEphemeris_name, PlanetEphemeris_value)
+	proto.RegisterEnum("v1.PositionKind", PositionKind_name, PositionKind_value)
+	proto.RegisterEnum("v1.PlanetEventStatus", PlanetEventStatus_name, PlanetEventStatus_value)
+	proto.RegisterEnum("v1.PlanetaryEventType", PlanetaryEventType_name, PlanetaryEventType_value)
+	proto.RegisterEnum("v1.MinorBodyOrbit", MinorBodyOrbit_name, MinorBodyOrbit_value)
+	proto.RegisterEnum("v1.GalileanMoon", GalileanMoon_name, GalileanMoon_value)
+	proto.RegisterEnum("v1.GalileanEventType", GalileanEventType_name, GalileanEventType_value)
+	proto.RegisterType((*PlanetPositionRequest)(nil), "v1.PlanetPositionRequest")
+	proto.RegisterType((*PlanetPosition)(nil), "v1.PlanetPosition")
+	proto.RegisterType((*PlanetInstant)(nil), "v1.PlanetInstant")
+	proto.RegisterType((*PlanetVisibilityRequest)(nil), "v1.PlanetVisibilityRequest")
+	proto.RegisterType((*PlanetEvent)(nil), "v1.PlanetEvent")
+	proto.RegisterType((*PlanetVisibility)(nil), "v1.PlanetVisibility")
+	proto.RegisterType((*PlanetaryEventsRequest)(nil), "v1.PlanetaryEventsRequest")
+	proto.RegisterType((*PlanetaryEvent)(nil), "v1.PlanetaryEvent")
+	proto.RegisterType((*MinorBodyPositionRequest)(nil), "v1.MinorBodyPositionRequest")
+	proto.RegisterType((*MinorBodyPosition)(nil), "v1.MinorBodyPosition")
+	proto.RegisterType((*GalileanMoonsRequest)(nil), "v1.GalileanMoonsRequest")
+	proto.RegisterType((*GalileanMoonPosition)(nil), "v1.GalileanMoonPosition")
+	proto.RegisterType((*GalileanMoons)(nil), "v1.GalileanMoons")
+	proto.RegisterType((*GalileanEventsRequest)(nil), "v1.GalileanEventsRequest")
+	proto.RegisterType((*GalileanEvent)(nil), "v1.GalileanEvent")
+}
+
+func init() { proto.RegisterFile("planets.proto", fileDescriptor_2d83cbef893dcf94) }
+
+var fileDescriptor_2d83cbef893dcf94 = []byte{
+	// 2663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x73, 0x1b, 0x49,
+	0xd9, 0x7f, 0x47, 0xdf, 0x7a, 0x64, 0xcb, 0xe3, 0x8e, 0x9d, 0x28, 0xce, 0x97, 0x5e, 0x65, 0xdf,
+	0x77, 0xbd, 0x66, 0x6d, 0xc5, 0xde, 0xb0, 0x50, 0xcb, 0xd6, 0x16, 0x13, 0x6b, 0xe2, 0x28, 0x2b,
+	0x4b, 0xaa, 0xd6, 0x28, 0xc1, 0x0b, 0xd4, 0xd4, 0x58, 0xea, 0x48, 0x93, 0x95, 0x66, 0xc4, 0x4c,
+	0xcb, 0x8e, 0x12, 0x5c, 0xb5, 0xc5, 0x81, 0x2a, 0x0e, 0x40, 0x15, 0x70, 0x00, 0xfe, 0x00, 0x0e,
+	0xfc, 0x31, 0x5c, 0xb8, 0x72, 0xa4, 0xf6, 0xce, 0x15, 0xa8, 0x5a, 0xaa, 0xbb, 0x67, 0x46, 0x33,
+	0x92, 0xac, 0x4d, 0x52, 0x7b, 0xe2, 0x92, 0x4c, 0x3f, 0xbf, 0xa7, 0xbb, 0x9f, 0x7e, 0xfa, 0xf9,
+	0xf8, 0xb5, 0x0c, 0xab, 0xa3, 0x81, 0x61, 0x11, 0xea, 0xee, 0x8d, 0x1c, 0x9b, 0xda, 0x28, 0x76,
+	0xb6, 0xbf, 0x75, 0xb3, 0x67, 0xdb, 0xbd, 0x01, 0x29, 0x1b, 0x23, 0xb3, 0x6c, 0x58, 0x96, 0x4d,
+	0x0d, 0x6a, 0xda, 0x96, 0xa7, 0xb1, 0xf5, 0x3e, 0xff, 0xaf, 0xb3, 0xdb, 0x23, 0xd6, 0xae, 0x7b,
+	0x6e, 0xf4, 0x7a, 0xc4, 0x29, 0xdb, 0x23, 0xae, 0xb1, 0x40, 0xfb, 0xb6, 0xb7, 0x16, 0x1f, 0x9d,
+	0x8e, 0x9f, 0x95, 0xcf, 0x1d, 0x63, 0x34, 0x22, 0x8e, 0x87, 0x97, 0xbe, 0x8c, 0xc1, 0x66, 0x93,
+	0x5b, 0xd0, 0xb4, 0x5d, 0x93, 0xcd, 0xc4, 0xe4, 0x27, 0x63, 0xe2, 0x52, 0x24, 0x43, 0xdc, 0x18,
+	0x99, 0x05, 0xa9, 0x28, 0x6d, 0x67, 0x31, 0xfb, 0x44, 0xb7, 0x21, 0x71, 0x6a, 0x77, 0x27, 0x85,
+	0x58, 0x51, 0xda, 0xce, 0x1f, 0xc0, 0xde, 0xd9, 0xfe, 0x9e, 0x98, 0x8a, 0xb9, 0x1c, 0x21, 0x48,
+	0x4c, 0x88, 0xe1, 0x14, 0xe2, 0x45, 0x69, 0x3b, 0x89, 0xf9, 0x37, 0xda, 0x80, 0xe4, 0xd0, 0xb6,
+	0x68, 0xbf, 0x90, 0xe0, 0x42, 0x31, 0x60, 0x6b, 0x77, 0x8d, 0x49, 0x21, 0xc9, 0x65, 0xec, 0x93,
+	0xcd, 0xed, 0xdb, 0x63, 0xa7, 0x90, 0x2a, 0x4a, 0xdb, 0x12, 0xe6, 0xdf, 0xa8, 0x08, 0x39, 0x6a,
+	0x8f, 0xec, 0x0e, 0xb1, 0xa8, 0x63, 0x76, 0x0a, 0xe9, 0xa2, 0xb4, 0x9d, 0xc1, 0x61, 0x11, 0xba,
+	0x09, 0xd9, 0x81, 0x6d, 0xf5, 0x4c, 0x3a, 0xee, 0x92, 0x42, 0x86, 0x4f, 0x9d, 0x0a, 0xd0, 0x16,
+	0x64, 0x06, 0x06, 0x15, 0x60, 0x96, 0x83, 0xc1, 0x18, 0x5d, 0x85, 0x54, 0x9f, 0x98, 0xbd, 0x3e,
+	0x2d, 0x00, 0x47, 0xbc, 0x11, 0x7a, 0x07, 0x12, 0x9f, 0x9b, 0x56, 0xb7, 0x90, 0xe3, 0x67, 0x94,
+	0xf9, 0x19, 0x3d, 0xc7, 0x7c, 0x6a, 0x5a, 0x5d, 0xcc, 0x51, 0xb4, 0x0f, 0x59, 0x32, 0xea, 0x93,
+	0x21, 0x71, 0x4c, 0xb7, 0xb0, 0xc2, 0x55, 0xaf, 0x4c, 0xdd, 0xa1, 0xfa, 0x10, 0x9e, 0x6a, 0x95,
+	0xbe, 0x4c, 0x42, 0x3e, 0xea, 0xe8, 0xb7, 0xf0, 0xf0, 0x1d, 0xc8, 0x3d, 0x1f, 0x0f, 0x4c, 0xc3,
+	0xd2, 0xbb, 0x06, 0x25, 0xdc, 0xd1, 0x12, 0x06, 0x21, 0xaa, 0x18, 0x94, 0xa0, 0x6f, 0xc3, 0xd5,
+	0x3e, 0x19, 0x98, 0xbe, 0x83, 0xf4, 0xa9, 0x77, 0x12, 0x5c, 0x77, 0x33, 0x8c, 0xd6, 0x02, 0x4f,
+	0x7d, 0x00, 0x9b, 0xd1, 0x69, 0xbe, 0xdb, 0x92, 0x7c, 0xd6, 0x46, 0x64, 0x96, 0xef, 0xc2, 0xbb,
+	0xb0, 0xea, 0x18, 0x5d, 0x73, 0xec, 0xea, 0x67, 0xa4, 0x43, 0x6d, 0xff, 0xee, 0x56, 0x84, 0xf0,
+	0x09, 0x97, 0xa1, 0x5d, 0x40, 0xa4, 0x33, 0x30, 0x47, 0x34, 0x62, 0x4c, 0x9a, 0x6b, 0xae, 0xfb,
+	0xc8, 0xd4, 0x90, 0x6f, 0xc1, 0xfa, 0x54, 0xdd, 0xa0, 0xe1, 0x8b, 0x95, 0x03, 0x6d, 0xdf, 0x80,
+	0x77, 0x61, 0xcd, 0x61, 0x97, 0xa6, 0x1b, 0x6e, 0x87, 0x58, 0xae, 0x69, 0x5b, 0xde, 0x35, 0xe7,
+	0xb9, 0x58, 0xf1, 0xa5, 0x2c, 0x90, 0xba, 0x6c, 0xb6, 0xc5, 0x53, 0xc3, 0xbb, 0xf1, 0xb0, 0x88,
+	0x85, 0x4a, 0xd7, 0x74, 0xa9, 0x61, 0x75, 0x08, 0xbf, 0x7a, 0x09, 0x07, 0x63, 0x74, 0x0b, 0x60,
+	0xc0, 0xb7, 0xa1, 0xe6, 0x90, 0x14, 0x56, 0xbc, 0x28, 0x63, 0x12, 0xcd, 0x1c, 0xf2, 0x48, 0xa2,
+	0x7d, 0x62, 0x3b, 0x93, 0xc2, 0x2a, 0xbf, 0x48, 0x6f, 0x84, 0x3e, 0x81, 0x1b, 0xa1, 0x50, 0xd5,
+	0x67, 0x2d, 0xcd, 0xf3, 0x75, 0xae, 0x87, 0x54, 0x70, 0xd4, 0xe8, 0xef, 0xc0, 0xb5, 0xf0, 0xfc,
+	0xf0, 0x01, 0xd6, 0xf8, 0xdc, 0xab, 0x21, 0xb8, 0x12, 0x3a, 0xcb, 0x3e, 0x6c, 0x44, 0x26, 0xfa,
+	0xe7, 0x92, 0xf9, 0xac, 0x2b, 0xe1, 0x59, 0xfe, 0x11, 0xdf, 0x81, 0xd5, 0x8e, 0x6d, 0xb9, 0x94,
+	0x0c, 0x06, 0x62, 0x87, 0x75, 0x7e, 0x94, 0xa8, 0x30, 0xc8, 0x0d, 0xb4, 0x2c, 0x37, 0x4a, 0x5f,
+	0x48, 0xb0, 0x2a, 0x82, 0xb6, 0x6a, 0xb1, 0xe5, 0x69, 0x50, 0x17, 0xa4, 0x45, 0x75, 0x21, 0xb6,
+	0xa0, 0x2e, 0xc4, 0xe7, 0xeb, 0x42, 0x22, 0x54, 0x17, 0x66, 0xb2, 0x20, 0x39, 0x9b, 0x05, 0xa5,
+	0x7f, 0xc6, 0xe0, 0x9a, 0x30, 0xe1, 0x89, 0xe9, 0x9a, 0xa7, 0xe6, 0xc0, 0xa4, 0x93, 0xb7, 0x2f,
+	0x6b, 0x91, 0x22, 0x13, 0x5f, 0x56, 0x64, 0x12, 0x33, 0x45, 0xc6, 0x3f, 0x78, 0x72, 0xd1, 0xc1,
+	0x53, 0x0b, 0x0e, 0x9e, 0x9e, 0x1e, 0xfc, 0x16, 0xc0, 0x98, 0x76, 0x74, 0xfb, 0xd9, 0x33, 0x97,
+	0x50, 0xbf, 0xb6, 0x8d, 0x69, 0xa7, 0xc1, 0x05, 0xe8, 0x36, 0x80, 0x43, 0x9e, 0x39, 0x46, 0x87,
+	0xfa, 0x61, 0x9f, 0xc5, 0x21, 0x09, 0xfa, 0x04, 0x72, 0x94, 0x0c, 0x47, 0xc4, 0x31, 0xe8, 0xd8,
+	0x21, 0x3c, 0xe4, 0x73, 0x07, 0x37, 0xf7, 0x44, 0x37, 0xd8, 0xf3, 0xbb, 0xc1, 0x5e, 0xc5, 0x1e,
+	0x9f, 0x0e, 0xc8, 0x13, 0x63, 0x30, 0x26, 0x38, 0x3c, 0x01, 0x7d, 0x17, 0x32, 0x23, 0x87, 0xb8,
+	0xee, 0xd8, 0x11, 0x09, 0xf1, 0x75, 0x93, 0x03, 0xed, 0xd2, 0x73, 0xc8, 0x79, 0x65, 0xf0, 0x8c,
+	0x58, 0x14, 0xfd, 0x1f, 0x24, 0x78, 0xde, 0x48, 0x7c, 0x91, 0xf5, 0xa9, 0x77, 0xbd, 0xe8, 0xc0,
+	0x1c, 0x46, 0x05, 0x48, 0x1b, 0x2f, 0xcd, 0xe1, 0xd8, 0x8b, 0x08, 0x09, 0xfb, 0x43, 0xe6, 0x60,
+	0x63, 0x40, 0xc3, 0xde, 0x0f, 0xc6, 0xa5, 0x3f, 0x24, 0x41, 0x9e, 0xbd, 0xe8, 0xb7, 0xb8, 0xe1,
+	0x0f, 0x21, 0xe7, 0x98, 0x2e, 0xd1, 0x5d, 0x6a, 0xd0, 0xb1, 0xcb, 0x77, 0xc9, 0x1f, 0x6c, 0x4e,
+	0xd5, 0xf8, 0x49, 0x5a, 0x1c, 0xc4, 0xc0, 0x34, 0xc5, 0x37, 0xba, 0x0b, 0x09, 0x36, 0xe2, 0xf7,
+	0x9e, 0x3b, 0x58, 0x9b, 0x99, 0x80, 0x39, 0x88, 0x3e, 0x86, 0x3c, 0x75, 0x0c, 0xcb, 0x35, 0xa9,
+	0xbf, 0x7e, 0x72, 0xd9, 0xfa, 0xab, 0x9e, 0xb2, 0xb7, 0xc5, 0x7b, 0x90, 0xf6, 0x04, 0x3c, 0x60,
+	0x16, 0xec, 0xe2, 0xe3, 0xe8, 0x3e, 0x80, 0x4b, 0x82, 0x4d, 0xd2, 0xcb, 0x36, 0xc9, 0xba, 0xc4,
+	0xdf, 0xe0, 0x7f, 0x21, 0xee, 0x07, 0xd8, 0x82, 0xc5, 0x19, 0xc6, 0x12, 0x60, 0x68, 0xf4, 0xac,
+	0x70, 0x23, 0x9d, 0x0a, 0x58, 0xb9, 0x31, 0x07, 0x83, 0xf1, 0x90, 0x95, 0x1f, 0xd2, 0xd5, 0x83,
+	0x98, 0x14, 0x55, 0xf6, 0x4a, 0x08, 0x7b, 0xe8, 0x41, 0x2c, 0x81, 0x47, 0x7d, 0xc3, 0x25, 0xba,
+	0x61, 0xf5, 0x06, 0x7e, 0xc1, 0x05, 0x2e, 0x52, 0x98, 0x84, 0xb5, 0x01, 0x63, 0x34, 0x32, 0x1c,
+	0x62, 0x51, 0xbd, 0x6b, 0x1a, 0x43, 0x42, 0x89, 0xe3, 0x55, 0x5e, 0xd9, 0x07, 0x2a, 0x9e, 0x9c,
+	0xa5, 0x02, 0x61, 0xf9, 0x28, 0x2a, 0xd7, 0xaa, 0x58, 0x6c, 0x2a, 0x61, 0x11, 0xd8, 0x1d, 0xbb,
+	0x9f, 0x17, 0xf2, 0x97, 0x46, 0x20, 0x83, 0xb9, 0x9a, 0x71, 0x2e, 0x8a, 0xeb, 0x25, 0x6a, 0xc6,
+	0xb9, 0xc5, 0x9a, 0xce, 0x19, 0x8b, 0xb5, 0x01, 0xd1, 0xa9, 0x6d, 0x71, 0x06, 0x21, 0x73, 0x62,
+	0x92, 0xf7, 0xc4, 0x9a, 0x90, 0x96, 0xfe, 0x2d, 0xc1, 0x55, 0xb1, 0x80, 0xe1, 0x4c, 0xb8, 0x37,
+	0xdd, 0xcb, 0x6b, 0xd0, 0x2d, 0x00, 0x97, 0x1a, 0x0e, 0xd5, 0x79, 0xbd, 0x10, 0x35, 0x31, 0xcb,
+	0x25, 0x27, 0xac, 0x68, 0xdc, 0x81, 0x9c, 0x80, 0x45, 0xe9, 0x10, 0xf5, 0x51, 0xcc, 0x38, 0x66,
+	0x12, 0x74, 0x03, 0x84, 0xb6, 0xce, 0xaa, 0x88, 0xa0, 0x5a, 0x19, 0x2e, 0xa8, 0x18, 0x13, 0x74,
+	0x1d, 0x32, 0xc4, 0xea, 0xea, 0xa1, 0x52, 0x94, 0x26, 0x56, 0x97, 0x2f, 0x7c, 0x03, 0xb2, 0x0c,
+	0x0a, 0x57, 0x24, 0xa6, 0x2b, 0x16, 0xbd, 0x06, 0x4c, 0x4f, 0x9f, 0x16, 0xa6, 0x14, 0xb1, 0xba,
+	0x6c, 0xc1, 0x12, 0xa4, 0x4e, 0xed, 0xae, 0x49, 0xdc, 0x42, 0xa6, 0x18, 0x9f, 0xc9, 0x28, 0x0f,
+	0x29, 0xfd, 0x3c, 0x06, 0xf9, 0xe8, 0xf1, 0x17, 0x1c, 0x7b, 0x07, 0x12, 0x74, 0x32, 0x22, 0x5e,
+	0x62, 0x5e, 0x9d, 0x2e, 0xe3, 0xcf, 0xd1, 0x26, 0x23, 0x82, 0xb9, 0x4e, 0x50, 0x48, 0xe2, 0xcb,
+	0x0b, 0x89, 0x9f, 0xeb, 0x89, 0x4b, 0x72, 0xbd, 0x08, 0x49, 0x9b, 0xf6, 0x89, 0x53, 0x48, 0xce,
+	0x29, 0x08, 0x80, 0xc5, 0x93, 0x4b, 0x46, 0x86, 0x23, 0xe2, 0x49, 0x90, 0x9a, 0x90, 0xe4, 0x0d,
+	0x29, 0x4d, 0xe9, 0x1f, 0x12, 0x14, 0x8e, 0x4d, 0xcb, 0x76, 0x1e, 0xd8, 0xdd, 0xc9, 0xd7, 0x93,
+	0xec, 0x2d, 0xc8, 0x90, 0x01, 0x19, 0xb2, 0x70, 0xe1, 0x6e, 0xc9, 0xe2, 0x60, 0xfc, 0x8d, 0x13,
+	0x6c, 0xbf, 0xa1, 0xa7, 0x5f, 0x9f, 0xec, 0x66, 0x5e, 0x8b, 0xec, 0x7e, 0x95, 0x84, 0xf5, 0xb9,
+	0x33, 0x2f, 0x38, 0x2c, 0x27, 0x66, 0xae, 0xd9, 0xf3, 0x78, 0x8d, 0x38, 0x6f, 0x58, 0xc4, 0xcc,
+	0xb6, 0x0c, 0xef, 0xd6, 0xb3, 0x98, 0x7f, 0xb3, 0x23, 0x77, 0xec, 0x21, 0xa1, 0xfc, 0xc8, 0x19,
+	0x2c, 0x06, 0x68, 0x1b, 0x92, 0xb6, 0x73, 0x6a, 0x52, 0xef, 0x62, 0x11, 0x33, 0x31, 0xb0, 0xa1,
+	0xc1, 0x10, 0x2c, 0x14, 0x66, 0xf9, 0x43, 0xea, 0x0d, 0x58, 0x74, 0xfa, 0xad, 0x58, 0x74, 0xe6,
+	0x4d, 0x58, 0x74, 0xf6, 0xb5, 0x59, 0x34, 0xbc, 0x11, 0x8b, 0xce, 0xbd, 0x3e, 0x8b, 0x5e, 0x79,
+	0x1d, 0x16, 0xbd, 0xba, 0x9c, 0x45, 0xe7, 0x97, 0xb2, 0xe8, 0xb5, 0x59, 0x16, 0x1d, 0x2d, 0xe2,
+	0xf2, 0x5c, 0x11, 0x9f, 0x69, 0x19, 0xeb, 0x73, 0x2d, 0x23, 0xd2, 0xa4, 0xd0, 0x6c, 0x93, 0xba,
+	0x0b, 0xab, 0x7d, 0xc3, 0xd5, 0xa7, 0x1a, 0x57, 0x78, 0xe8, 0xac, 0xf4, 0x0d, 0xf7, 0x38, 0x50,
+	0x9a, 0x63, 0xc1, 0x1b, 0xcb, 0x58, 0xf0, 0xe6, 0x52, 0x16, 0xfc, 0x02, 0x36, 0x8e, 0x8c, 0x81,
+	0x39, 0x20, 0x86, 0x75, 0x6c, 0xdb, 0xd6, 0x92, 0xd2, 0xef, 0x27, 0x75, 0x6c, 0x51, 0x52, 0xc7,
+	0x17, 0x24, 0x75, 0x62, 0x3e, 0xa9, 0x93, 0xd3, 0xa4, 0x2e, 0x7d, 0x29, 0x45, 0xb7, 0x0e, 0xd2,
+	0xef, 0x1d, 0x48, 0x0c, 0x6d, 0xdb, 0x2a, 0x48, 0x53, 0xc3, 0xc3, 0x7a, 0x98, 0xa3, 0x68, 0x05,
+	0xa4, 0x17, 0x1e, 0x05, 0x93, 0x5e, 0xb0, 0xd1, 0xc4, 0x63, 0x5d, 0xd2, 0x84, 0x8d, 0x5e, 0x7a,
+	0x24, 0x57, 0x7a, 0xc9, 0xda, 0x8a, 0xdb, 0x37, 0xba, 0xf6, 0xb9, 0xfe, 0xc2, 0x33, 0x20, 0x2d,
+	0xc6, 0x3f, 0x08, 0x41, 0x93, 0x42, 0x2a, 0x0c, 0x9d, 0x84, 0xa0, 0x97, 0x85, 0x74, 0x18, 0xfa,
+	0x0c, 0xed, 0x42, 0x8a, 0xf0, 0x3e, 0xe9, 0xb5, 0x95, 0xcd, 0xb0, 0x89, 0xd3, 0x76, 0xe0, 0x29,
+	0x95, 0xfe, 0x22, 0xc1, 0x6a, 0xc4, 0xc7, 0x0b, 0x9c, 0xeb, 0x37, 0x8d, 0xd8, 0xf2, 0xa6, 0xb1,
+	0xc7, 0xfc, 0x6d, 0x5b, 0x8c, 0xfa, 0xc5, 0xb7, 0x73, 0x07, 0x85, 0x59, 0xdf, 0x04, 0x65, 0x5b,
+	0xa8, 0xf1, 0x04, 0x33, 0x1c, 0xda, 0x8f, 0xbc, 0xca, 0x12, 0x5e, 0x82, 0x31, 0x20, 0xfc, 0x1e,
+	0x7b, 0x17, 0xd6, 0xdc, 0xb1, 0x15, 0x51, 0x15, 0xee, 0xca, 0xbb, 0x63, 0x2b, 0xa4, 0x58, 0xfa,
+	0x4a, 0x82, 0xcd, 0xc8, 0x71, 0xff, 0x6b, 0x08, 0xc3, 0xff, 0xfb, 0xfe, 0x15, 0x17, 0x3b, 0x1f,
+	0x7b, 0x02, 0x2e, 0xfd, 0x2d, 0x74, 0xa5, 0x97, 0x71, 0x86, 0xf7, 0x22, 0x9c, 0xe1, 0x92, 0x18,
+	0xe1, 0x2a, 0x41, 0xc4, 0xc7, 0x97, 0x46, 0xfc, 0xbb, 0x90, 0xe4, 0x27, 0x2f, 0x24, 0x2e, 0x0b,
+	0x12, 0x81, 0xa3, 0xbb, 0x10, 0x27, 0x56, 0xb7, 0x90, 0xbc, 0x4c, 0x8d, 0xa1, 0xbc, 0x06, 0x8e,
+	0x23, 0xdc, 0x21, 0x18, 0xef, 0xfc, 0x42, 0x82, 0x94, 0x98, 0x82, 0xae, 0x02, 0x6a, 0xd6, 0x94,
+	0xba, 0xaa, 0xe9, 0xed, 0x7a, 0xab, 0xa9, 0x1e, 0x56, 0x1f, 0x56, 0xd5, 0x8a, 0xfc, 0x3f, 0x28,
+	0x07, 0xe9, 0x63, 0x15, 0x1f, 0xb6, 0xf1, 0x89, 0x2c, 0xa1, 0x2c, 0x24, 0x9f, 0xa8, 0xf5, 0x76,
+	0x4b, 0x8e, 0xa1, 0x0c, 0x24, 0x8e, 0x15, 0xdc, 0x92, 0xe3, 0x4c, 0xe3, 0x71, 0xbb, 0x59, 0xd5,
+	0x54, 0x2c, 0x27, 0x10, 0x40, 0xaa, 0xa5, 0x68, 0x6d, 0x5c, 0x97, 0x93, 0xec, 0xbb, 0x8d, 0x15,
+	0xa6, 0x9e, 0x62, 0x4a, 0x75, 0xb5, 0xa9, 0xb5, 0xeb, 0xaa, 0x9c, 0x66, 0xcb, 0x34, 0x6b, 0x6d,
+	0xad, 0x21, 0x67, 0xf8, 0x32, 0x8d, 0x46, 0x5d, 0xce, 0xee, 0x3c, 0x86, 0xb5, 0x99, 0x06, 0x8e,
+	0x8a, 0x70, 0xd3, 0xb3, 0x49, 0x6d, 0x3e, 0x52, 0x8f, 0x55, 0x5c, 0x6d, 0xcd, 0x58, 0xb7, 0x02,
+	0x19, 0xa5, 0xae, 0xd4, 0x4e, 0xb4, 0xea, 0xa1, 0x2c, 0xa1, 0x34, 0xc4, 0x1f, 0x37, 0x6b, 0x72,
+	0x6c, 0x67, 0x08, 0x2b, 0xe1, 0x12, 0x88, 0x6e, 0xc1, 0xf5, 0x66, 0xa3, 0x55, 0xd5, 0xaa, 0x8d,
+	0xba, 0xfe, 0x69, 0xb5, 0x5e, 0x99, 0x59, 0x45, 0x86, 0x95, 0x63, 0x55, 0xa9, 0xeb, 0x8d, 0x87,
+	0x7a, 0x45, 0xd1, 0x54, 0x59, 0x42, 0xab, 0x90, 0x3d, 0x52, 0x1b, 0xc7, 0xaa, 0x86, 0xab, 0x87,
+	0x72, 0x0c, 0xad, 0x41, 0x4e, 0x69, 0x69, 0xd8, 0x17, 0xc4, 0xf9, 0xbe, 0xcd, 0xa6, 0x82, 0xd5,
+	0xba, 0x26, 0x27, 0x76, 0xbe, 0x90, 0x60, 0x7d, 0xee, 0x4d, 0x83, 0xee, 0xc2, 0x1d, 0xdf, 0xfa,
+	0x27, 0x6a, 0x5d, 0xd3, 0x5b, 0x9a, 0xa2, 0xb5, 0x5b, 0xf3, 0x5b, 0x0b, 0xb4, 0x71, 0x78, 0xd8,
+	0xc6, 0x2d, 0x59, 0x42, 0x1b, 0x20, 0xd7, 0x1b, 0xde, 0x94, 0x46, 0x5d, 0x18, 0x14, 0x63, 0x06,
+	0x29, 0xb5, 0xa7, 0xca, 0x49, 0x4b, 0x6f, 0x37, 0xe5, 0x38, 0x37, 0x48, 0x0c, 0x2b, 0x8d, 0xa7,
+	0x75, 0x39, 0xb1, 0xf3, 0xab, 0x18, 0xa0, 0x79, 0xa6, 0x8a, 0xee, 0xc0, 0x0d, 0x61, 0x83, 0x82,
+	0x4f, 0xbc, 0x35, 0xa3, 0xfb, 0xaf, 0x41, 0xee, 0xb0, 0x51, 0x7f, 0xdc, 0xae, 0x1f, 0x32, 0xe7,
+	0x88, 0xed, 0xd9, 0x85, 0xe8, 0x61, 0x69, 0x0c, 0xe5, 0x01, 0x1a, 0x4d, 0xdf, 0x85, 0x72, 0x1c,
+	0x15, 0x60, 0xa3, 0xd5, 0x6e, 0xaa, 0xb8, 0xda, 0xc0, 0x11, 0xcd, 0x04, 0x43, 0xaa, 0xf5, 0x87,
+	0xf3, 0x48, 0x92, 0xd9, 0x72, 0x84, 0x55, 0x45, 0x53, 0x5b, 0x9a, 0xae, 0x2a, 0x2d, 0x4d, 0xc5,
+	0x75, 0x5d, 0xad, 0x35, 0xea, 0x47, 0x0a, 0x57, 0x48, 0x45, 0x14, 0x9e, 0xaa, 0x73, 0x0a, 0x69,
+	0x16, 0xa3, 0xcc, 0x89, 0xec, 0x16, 0xb1, 0xaa, 0xe1, 0xc6, 0x11, 0x56, 0x2a, 0xaa, 0x9c, 0x41,
+	0x08, 0xf2, 0xbe, 0xbc, 0x52, 0xc5, 0xea, 0xa1, 0x26, 0x67, 0x77, 0x74, 0xc8, 0x47, 0xc9, 0x16,
+	0x8b, 0xa6, 0xe3, 0x6a, 0xbd, 0x81, 0xf5, 0x07, 0x8d, 0xca, 0x89, 0xde, 0xc0, 0x0f, 0xaa, 0xda,
+	0x7c, 0x34, 0xa9, 0xb5, 0x5a, 0xb5, 0x29, 0xa2, 0x69, 0x15, 0xb2, 0x4d, 0x05, 0x2b, 0x0f, 0x1a,
+	0x35, 0x1e, 0x03, 0x79, 0x80, 0x47, 0x27, 0x4d, 0x15, 0x8b, 0x71, 0x7c, 0xe7, 0xc7, 0xb0, 0x12,
+	0xce, 0x5d, 0x16, 0x63, 0x47, 0x4a, 0xad, 0x5a, 0x63, 0x81, 0xc4, 0x3d, 0x18, 0x5d, 0x3b, 0x05,
+	0xb1, 0x6a, 0x43, 0x96, 0x58, 0x52, 0xa8, 0x6d, 0xdc, 0x68, 0x2a, 0x72, 0x8c, 0xed, 0x77, 0xa4,
+	0xd4, 0x4f, 0x8e, 0xd5, 0x8a, 0x2a, 0x62, 0xea, 0x50, 0xa9, 0xd5, 0xaa, 0x2d, 0xad, 0x21, 0x27,
+	0x76, 0x1c, 0x58, 0x9f, 0xab, 0x22, 0xe8, 0x36, 0x6c, 0x05, 0x7b, 0x2c, 0xba, 0xcd, 0x1c, 0xa4,
+	0x35, 0xac, 0xd4, 0x5b, 0x55, 0x4d, 0x96, 0xb8, 0x57, 0x1e, 0x29, 0x95, 0xc6, 0x53, 0xdd, 0x97,
+	0xf1, 0x40, 0x66, 0x81, 0x56, 0x13, 0xde, 0x12, 0xc9, 0xab, 0x1e, 0xd6, 0xaa, 0xcd, 0x96, 0x2a,
+	0x27, 0x0e, 0x7e, 0x99, 0xf6, 0x9f, 0x48, 0x6e, 0x8b, 0x38, 0x67, 0x66, 0x87, 0x20, 0x16, 0xda,
+	0x47, 0x84, 0xce, 0xfc, 0x50, 0x7c, 0x7d, 0x5a, 0x6b, 0x66, 0x1e, 0x10, 0x5b, 0x68, 0x1e, 0x2a,
+	0x7d, 0xfc, 0xb3, 0xbf, 0xfe, 0xfd, 0xb7, 0xb1, 0x0f, 0xd1, 0xfd, 0xb3, 0xfd, 0xb2, 0xf8, 0xeb,
+	0xc2, 0xc8, 0x83, 0xca, 0xaf, 0xd8, 0x1b, 0xe8, 0xa2, 0xfc, 0x8a, 0x95, 0xf6, 0x8b, 0xf2, 0x2b,
+	0x5e, 0xc6, 0x2f, 0xca, 0xaf, 0xba, 0x06, 0x13, 0x32, 0xfa, 0x70, 0x81, 0x7e, 0x2f, 0xc1, 0x95,
+	0xc0, 0x84, 0xd0, 0xcf, 0x2a, 0x37, 0xa6, 0x3b, 0xcd, 0xfd, 0xaa, 0xb6, 0xb5, 0xb1, 0x08, 0x2c,
+	0xd5, 0xb9, 0x21, 0x8f, 0xd0, 0xc3, 0xc0, 0x90, 0xb3, 0x00, 0x0c, 0x4c, 0x09, 0xf8, 0x2c, 0xfb,
+	0xf6, 0x48, 0xe9, 0x62, 0x0b, 0xd1, 0x9f, 0x25, 0x40, 0x81, 0x69, 0xc1, 0xab, 0x1a, 0x6d, 0xcd,
+	0xbf, 0x1b, 0xdd, 0x05, 0xfe, 0xf1, 0xb1, 0xd2, 0x29, 0x37, 0xeb, 0x47, 0xe8, 0xb3, 0xc0, 0x2c,
+	0xc3, 0x99, 0x08, 0x52, 0x51, 0x7e, 0x35, 0xed, 0xaa, 0x17, 0xfe, 0xc0, 0xb7, 0x21, 0x68, 0x98,
+	0x17, 0xe5, 0x57, 0x7e, 0x7f, 0xf4, 0x3e, 0x7d, 0x15, 0xaf, 0xfd, 0x5d, 0xdc, 0x93, 0xd0, 0xaf,
+	0x19, 0x0f, 0x23, 0x74, 0xfe, 0x19, 0x74, 0x33, 0xf2, 0x32, 0x99, 0xbd, 0xd0, 0xcd, 0x85, 0x68,
+	0xe9, 0x01, 0xb7, 0xf9, 0xe3, 0xd2, 0xbd, 0xb3, 0xfd, 0xf2, 0x90, 0xa1, 0xcc, 0x7b, 0xd3, 0x6b,
+	0xbd, 0xfc, 0x3e, 0x3f, 0x9a, 0x3e, 0x1f, 0xc7, 0x20, 0x1f, 0x11, 0x1a, 0xa5, 0x4c, 0x73, 0x54,
+	0x27, 0xf0, 0xdc, 0xfa, 0x1c, 0x52, 0xba, 0xcf, 0x8d, 0xd8, 0x43, 0xef, 0x9f, 0xed, 0x97, 0x7b,
+	0x1e, 0xc2, 0x1b, 0xf7, 0xd2, 0x80, 0xfa, 0x93, 0x88, 0xe9, 0x28, 0xb3, 0x11, 0x31, 0xbd, 0x90,
+	0xed, 0x6c, 0xad, 0xcf, 0x41, 0x25, 0x83, 0xef, 0xfc, 0x43, 0x74, 0x12, 0xda, 0xf9, 0x9b, 0xbe,
+	0xb1, 0x07, 0x5f, 0x49, 0xbf, 0x51, 0xfe, 0x25, 0xa1, 0xdf, 0x49, 0x7e, 0x6b, 0x74, 0x8b, 0xae,
+	0xc8, 0xcb, 0x83, 0xf8, 0xfe, 0xde, 0xbd, 0xd2, 0x4f, 0xb7, 0xae, 0xb9, 0x7d, 0xc3, 0x22, 0xdf,
+	0xe7, 0xff, 0xf6, 0xed, 0x73, 0x4e, 0xf5, 0xf6, 0x3a, 0xf6, 0x10, 0xca, 0x3d, 0x7b, 0xb7, 0xe7,
+	0x8c, 0x3a, 0xbb, 0x7d, 0x4a, 0x47, 0xbb, 0x0e, 0x71, 0xe9, 0xee, 0xd0, 0xec, 0x38, 0xb6, 0x37,
+	0x7f, 0x97, 0x8e, 0xa9, 0xed, 0x98, 0xc6, 0xa0, 0x38, 0x72, 0xec, 0xe7, 0xa4, 0x43, 0xd1, 0x3d,
+	0xa6, 0xe8, 0x7e, 0x54, 0x2e, 0xf7, 0x4c, 0xda, 0x1f, 0x9f, 0xb2, 0x45, 0xca, 0x91, 0x65, 0x67,
+	0x32, 0xd7, 0xdd, 0x91, 0xa4, 0x03, 0xf6, 0xdb, 0xd7, 0xc0, 0xec, 0x70, 0x32, 0x51, 0x7e, 0xee,
+	0xda, 0xd6, 0x47, 0x73, 0x12, 0xfc, 0x3d, 0x88, 0xdf, 0xbf, 0x77, 0x1f, 0xdd, 0x87, 0x1d, 0x4c,
+	0xe8, 0xd8, 0xb1, 0x48, 0xb7, 0x78, 0xde, 0x27, 0x56, 0x91, 0xf6, 0x49, 0xd1, 0x21, 0xae, 0x3d,
+	0x76, 0x3a, 0xa4, 0xd8, 0xb5, 0x89, 0x5b, 0xb4, 0x6c, 0x5a, 0x24, 0x2f, 0x4c, 0x97, 0xee, 0xa1,
+	0x14, 0x24, 0xfe, 0x18, 0x93, 0xd2, 0xa7, 0x29, 0xfe, 0xdb, 0xee, 0x07, 0xff, 0x19, 0x00, 0x6f,
+	0x9e, 0x51, 0xb1, 0x95, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// PlanetsServiceClient is the client API for PlanetsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type PlanetsServiceClient interface {
+	// Get the position of a planet
+	GetPlanetPosition(ctx context.Context, in *PlanetPositionRequest, opts ...grpc.CallOption) (*PlanetPosition, error)
+	// Get the rise, transit and set times and visibility of a planet
+	GetPlanetVisibility(ctx context.Context, in *PlanetVisibilityRequest, opts ...grpc.CallOption) (*PlanetVisibility, error)
+	// Search a date range for conjunctions, oppositions, elongations and
+	// stations, streamed in chronological order a month at a time as the
+	// search proceeds
+	GetPlanetaryEvents(ctx context.Context, in *PlanetaryEventsRequest, opts ...grpc.CallOption) (PlanetsService_GetPlanetaryEventsClient, error)
+	// Get the position and magnitude of a comet or asteroid from its
+	// orbital elements
+	GetMinorBodyPosition(ctx context.Context, in *MinorBodyPositionRequest, opts ...grpc.CallOption) (*MinorBodyPosition, error)
+	// Get the positions of the Galilean satellites relative to Jupiter
+	GetGalileanMoons(ctx context.Context, in *GalileanMoonsRequest, opts ...grpc.CallOption) (*GalileanMoons, error)
+	// Search a date range for transits, shadow transits, occultations and
+	// eclipses of the Galilean satellites, streamed in chronological order
+	GetGalileanEvents(ctx context.Context, in *GalileanEventsRequest, opts ...grpc.CallOption) (PlanetsService_GetGalileanEventsClient, error)
+}
+
+type planetsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewPlanetsServiceClient(cc *grpc.ClientConn) PlanetsServiceClient {
+	return &planetsServiceClient{cc}
+}
+
+func (c *planetsServiceClient) GetPlanetPosition(ctx context.Context, in *PlanetPositionRequest, opts ...grpc.CallOption) (*PlanetPosition, error) {
+	out := new(PlanetPosition)
+	err := c.cc.Invoke(ctx, "/v1.PlanetsService/GetPlanetPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planetsServiceClient) GetPlanetVisibility(ctx context.Context, in *PlanetVisibilityRequest, opts ...grpc.CallOption) (*PlanetVisibility, error) {
+	out := new(PlanetVisibility)
+	err := c.cc.Invoke(ctx, "/v1.PlanetsService/GetPlanetVisibility", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planetsServiceClient) GetPlanetaryEvents(ctx context.Context, in *PlanetaryEventsRequest, opts ...grpc.CallOption) (PlanetsService_GetPlanetaryEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PlanetsService_serviceDesc.Streams[0], "/v1.PlanetsService/GetPlanetaryEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &planetsServiceGetPlanetaryEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlanetsService_GetPlanetaryEventsClient interface {
+	Recv() (*PlanetaryEvent, error)
+	grpc.ClientStream
+}
+
+type planetsServiceGetPlanetaryEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *planetsServiceGetPlanetaryEventsClient) Recv() (*PlanetaryEvent, error) {
+	m := new(PlanetaryEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *planetsServiceClient) GetMinorBodyPosition(ctx context.Context, in *MinorBodyPositionRequest, opts ...grpc.CallOption) (*MinorBodyPosition, error) {
+	out := new(MinorBodyPosition)
+	err := c.cc.Invoke(ctx, "/v1.PlanetsService/GetMinorBodyPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planetsServiceClient) GetGalileanMoons(ctx context.Context, in *GalileanMoonsRequest, opts ...grpc.CallOption) (*GalileanMoons, error) {
+	out := new(GalileanMoons)
+	err := c.cc.Invoke(ctx, "/v1.PlanetsService/GetGalileanMoons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *planetsServiceClient) GetGalileanEvents(ctx context.Context, in *GalileanEventsRequest, opts ...grpc.CallOption) (PlanetsService_GetGalileanEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_PlanetsService_serviceDesc.Streams[1], "/v1.PlanetsService/GetGalileanEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &planetsServiceGetGalileanEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PlanetsService_GetGalileanEventsClient interface {
+	Recv() (*GalileanEvent, error)
+	grpc.ClientStream
+}
+
+type planetsServiceGetGalileanEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *planetsServiceGetGalileanEventsClient) Recv() (*GalileanEvent, error) {
+	m := new(GalileanEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// PlanetsServiceServer is the server API for PlanetsService service.
+type PlanetsServiceServer interface {
+	// Get the position of a planet
+	GetPlanetPosition(context.Context, *PlanetPositionRequest) (*PlanetPosition, error)
+	// Get the rise, transit and set times and visibility of a planet
+	GetPlanetVisibility(context.Context, *PlanetVisibilityRequest) (*PlanetVisibility, error)
+	// Search a date range for conjunctions, oppositions, elongations and
+	// stations, streamed in chronological order a month at a time as the
+	// search proceeds
+	GetPlanetaryEvents(*PlanetaryEventsRequest, PlanetsService_GetPlanetaryEventsServer) error
+	// Get the position and magnitude of a comet or asteroid from its
+	// orbital elements
+	GetMinorBodyPosition(context.Context, *MinorBodyPositionRequest) (*MinorBodyPosition, error)
+	// Get the positions of the Galilean satellites relative to Jupiter
+	GetGalileanMoons(context.Context, *GalileanMoonsRequest) (*GalileanMoons, error)
+	// Search a date range for transits, shadow transits, occultations and
+	// eclipses of the Galilean satellites, streamed in chronological order
+	GetGalileanEvents(*GalileanEventsRequest, PlanetsService_GetGalileanEventsServer) error
+}
+
+func RegisterPlanetsServiceServer(s *grpc.Server, srv PlanetsServiceServer) {
+	s.RegisterService(&_PlanetsService_serviceDesc, srv)
+}
+
+func _PlanetsService_GetPlanetPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanetPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanetsServiceServer).GetPlanetPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlanetsService/GetPlanetPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanetsServiceServer).GetPlanetPosition(ctx, req.(*PlanetPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlanetsService_GetPlanetVisibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PlanetVisibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanetsServiceServer).GetPlanetVisibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlanetsService/GetPlanetVisibility",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanetsServiceServer).GetPlanetVisibility(ctx, req.(*PlanetVisibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlanetsService_GetPlanetaryEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PlanetaryEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlanetsServiceServer).GetPlanetaryEvents(m, &planetsServiceGetPlanetaryEventsServer{stream})
+}
+
+type PlanetsService_GetPlanetaryEventsServer interface {
+	Send(*PlanetaryEvent) error
+	grpc.ServerStream
+}
+
+type planetsServiceGetPlanetaryEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *planetsServiceGetPlanetaryEventsServer) Send(m *PlanetaryEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PlanetsService_GetMinorBodyPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinorBodyPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanetsServiceServer).GetMinorBodyPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlanetsService/GetMinorBodyPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanetsServiceServer).GetMinorBodyPosition(ctx, req.(*MinorBodyPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlanetsService_GetGalileanMoons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GalileanMoonsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PlanetsServiceServer).GetGalileanMoons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.PlanetsService/GetGalileanMoons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PlanetsServiceServer).GetGalileanMoons(ctx, req.(*GalileanMoonsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PlanetsService_GetGalileanEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GalileanEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PlanetsServiceServer).GetGalileanEvents(m, &planetsServiceGetGalileanEventsServer{stream})
+}
+
+type PlanetsService_GetGalileanEventsServer interface {
+	Send(*GalileanEvent) error
+	grpc.ServerStream
+}
+
+type planetsServiceGetGalileanEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *planetsServiceGetGalileanEventsServer) Send(m *GalileanEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _PlanetsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.PlanetsService",
+	HandlerType: (*PlanetsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPlanetPosition",
+			Handler:    _PlanetsService_GetPlanetPosition_Handler,
+		},
+		{
+			MethodName: "GetPlanetVisibility",
+			Handler:    _PlanetsService_GetPlanetVisibility_Handler,
+		},
+		{
+			MethodName: "GetMinorBodyPosition",
+			Handler:    _PlanetsService_GetMinorBodyPosition_Handler,
+		},
+		{
+			MethodName: "GetGalileanMoons",
+			Handler:    _PlanetsService_GetGalileanMoons_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetPlanetaryEvents",
+			Handler:       _PlanetsService_GetPlanetaryEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetGalileanEvents",
+			Handler:       _PlanetsService_GetGalileanEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "planets.proto",
+}
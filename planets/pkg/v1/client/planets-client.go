@@ -0,0 +1,183 @@
+package planetsclient
+
+import (
+	"context"
+	"io"
+	"log"
+	"time"
+
+	"planetpositions/planets/grpc/v1"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc"
+)
+
+// PlanetsClient -
+type PlanetsClient struct {
+	Address string
+}
+
+func (p *PlanetsClient) newConnection() (v1.PlanetsServiceClient, *grpc.ClientConn) {
+
+	// Set up a connection to the server.
+	conn, err := grpc.Dial(p.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	return v1.NewPlanetsServiceClient(conn), conn
+}
+
+// GetPlanetPosition -
+func (p *PlanetsClient) GetPlanetPosition(body v1.Planet, year, month, day int32, hour float64, topocentric bool, long, lat, height float64, kind v1.PositionKind, ephemeris v1.PlanetEphemeris) (*v1.PlanetPosition, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.PlanetPositionRequest{
+		Api:         "v1",
+		Body:        body,
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		Hour:        hour,
+		Topocentric: topocentric,
+		Longitude:   long,
+		Latitude:    lat,
+		Height:      height,
+		Kind:        kind,
+		Ephemeris:   ephemeris,
+	}
+	return c.GetPlanetPosition(ctx, &req)
+}
+
+// GetPlanetVisibility -
+func (p *PlanetsClient) GetPlanetVisibility(body v1.Planet, long, lat float64, year, month, day int32, utcOffset float64, refraction string, temperature, pressure *wrappers.DoubleValue) (*v1.PlanetVisibility, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.PlanetVisibilityRequest{
+		Api:         "v1",
+		Body:        body,
+		Longitude:   long,
+		Latitude:    lat,
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		UtcOffset:   utcOffset,
+		Refraction:  refraction,
+		Temperature: temperature,
+		Pressure:    pressure,
+	}
+	return c.GetPlanetVisibility(ctx, &req)
+}
+
+// GetPlanetaryEvents -
+func (p *PlanetsClient) GetPlanetaryEvents(bodies []v1.Planet, startYear, startMonth, startDay, endYear, endMonth, endDay int32) ([]*v1.PlanetaryEvent, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.PlanetaryEventsRequest{
+		Api:        "v1",
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+		Bodies:     bodies,
+	}
+	stream, err := c.GetPlanetaryEvents(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	events := []*v1.PlanetaryEvent{}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+}
+
+// GetMinorBodyPosition -
+func (p *PlanetsClient) GetMinorBodyPosition(elements string, year, month, day int32, hour float64, kind v1.PositionKind, ephemeris v1.PlanetEphemeris) (*v1.MinorBodyPosition, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.MinorBodyPositionRequest{
+		Api:       "v1",
+		Elements:  elements,
+		Year:      year,
+		Month:     month,
+		Day:       day,
+		Hour:      hour,
+		Kind:      kind,
+		Ephemeris: ephemeris,
+	}
+	return c.GetMinorBodyPosition(ctx, &req)
+}
+
+// GetGalileanMoons -
+func (p *PlanetsClient) GetGalileanMoons(year, month, day int32, hour float64) (*v1.GalileanMoons, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.GalileanMoonsRequest{
+		Api:   "v1",
+		Year:  year,
+		Month: month,
+		Day:   day,
+		Hour:  hour,
+	}
+	return c.GetGalileanMoons(ctx, &req)
+}
+
+// GetGalileanEvents -
+func (p *PlanetsClient) GetGalileanEvents(moons []v1.GalileanMoon, startYear, startMonth, startDay, endYear, endMonth, endDay int32) ([]*v1.GalileanEvent, error) {
+	c, conn := p.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.GalileanEventsRequest{
+		Api:        "v1",
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+		Moons:      moons,
+	}
+	stream, err := c.GetGalileanEvents(ctx, &req)
+	if err != nil {
+		return nil, err
+	}
+	events := []*v1.GalileanEvent{}
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return events, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+}
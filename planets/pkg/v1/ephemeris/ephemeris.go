@@ -0,0 +1,107 @@
+package ephemeris
+
+import "math"
+
+// Heliocentric positions of the major planets and Pluto.
+
+// Body -
+type Body int
+
+const (
+	// Mercury -
+	Mercury Body = iota
+	// Venus -
+	Venus
+	// Earth -
+	Earth
+	// Mars -
+	Mars
+	// Jupiter -
+	Jupiter
+	// Saturn -
+	Saturn
+	// Uranus -
+	Uranus
+	// Neptune -
+	Neptune
+	// Pluto -
+	Pluto
+	// Sun - only the JPL ephemerides give the sun and moon
+	Sun
+	// Moon -
+	Moon
+)
+
+// Ephemeris is a source of heliocentric positions
+type Ephemeris interface {
+	// Heliocentric returns the heliocentric ecliptic longitude and latitude
+	// of the body in degrees, referred to the mean ecliptic and equinox of
+	// date, and its distance from the Sun in AU. jde is the Julian
+	// ephemeris day.
+	Heliocentric(body Body, jde float64) (longitude, latitude, distance float64, err error)
+	// Name describes the theory used
+	Name() string
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
+
+// julianCentury returns the Julian centuries since J2000.0
+func julianCentury(jde float64) float64 {
+	return (jde - 2451545.0) / 36525.0
+}
+
+// Kepler solves Kepler's equation for the eccentric anomaly in radians, m is
+// the mean anomaly in radians
+func Kepler(e, m float64) float64 {
+	ea := m
+	if e > 0.8 {
+		ea = math.Pi
+	}
+	for i := 0; i < 50; i++ {
+		delta := (ea - e*math.Sin(ea) - m) / (1 - e*math.Cos(ea))
+		ea -= delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+	return ea
+}
+
+// PrecessEcliptic returns ecliptic coordinates referred to the equinox of
+// J2000.0 referred to the equinox of date, Meeus, Astronomical Algorithms,
+// equation 21.5
+func PrecessEcliptic(longitude, latitude, jde float64) (float64, float64) {
+	t := julianCentury(jde)
+	eta := degreesToRadians(t * (47.0029 + t*(-0.03302+t*0.000060)) / 3600)
+	pi := 174.876384 + t*(-869.8089+t*0.03536)/3600
+	p := t * (5029.0966 + t*(1.11113-t*0.000006)) / 3600
+
+	l := degreesToRadians(pi - longitude)
+	b := degreesToRadians(latitude)
+	a := math.Cos(eta)*math.Cos(b)*math.Sin(l) - math.Sin(eta)*math.Sin(b)
+	bb := math.Cos(b) * math.Cos(l)
+	c := math.Cos(eta)*math.Sin(b) + math.Sin(eta)*math.Cos(b)*math.Sin(l)
+	return normalise(p + pi - radiansToDegrees(math.Atan2(a, bb))), radiansToDegrees(math.Asin(c))
+}
+
+// plutoOfDate returns the position of Pluto referred to the equinox of date
+func plutoOfDate(jde float64) (longitude, latitude, distance float64) {
+	longitude, latitude, distance = PlutoJ2000(jde)
+	longitude, latitude = PrecessEcliptic(longitude, latitude, jde)
+	return longitude, latitude, distance
+}
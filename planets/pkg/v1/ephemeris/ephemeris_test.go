@@ -0,0 +1,53 @@
+package ephemeris_test
+
+import (
+	"testing"
+
+	"planetpositions/planets/pkg/v1/ephemeris"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlutoJ2000(t *testing.T) {
+	// Meeus example 37.a
+	l, b, r := ephemeris.PlutoJ2000(2448908.5)
+	assert.InDelta(t, 232.74071, l, 0.00001, "PlutoJ2000 did not return the expected longitude")
+	assert.InDelta(t, 14.58782, b, 0.00001, "PlutoJ2000 did not return the expected latitude")
+	assert.InDelta(t, 29.711111, r, 0.000001, "PlutoJ2000 did not return the expected distance")
+}
+
+func TestTruncatedVSOP87(t *testing.T) {
+	testcases := map[string]struct {
+		body      ephemeris.Body
+		jde       float64
+		longitude float64
+		latitude  float64
+		distance  float64
+	}{
+		// Meeus example 32.a
+		"Venus 1992 December 20": {
+			body:      ephemeris.Venus,
+			jde:       2448976.5,
+			longitude: 26.11428,
+			latitude:  -2.62070,
+			distance:  0.724603,
+		},
+		// Meeus example 25.b
+		"Earth 1992 October 13": {
+			body:      ephemeris.Earth,
+			jde:       2448908.5,
+			longitude: 19.907372,
+			latitude:  -0.000179,
+			distance:  0.99760775,
+		},
+	}
+	v := ephemeris.NewTruncatedVSOP87()
+	for name, tc := range testcases {
+		l, b, r, err := v.Heliocentric(tc.body, tc.jde)
+		assert.NoError(t, err, "Test %s returned an error", name)
+		// An arcsecond
+		assert.InDelta(t, tc.longitude, l, 1.0/3600, "Test %s did not return the expected longitude", name)
+		assert.InDelta(t, tc.latitude, b, 1.0/3600, "Test %s did not return the expected latitude", name)
+		assert.InDelta(t, tc.distance, r, 0.000002, "Test %s did not return the expected distance", name)
+	}
+}
@@ -0,0 +1,308 @@
+package ephemeris
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// The JPL Development Ephemerides, such as DE440 and DE441, read from the
+// SPICE kernel (SPK) files distributed by the Navigation and Ancillary
+// Information Facility. The files are in the Double precision Array File
+// (DAF) format, each segment holds Chebyshev polynomials for the position of
+// a body relative to a centre over a span of time, in km in the ICRF.
+
+const (
+	// dafRecord is the length of a record of the file in bytes
+	dafRecord = 1024
+	// kilometresPerAU -
+	kilometresPerAU = 149597870.7
+	// secondsPerDay -
+	secondsPerDay = 86400.0
+	// solarSystemBarycentre is the NAIF id every chain of centres ends at
+	solarSystemBarycentre = 0
+)
+
+// naifIDs maps the bodies onto the NAIF ids of the segments holding them,
+// the planet itself where the file has it and otherwise the barycentre of
+// the planet and its satellites
+var naifIDs = map[Body][]int{
+	Mercury: {199, 1},
+	Venus:   {299, 2},
+	Earth:   {399},
+	Mars:    {499, 4},
+	Jupiter: {599, 5},
+	Saturn:  {699, 6},
+	Uranus:  {799, 7},
+	Neptune: {899, 8},
+	Pluto:   {999, 9},
+	Sun:     {10},
+	Moon:    {301},
+}
+
+// spkSegment is a segment of Chebyshev polynomials, type 2 holding the
+// position and type 3 the position and velocity
+type spkSegment struct {
+	target, centre int
+	// start and end of the segment in seconds past J2000.0 TDB
+	start, end float64
+	// First word of the data, from 0
+	address int64
+	// Start of the first record and the length of each in seconds, the
+	// number of words in a record and the number of records
+	init, interval float64
+	size, records  int
+	// Number of coefficients for each component
+	coefficients int
+	withVelocity bool
+	byteOrder    binary.ByteOrder
+	file         *os.File
+}
+
+// JPL is an Ephemeris that interpolates a JPL Development Ephemeris
+type JPL struct {
+	name     string
+	file     *os.File
+	segments map[int][]spkSegment
+}
+
+// NewJPL opens the SPK file at path and reads its segments, the file stays
+// open while the ephemeris is in use
+func NewJPL(path string) (*JPL, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("NewJPL encountered the following error when opening %s: %v", path, err)
+	}
+	j := &JPL{
+		name:     "JPL " + strings.TrimSuffix(filepath.Base(path), filepath.Ext(path)),
+		file:     f,
+		segments: map[int][]spkSegment{},
+	}
+	if err := j.readSummaries(); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("NewJPL could not read %s: %v", path, err)
+	}
+	return j, nil
+}
+
+// Name -
+func (j *JPL) Name() string {
+	return j.name
+}
+
+// Close releases the file
+func (j *JPL) Close() error {
+	return j.file.Close()
+}
+
+// Heliocentric -
+func (j *JPL) Heliocentric(body Body, jde float64) (longitude, latitude, distance float64, err error) {
+	et := (jde - precession.J2000) * secondsPerDay
+	p, err := j.barycentric(body, et)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	sun, err := j.barycentric(Sun, et)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	// From the equator of J2000.0 to the mean ecliptic and equinox of date
+	x, y, z := precession.PrecessionMatrix(precession.J2000, jde).Apply(p[0]-sun[0], p[1]-sun[1], p[2]-sun[2])
+	s, c := math.Sincos(degreesToRadians(precession.MeanObliquity(jde)))
+	y, z = y*c+z*s, -y*s+z*c
+
+	distance = math.Sqrt(x*x+y*y+z*z) / kilometresPerAU
+	longitude = normalise(radiansToDegrees(math.Atan2(y, x)))
+	latitude = radiansToDegrees(math.Atan2(z, math.Hypot(x, y)))
+	return longitude, latitude, distance, nil
+}
+
+// barycentric returns the position of the body relative to the barycentre
+// of the solar system in km, et is in seconds past J2000.0 TDB
+func (j *JPL) barycentric(body Body, et float64) ([3]float64, error) {
+	ids, ok := naifIDs[body]
+	if !ok {
+		return [3]float64{}, fmt.Errorf("JPL has no NAIF id for body %d", body)
+	}
+	for _, id := range ids {
+		if _, ok := j.segments[id]; ok {
+			return j.position(id, et)
+		}
+	}
+	return [3]float64{}, fmt.Errorf("%s has no segment for body %d", j.name, body)
+}
+
+// position follows the chain of centres from the NAIF id to the barycentre
+// of the solar system, summing the positions
+func (j *JPL) position(id int, et float64) ([3]float64, error) {
+	var p [3]float64
+	for id != solarSystemBarycentre {
+		segment, err := j.segment(id, et)
+		if err != nil {
+			return p, err
+		}
+		q, err := segment.position(et)
+		if err != nil {
+			return p, err
+		}
+		for i := range p {
+			p[i] += q[i]
+		}
+		id = segment.centre
+	}
+	return p, nil
+}
+
+// segment returns the segment for the NAIF id covering et
+func (j *JPL) segment(id int, et float64) (spkSegment, error) {
+	for _, s := range j.segments[id] {
+		if et >= s.start && et <= s.end {
+			return s, nil
+		}
+	}
+	return spkSegment{}, fmt.Errorf("%s does not cover NAIF id %d at %.1f seconds past J2000.0", j.name, id, et)
+}
+
+// position evaluates the Chebyshev polynomials of the record covering et
+func (s spkSegment) position(et float64) ([3]float64, error) {
+	var p [3]float64
+	index := int(math.Floor((et - s.init) / s.interval))
+	if index >= s.records {
+		// The end of the last record
+		index = s.records - 1
+	}
+	if index < 0 {
+		index = 0
+	}
+	record := make([]float64, s.size)
+	if err := readFloats(s.file, s.byteOrder, s.address+int64(index*s.size), record); err != nil {
+		return p, err
+	}
+
+	// The midpoint and radius of the record, then the coefficients of x, y
+	// and z
+	t := (et - record[0]) / record[1]
+	for i := range p {
+		p[i] = chebyshev(record[2+i*s.coefficients:2+(i+1)*s.coefficients], t)
+	}
+	return p, nil
+}
+
+// chebyshev sums the series of Chebyshev polynomials at t, in the range -1
+// to 1, by Clenshaw's recurrence
+func chebyshev(coefficients []float64, t float64) float64 {
+	var b1, b2 float64
+	for k := len(coefficients) - 1; k > 0; k-- {
+		b1, b2 = 2*t*b1-b2+coefficients[k], b1
+	}
+	return t*b1 - b2 + coefficients[0]
+}
+
+// readSummaries reads the file record and the summaries of the segments,
+// which are kept in a linked list of records
+func (j *JPL) readSummaries() error {
+	header := make([]byte, dafRecord)
+	if _, err := j.file.ReadAt(header, 0); err != nil {
+		return err
+	}
+	if id := string(header[0:8]); id != "DAF/SPK " {
+		return fmt.Errorf("the file is not an SPK file, its id word is %q", id)
+	}
+	var order binary.ByteOrder
+	switch format := string(header[88:96]); format {
+	case "LTL-IEEE":
+		order = binary.LittleEndian
+	case "BIG-IEEE":
+		order = binary.BigEndian
+	default:
+		return fmt.Errorf("unsupported binary format %q", format)
+	}
+	nd := int(order.Uint32(header[8:12]))
+	ni := int(order.Uint32(header[12:16]))
+	if nd != 2 || ni != 6 {
+		return fmt.Errorf("unexpected summary format ND=%d NI=%d", nd, ni)
+	}
+	// The size of a summary in double precision words
+	size := nd + (ni+1)/2
+
+	next := int64(order.Uint32(header[76:80]))
+	for next != 0 {
+		record := make([]float64, dafRecord/8)
+		if err := readFloats(j.file, order, (next-1)*dafRecord/8, record); err != nil {
+			return err
+		}
+		count := int(record[2])
+		for i := 0; i < count; i++ {
+			summary := record[3+i*size : 3+(i+1)*size]
+			if err := j.addSegment(order, summary); err != nil {
+				return err
+			}
+		}
+		next = int64(record[0])
+	}
+	if len(j.segments) == 0 {
+		return fmt.Errorf("the file has no segments")
+	}
+	return nil
+}
+
+// addSegment reads the directory at the end of the segment a summary
+// describes, the integer components of the summary are packed in pairs into
+// its last words
+func (j *JPL) addSegment(order binary.ByteOrder, summary []float64) error {
+	ints := make([]int, 6)
+	for i := range ints {
+		bits := make([]byte, 8)
+		order.PutUint64(bits, math.Float64bits(summary[2+i/2]))
+		ints[i] = int(int32(order.Uint32(bits[4*(i%2):])))
+	}
+	s := spkSegment{
+		target:    ints[0],
+		centre:    ints[1],
+		start:     summary[0],
+		end:       summary[1],
+		address:   int64(ints[4] - 1),
+		byteOrder: order,
+		file:      j.file,
+	}
+	kind := ints[3]
+	if kind != 2 && kind != 3 {
+		// Other types hold bodies this ephemeris does not use
+		return nil
+	}
+	directory := make([]float64, 4)
+	if err := readFloats(j.file, order, int64(ints[5]-4), directory); err != nil {
+		return err
+	}
+	s.init, s.interval = directory[0], directory[1]
+	s.size, s.records = int(directory[2]), int(directory[3])
+	s.withVelocity = kind == 3
+	components := 3
+	if s.withVelocity {
+		components = 6
+	}
+	s.coefficients = (s.size - 2) / components
+	if s.records <= 0 || s.interval <= 0 || s.coefficients <= 0 {
+		return fmt.Errorf("segment for NAIF id %d has a malformed directory", s.target)
+	}
+	j.segments[s.target] = append(j.segments[s.target], s)
+	return nil
+}
+
+// readFloats reads double precision words from the word address, from 0
+func readFloats(f *os.File, order binary.ByteOrder, address int64, words []float64) error {
+	b := make([]byte, 8*len(words))
+	if _, err := f.ReadAt(b, 8*address); err != nil {
+		return fmt.Errorf("could not read %d words at word %d: %v", len(words), address, err)
+	}
+	for i := range words {
+		words[i] = math.Float64frombits(order.Uint64(b[8*i:]))
+	}
+	return nil
+}
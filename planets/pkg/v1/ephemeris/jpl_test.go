@@ -0,0 +1,161 @@
+package ephemeris_test
+
+import (
+	"encoding/binary"
+	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/planets/pkg/v1/ephemeris"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const au = 149597870.7
+
+// linear is a segment whose coordinates change linearly with time, a + b et
+// in km with et in seconds past J2000.0
+type linear struct {
+	target, centre, kind int
+	a, b                 [3]float64
+}
+
+// writeSPK writes a little endian SPK file holding the segments, each of
+// two records of 10 days covering 20 days centred on J2000.0
+func writeSPK(t *testing.T, segments []linear) string {
+	const (
+		interval = 10 * 86400.0
+		records  = 2
+	)
+	words := []float64{}
+	summaries := []float64{}
+	// The data follow the file record, the summary record and the name
+	// record
+	address := 3*128 + 1
+	for _, s := range segments {
+		components := 3
+		if s.kind == 3 {
+			components = 6
+		}
+		size := 2 + 2*components
+		start := address
+		for r := 0; r < records; r++ {
+			mid := -interval + interval/2 + float64(r)*interval
+			radius := interval / 2
+			words = append(words, mid, radius)
+			for i := 0; i < 3; i++ {
+				words = append(words, s.a[i]+s.b[i]*mid, s.b[i]*radius)
+			}
+			if s.kind == 3 {
+				for i := 0; i < 3; i++ {
+					words = append(words, s.b[i], 0)
+				}
+			}
+		}
+		words = append(words, -interval, interval, float64(size), records)
+		address += records*size + 4
+
+		ints := []int32{int32(s.target), int32(s.centre), 1, int32(s.kind), int32(start), int32(address - 1)}
+		summaries = append(summaries, -interval, interval)
+		for i := 0; i < 6; i += 2 {
+			b := make([]byte, 8)
+			binary.LittleEndian.PutUint32(b, uint32(ints[i]))
+			binary.LittleEndian.PutUint32(b[4:], uint32(ints[i+1]))
+			summaries = append(summaries, math.Float64frombits(binary.LittleEndian.Uint64(b)))
+		}
+	}
+
+	file := make([]byte, 3*1024)
+	copy(file, "DAF/SPK ")
+	binary.LittleEndian.PutUint32(file[8:], 2)
+	binary.LittleEndian.PutUint32(file[12:], 6)
+	binary.LittleEndian.PutUint32(file[76:], 2)
+	binary.LittleEndian.PutUint32(file[80:], 2)
+	copy(file[88:], "LTL-IEEE")
+	record := append([]float64{0, 0, float64(len(segments))}, summaries...)
+	for i, w := range record {
+		binary.LittleEndian.PutUint64(file[1024+8*i:], math.Float64bits(w))
+	}
+	for _, w := range words {
+		b := make([]byte, 8)
+		binary.LittleEndian.PutUint64(b, math.Float64bits(w))
+		file = append(file, b...)
+	}
+
+	dir, err := ioutil.TempDir("", "spk")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "test.bsp")
+	if err := ioutil.WriteFile(path, file, 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestJPL(t *testing.T) {
+	path := writeSPK(t, []linear{
+		// The sun drifts along x at 0.01 km/s
+		{target: 10, centre: 0, kind: 2, b: [3]float64{0.01, 0, 0}},
+		// The Earth-Moon barycentre and the Earth
+		{target: 3, centre: 0, kind: 2, a: [3]float64{au, 0, 0}},
+		{target: 399, centre: 3, kind: 2, a: [3]float64{-4670, 0, 0}},
+		{target: 301, centre: 3, kind: 3, a: [3]float64{0, 380000, 0}},
+		// The barycentre of the Mars system on the equator, 1.5 AU away at
+		// right ascension 90 degrees
+		{target: 4, centre: 0, kind: 2, a: [3]float64{0, 1.5 * au, 0}},
+	})
+	defer os.RemoveAll(filepath.Dir(path))
+
+	j, err := ephemeris.NewJPL(path)
+	assert.NoError(t, err)
+	defer j.Close()
+	assert.Equal(t, "JPL test", j.Name())
+
+	// The Earth is reached through the Earth-Moon barycentre
+	l, b, r, err := j.Heliocentric(ephemeris.Earth, precession.J2000)
+	assert.NoError(t, err)
+	assert.InDelta(t, 0, l, 1e-9)
+	assert.InDelta(t, 0, b, 1e-9)
+	assert.InDelta(t, (au-4670)/au, r, 1e-12)
+
+	// Mars falls back to the barycentre of its system, a point on the
+	// equator has the obliquity for its ecliptic latitude
+	l, b, r, err = j.Heliocentric(ephemeris.Mars, precession.J2000)
+	assert.NoError(t, err)
+	assert.InDelta(t, 90, l, 1e-9)
+	assert.InDelta(t, -precession.MeanObliquity(precession.J2000), b, 1e-9)
+	assert.InDelta(t, 1.5, r, 1e-12)
+
+	// Positions are heliocentric, the sun has moved 4320 km five days after
+	// J2000.0, in the second record
+	_, _, r, err = j.Heliocentric(ephemeris.Earth, precession.J2000+5)
+	assert.NoError(t, err)
+	assert.InDelta(t, (au-4670-4320)/au, r, 1e-12)
+
+	// Type 3 segments hold velocities after the positions
+	_, _, r, err = j.Heliocentric(ephemeris.Moon, precession.J2000)
+	assert.NoError(t, err)
+	assert.InDelta(t, math.Hypot(au, 380000)/au, r, 1e-12)
+
+	// Outside the file and bodies it does not hold
+	_, _, _, err = j.Heliocentric(ephemeris.Earth, precession.J2000+30)
+	assert.Error(t, err)
+	_, _, _, err = j.Heliocentric(ephemeris.Venus, precession.J2000)
+	assert.Error(t, err)
+}
+
+func TestJPLNotSPK(t *testing.T) {
+	f, err := ioutil.TempFile("", "spk")
+	assert.NoError(t, err)
+	defer os.Remove(f.Name())
+	_, err = f.Write(make([]byte, 1024))
+	assert.NoError(t, err)
+	f.Close()
+
+	_, err = ephemeris.NewJPL(f.Name())
+	assert.Error(t, err)
+}
@@ -0,0 +1,3379 @@
+package ephemeris
+
+// The truncated VSOP87D series built into the service, from Jean Meeus,
+// Astronomical Algorithms, appendix III, which he gives as good to around an
+// arcsecond for the inner planets and a few for the outer ones between -2000
+// and +6000. The Earth keeps the longer truncation from Xu Jianwei's sxwnl.
+// Amplitudes are in radians and AU, frequencies in radians per Julian
+// millennium.
+
+// vsop87Truncated holds the longitude, latitude and radius series of each
+// planet by power of time
+var vsop87Truncated = map[Body]vsop87Series{
+	Mercury: {
+		// Longitude
+		{
+			{
+				{4.4025071, 0, 0},
+				{0.40989415, 1.48302034, 26087.90314157},
+				{0.05046294, 4.4778549, 52175.8062831},
+				{0.00855347, 1.165203, 78263.709425},
+				{0.0016559, 4.119692, 104351.612566},
+				{0.00034562, 0.77931, 130439.51571},
+				{0.00007583, 3.7135, 156527.4188},
+				{0.0000356, 1.512, 1109.3786},
+				{0.00001803, 4.1033, 5661.332},
+				{0.00001726, 0.3583, 182615.322},
+				{0.0000159, 2.9951, 25028.5212},
+				{0.00001365, 4.5992, 27197.2817},
+				{0.00001017, 0.8803, 31749.2352},
+				{0.00000714, 1.541, 24978.525},
+				{0.00000644, 5.303, 21535.95},
+				{0.00000451, 6.05, 51116.424},
+				{0.00000404, 3.282, 208703.225},
+				{0.00000352, 5.242, 20426.571},
+				{0.00000345, 2.792, 15874.618},
+				{0.00000343, 5.765, 955.6},
+				{0.00000339, 5.863, 25558.212},
+				{0.00000325, 1.337, 53285.185},
+				{0.00000273, 2.495, 529.691},
+				{0.00000264, 3.917, 57837.138},
+				{0.0000026, 0.987, 4551.953},
+				{0.00000239, 0.113, 1059.382},
+				{0.00000235, 0.267, 11322.664},
+				{0.00000217, 0.66, 13521.751},
+				{0.00000209, 2.092, 47623.853},
+				{0.00000183, 2.629, 27043.503},
+				{0.00000182, 2.434, 25661.305},
+				{0.00000176, 4.536, 51066.428},
+				{0.00000173, 2.452, 24498.83},
+				{0.00000142, 3.36, 37410.567},
+				{0.00000138, 0.291, 10213.286},
+				{0.00000125, 3.721, 39609.655},
+				{0.00000118, 2.781, 77204.327},
+				{0.00000106, 4.206, 19804.827},
+			},
+			{
+				{26088.14706223, 0, 0},
+				{0.01126008, 6.2170397, 26087.9031416},
+				{0.00303471, 3.055655, 52175.806283},
+				{0.00080538, 6.10455, 78263.70942},
+				{0.00021245, 2.83532, 104351.61257},
+				{0.00005592, 5.8268, 130439.5157},
+				{0.00001472, 2.5185, 156527.4188},
+				{0.00000388, 5.48, 182615.322},
+				{0.00000352, 3.052, 1109.379},
+				{0.00000103, 2.149, 208703.225},
+				{0.00000094, 6.12, 27197.28},
+				{0.00000091, 0, 24978.52},
+				{0.00000052, 5.62, 5661.33},
+				{0.00000044, 4.57, 25028.52},
+				{0.00000028, 3.04, 51066.43},
+				{0.00000027, 5.09, 234791.13},
+			},
+			{
+				{0.0005305, 0, 0},
+				{0.00016904, 4.69072, 26087.90314},
+				{0.00007397, 1.3474, 52175.8063},
+				{0.00003018, 4.4564, 78263.7094},
+				{0.00001107, 1.2623, 104351.6126},
+				{0.00000378, 4.32, 130439.516},
+				{0.00000123, 1.069, 156527.419},
+				{0.00000039, 4.08, 182615.32},
+				{0.00000015, 4.63, 1109.38},
+				{0.00000012, 0.79, 208703.23},
+			},
+			{
+				{0.00000188, 0.035, 52175.806},
+				{0.00000142, 3.125, 26087.903},
+				{0.00000097, 3, 78263.71},
+				{0.00000044, 6.02, 104351.61},
+				{0.00000035, 0, 0},
+				{0.00000018, 2.78, 130439.52},
+				{0.00000007, 5.82, 156527.42},
+				{0.00000003, 2.57, 182615.32},
+			},
+			{
+				{0.00000114, 3.1416, 0},
+				{0.00000002, 2.03, 26087.9},
+				{0.00000002, 1.42, 78263.71},
+				{0.00000002, 4.5, 52175.81},
+				{0.00000001, 4.5, 104351.61},
+				{0.00000001, 1.27, 130439.52},
+			},
+			{
+				{0.00000001, 3.14, 0},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.11737529, 1.98357499, 26087.90314157},
+				{0.02388077, 5.0373896, 52175.8062831},
+				{0.0122284, 3.1415927, 0},
+				{0.00543252, 1.796444, 78263.709425},
+				{0.00129779, 4.832325, 104351.612566},
+				{0.00031867, 1.58088, 130439.51571},
+				{0.00007963, 4.6097, 156527.4188},
+				{0.00002014, 1.3532, 182615.322},
+				{0.00000514, 4.378, 208703.225},
+				{0.00000209, 2.02, 24978.525},
+				{0.00000208, 4.918, 27197.282},
+				{0.00000132, 1.119, 234791.128},
+				{0.00000121, 1.813, 53285.185},
+				{0.000001, 5.657, 20426.571},
+			},
+			{
+				{0.00429151, 3.501698, 26087.903142},
+				{0.00146234, 3.141593, 0},
+				{0.00022675, 0.01515, 52175.80628},
+				{0.00010895, 0.4854, 78263.70942},
+				{0.00006353, 3.4294, 104351.6126},
+				{0.00002496, 0.1605, 130439.5157},
+				{0.0000086, 3.185, 156527.419},
+				{0.00000278, 6.21, 182615.322},
+				{0.00000086, 2.95, 208703.23},
+				{0.00000028, 0.29, 27197.28},
+				{0.00000026, 5.98, 234791.13},
+			},
+			{
+				{0.00011831, 4.79066, 26087.90314},
+				{0.00001914, 0, 0},
+				{0.00001045, 1.2122, 52175.8063},
+				{0.00000266, 4.434, 78263.709},
+				{0.0000017, 1.623, 104351.613},
+				{0.00000096, 4.8, 130439.52},
+				{0.00000045, 1.61, 156527.42},
+				{0.00000018, 4.67, 182615.32},
+				{0.00000007, 1.43, 208703.23},
+			},
+			{
+				{0.00000235, 0.354, 26087.903},
+				{0.00000161, 0, 0},
+				{0.00000019, 4.36, 52175.81},
+				{0.00000006, 2.51, 78263.71},
+				{0.00000005, 6.14, 104351.61},
+				{0.00000003, 3.12, 130439.52},
+				{0.00000002, 6.27, 156527.42},
+			},
+			{
+				{0.00000004, 1.75, 26087.9},
+				{0.00000001, 3.14, 0},
+			},
+		},
+		// Radius
+		{
+			{
+				{0.39528272, 0, 0},
+				{0.07834132, 6.1923372, 26087.9031416},
+				{0.00795526, 2.959897, 52175.806283},
+				{0.00121282, 6.010642, 78263.709425},
+				{0.00021922, 2.7782, 104351.61257},
+				{0.00004354, 5.8289, 130439.5157},
+				{0.00000918, 2.597, 156527.419},
+				{0.0000029, 1.424, 25028.521},
+				{0.0000026, 3.028, 27197.282},
+				{0.00000202, 5.647, 182615.322},
+				{0.00000201, 5.592, 31749.235},
+				{0.00000142, 6.253, 24978.525},
+				{0.000001, 3.734, 21535.95},
+			},
+			{
+				{0.00217348, 4.656172, 26087.903142},
+				{0.00044142, 1.42386, 52175.80628},
+				{0.00010094, 4.47466, 78263.70942},
+				{0.00002433, 1.2423, 104351.6126},
+				{0.00001624, 0, 0},
+				{0.00000604, 4.293, 130439.516},
+				{0.00000153, 1.061, 156527.419},
+				{0.00000039, 4.11, 182615.32},
+			},
+			{
+				{0.00003118, 3.0823, 26087.9031},
+				{0.00001245, 6.1518, 52175.8063},
+				{0.00000425, 2.926, 78263.709},
+				{0.00000136, 5.98, 104351.613},
+				{0.00000042, 2.75, 130439.52},
+				{0.00000022, 3.14, 0},
+				{0.00000013, 5.8, 156527.42},
+			},
+			{
+				{0.00000033, 1.68, 26087.9},
+				{0.00000024, 4.63, 52175.81},
+				{0.00000012, 1.39, 78263.71},
+				{0.00000005, 4.44, 104351.61},
+				{0.00000002, 1.21, 130439.52},
+			},
+		},
+	},
+	Venus: {
+		// Longitude
+		{
+			{
+				{3.17614667, 0, 0},
+				{0.01353968, 5.5931332, 10213.2855462},
+				{0.00089892, 5.3065, 20426.57109},
+				{0.00005477, 4.4163, 7860.4194},
+				{0.00003456, 2.6996, 11790.6291},
+				{0.00002372, 2.9938, 3930.2097},
+				{0.00001664, 4.2502, 1577.3435},
+				{0.00001438, 4.1575, 9683.5946},
+				{0.00001317, 5.1867, 26.2983},
+				{0.00001201, 6.1536, 30639.8566},
+				{0.00000769, 0.816, 9437.763},
+				{0.00000761, 1.95, 529.691},
+				{0.00000708, 1.065, 775.523},
+				{0.00000585, 3.998, 191.448},
+				{0.000005, 4.123, 15720.839},
+				{0.00000429, 3.586, 19367.189},
+				{0.00000327, 5.677, 5507.553},
+				{0.00000326, 4.591, 10404.734},
+				{0.00000232, 3.163, 9153.904},
+				{0.0000018, 4.653, 1109.379},
+				{0.00000155, 5.57, 19651.048},
+				{0.00000128, 4.226, 20.775},
+				{0.00000128, 0.962, 5661.332},
+				{0.00000106, 1.537, 801.821},
+			},
+			{
+				{10213.52943053, 0, 0},
+				{0.00095708, 2.46424, 10213.28555},
+				{0.00014445, 0.51625, 20426.57109},
+				{0.00000213, 1.795, 30639.857},
+				{0.00000174, 2.655, 26.298},
+				{0.00000152, 6.106, 1577.344},
+				{0.00000082, 5.7, 191.45},
+				{0.0000007, 2.68, 9437.76},
+				{0.00000052, 3.6, 775.52},
+				{0.00000038, 1.03, 529.69},
+				{0.0000003, 1.25, 5507.55},
+				{0.00000025, 6.11, 10404.73},
+			},
+			{
+				{0.00054127, 0, 0},
+				{0.00003891, 0.3451, 10213.2855},
+				{0.00001338, 2.0201, 20426.5711},
+				{0.00000024, 2.05, 26.3},
+				{0.00000019, 3.54, 30639.86},
+				{0.0000001, 3.97, 775.52},
+				{0.00000007, 1.52, 1577.34},
+				{0.00000006, 1, 191.45},
+			},
+			{
+				{0.00000136, 4.804, 10213.286},
+				{0.00000078, 3.67, 20426.57},
+				{0.00000026, 0, 0},
+			},
+			{
+				{0.00000114, 3.1416, 0},
+				{0.00000003, 5.21, 20426.57},
+				{0.00000002, 2.51, 10213.29},
+			},
+			{
+				{0.00000001, 3.14, 0},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.05923638, 0.2670278, 10213.2855462},
+				{0.00040108, 1.14737, 20426.57109},
+				{0.00032815, 3.14159, 0},
+				{0.00001011, 1.0895, 30639.8566},
+				{0.00000149, 6.254, 18073.705},
+				{0.00000138, 0.86, 1577.344},
+				{0.0000013, 3.672, 9437.763},
+				{0.0000012, 3.705, 2352.866},
+				{0.00000108, 4.539, 22003.915},
+			},
+			{
+				{0.00513348, 1.803643, 10213.285546},
+				{0.0000438, 3.3862, 20426.5711},
+				{0.00000199, 0, 0},
+				{0.00000197, 2.53, 30639.857},
+			},
+			{
+				{0.00022378, 3.38509, 10213.28555},
+				{0.00000282, 0, 0},
+				{0.00000173, 5.256, 20426.571},
+				{0.00000027, 3.87, 30639.86},
+			},
+			{
+				{0.00000647, 4.992, 10213.286},
+				{0.0000002, 3.14, 0},
+				{0.00000006, 0.77, 20426.57},
+				{0.00000003, 5.44, 30639.86},
+			},
+			{
+				{0.00000014, 0.32, 10213.29},
+			},
+		},
+		// Radius
+		{
+			{
+				{0.72334821, 0, 0},
+				{0.00489824, 4.021518, 10213.285546},
+				{0.00001658, 4.9021, 20426.5711},
+				{0.00001632, 2.8455, 7860.4194},
+				{0.00001378, 1.1285, 11790.6291},
+				{0.00000498, 2.587, 9683.595},
+				{0.00000374, 1.423, 3930.21},
+				{0.00000264, 5.529, 9437.763},
+				{0.00000237, 2.551, 15720.839},
+				{0.00000222, 2.013, 19367.189},
+				{0.00000126, 2.728, 1577.344},
+				{0.00000119, 3.02, 10404.734},
+			},
+			{
+				{0.00034551, 0.89199, 10213.28555},
+				{0.00000234, 1.772, 20426.571},
+				{0.00000234, 3.142, 0},
+			},
+			{
+				{0.00001407, 5.0637, 10213.2855},
+				{0.00000016, 5.47, 20426.57},
+				{0.00000013, 0, 0},
+			},
+			{
+				{0.0000005, 3.22, 10213.29},
+			},
+			{
+				{0.00000001, 0.92, 10213.29},
+			},
+		},
+	},
+	Earth: {
+		// Longitude
+		{
+			{
+				{1.7534704567, 0, 0},
+				{0.0334165646, 4.669256804, 6283.075849991},
+				{0.0003489428, 4.6261024, 12566.1517},
+				{0.0000349706, 2.744118, 5753.384885},
+				{0.0000341757, 2.828866, 3.523118},
+				{0.000031359, 3.62767, 77713.771468},
+				{0.0000267622, 4.418084, 7860.419392},
+				{0.0000234269, 6.135162, 3930.209696},
+				{0.0000132429, 0.742464, 11506.76977},
+				{0.0000127317, 2.037097, 529.690965},
+				{0.0000119917, 1.109629, 1577.343542},
+				{0.0000099025, 5.23268, 5884.92685},
+				{0.0000090186, 2.04505, 26.29832},
+				{0.0000085722, 3.50849, 398.149},
+				{0.0000077979, 1.17883, 5223.69392},
+				{0.0000075314, 2.53339, 5507.55324},
+				{0.0000050526, 4.58293, 18849.22755},
+				{0.0000049238, 4.20507, 775.52261},
+				{0.0000035666, 2.91954, 0.06731},
+				{0.0000031709, 5.84902, 11790.62909},
+				{0.0000028413, 1.89869, 796.29801},
+				{0.0000027104, 0.31489, 10977.0788},
+				{0.0000024281, 0.34481, 5486.77784},
+				{0.0000020616, 4.80647, 2544.31442},
+				{0.0000020539, 1.86948, 5573.1428},
+				{0.0000020226, 2.45768, 6069.77675},
+				{0.0000015552, 0.83306, 213.2991},
+				{0.0000013221, 3.41118, 2942.46342},
+				{0.0000012618, 1.08303, 20.7754},
+				{0.0000011513, 0.64545, 0.98032},
+				{0.0000010285, 0.636, 4694.00295},
+				{0.000001019, 0.97569, 15720.83878},
+				{0.0000010172, 4.2668, 7.11355},
+				{0.0000009921, 6.2099, 2146.1654},
+				{0.0000009761, 0.681, 155.4204},
+				{0.000000858, 5.9832, 161000.6857},
+				{0.0000008513, 1.2987, 6275.9623},
+				{0.0000008471, 3.6708, 71430.6956},
+				{0.0000007964, 1.8079, 17260.1547},
+				{0.0000007876, 3.037, 12036.4607},
+				{0.0000007465, 1.7551, 5088.6288},
+				{0.0000007387, 3.5032, 3154.6871},
+				{0.0000007355, 4.6793, 801.8209},
+				{0.0000006963, 0.833, 9437.7629},
+				{0.0000006245, 3.9776, 8827.3903},
+				{0.0000006115, 1.8184, 7084.8968},
+				{0.0000005696, 2.7843, 6286.599},
+				{0.0000005612, 4.3869, 14143.4952},
+				{0.0000005558, 3.4701, 6279.5527},
+				{0.0000005199, 0.1891, 12139.5535},
+				{0.0000005161, 1.3328, 1748.0164},
+				{0.0000005115, 0.2831, 5856.4777},
+				{0.00000049, 0.4874, 1194.447},
+				{0.0000004104, 5.3682, 8429.2413},
+				{0.0000004094, 2.3985, 19651.0485},
+				{0.000000392, 6.1683, 10447.3878},
+				{0.0000003677, 6.0413, 10213.2855},
+				{0.000000366, 2.5696, 1059.3819},
+				{0.0000003595, 1.7088, 2352.8662},
+				{0.0000003557, 1.776, 6812.7668},
+				{0.0000003329, 0.5931, 17789.8456},
+				{0.0000003041, 0.4429, 83996.8473},
+				{0.0000003005, 2.7398, 1349.8674},
+				{0.0000002535, 3.1647, 4690.4798},
+				{0.0000002474, 0.2148, 3.5904},
+				{0.0000002366, 0.4847, 8031.0923},
+				{0.0000002357, 2.0653, 3340.6124},
+				{0.0000002282, 5.222, 4705.7323},
+				{0.0000002189, 5.5559, 553.5694},
+				{0.0000002142, 1.4256, 16730.4637},
+				{0.0000002109, 4.1483, 951.7184},
+				{0.000000203, 0.3713, 283.8593},
+				{0.0000001992, 5.2221, 12168.0027},
+				{0.0000001986, 5.7747, 6309.3742},
+				{0.0000001912, 3.8222, 23581.2582},
+				{0.0000001889, 5.3863, 149854.4001},
+				{0.000000179, 2.2149, 13367.9726},
+				{0.0000001748, 4.5605, 135.0651},
+				{0.0000001622, 5.9884, 11769.8537},
+				{0.0000001508, 4.1957, 6256.7775},
+				{0.0000001442, 4.1932, 242.7286},
+				{0.0000001435, 3.7236, 38.0277},
+				{0.0000001397, 4.4014, 6681.2249},
+				{0.0000001362, 1.8893, 7632.9433},
+				{0.000000125, 1.1305, 5.5229},
+				{0.0000001205, 2.6223, 955.5997},
+				{0.00000012, 1.0035, 632.7837},
+				{0.0000001129, 0.1774, 4164.312},
+				{0.0000001083, 0.3273, 103.0928},
+				{0.0000001052, 0.9387, 11926.2544},
+				{0.000000105, 5.3591, 1592.596},
+				{0.0000001033, 6.1998, 6438.4962},
+				{0.0000001001, 6.0291, 5746.2713},
+				{0.000000098, 0.999, 11371.705},
+				{0.000000098, 5.244, 27511.468},
+				{0.0000000938, 2.624, 5760.498},
+				{0.0000000923, 0.483, 522.577},
+				{0.0000000922, 4.571, 4292.331},
+				{0.0000000905, 5.337, 6386.169},
+				{0.0000000862, 4.165, 7058.598},
+				{0.0000000841, 3.299, 7234.794},
+				{0.0000000836, 4.539, 25132.303},
+				{0.0000000813, 6.112, 4732.031},
+				{0.0000000812, 6.271, 426.598},
+				{0.0000000801, 5.821, 28.449},
+				{0.0000000787, 0.996, 5643.179},
+				{0.0000000776, 2.957, 23013.54},
+				{0.0000000769, 3.121, 7238.676},
+				{0.0000000758, 3.974, 11499.656},
+				{0.0000000735, 4.386, 316.392},
+				{0.0000000731, 0.607, 11513.883},
+				{0.0000000719, 3.998, 74.782},
+				{0.0000000706, 0.323, 263.084},
+				{0.0000000676, 5.911, 90955.552},
+				{0.0000000663, 3.665, 17298.182},
+				{0.0000000653, 5.791, 18073.705},
+				{0.000000063, 4.717, 6836.645},
+				{0.0000000615, 1.458, 233141.314},
+				{0.0000000612, 1.075, 19804.827},
+				{0.0000000596, 3.321, 6283.009},
+				{0.0000000596, 2.876, 6283.143},
+				{0.0000000555, 2.452, 12352.853},
+				{0.0000000541, 5.392, 419.485},
+				{0.0000000531, 0.382, 31441.678},
+				{0.0000000519, 4.065, 6208.294},
+				{0.0000000513, 2.361, 10973.556},
+				{0.0000000494, 5.737, 9917.697},
+				{0.000000045, 3.272, 11015.106},
+				{0.0000000449, 3.653, 206.186},
+				{0.0000000447, 2.064, 7079.374},
+				{0.0000000435, 4.423, 5216.58},
+				{0.0000000421, 1.906, 245.832},
+				{0.0000000413, 0.921, 3738.761},
+				{0.0000000402, 0.84, 20.355},
+				{0.0000000387, 1.826, 11856.219},
+				{0.0000000379, 2.344, 3.881},
+				{0.0000000374, 2.954, 3128.389},
+				{0.000000037, 5.031, 536.805},
+				{0.0000000365, 1.018, 16200.773},
+				{0.0000000365, 1.083, 88860.057},
+				{0.0000000352, 5.978, 3894.182},
+				{0.0000000352, 2.056, 244287.6},
+				{0.0000000351, 3.713, 6290.189},
+				{0.000000034, 1.106, 14712.317},
+				{0.0000000339, 0.978, 8635.942},
+				{0.0000000339, 3.202, 5120.601},
+				{0.0000000333, 0.837, 6496.375},
+				{0.0000000325, 3.479, 6133.513},
+				{0.0000000316, 5.089, 21228.392},
+				{0.0000000316, 1.328, 10873.986},
+				{0.0000000309, 3.646, 10.637},
+				{0.0000000303, 1.802, 35371.887},
+				{0.0000000296, 3.397, 9225.539},
+				{0.0000000288, 6.026, 154717.61},
+				{0.0000000281, 2.585, 14314.168},
+				{0.0000000262, 3.856, 266.607},
+				{0.0000000262, 2.579, 22483.849},
+				{0.0000000257, 1.561, 23543.231},
+				{0.0000000255, 3.949, 1990.745},
+				{0.0000000251, 3.744, 10575.407},
+				{0.000000024, 1.161, 10984.192},
+				{0.0000000238, 0.106, 7.046},
+				{0.0000000236, 4.272, 6040.347},
+				{0.0000000234, 3.577, 10969.965},
+				{0.0000000211, 3.714, 65147.62},
+				{0.000000021, 0.754, 13521.751},
+				{0.0000000207, 4.228, 5650.292},
+				{0.0000000202, 0.814, 170.673},
+				{0.0000000201, 4.629, 6037.244},
+				{0.00000002, 0.381, 6172.87},
+				{0.0000000199, 3.933, 6206.81},
+				{0.0000000199, 5.197, 6262.3},
+				{0.0000000197, 1.046, 18209.33},
+				{0.0000000195, 1.07, 5230.807},
+				{0.0000000195, 4.869, 36.028},
+				{0.0000000194, 4.313, 6244.943},
+				{0.0000000192, 1.229, 709.933},
+				{0.0000000192, 5.595, 6282.096},
+				{0.0000000192, 0.602, 6284.056},
+				{0.0000000189, 3.744, 23.878},
+				{0.0000000188, 1.904, 15.252},
+				{0.0000000188, 0.867, 22003.915},
+				{0.0000000182, 3.681, 15110.466},
+				{0.0000000181, 0.491, 1.484},
+				{0.0000000179, 3.222, 39302.097},
+				{0.0000000179, 1.259, 12559.038},
+			},
+			{
+				{6283.3196674749, 0, 0},
+				{0.0020605886, 2.67823456, 6283.07584999},
+				{0.0000430343, 2.635127, 12566.1517},
+				{0.0000042526, 1.59047, 3.52312},
+				{0.0000011926, 5.79557, 26.29832},
+				{0.0000010898, 2.96618, 1577.34354},
+				{0.0000009348, 2.5921, 18849.2275},
+				{0.0000007212, 1.1385, 529.691},
+				{0.0000006777, 1.8747, 398.149},
+				{0.0000006733, 4.4092, 5507.5532},
+				{0.0000005903, 2.888, 5223.6939},
+				{0.0000005598, 2.1747, 155.4204},
+				{0.0000004541, 0.398, 796.298},
+				{0.0000003637, 0.4662, 775.5226},
+				{0.0000002896, 2.6471, 7.1135},
+				{0.0000002084, 5.3414, 0.9803},
+				{0.000000191, 1.8463, 5486.7778},
+				{0.0000001851, 4.9686, 213.2991},
+				{0.0000001729, 2.9912, 6275.9623},
+				{0.0000001623, 0.0322, 2544.3144},
+				{0.0000001583, 1.4305, 2146.1654},
+				{0.0000001462, 1.2053, 10977.0788},
+				{0.0000001246, 2.8343, 1748.0164},
+				{0.0000001188, 3.258, 5088.6288},
+				{0.0000001181, 5.2738, 1194.447},
+				{0.0000001151, 2.075, 4694.003},
+				{0.0000001064, 0.7661, 553.5694},
+				{0.0000000997, 1.303, 6286.599},
+				{0.0000000972, 4.239, 1349.867},
+				{0.0000000945, 2.7, 242.729},
+				{0.0000000858, 5.645, 951.718},
+				{0.0000000758, 5.301, 2352.866},
+				{0.0000000639, 2.65, 9437.763},
+				{0.000000061, 4.666, 4690.48},
+				{0.0000000583, 1.766, 1059.382},
+				{0.0000000531, 0.909, 3154.687},
+				{0.0000000522, 5.661, 71430.696},
+				{0.000000052, 1.854, 801.821},
+				{0.0000000504, 1.425, 6438.496},
+				{0.0000000433, 0.241, 6812.767},
+				{0.0000000426, 0.774, 10447.388},
+				{0.0000000413, 5.24, 7084.897},
+				{0.0000000374, 2.001, 8031.092},
+				{0.0000000356, 2.429, 14143.495},
+				{0.000000035, 4.8, 6279.553},
+				{0.0000000337, 0.888, 12036.461},
+				{0.0000000337, 3.862, 1592.596},
+				{0.0000000325, 3.4, 7632.943},
+				{0.0000000322, 0.616, 8429.241},
+				{0.0000000318, 3.188, 4705.732},
+				{0.0000000297, 6.07, 4292.331},
+				{0.0000000295, 1.431, 5746.271},
+				{0.000000029, 2.325, 20.355},
+				{0.0000000275, 0.935, 5760.498},
+				{0.000000027, 4.804, 7234.794},
+				{0.0000000253, 6.223, 6836.645},
+				{0.0000000228, 5.003, 17789.846},
+				{0.0000000225, 5.672, 11499.656},
+				{0.0000000215, 5.202, 11513.883},
+				{0.0000000208, 3.955, 10213.286},
+				{0.0000000208, 2.268, 522.577},
+				{0.0000000206, 2.224, 5856.478},
+				{0.0000000206, 2.55, 25132.303},
+				{0.0000000203, 0.91, 6256.778},
+				{0.0000000189, 0.532, 3340.612},
+				{0.0000000188, 4.735, 83996.847},
+				{0.0000000179, 1.474, 4164.312},
+				{0.0000000178, 3.025, 5.523},
+				{0.0000000177, 3.026, 5753.385},
+				{0.0000000159, 4.637, 3.286},
+				{0.0000000157, 6.124, 5216.58},
+				{0.0000000155, 3.077, 6681.225},
+				{0.0000000154, 4.2, 13367.973},
+				{0.0000000143, 1.191, 3894.182},
+				{0.0000000138, 3.093, 135.065},
+				{0.0000000136, 4.245, 426.598},
+				{0.0000000134, 5.765, 6040.347},
+				{0.0000000128, 3.085, 5643.179},
+				{0.0000000127, 2.092, 6290.189},
+				{0.0000000125, 3.077, 11926.254},
+				{0.0000000125, 3.445, 536.805},
+				{0.0000000114, 3.244, 12168.003},
+				{0.0000000112, 2.318, 16730.464},
+				{0.0000000111, 3.901, 11506.77},
+				{0.0000000111, 5.32, 23.878},
+				{0.0000000105, 3.75, 7860.419},
+				{0.0000000103, 2.447, 1990.745},
+				{0.0000000096, 0.82, 3.88},
+				{0.0000000096, 4.08, 6127.66},
+				{0.0000000091, 5.42, 206.19},
+				{0.0000000091, 0.42, 7079.37},
+				{0.0000000088, 5.17, 11790.63},
+				{0.0000000081, 0.34, 9917.7},
+				{0.000000008, 3.89, 10973.56},
+				{0.0000000078, 2.4, 1589.07},
+				{0.0000000078, 2.58, 11371.7},
+				{0.0000000077, 3.98, 955.6},
+				{0.0000000077, 3.36, 36.03},
+				{0.0000000076, 1.3, 103.09},
+				{0.0000000075, 5.18, 10969.97},
+				{0.0000000075, 4.96, 6496.37},
+				{0.0000000073, 5.21, 38.03},
+				{0.0000000072, 2.65, 6309.37},
+				{0.000000007, 5.61, 3738.76},
+				{0.0000000069, 2.6, 3496.03},
+				{0.0000000069, 0.39, 15.25},
+				{0.0000000069, 2.78, 20.78},
+				{0.0000000065, 1.13, 7058.6},
+				{0.0000000064, 4.28, 28.45},
+				{0.0000000061, 5.63, 10984.19},
+				{0.000000006, 0.73, 419.48},
+				{0.000000006, 5.28, 10575.41},
+				{0.0000000058, 5.55, 17298.18},
+				{0.0000000058, 3.19, 4732.03},
+			},
+			{
+				{0.0005291887, 0, 0},
+				{0.0000871984, 1.072097, 6283.07585},
+				{0.0000030913, 0.86729, 12566.1517},
+				{0.0000002734, 0.053, 3.5231},
+				{0.0000001633, 5.1883, 26.2983},
+				{0.0000001575, 3.6846, 155.4204},
+				{0.0000000954, 0.757, 18849.228},
+				{0.0000000894, 2.057, 77713.771},
+				{0.0000000695, 0.827, 775.523},
+				{0.0000000506, 4.663, 1577.344},
+				{0.0000000406, 1.031, 7.114},
+				{0.0000000381, 3.441, 5573.143},
+				{0.0000000346, 5.141, 796.298},
+				{0.0000000317, 6.053, 5507.553},
+				{0.0000000302, 1.192, 242.729},
+				{0.0000000289, 6.117, 529.691},
+				{0.0000000271, 0.306, 398.149},
+				{0.0000000254, 2.28, 553.569},
+				{0.0000000237, 4.381, 5223.694},
+				{0.0000000208, 3.754, 0.98},
+				{0.0000000168, 0.902, 951.718},
+				{0.0000000153, 5.759, 1349.867},
+				{0.0000000145, 4.364, 1748.016},
+				{0.0000000134, 3.721, 1194.447},
+				{0.0000000125, 2.948, 6438.496},
+				{0.0000000122, 2.973, 2146.165},
+				{0.000000011, 1.271, 161000.686},
+				{0.0000000104, 0.604, 3154.687},
+				{0.00000001, 5.986, 6286.599},
+				{0.0000000092, 4.8, 5088.63},
+				{0.0000000089, 5.23, 7084.9},
+				{0.0000000083, 3.31, 213.3},
+				{0.0000000076, 3.42, 5486.78},
+				{0.0000000071, 6.19, 4690.48},
+				{0.0000000068, 3.43, 4694},
+				{0.0000000065, 1.6, 2544.31},
+				{0.0000000064, 1.98, 801.82},
+				{0.0000000061, 2.48, 10977.08},
+				{0.000000005, 1.44, 6836.65},
+				{0.0000000049, 2.34, 1592.6},
+				{0.0000000046, 1.31, 4292.33},
+				{0.0000000046, 3.81, 149854.4},
+				{0.0000000043, 0.04, 7234.79},
+				{0.000000004, 4.94, 7632.94},
+				{0.0000000039, 1.57, 71430.7},
+				{0.0000000038, 3.17, 6309.37},
+				{0.0000000035, 0.99, 6040.35},
+				{0.0000000035, 0.67, 1059.38},
+				{0.0000000031, 3.18, 2352.87},
+				{0.0000000031, 3.55, 8031.09},
+				{0.000000003, 1.92, 10447.39},
+				{0.000000003, 2.52, 6127.66},
+				{0.0000000028, 4.42, 9437.76},
+				{0.0000000028, 2.71, 3894.18},
+				{0.0000000027, 0.67, 25132.3},
+				{0.0000000026, 5.27, 6812.77},
+				{0.0000000025, 0.55, 6279.55},
+				{0.0000000023, 1.38, 4705.73},
+				{0.0000000022, 0.64, 6256.78},
+				{0.000000002, 6.07, 640.88},
+			},
+			{
+				{0.0000028923, 5.84384, 6283.07585},
+				{0.0000003496, 0, 0},
+				{0.0000001682, 5.4877, 12566.1517},
+				{0.0000000296, 5.196, 155.42},
+				{0.0000000129, 4.722, 3.523},
+				{0.0000000071, 5.3, 18849.23},
+				{0.0000000064, 5.97, 242.73},
+				{0.000000004, 3.79, 553.57},
+			},
+			{
+				{0.0000011408, 3.14159, 0},
+				{0.0000000772, 4.134, 6283.076},
+				{0.0000000077, 3.84, 12566.15},
+				{0.0000000042, 0.42, 155.42},
+			},
+			{
+				{0.0000000088, 3.14, 0},
+				{0.0000000017, 2.77, 6283.08},
+				{0.0000000005, 2.01, 155.42},
+				{0.0000000003, 2.21, 12566.15},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.0000027962, 3.1987, 84334.66158},
+				{0.0000010164, 5.42249, 5507.55324},
+				{0.0000008045, 3.8801, 5223.6939},
+				{0.0000004381, 3.7044, 2352.8662},
+				{0.0000003193, 4.0003, 1577.3435},
+				{0.0000002272, 3.9847, 1047.7473},
+				{0.0000001814, 4.9837, 6283.0758},
+				{0.0000001639, 3.5646, 5856.4777},
+				{0.0000001444, 3.7028, 9437.7629},
+				{0.000000143, 3.4112, 10213.2855},
+				{0.0000001125, 4.8282, 14143.4952},
+				{0.000000109, 2.0857, 6812.7668},
+				{0.0000001037, 4.0566, 71092.8814},
+				{0.0000000971, 3.473, 4694.003},
+				{0.0000000915, 1.142, 6620.89},
+				{0.0000000878, 4.44, 5753.385},
+				{0.0000000837, 4.993, 7084.897},
+				{0.000000077, 5.554, 167621.576},
+				{0.0000000719, 3.602, 529.691},
+				{0.0000000692, 4.326, 6275.962},
+				{0.0000000558, 4.41, 7860.419},
+				{0.0000000529, 2.484, 4705.732},
+				{0.0000000521, 6.25, 18073.705},
+			},
+			{
+				{0.0000000903, 3.897, 5507.553},
+				{0.0000000618, 1.73, 5223.694},
+				{0.000000038, 5.244, 2352.866},
+			},
+			{
+				{0.0000000166, 1.627, 84334.662},
+			},
+		},
+		// Radius
+		{
+			{
+				{1.000139888, 0, 0},
+				{0.0167069963, 3.098463508, 6283.075849991},
+				{0.0001395602, 3.0552461, 12566.1517},
+				{0.0000308372, 5.198467, 77713.771468},
+				{0.0000162846, 1.173877, 5753.384885},
+				{0.0000157557, 2.846852, 7860.419392},
+				{0.000009248, 5.45292, 11506.76977},
+				{0.0000054244, 4.56409, 3930.2097},
+				{0.0000047211, 3.661, 5884.92685},
+				{0.0000034598, 0.96369, 5507.55324},
+				{0.0000032878, 5.89984, 5223.69392},
+				{0.0000030678, 0.29867, 5573.1428},
+				{0.0000024319, 4.2735, 11790.62909},
+				{0.0000021183, 5.84715, 1577.34354},
+				{0.0000018575, 5.02194, 10977.0788},
+				{0.0000017484, 3.01194, 18849.22755},
+				{0.0000010984, 5.05511, 5486.77784},
+				{0.0000009832, 0.8868, 6069.7768},
+				{0.000000865, 5.6896, 15720.8388},
+				{0.0000008583, 1.2708, 161000.6857},
+				{0.000000649, 0.2725, 17260.1547},
+				{0.0000006292, 0.9218, 529.691},
+				{0.0000005706, 2.0137, 83996.8473},
+				{0.0000005574, 5.2416, 71430.6956},
+				{0.0000004938, 3.245, 2544.3144},
+				{0.0000004696, 2.5781, 775.5226},
+				{0.0000004466, 5.5372, 9437.7629},
+				{0.0000004252, 6.0111, 6275.9623},
+				{0.0000003897, 5.3607, 4694.003},
+				{0.0000003825, 2.3926, 8827.3903},
+				{0.0000003749, 0.8295, 19651.0485},
+				{0.0000003696, 4.9011, 12139.5535},
+				{0.0000003566, 1.6747, 12036.4607},
+				{0.0000003454, 1.8427, 2942.4634},
+				{0.0000003319, 0.2437, 7084.8968},
+				{0.0000003192, 0.1837, 5088.6288},
+				{0.0000003185, 1.7778, 398.149},
+				{0.0000002846, 1.2134, 6286.599},
+				{0.0000002779, 1.8993, 6279.5527},
+				{0.0000002628, 4.589, 10447.3878},
+				{0.000000246, 3.7866, 8429.2413},
+				{0.0000002393, 4.996, 5856.4777},
+				{0.0000002359, 0.2687, 796.298},
+				{0.0000002329, 2.8078, 14143.4952},
+				{0.000000221, 1.95, 3154.6871},
+				{0.0000002035, 4.6527, 2146.1654},
+				{0.0000001951, 5.3823, 2352.8662},
+				{0.0000001883, 0.6731, 149854.4001},
+				{0.0000001833, 2.2535, 23581.2582},
+				{0.0000001796, 0.1987, 6812.7668},
+				{0.0000001731, 6.152, 16730.4637},
+				{0.0000001717, 4.4332, 10213.2855},
+				{0.0000001619, 5.2316, 17789.8456},
+				{0.0000001381, 5.1896, 8031.0923},
+				{0.0000001364, 3.6852, 4705.7323},
+				{0.0000001314, 0.6529, 13367.9726},
+				{0.0000001041, 4.3329, 11769.8537},
+				{0.0000001017, 1.5939, 4690.4798},
+				{0.0000000998, 4.201, 6309.374},
+				{0.0000000966, 3.676, 27511.468},
+				{0.0000000874, 6.064, 1748.016},
+				{0.0000000779, 3.674, 12168.003},
+				{0.0000000771, 0.312, 7632.943},
+				{0.0000000756, 2.626, 6256.778},
+				{0.0000000746, 5.648, 11926.254},
+				{0.0000000693, 2.924, 6681.225},
+				{0.000000068, 1.423, 23013.54},
+				{0.0000000674, 0.563, 3340.612},
+				{0.0000000663, 5.661, 11371.705},
+				{0.0000000659, 3.136, 801.821},
+				{0.0000000648, 2.65, 19804.827},
+				{0.0000000615, 3.029, 233141.314},
+				{0.0000000612, 5.134, 1194.447},
+				{0.0000000563, 4.341, 90955.552},
+				{0.0000000552, 2.091, 17298.182},
+				{0.0000000534, 5.1, 31441.678},
+				{0.0000000531, 2.407, 11499.656},
+				{0.0000000523, 4.624, 6438.496},
+				{0.0000000513, 5.324, 11513.883},
+				{0.0000000477, 0.256, 11856.219},
+				{0.0000000461, 1.722, 7234.794},
+				{0.0000000458, 3.766, 6386.169},
+				{0.0000000458, 4.466, 5746.271},
+				{0.0000000423, 1.055, 5760.498},
+				{0.0000000422, 1.557, 7238.676},
+				{0.0000000415, 2.599, 7058.598},
+				{0.0000000401, 3.03, 1059.382},
+				{0.0000000397, 1.201, 1349.867},
+				{0.0000000379, 4.907, 4164.312},
+				{0.000000036, 5.707, 5643.179},
+				{0.0000000352, 3.626, 244287.6},
+				{0.0000000348, 0.761, 10973.556},
+				{0.0000000342, 3.001, 4292.331},
+				{0.0000000336, 4.546, 4732.031},
+				{0.0000000334, 3.138, 6836.645},
+				{0.0000000324, 4.164, 9917.697},
+				{0.0000000316, 1.691, 11015.106},
+				{0.0000000307, 0.238, 35371.887},
+				{0.0000000298, 1.306, 6283.143},
+				{0.0000000298, 1.75, 6283.009},
+				{0.0000000293, 5.738, 16200.773},
+				{0.0000000286, 5.928, 14712.317},
+				{0.0000000281, 3.515, 21228.392},
+				{0.000000028, 5.663, 8635.942},
+				{0.0000000277, 0.513, 26.298},
+				{0.0000000268, 4.207, 18073.705},
+				{0.0000000266, 0.9, 12352.853},
+				{0.000000026, 2.962, 25132.303},
+				{0.0000000255, 2.477, 6208.294},
+				{0.0000000242, 2.8, 709.933},
+				{0.0000000231, 1.054, 22483.849},
+				{0.0000000229, 1.07, 14314.168},
+				{0.0000000216, 1.314, 154717.61},
+				{0.0000000215, 6.038, 10873.986},
+				{0.00000002, 0.561, 7079.374},
+				{0.0000000198, 2.614, 951.718},
+				{0.0000000197, 4.369, 167283.762},
+				{0.0000000186, 2.861, 5216.58},
+				{0.0000000183, 1.66, 39302.097},
+				{0.0000000183, 5.912, 3738.761},
+				{0.0000000175, 2.145, 6290.189},
+				{0.0000000173, 2.168, 10575.407},
+				{0.0000000171, 3.702, 1592.596},
+				{0.0000000171, 1.343, 3128.389},
+				{0.0000000164, 5.55, 6496.375},
+				{0.0000000164, 5.856, 10984.192},
+				{0.0000000161, 1.998, 10969.965},
+				{0.0000000161, 1.909, 6133.513},
+				{0.0000000157, 4.955, 25158.602},
+				{0.0000000154, 6.216, 23543.231},
+				{0.0000000153, 5.357, 13521.751},
+				{0.000000015, 5.77, 18209.33},
+				{0.000000015, 5.439, 155.42},
+				{0.0000000139, 1.778, 9225.539},
+				{0.0000000139, 1.626, 5120.601},
+				{0.0000000128, 2.46, 13916.019},
+				{0.0000000123, 0.717, 143571.324},
+				{0.0000000122, 2.654, 88860.057},
+				{0.0000000121, 4.414, 3894.182},
+				{0.0000000121, 1.192, 3.523},
+				{0.000000012, 4.03, 553.569},
+				{0.0000000119, 1.513, 17654.781},
+				{0.0000000117, 3.117, 14945.316},
+				{0.0000000113, 2.698, 6040.347},
+				{0.000000011, 3.085, 43232.307},
+				{0.0000000109, 0.998, 955.6},
+				{0.0000000108, 2.939, 17256.632},
+				{0.0000000107, 5.285, 65147.62},
+				{0.0000000103, 0.139, 11712.955},
+				{0.0000000103, 5.85, 213.299},
+				{0.0000000102, 3.046, 6037.244},
+				{0.0000000101, 2.842, 8662.24},
+				{0.00000001, 3.626, 6262.3},
+				{0.0000000098, 2.36, 6206.81},
+				{0.0000000098, 5.11, 6172.87},
+				{0.0000000098, 2, 15110.47},
+				{0.0000000097, 2.67, 5650.29},
+				{0.0000000097, 2.75, 6244.94},
+				{0.0000000096, 4.02, 6282.1},
+				{0.0000000096, 5.31, 6284.06},
+				{0.0000000092, 0.1, 29088.81},
+				{0.0000000085, 3.26, 20426.57},
+				{0.0000000084, 2.6, 28766.92},
+				{0.0000000081, 3.58, 10177.26},
+				{0.000000008, 5.81, 5230.81},
+				{0.0000000078, 2.53, 16496.36},
+				{0.0000000077, 4.06, 6127.66},
+				{0.0000000073, 0.04, 5481.25},
+				{0.0000000072, 5.96, 12559.04},
+				{0.0000000072, 5.92, 4136.91},
+				{0.0000000071, 5.49, 22003.91},
+				{0.000000007, 3.41, 7.11},
+				{0.0000000069, 0.62, 11403.68},
+				{0.0000000069, 3.9, 1589.07},
+				{0.0000000069, 1.96, 12416.59},
+				{0.0000000069, 4.51, 426.6},
+				{0.0000000067, 1.61, 11087.29},
+				{0.0000000066, 4.5, 47162.52},
+				{0.0000000066, 5.08, 283.86},
+				{0.0000000066, 4.32, 16858.48},
+				{0.0000000065, 1.04, 6062.66},
+				{0.0000000064, 1.59, 18319.54},
+				{0.0000000063, 5.7, 45892.73},
+				{0.0000000063, 4.6, 66567.49},
+				{0.0000000063, 3.82, 13517.87},
+				{0.0000000062, 2.62, 11190.38},
+				{0.0000000061, 1.54, 33019.02},
+				{0.000000006, 5.58, 10344.3},
+				{0.000000006, 5.38, 316428.23},
+				{0.000000006, 5.78, 632.78},
+				{0.0000000059, 6.12, 9623.69},
+				{0.0000000057, 0.16, 17267.27},
+				{0.0000000057, 3.86, 6076.89},
+				{0.0000000057, 1.98, 7668.64},
+				{0.0000000056, 4.78, 20199.09},
+				{0.0000000055, 4.56, 18875.53},
+				{0.0000000055, 3.51, 17253.04},
+				{0.0000000054, 3.07, 226858.24},
+				{0.0000000054, 4.83, 18422.63},
+				{0.0000000053, 5.02, 12132.44},
+				{0.0000000052, 3.63, 5333.9},
+				{0.0000000052, 0.97, 155427.54},
+				{0.0000000051, 3.36, 20597.24},
+				{0.000000005, 0.99, 11609.86},
+				{0.000000005, 2.21, 1990.75},
+				{0.0000000048, 1.62, 12146.67},
+				{0.0000000048, 1.17, 12569.67},
+				{0.0000000047, 4.62, 5436.99},
+				{0.0000000047, 1.81, 12562.63},
+				{0.0000000047, 0.59, 21954.16},
+				{0.0000000047, 0.76, 7342.46},
+				{0.0000000046, 0.27, 4590.91},
+				{0.0000000046, 3.77, 156137.48},
+				{0.0000000045, 5.66, 10454.5},
+				{0.0000000044, 5.84, 3496.03},
+				{0.0000000043, 0.24, 17996.03},
+				{0.0000000041, 5.93, 51092.73},
+				{0.0000000041, 4.21, 12592.45},
+				{0.000000004, 5.14, 1551.05},
+				{0.000000004, 5.28, 15671.08},
+				{0.0000000039, 3.69, 18052.93},
+				{0.0000000039, 4.94, 24356.78},
+				{0.0000000038, 2.72, 11933.37},
+				{0.0000000038, 5.23, 7477.52},
+				{0.0000000038, 4.99, 9779.11},
+				{0.0000000037, 3.7, 9388.01},
+				{0.0000000037, 4.44, 4535.06},
+				{0.0000000036, 2.16, 28237.23},
+				{0.0000000036, 2.54, 242.73},
+				{0.0000000036, 0.22, 5429.88},
+				{0.0000000035, 6.15, 19800.95},
+				{0.0000000035, 2.92, 36949.23},
+				{0.0000000034, 5.63, 2379.16},
+				{0.0000000034, 5.73, 16460.33},
+				{0.0000000034, 5.11, 5849.36},
+				{0.0000000033, 6.19, 6268.85},
+			},
+			{
+				{0.0010301861, 1.1074897, 6283.07584999},
+				{0.0000172124, 1.064423, 12566.1517},
+				{0.0000070222, 3.14159, 0},
+				{0.0000003235, 1.0217, 18849.2275},
+				{0.000000308, 2.8435, 5507.5532},
+				{0.0000002497, 1.3191, 5223.6939},
+				{0.0000001849, 1.4243, 1577.3435},
+				{0.0000001008, 5.9138, 10977.0788},
+				{0.0000000865, 1.42, 6275.962},
+				{0.0000000863, 0.271, 5486.778},
+				{0.0000000507, 1.686, 5088.629},
+				{0.0000000499, 6.014, 6286.599},
+				{0.0000000467, 5.987, 529.691},
+				{0.000000044, 0.518, 4694.003},
+				{0.000000041, 1.084, 9437.763},
+				{0.0000000387, 4.75, 2544.314},
+				{0.0000000375, 5.071, 796.298},
+				{0.0000000352, 0.023, 83996.847},
+				{0.0000000344, 0.949, 71430.696},
+				{0.0000000341, 5.412, 775.523},
+				{0.0000000322, 6.156, 2146.165},
+				{0.0000000286, 5.484, 10447.388},
+				{0.0000000284, 3.42, 2352.866},
+				{0.0000000255, 6.132, 6438.496},
+				{0.0000000252, 0.243, 398.149},
+				{0.0000000243, 3.092, 4690.48},
+				{0.0000000225, 3.689, 7084.897},
+				{0.000000022, 4.952, 6812.767},
+				{0.0000000219, 0.42, 8031.092},
+				{0.0000000209, 1.282, 1748.016},
+				{0.0000000193, 5.314, 8429.241},
+				{0.0000000185, 1.82, 7632.943},
+				{0.0000000175, 3.229, 6279.553},
+				{0.0000000173, 1.537, 4705.732},
+				{0.0000000158, 4.097, 11499.656},
+				{0.0000000158, 5.539, 3154.687},
+				{0.000000015, 3.633, 11513.883},
+				{0.0000000148, 3.222, 7234.794},
+				{0.0000000147, 3.653, 1194.447},
+				{0.0000000144, 0.817, 14143.495},
+				{0.0000000135, 6.151, 5746.271},
+				{0.0000000134, 4.644, 6836.645},
+				{0.0000000128, 2.693, 1349.867},
+				{0.0000000123, 5.65, 5760.498},
+				{0.0000000118, 2.577, 13367.973},
+				{0.0000000113, 3.357, 17789.846},
+				{0.000000011, 4.497, 4292.331},
+				{0.0000000108, 5.828, 12036.461},
+				{0.0000000102, 5.621, 6256.778},
+				{0.0000000099, 1.14, 1059.38},
+				{0.0000000098, 0.66, 5856.48},
+				{0.0000000093, 2.32, 10213.29},
+				{0.0000000092, 0.77, 16730.46},
+				{0.0000000088, 1.5, 11926.25},
+				{0.0000000086, 1.42, 5753.38},
+				{0.0000000085, 0.66, 155.42},
+				{0.0000000081, 1.64, 6681.22},
+				{0.000000008, 4.11, 951.72},
+				{0.0000000066, 4.55, 5216.58},
+				{0.0000000065, 0.98, 25132.3},
+				{0.0000000064, 4.19, 6040.35},
+				{0.0000000064, 0.52, 6290.19},
+				{0.0000000063, 1.51, 5643.18},
+				{0.0000000059, 6.18, 4164.31},
+				{0.0000000057, 2.3, 10973.56},
+				{0.0000000055, 2.32, 11506.77},
+				{0.0000000055, 2.2, 1592.6},
+				{0.0000000055, 5.27, 3340.61},
+				{0.0000000054, 5.54, 553.57},
+				{0.0000000053, 5.04, 9917.7},
+				{0.0000000053, 0.92, 11371.7},
+				{0.0000000052, 3.98, 17298.18},
+				{0.0000000052, 3.6, 10969.97},
+				{0.0000000049, 5.91, 3894.18},
+				{0.0000000049, 2.51, 6127.66},
+				{0.0000000048, 1.67, 12168},
+				{0.0000000046, 0.31, 801.82},
+				{0.0000000042, 3.7, 10575.41},
+				{0.0000000042, 4.05, 10984.19},
+				{0.000000004, 2.17, 7860.42},
+				{0.000000004, 4.17, 26.3},
+				{0.0000000038, 5.82, 7058.6},
+				{0.0000000037, 3.39, 6496.37},
+				{0.0000000036, 1.08, 6309.37},
+				{0.0000000036, 5.34, 7079.37},
+				{0.0000000034, 3.62, 11790.63},
+				{0.0000000032, 0.32, 16200.77},
+				{0.0000000031, 4.24, 3738.76},
+				{0.0000000029, 4.55, 11856.22},
+				{0.0000000029, 1.26, 8635.94},
+				{0.0000000027, 3.45, 5884.93},
+				{0.0000000026, 5.08, 10177.26},
+				{0.0000000026, 5.38, 21228.39},
+				{0.0000000024, 2.26, 11712.96},
+				{0.0000000024, 1.05, 242.73},
+				{0.0000000024, 5.59, 6069.78},
+				{0.0000000023, 3.63, 6284.06},
+				{0.0000000023, 1.64, 4732.03},
+				{0.0000000022, 3.46, 213.3},
+				{0.0000000021, 1.05, 3496.03},
+				{0.0000000021, 3.92, 13916.02},
+				{0.0000000021, 4.01, 5230.81},
+				{0.000000002, 5.16, 12352.85},
+				{0.000000002, 0.69, 1990.75},
+				{0.0000000019, 2.73, 6062.66},
+				{0.0000000019, 5.01, 11015.11},
+				{0.0000000018, 6.04, 6283.01},
+				{0.0000000018, 2.85, 7238.68},
+				{0.0000000018, 5.6, 6283.14},
+				{0.0000000018, 5.16, 17253.04},
+				{0.0000000018, 2.54, 14314.17},
+				{0.0000000017, 1.58, 7.11},
+				{0.0000000017, 0.98, 3930.21},
+				{0.0000000017, 4.75, 17267.27},
+				{0.0000000016, 2.19, 6076.89},
+				{0.0000000016, 2.19, 18073.7},
+				{0.0000000016, 6.12, 3.52},
+				{0.0000000016, 4.61, 9623.69},
+				{0.0000000016, 3.4, 16496.36},
+				{0.0000000015, 0.19, 9779.11},
+				{0.0000000015, 5.3, 13517.87},
+				{0.0000000015, 4.26, 3128.39},
+				{0.0000000015, 0.81, 709.93},
+				{0.0000000014, 0.5, 25158.6},
+				{0.0000000014, 4.38, 4136.91},
+				{0.0000000013, 0.98, 65147.62},
+				{0.0000000013, 3.31, 154717.61},
+				{0.0000000013, 2.11, 1589.07},
+				{0.0000000013, 1.92, 22483.85},
+				{0.0000000012, 6.03, 9225.54},
+				{0.0000000012, 1.53, 12559.04},
+				{0.0000000012, 5.82, 6282.1},
+				{0.0000000012, 5.61, 5642.2},
+				{0.0000000012, 2.38, 167283.76},
+				{0.0000000012, 0.39, 12132.44},
+				{0.0000000012, 3.98, 4686.89},
+				{0.0000000012, 5.81, 12569.67},
+				{0.0000000012, 0.56, 5849.36},
+				{0.0000000011, 0.45, 6172.87},
+				{0.0000000011, 5.8, 16858.48},
+				{0.0000000011, 6.22, 12146.67},
+				{0.0000000011, 2.27, 5429.88},
+			},
+			{
+				{0.0000435939, 5.784551, 6283.07585},
+				{0.0000012363, 5.57935, 12566.1517},
+				{0.0000001234, 3.1416, 0},
+				{0.0000000879, 3.628, 77713.771},
+				{0.0000000569, 1.87, 5573.143},
+				{0.000000033, 5.47, 18849.228},
+				{0.0000000147, 4.48, 5507.553},
+				{0.000000011, 2.842, 161000.686},
+				{0.0000000101, 2.815, 5223.694},
+				{0.0000000085, 3.11, 1577.34},
+				{0.0000000065, 5.47, 775.52},
+				{0.0000000061, 1.38, 6438.5},
+				{0.000000005, 4.42, 6286.6},
+				{0.0000000047, 3.66, 7084.9},
+				{0.0000000046, 5.39, 149854.4},
+				{0.0000000042, 0.9, 10977.08},
+				{0.000000004, 3.2, 5088.63},
+				{0.0000000035, 1.81, 5486.78},
+				{0.0000000032, 5.35, 3154.69},
+				{0.000000003, 3.52, 796.3},
+				{0.0000000029, 4.62, 4690.48},
+				{0.0000000028, 1.84, 4694},
+				{0.0000000027, 3.14, 71430.7},
+				{0.0000000027, 6.17, 6836.65},
+				{0.0000000026, 1.42, 2146.17},
+				{0.0000000025, 2.81, 1748.02},
+				{0.0000000024, 2.18, 155.42},
+				{0.0000000023, 4.76, 7234.79},
+				{0.0000000021, 3.38, 7632.94},
+				{0.0000000021, 0.22, 4705.73},
+				{0.000000002, 4.22, 1349.87},
+				{0.000000002, 2.01, 1194.45},
+				{0.000000002, 4.58, 529.69},
+				{0.0000000019, 1.59, 6309.37},
+				{0.0000000018, 5.7, 6040.35},
+				{0.0000000018, 6.03, 4292.33},
+				{0.0000000017, 2.9, 9437.76},
+				{0.0000000017, 2, 8031.09},
+				{0.0000000017, 5.78, 83996.85},
+				{0.0000000016, 0.05, 2544.31},
+				{0.0000000015, 0.95, 6127.66},
+				{0.0000000014, 0.36, 10447.39},
+				{0.0000000014, 1.48, 2352.87},
+				{0.0000000013, 0.77, 553.57},
+				{0.0000000013, 5.48, 951.72},
+				{0.0000000013, 5.27, 6279.55},
+				{0.0000000013, 3.76, 6812.77},
+				{0.0000000011, 5.41, 6256.78},
+				{0.000000001, 0.68, 1592.6},
+				{0.000000001, 4.95, 398.15},
+				{0.000000001, 1.15, 3894.18},
+				{0.000000001, 5.2, 244287.6},
+				{0.000000001, 1.94, 11856.22},
+				{0.0000000009, 5.39, 25132.3},
+				{0.0000000008, 6.18, 1059.38},
+				{0.0000000008, 0.69, 8429.24},
+				{0.0000000008, 5.85, 242.73},
+				{0.0000000007, 5.26, 14143.5},
+				{0.0000000007, 0.52, 801.82},
+				{0.0000000006, 2.24, 8635.94},
+				{0.0000000006, 4, 13367.97},
+				{0.0000000006, 2.77, 90955.55},
+				{0.0000000006, 5.17, 7058.6},
+				{0.0000000005, 1.46, 233141.31},
+				{0.0000000005, 4.13, 7860.42},
+				{0.0000000005, 3.91, 26.3},
+				{0.0000000005, 3.89, 12036.46},
+				{0.0000000005, 5.58, 6290.19},
+				{0.0000000005, 5.54, 1990.75},
+				{0.0000000005, 0.83, 11506.77},
+				{0.0000000005, 6.22, 6681.22},
+				{0.0000000004, 5.26, 10575.41},
+				{0.0000000004, 1.91, 7477.52},
+				{0.0000000004, 0.43, 10213.29},
+				{0.0000000004, 1.09, 709.93},
+				{0.0000000004, 5.09, 11015.11},
+				{0.0000000004, 4.22, 88860.06},
+				{0.0000000004, 3.57, 7079.37},
+				{0.0000000004, 1.98, 6284.06},
+				{0.0000000004, 3.93, 10973.56},
+				{0.0000000004, 6.18, 9917.7},
+				{0.0000000004, 0.36, 10177.26},
+				{0.0000000004, 2.75, 3738.76},
+				{0.0000000004, 3.33, 5643.18},
+				{0.0000000004, 5.36, 25158.6},
+			},
+			{
+				{0.0000014459, 4.27319, 6283.07585},
+				{0.0000000673, 3.917, 12566.152},
+				{0.0000000077, 0, 0},
+				{0.0000000025, 3.73, 18849.23},
+				{0.0000000004, 2.8, 6286.6},
+			},
+			{
+				{0.0000000386, 2.564, 6283.076},
+				{0.0000000031, 2.27, 12566.15},
+				{0.0000000005, 3.44, 5573.14},
+				{0.0000000002, 2.05, 18849.23},
+				{0.0000000001, 2.06, 77713.77},
+				{0.0000000001, 4.41, 161000.69},
+				{0.0000000001, 3.82, 149854.4},
+				{0.0000000001, 4.08, 6127.66},
+				{0.0000000001, 5.26, 6438.5},
+			},
+			{
+				{0.0000000009, 1.22, 6283.08},
+				{0.0000000001, 0.66, 12566.15},
+			},
+		},
+	},
+	Mars: {
+		// Longitude
+		{
+			{
+				{6.20347712, 0, 0},
+				{0.18656368, 5.050371, 3340.6124267},
+				{0.01108217, 5.4009984, 6681.2248534},
+				{0.00091798, 5.75479, 10021.83728},
+				{0.00027745, 5.9705, 3.52312},
+				{0.00012316, 0.84956, 2810.92146},
+				{0.0001061, 2.93959, 2281.2305},
+				{0.00008927, 4.157, 0.0173},
+				{0.00008716, 6.1101, 13362.4497},
+				{0.00007775, 3.3397, 5621.8429},
+				{0.00006798, 0.3646, 398.149},
+				{0.00004161, 0.2281, 2942.4634},
+				{0.00003575, 1.6619, 2544.3144},
+				{0.00003075, 0.857, 191.4483},
+				{0.00002938, 6.0789, 0.0673},
+				{0.00002628, 0.6481, 3337.0893},
+				{0.0000258, 0.03, 3344.1355},
+				{0.00002389, 5.039, 796.298},
+				{0.00001799, 0.6563, 529.691},
+				{0.00001546, 2.9158, 1751.5395},
+				{0.00001528, 1.1498, 6151.5339},
+				{0.00001286, 3.068, 2146.1654},
+				{0.00001264, 3.6228, 5092.152},
+				{0.00001025, 3.6933, 8962.4553},
+				{0.00000892, 0.183, 16703.062},
+				{0.00000859, 2.401, 2914.014},
+				{0.00000833, 4.495, 3340.63},
+				{0.00000833, 2.464, 3340.595},
+				{0.00000749, 3.822, 155.42},
+				{0.00000724, 0.675, 3738.761},
+				{0.00000713, 3.663, 1059.382},
+				{0.00000655, 0.489, 3127.313},
+				{0.00000636, 2.922, 8432.764},
+				{0.00000553, 4.475, 1748.016},
+				{0.0000055, 3.81, 0.98},
+				{0.00000472, 3.625, 1194.447},
+				{0.00000426, 0.554, 6283.076},
+				{0.00000415, 0.497, 213.299},
+				{0.00000312, 0.999, 6677.702},
+				{0.00000307, 0.381, 6684.748},
+				{0.00000302, 4.486, 3532.061},
+				{0.00000299, 2.783, 6254.627},
+				{0.00000293, 4.221, 20.775},
+				{0.00000284, 5.769, 3149.164},
+				{0.00000281, 5.882, 1349.867},
+				{0.00000274, 0.542, 3340.545},
+				{0.00000274, 0.134, 3340.68},
+				{0.00000239, 5.372, 4136.91},
+				{0.00000236, 5.755, 3333.499},
+				{0.00000231, 1.282, 3870.303},
+				{0.00000221, 3.505, 382.897},
+				{0.00000204, 2.821, 1221.849},
+				{0.00000193, 3.357, 3.59},
+				{0.00000189, 1.491, 9492.146},
+				{0.00000179, 1.006, 951.718},
+				{0.00000174, 2.414, 553.569},
+				{0.00000172, 0.439, 5486.778},
+				{0.0000016, 3.949, 4562.461},
+				{0.00000144, 1.419, 135.065},
+				{0.0000014, 3.326, 2700.715},
+				{0.00000138, 4.301, 7.114},
+				{0.00000131, 4.045, 12303.068},
+				{0.00000128, 2.208, 1592.596},
+				{0.00000128, 1.807, 5088.629},
+				{0.00000117, 3.128, 7903.073},
+				{0.00000113, 3.701, 1589.073},
+				{0.0000011, 1.052, 242.729},
+				{0.00000105, 0.785, 8827.39},
+				{0.000001, 3.243, 11773.377},
+			},
+			{
+				{3340.85627474, 0, 0},
+				{0.01458227, 3.6042605, 3340.6124267},
+				{0.00164901, 3.926313, 6681.224853},
+				{0.00019963, 4.26594, 10021.83728},
+				{0.00003452, 4.7321, 3.5231},
+				{0.00002485, 4.6128, 13362.4497},
+				{0.00000842, 4.459, 2281.23},
+				{0.00000538, 5.016, 398.149},
+				{0.00000521, 4.994, 3344.136},
+				{0.00000433, 2.561, 191.448},
+				{0.0000043, 5.316, 155.42},
+				{0.00000382, 3.539, 796.298},
+				{0.00000314, 4.963, 16703.062},
+				{0.00000283, 3.16, 2544.314},
+				{0.00000206, 4.569, 2146.165},
+				{0.00000169, 1.329, 3337.089},
+				{0.00000158, 4.185, 1751.54},
+				{0.00000134, 2.233, 0.98},
+				{0.00000134, 5.974, 1748.016},
+				{0.00000118, 6.024, 6151.534},
+				{0.00000117, 2.213, 1059.382},
+				{0.00000114, 2.129, 1194.447},
+				{0.00000114, 5.428, 3738.761},
+				{0.00000091, 1.1, 1349.87},
+				{0.00000085, 3.91, 553.57},
+				{0.00000083, 5.3, 6684.75},
+				{0.00000081, 4.43, 529.69},
+				{0.0000008, 2.25, 8962.46},
+				{0.00000073, 2.5, 951.72},
+				{0.00000073, 5.84, 242.73},
+				{0.00000071, 3.86, 2914.01},
+				{0.00000068, 5.02, 382.9},
+				{0.00000065, 1.02, 3340.6},
+				{0.00000065, 3.05, 3340.63},
+				{0.00000062, 4.15, 3149.16},
+				{0.00000057, 3.89, 4136.91},
+				{0.00000048, 4.87, 213.3},
+				{0.00000048, 1.18, 3333.5},
+				{0.00000047, 1.31, 3185.19},
+				{0.00000041, 0.71, 1592.6},
+				{0.0000004, 2.73, 7.11},
+				{0.0000004, 5.32, 20043.67},
+				{0.00000033, 5.41, 6283.08},
+				{0.00000028, 0.05, 9492.15},
+				{0.00000027, 3.89, 1221.85},
+				{0.00000027, 5.11, 2700.72},
+			},
+			{
+				{0.00058016, 2.04979, 3340.61243},
+				{0.00054188, 0, 0},
+				{0.00013908, 2.45742, 6681.22485},
+				{0.00002465, 2.8, 10021.8373},
+				{0.00000398, 3.141, 13362.45},
+				{0.00000222, 3.194, 3.523},
+				{0.00000121, 0.543, 155.42},
+				{0.00000062, 3.49, 16703.06},
+				{0.00000054, 3.54, 3344.14},
+				{0.00000034, 6, 2281.23},
+				{0.00000032, 4.14, 191.45},
+				{0.0000003, 2, 796.3},
+				{0.00000023, 4.33, 242.73},
+				{0.00000022, 3.45, 398.15},
+				{0.0000002, 5.42, 553.57},
+				{0.00000016, 0.66, 0.98},
+				{0.00000016, 6.11, 2146.17},
+				{0.00000016, 1.22, 1748.02},
+				{0.00000015, 6.1, 3185.19},
+				{0.00000014, 4.02, 951.72},
+				{0.00000014, 2.62, 1349.87},
+				{0.00000013, 0.6, 1194.45},
+				{0.00000012, 3.86, 6684.75},
+				{0.00000011, 4.72, 2544.31},
+				{0.0000001, 0.25, 382.9},
+				{0.00000009, 0.68, 1059.38},
+				{0.00000009, 3.83, 20043.67},
+				{0.00000009, 3.88, 3738.76},
+				{0.00000008, 5.46, 1751.54},
+				{0.00000007, 2.58, 3149.16},
+				{0.00000007, 2.38, 4136.91},
+				{0.00000006, 5.48, 1592.6},
+				{0.00000006, 2.34, 3097.88},
+			},
+			{
+				{0.00001482, 0.4443, 3340.6124},
+				{0.00000662, 0.885, 6681.225},
+				{0.00000188, 1.288, 10021.837},
+				{0.00000041, 1.65, 13362.45},
+				{0.00000026, 0, 0},
+				{0.00000023, 2.05, 155.42},
+				{0.0000001, 1.58, 3.52},
+				{0.00000008, 2, 16703.06},
+				{0.00000005, 2.82, 242.73},
+				{0.00000004, 2.02, 3344.14},
+				{0.00000003, 4.59, 3185.19},
+				{0.00000003, 0.65, 553.57},
+			},
+			{
+				{0.00000114, 3.1416, 0},
+				{0.00000029, 5.64, 6681.22},
+				{0.00000024, 5.14, 3340.61},
+				{0.00000011, 6.03, 10021.84},
+				{0.00000003, 0.13, 13362.45},
+				{0.00000003, 3.56, 155.42},
+				{0.00000001, 0.49, 16703.06},
+				{0.00000001, 1.32, 242.73},
+			},
+			{
+				{0.00000001, 3.14, 0},
+				{0.00000001, 4.04, 6681.22},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.03197135, 3.7683204, 3340.6124267},
+				{0.00298033, 4.10617, 6681.224853},
+				{0.00289105, 0, 0},
+				{0.00031366, 4.44651, 10021.83728},
+				{0.00003484, 4.7881, 13362.4497},
+				{0.00000443, 5.026, 3344.136},
+				{0.00000443, 5.652, 3337.089},
+				{0.00000399, 5.131, 16703.062},
+				{0.00000293, 3.793, 2281.23},
+				{0.00000182, 6.136, 6151.534},
+				{0.00000163, 4.264, 529.691},
+				{0.0000016, 2.232, 1059.382},
+				{0.00000149, 2.165, 5621.843},
+				{0.00000143, 1.182, 3340.595},
+				{0.00000143, 3.213, 3340.63},
+				{0.00000139, 2.418, 8962.455},
+			},
+			{
+				{0.00350069, 5.368478, 3340.612427},
+				{0.00014116, 3.14159, 0},
+				{0.00009671, 5.4788, 6681.2249},
+				{0.00001472, 3.2021, 10021.8373},
+				{0.00000426, 3.408, 13362.45},
+				{0.00000102, 0.776, 3337.089},
+				{0.00000079, 3.72, 16703.06},
+				{0.00000033, 3.46, 5621.84},
+				{0.00000026, 2.48, 2281.23},
+			},
+			{
+				{0.00016727, 0.60221, 3340.61243},
+				{0.00004987, 3.1416, 0},
+				{0.00000302, 5.559, 6681.225},
+				{0.00000026, 1.9, 13362.45},
+				{0.00000021, 0.92, 10021.84},
+				{0.00000012, 2.24, 3337.09},
+				{0.00000008, 2.25, 16703.06},
+			},
+			{
+				{0.00000607, 1.981, 3340.612},
+				{0.00000043, 0, 0},
+				{0.00000014, 1.8, 6681.22},
+				{0.00000003, 3.45, 10021.84},
+			},
+			{
+				{0.00000013, 0, 0},
+				{0.00000011, 3.46, 3340.61},
+				{0.00000001, 0.5, 6681.22},
+			},
+		},
+		// Radius
+		{
+			{
+				{1.53033488, 0, 0},
+				{0.14184953, 3.47971284, 3340.6124267},
+				{0.00660776, 3.817834, 6681.224853},
+				{0.00046179, 4.15595, 10021.83728},
+				{0.0000811, 5.5596, 2810.9215},
+				{0.00007485, 1.7724, 5621.8429},
+				{0.00005523, 1.3644, 2281.2305},
+				{0.00003825, 4.4941, 13362.4497},
+				{0.00002484, 4.9255, 2942.4634},
+				{0.00002307, 0.0908, 2544.3144},
+				{0.00001999, 5.3606, 3337.0893},
+				{0.0000196, 4.7425, 3344.1355},
+				{0.00001167, 2.1126, 5092.152},
+				{0.00001103, 5.0091, 398.149},
+				{0.00000992, 5.839, 6151.534},
+				{0.00000899, 4.408, 529.691},
+				{0.00000807, 2.102, 1059.382},
+				{0.00000798, 3.448, 796.298},
+				{0.00000741, 1.499, 2146.165},
+				{0.00000726, 1.245, 8432.764},
+				{0.00000692, 2.134, 8962.455},
+				{0.00000633, 0.894, 3340.595},
+				{0.00000633, 2.924, 3340.63},
+				{0.0000063, 1.287, 1751.54},
+				{0.00000574, 0.829, 2914.014},
+				{0.00000526, 5.383, 3738.761},
+				{0.00000473, 5.199, 3127.313},
+				{0.00000348, 4.832, 16703.062},
+				{0.00000284, 2.907, 3532.061},
+				{0.0000028, 5.257, 6283.076},
+				{0.00000276, 1.218, 6254.627},
+				{0.00000275, 2.908, 1748.016},
+				{0.0000027, 3.764, 5884.927},
+				{0.00000239, 2.037, 1194.447},
+				{0.00000234, 5.105, 5486.778},
+				{0.00000228, 3.255, 6872.673},
+				{0.00000223, 4.199, 3149.164},
+				{0.00000219, 5.583, 191.448},
+				{0.00000208, 5.255, 3340.545},
+				{0.00000208, 4.846, 3340.68},
+				{0.00000186, 5.699, 6677.702},
+				{0.00000183, 5.081, 6684.748},
+				{0.00000179, 4.184, 3333.499},
+				{0.00000176, 5.953, 3870.303},
+				{0.00000164, 3.799, 4136.91},
+			},
+			{
+				{0.01107433, 2.0325052, 3340.6124267},
+				{0.00103176, 2.370718, 6681.224853},
+				{0.00012877, 0, 0},
+				{0.00010816, 2.70888, 10021.83728},
+				{0.00001195, 3.047, 13362.4497},
+				{0.00000439, 2.888, 2281.23},
+				{0.00000396, 3.423, 3344.136},
+				{0.00000183, 1.584, 2544.314},
+				{0.00000136, 3.385, 16703.062},
+				{0.00000128, 6.043, 3337.089},
+				{0.00000128, 0.63, 1059.382},
+				{0.00000127, 1.954, 796.298},
+				{0.00000118, 2.998, 2146.165},
+				{0.00000088, 3.42, 398.15},
+				{0.00000083, 3.86, 3738.76},
+				{0.00000076, 4.45, 6151.53},
+				{0.00000072, 2.76, 529.69},
+				{0.00000067, 2.55, 1751.54},
+				{0.00000066, 4.41, 1748.02},
+				{0.00000058, 0.54, 1194.45},
+				{0.00000054, 0.68, 8962.46},
+				{0.00000051, 3.73, 6684.75},
+				{0.00000049, 5.73, 3340.6},
+				{0.00000049, 1.48, 3340.63},
+				{0.00000048, 2.58, 3149.16},
+				{0.00000048, 2.29, 2914.01},
+				{0.00000039, 2.32, 4136.91},
+			},
+			{
+				{0.00044242, 0.47931, 3340.61243},
+				{0.00008138, 0.87, 6681.2249},
+				{0.00001275, 1.2259, 10021.8373},
+				{0.00000187, 1.573, 13362.45},
+				{0.00000052, 3.14, 0},
+				{0.00000041, 1.97, 3344.14},
+				{0.00000027, 1.92, 16703.06},
+				{0.00000018, 4.43, 2281.23},
+				{0.00000012, 4.53, 3185.19},
+				{0.0000001, 5.39, 1059.38},
+				{0.0000001, 0.42, 796.3},
+			},
+			{
+				{0.00001113, 5.1499, 3340.6124},
+				{0.00000424, 5.613, 6681.225},
+				{0.000001, 5.997, 10021.837},
+				{0.0000002, 0.08, 13362.45},
+				{0.00000005, 3.14, 0},
+				{0.00000003, 0.43, 16703.06},
+			},
+			{
+				{0.0000002, 3.58, 3340.61},
+				{0.00000016, 4.05, 6681.22},
+				{0.00000006, 4.46, 10021.84},
+				{0.00000002, 4.84, 13362.45},
+			},
+		},
+	},
+	Jupiter: {
+		// Longitude
+		{
+			{
+				{0.59954691, 0, 0},
+				{0.09695899, 5.0619179, 529.6909651},
+				{0.0057361, 1.444062, 7.113547},
+				{0.00306389, 5.417347, 1059.38193},
+				{0.00097178, 4.14265, 632.78374},
+				{0.00072903, 3.64043, 522.57742},
+				{0.00064264, 3.41145, 103.09277},
+				{0.00039806, 2.29377, 419.48464},
+				{0.00038858, 1.27232, 316.39187},
+				{0.00027965, 1.78455, 536.80451},
+				{0.0001359, 5.77481, 1589.0729},
+				{0.00008769, 3.63, 949.1756},
+				{0.00008246, 3.5823, 206.1855},
+				{0.00007368, 5.081, 735.8765},
+				{0.00006263, 0.025, 213.2991},
+				{0.00006114, 4.5132, 1162.4747},
+				{0.00005305, 4.1863, 1052.2684},
+				{0.00005305, 1.3067, 14.2271},
+				{0.00004905, 1.3208, 110.2063},
+				{0.00004647, 4.6996, 3.9322},
+				{0.00003045, 4.3168, 426.5982},
+				{0.0000261, 1.5667, 846.0828},
+				{0.00002028, 1.0638, 3.1814},
+				{0.00001921, 0.9717, 639.8973},
+				{0.00001765, 2.1415, 1066.4955},
+				{0.00001723, 3.8804, 1265.5675},
+				{0.00001633, 3.582, 515.4639},
+				{0.00001432, 4.2968, 625.6702},
+				{0.00000973, 4.098, 95.979},
+				{0.00000884, 2.437, 412.371},
+				{0.00000733, 6.085, 838.969},
+				{0.00000731, 3.806, 1581.959},
+				{0.00000709, 1.293, 742.99},
+				{0.00000692, 6.134, 2118.764},
+				{0.00000614, 4.109, 1478.867},
+				{0.00000582, 4.54, 309.278},
+				{0.00000495, 3.756, 323.505},
+				{0.00000441, 2.958, 454.909},
+				{0.00000417, 1.036, 2.448},
+				{0.0000039, 4.897, 1692.166},
+				{0.00000376, 4.703, 1368.66},
+				{0.00000341, 5.715, 533.623},
+				{0.0000033, 4.74, 0.048},
+				{0.00000262, 1.877, 0.963},
+				{0.00000261, 0.82, 380.128},
+				{0.00000257, 3.724, 199.072},
+				{0.00000244, 5.22, 728.763},
+				{0.00000235, 1.227, 909.819},
+				{0.0000022, 1.651, 543.918},
+				{0.00000207, 1.855, 525.759},
+				{0.00000202, 1.807, 1375.774},
+				{0.00000197, 5.293, 1155.361},
+				{0.00000175, 3.73, 942.062},
+				{0.00000175, 3.226, 1898.351},
+				{0.00000175, 5.91, 956.289},
+				{0.00000158, 4.365, 860.31},
+			},
+			{
+				{529.93480757, 0, 0},
+				{0.00489741, 4.220667, 529.690965},
+				{0.00228919, 6.026475, 7.113547},
+				{0.00027655, 4.57266, 536.80451},
+				{0.00020721, 5.45939, 522.57742},
+				{0.00012106, 0.16986, 1059.38193},
+				{0.00006068, 4.4242, 103.0928},
+				{0.00005434, 3.9848, 419.4846},
+				{0.00004238, 5.8901, 14.2271},
+				{0.00002212, 5.2677, 206.1855},
+				{0.00001746, 4.9267, 1589.0729},
+				{0.00001296, 5.5513, 3.1814},
+				{0.00001173, 5.8565, 1052.2684},
+				{0.00001163, 0.5145, 3.9322},
+				{0.00001099, 5.307, 515.4639},
+				{0.00001007, 0.4648, 735.8765},
+				{0.00001004, 3.1504, 426.5982},
+				{0.00000848, 5.758, 110.206},
+				{0.00000827, 4.803, 213.299},
+				{0.00000816, 0.586, 1066.495},
+				{0.00000725, 5.518, 639.897},
+				{0.00000568, 5.989, 625.67},
+				{0.00000474, 4.132, 412.371},
+				{0.00000413, 5.737, 95.979},
+				{0.00000345, 4.242, 632.784},
+				{0.00000336, 3.732, 1162.475},
+				{0.00000234, 4.035, 949.176},
+				{0.00000234, 6.243, 309.278},
+				{0.00000199, 1.505, 838.969},
+				{0.00000195, 2.219, 323.505},
+				{0.00000187, 6.086, 742.99},
+				{0.00000184, 6.28, 543.918},
+				{0.00000171, 5.417, 199.072},
+				{0.00000131, 0.626, 728.763},
+				{0.00000115, 0.68, 846.083},
+				{0.00000115, 5.286, 2118.764},
+				{0.00000108, 4.493, 956.289},
+				{0.0000008, 5.82, 1265.57},
+				{0.00000072, 5.34, 942.06},
+				{0.0000007, 5.97, 532.87},
+				{0.00000067, 5.73, 21.34},
+				{0.00000066, 0.13, 526.51},
+				{0.00000065, 6.09, 1581.96},
+				{0.00000059, 0.59, 1155.36},
+				{0.00000058, 0.99, 1596.19},
+				{0.00000057, 5.97, 1169.59},
+				{0.00000057, 1.41, 533.62},
+				{0.00000055, 5.43, 10.29},
+				{0.00000052, 5.73, 117.32},
+				{0.00000052, 0.23, 1368.66},
+				{0.0000005, 6.08, 525.76},
+				{0.00000047, 3.63, 1478.87},
+				{0.00000047, 0.51, 1265.57},
+				{0.0000004, 4.16, 1692.17},
+				{0.00000034, 0.1, 302.16},
+				{0.00000033, 5.04, 220.41},
+				{0.00000032, 5.37, 508.35},
+				{0.00000029, 5.42, 1272.68},
+				{0.00000029, 3.36, 4.67},
+				{0.00000029, 0.76, 88.87},
+				{0.00000025, 1.61, 831.86},
+			},
+			{
+				{0.00047234, 4.32148, 7.11355},
+				{0.00038966, 0, 0},
+				{0.00030629, 2.93021, 529.69097},
+				{0.00003189, 1.055, 522.5774},
+				{0.00002729, 4.8455, 536.8045},
+				{0.00002723, 3.4141, 1059.3819},
+				{0.00001721, 4.1873, 14.2271},
+				{0.00000383, 5.768, 419.485},
+				{0.00000378, 0.76, 515.464},
+				{0.00000367, 6.055, 103.093},
+				{0.00000337, 3.786, 3.181},
+				{0.00000308, 0.694, 206.186},
+				{0.00000218, 3.814, 1589.073},
+				{0.00000199, 5.34, 1066.495},
+				{0.00000197, 2.484, 3.932},
+				{0.00000156, 1.406, 1052.268},
+				{0.00000146, 3.814, 639.897},
+				{0.00000142, 1.634, 426.598},
+				{0.0000013, 5.837, 412.371},
+				{0.00000117, 1.414, 625.67},
+				{0.00000097, 4.03, 110.21},
+				{0.00000091, 1.11, 95.98},
+				{0.00000087, 2.52, 632.78},
+				{0.00000079, 4.64, 543.92},
+				{0.00000072, 2.22, 735.88},
+				{0.00000058, 0.83, 199.07},
+				{0.00000057, 3.12, 213.3},
+				{0.00000049, 1.67, 309.28},
+				{0.0000004, 4.02, 21.34},
+				{0.0000004, 0.62, 323.51},
+				{0.00000036, 2.33, 728.76},
+				{0.00000029, 3.61, 10.29},
+				{0.00000028, 3.24, 838.97},
+				{0.00000026, 4.5, 742.99},
+				{0.00000026, 2.51, 1162.47},
+				{0.00000025, 1.22, 1045.15},
+				{0.00000024, 3.01, 956.29},
+				{0.00000019, 4.29, 532.87},
+				{0.00000018, 0.81, 508.35},
+				{0.00000017, 4.2, 2118.76},
+				{0.00000017, 1.83, 526.51},
+				{0.00000015, 5.81, 1596.19},
+				{0.00000015, 0.68, 942.06},
+				{0.00000015, 4, 117.32},
+				{0.00000014, 5.95, 316.39},
+				{0.00000014, 1.8, 302.16},
+				{0.00000013, 2.52, 88.87},
+				{0.00000013, 4.37, 1169.59},
+				{0.00000011, 4.44, 525.76},
+				{0.0000001, 1.72, 1581.96},
+				{0.00000009, 2.18, 1155.36},
+				{0.00000009, 3.29, 220.41},
+				{0.00000009, 3.32, 831.86},
+				{0.00000008, 5.76, 846.08},
+				{0.00000008, 2.71, 533.62},
+				{0.00000007, 2.18, 1265.57},
+				{0.00000006, 0.5, 949.18},
+			},
+			{
+				{0.00006502, 2.5986, 7.1135},
+				{0.00001357, 1.3464, 529.691},
+				{0.00000471, 2.475, 14.227},
+				{0.00000417, 3.245, 536.805},
+				{0.00000353, 2.974, 522.577},
+				{0.00000155, 2.076, 1059.382},
+				{0.00000087, 2.59, 515.46},
+				{0.00000044, 1.14, 1066.5},
+			},
+			{
+				{0.00000669, 0.853, 7.114},
+				{0.00000114, 3.142, 0},
+				{0.000001, 0.743, 14.227},
+				{0.0000005, 1.65, 536.8},
+				{0.00000044, 5.82, 529.69},
+				{0.00000032, 4.86, 522.58},
+				{0.00000015, 4.29, 515.46},
+				{0.00000009, 0.71, 1059.38},
+			},
+			{
+				{0.0000005, 5.26, 7.11},
+				{0.00000016, 5.25, 14.23},
+				{0.00000004, 0.01, 536.8},
+				{0.00000002, 1.1, 522.58},
+				{0.00000001, 3.14, 0},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.02268616, 3.5585261, 529.6909651},
+				{0.0011009, 0, 0},
+				{0.00109972, 3.908093, 1059.38193},
+				{0.00008101, 3.6051, 522.5774},
+				{0.00006438, 0.3063, 536.8045},
+				{0.00006044, 4.2588, 1589.0729},
+				{0.00001107, 2.9853, 1162.4747},
+				{0.00000944, 1.675, 426.598},
+				{0.00000942, 2.936, 1052.268},
+				{0.00000894, 1.754, 7.114},
+				{0.00000836, 5.179, 103.093},
+				{0.00000767, 2.155, 632.784},
+				{0.00000684, 3.678, 213.299},
+				{0.00000629, 0.643, 1066.495},
+				{0.00000559, 0.014, 846.083},
+				{0.00000532, 2.703, 110.206},
+				{0.00000464, 1.173, 949.176},
+				{0.00000431, 2.608, 419.485},
+				{0.00000351, 4.611, 2118.764},
+				{0.00000132, 4.778, 742.99},
+				{0.00000123, 3.35, 1692.166},
+				{0.00000116, 1.387, 323.505},
+				{0.00000115, 5.049, 316.392},
+				{0.00000104, 3.701, 515.464},
+				{0.00000103, 2.319, 1478.867},
+				{0.00000102, 3.153, 1581.959},
+			},
+			{
+				{0.00177352, 5.701665, 529.690965},
+				{0.0000323, 5.7794, 1059.3819},
+				{0.00003081, 5.4746, 522.5774},
+				{0.00002212, 4.7348, 536.8045},
+				{0.00001694, 3.1416, 0},
+				{0.00000346, 4.746, 1052.268},
+				{0.00000234, 5.189, 1066.495},
+				{0.00000196, 6.186, 7.114},
+				{0.0000015, 3.927, 1589.073},
+				{0.00000114, 3.439, 632.784},
+				{0.00000097, 2.91, 949.18},
+				{0.00000082, 5.08, 1162.47},
+				{0.00000077, 2.51, 103.09},
+				{0.00000077, 0.61, 419.48},
+				{0.00000074, 5.5, 515.46},
+				{0.00000061, 5.45, 213.3},
+				{0.0000005, 3.95, 735.88},
+				{0.00000046, 0.54, 110.21},
+				{0.00000045, 1.9, 846.08},
+				{0.00000037, 4.7, 543.92},
+				{0.00000036, 6.11, 316.39},
+				{0.00000032, 4.92, 1581.96},
+			},
+			{
+				{0.00008094, 1.4632, 529.691},
+				{0.00000813, 3.1416, 0},
+				{0.00000742, 0.957, 522.577},
+				{0.00000399, 2.899, 536.805},
+				{0.00000342, 1.447, 1059.382},
+				{0.00000074, 0.41, 1052.27},
+				{0.00000046, 3.48, 1066.5},
+				{0.0000003, 1.93, 1589.07},
+				{0.00000029, 0.99, 515.46},
+				{0.00000023, 4.27, 7.11},
+				{0.00000014, 2.92, 543.92},
+				{0.00000012, 5.22, 632.78},
+				{0.00000011, 4.88, 949.18},
+				{0.00000006, 6.21, 1045.15},
+			},
+			{
+				{0.00000252, 3.381, 529.691},
+				{0.00000122, 2.733, 522.577},
+				{0.00000049, 1.04, 536.8},
+				{0.00000011, 2.31, 1052.27},
+				{0.00000008, 2.77, 515.46},
+				{0.00000007, 4.25, 1059.38},
+				{0.00000006, 1.78, 1066.5},
+				{0.00000004, 1.13, 543.92},
+				{0.00000003, 3.14, 0},
+			},
+			{
+				{0.00000015, 4.53, 522.58},
+				{0.00000005, 4.47, 529.69},
+				{0.00000004, 5.44, 536.8},
+				{0.00000003, 0, 0},
+				{0.00000002, 4.52, 515.46},
+				{0.00000001, 4.2, 1052.27},
+			},
+			{
+				{0.00000001, 0.09, 522.58},
+			},
+		},
+		// Radius
+		{
+			{
+				{5.20887429, 0, 0},
+				{0.25209327, 3.4910864, 529.69096509},
+				{0.006106, 3.841154, 1059.38193},
+				{0.00282029, 2.574199, 632.783739},
+				{0.00187647, 2.075904, 522.577418},
+				{0.00086793, 0.71001, 419.48464},
+				{0.00072063, 0.21466, 536.80451},
+				{0.00065517, 5.97996, 316.39187},
+				{0.00030135, 2.16132, 949.17561},
+				{0.00029135, 1.67759, 103.09277},
+				{0.00023947, 0.27458, 7.11355},
+				{0.00023453, 3.54023, 735.87651},
+				{0.00022284, 4.19363, 1589.0729},
+				{0.00013033, 2.96043, 1162.4747},
+				{0.00012749, 2.7155, 1052.26838},
+				{0.00009703, 1.9067, 206.1855},
+				{0.00009161, 4.4135, 213.2991},
+				{0.00007895, 2.4791, 426.5982},
+				{0.00007058, 2.1818, 1265.5675},
+				{0.00006138, 6.2642, 846.0828},
+				{0.00005477, 5.6573, 639.8973},
+				{0.0000417, 2.0161, 515.4639},
+				{0.00004137, 2.7222, 625.6702},
+				{0.00003503, 0.5653, 1066.4955},
+				{0.00002617, 2.0099, 1581.9593},
+				{0.000025, 4.5518, 838.9693},
+				{0.00002128, 6.1275, 742.9901},
+				{0.00001912, 0.8562, 412.3711},
+				{0.00001611, 3.0887, 1368.6603},
+				{0.00001479, 2.6803, 1478.8666},
+				{0.00001231, 1.8904, 323.5054},
+				{0.00001217, 1.8017, 110.2063},
+				{0.00001015, 1.3867, 454.9094},
+				{0.00000999, 2.872, 309.278},
+				{0.00000961, 4.549, 2118.764},
+				{0.00000886, 4.148, 533.623},
+				{0.00000821, 1.593, 1898.351},
+				{0.00000812, 5.941, 909.819},
+				{0.00000777, 3.677, 728.763},
+				{0.00000727, 3.988, 1155.361},
+				{0.00000655, 2.791, 1685.052},
+				{0.00000654, 3.382, 1692.166},
+				{0.00000621, 4.823, 956.289},
+				{0.00000615, 2.276, 942.062},
+				{0.00000562, 0.081, 543.918},
+				{0.00000542, 0.284, 525.759},
+			},
+			{
+				{0.01271802, 2.6493751, 529.6909651},
+				{0.00061662, 3.00076, 1059.38193},
+				{0.00053444, 3.89718, 522.57742},
+				{0.0004139, 0, 0},
+				{0.00031185, 4.88277, 536.80451},
+				{0.00011847, 2.4133, 419.48464},
+				{0.00009166, 4.7598, 7.1135},
+				{0.00003404, 3.3469, 1589.0729},
+				{0.00003203, 5.2108, 735.8765},
+				{0.00003176, 2.793, 103.0928},
+				{0.00002806, 3.7422, 515.4639},
+				{0.00002677, 4.3305, 1052.2684},
+				{0.000026, 3.6344, 206.1855},
+				{0.00002412, 1.4695, 426.5982},
+				{0.00002101, 3.9276, 639.8973},
+				{0.00001646, 4.4163, 1066.4955},
+				{0.0000105, 3.1611, 213.2991},
+				{0.00001025, 2.5543, 412.3711},
+				{0.00000806, 2.678, 632.784},
+				{0.00000741, 2.171, 1162.475},
+				{0.00000677, 6.25, 838.969},
+				{0.00000567, 4.577, 742.99},
+				{0.00000485, 2.469, 949.176},
+				{0.00000469, 4.71, 543.918},
+				{0.00000445, 0.403, 323.505},
+				{0.00000416, 5.368, 728.763},
+				{0.00000402, 4.605, 309.278},
+				{0.00000347, 4.681, 14.227},
+				{0.00000338, 3.168, 956.289},
+				{0.00000261, 5.343, 846.083},
+				{0.00000247, 3.923, 942.062},
+				{0.0000022, 4.842, 1368.66},
+				{0.00000203, 5.6, 1155.361},
+				{0.000002, 4.439, 1045.155},
+				{0.00000197, 3.706, 2118.764},
+				{0.00000196, 3.759, 199.072},
+				{0.00000184, 4.265, 95.979},
+				{0.0000018, 4.402, 532.872},
+				{0.0000017, 4.846, 526.51},
+				{0.00000146, 6.13, 533.623},
+				{0.00000133, 1.322, 110.206},
+				{0.00000132, 4.512, 525.759},
+			},
+			{
+				{0.00079645, 1.35866, 529.69097},
+				{0.00008252, 5.7777, 522.5774},
+				{0.0000703, 3.2748, 536.8045},
+				{0.00005314, 1.8384, 1059.3819},
+				{0.00001861, 2.9768, 7.1135},
+				{0.00000964, 5.48, 515.464},
+				{0.00000836, 4.199, 419.485},
+				{0.00000498, 3.142, 0},
+				{0.00000427, 2.228, 639.897},
+				{0.00000406, 3.783, 1066.495},
+				{0.00000377, 2.242, 1589.073},
+				{0.00000363, 5.368, 206.186},
+				{0.00000342, 6.099, 1052.268},
+				{0.00000339, 6.127, 625.67},
+				{0.00000333, 0.003, 426.598},
+				{0.0000028, 4.262, 412.371},
+				{0.00000257, 0.963, 632.784},
+				{0.0000023, 0.705, 735.877},
+				{0.00000201, 3.069, 543.918},
+				{0.000002, 4.429, 103.093},
+				{0.00000139, 2.932, 14.227},
+				{0.00000114, 0.787, 728.763},
+				{0.00000095, 1.7, 838.97},
+				{0.00000086, 5.14, 323.51},
+				{0.00000083, 0.06, 309.28},
+				{0.0000008, 2.98, 742.99},
+				{0.00000075, 1.6, 956.29},
+				{0.0000007, 1.51, 213.3},
+				{0.00000067, 5.47, 199.07},
+				{0.00000062, 6.1, 1045.15},
+				{0.00000056, 0.96, 1162.47},
+				{0.00000052, 5.58, 942.06},
+				{0.0000005, 2.72, 532.87},
+				{0.00000045, 5.52, 508.35},
+				{0.00000044, 0.27, 526.51},
+				{0.0000004, 5.95, 95.98},
+			},
+			{
+				{0.00003519, 6.058, 529.691},
+				{0.00001073, 1.6732, 536.8045},
+				{0.00000916, 1.413, 522.577},
+				{0.00000342, 0.523, 1059.382},
+				{0.00000255, 1.196, 7.114},
+				{0.00000222, 0.952, 515.464},
+				{0.0000009, 3.14, 0},
+				{0.00000069, 2.27, 1066.5},
+				{0.00000058, 1.41, 543.92},
+				{0.00000058, 0.53, 639.9},
+				{0.00000051, 5.98, 412.37},
+				{0.00000047, 1.58, 625.67},
+				{0.00000043, 6.12, 419.48},
+				{0.00000037, 1.18, 14.23},
+				{0.00000034, 1.67, 1052.27},
+				{0.00000034, 0.85, 206.19},
+				{0.00000031, 1.04, 1589.07},
+				{0.0000003, 4.63, 426.6},
+				{0.00000021, 2.5, 728.76},
+				{0.00000015, 0.89, 199.07},
+				{0.00000014, 0.96, 508.35},
+				{0.00000013, 1.5, 1045.15},
+				{0.00000012, 2.61, 735.88},
+				{0.00000012, 3.56, 323.51},
+				{0.00000011, 1.79, 309.28},
+				{0.00000011, 6.28, 956.29},
+				{0.0000001, 6.26, 103.09},
+				{0.00000009, 3.45, 838.97},
+			},
+			{
+				{0.00000129, 0.084, 536.805},
+				{0.00000113, 4.249, 529.691},
+				{0.00000083, 3.3, 522.58},
+				{0.00000038, 2.73, 515.46},
+				{0.00000027, 5.69, 7.11},
+			},
+			{
+				{0.00000011, 4.75, 536.8},
+				{0.00000004, 5.92, 522.58},
+				{0.00000002, 5.57, 515.46},
+				{0.00000002, 4.3, 543.92},
+				{0.00000002, 3.69, 7.11},
+				{0.00000002, 4.13, 1059.38},
+			},
+		},
+	},
+	Saturn: {
+		// Longitude
+		{
+			{
+				{0.87401354, 0, 0},
+				{0.1110766, 3.9620509, 213.29909544},
+				{0.01414151, 4.5858152, 7.113547},
+				{0.00398379, 0.52112, 206.185548},
+				{0.00350769, 3.303299, 426.598191},
+				{0.00206816, 0.246584, 103.092774},
+				{0.00079271, 3.84007, 220.41264},
+				{0.0002399, 4.66977, 110.20632},
+				{0.00016574, 0.43719, 419.48464},
+				{0.0001582, 0.93809, 632.78374},
+				{0.00015054, 2.7167, 639.89729},
+				{0.00014907, 5.76903, 316.39187},
+				{0.0001461, 1.56519, 3.93215},
+				{0.0001316, 4.44891, 14.22709},
+				{0.00013005, 5.98119, 11.0457},
+				{0.00010725, 3.1294, 202.2534},
+				{0.00006126, 1.7633, 277.035},
+				{0.00005863, 0.2366, 529.691},
+				{0.00005228, 4.2078, 3.1814},
+				{0.0000502, 3.1779, 433.7117},
+				{0.00004593, 0.6198, 199.072},
+				{0.00004006, 2.2448, 63.7359},
+				{0.00003874, 3.2228, 138.5175},
+				{0.00003269, 0.7749, 949.1756},
+				{0.00002954, 0.9828, 95.9792},
+				{0.00002461, 2.0316, 735.8765},
+				{0.00001758, 3.2658, 522.5774},
+				{0.0000164, 5.505, 846.0828},
+				{0.00001581, 4.3727, 309.2783},
+				{0.00001391, 4.0233, 323.5054},
+				{0.00001124, 2.8373, 415.5525},
+				{0.00001087, 4.1834, 2.4477},
+				{0.00001017, 3.717, 227.5262},
+				{0.00000957, 0.507, 1265.567},
+				{0.00000853, 3.421, 175.166},
+				{0.00000849, 3.191, 209.367},
+				{0.00000789, 5.007, 0.963},
+				{0.00000749, 2.144, 853.196},
+				{0.00000744, 5.253, 224.345},
+				{0.00000687, 1.747, 1052.268},
+				{0.00000654, 1.599, 0.048},
+				{0.00000634, 2.299, 412.371},
+				{0.00000625, 0.97, 210.118},
+				{0.0000058, 3.093, 74.782},
+				{0.00000546, 2.127, 350.332},
+				{0.00000543, 1.518, 9.561},
+				{0.0000053, 4.449, 117.32},
+				{0.00000478, 2.965, 137.033},
+				{0.00000474, 5.475, 742.99},
+				{0.00000452, 1.044, 490.334},
+				{0.00000449, 1.29, 127.472},
+				{0.00000372, 2.278, 217.231},
+				{0.00000355, 3.013, 838.969},
+				{0.00000347, 1.539, 340.771},
+				{0.00000343, 0.246, 0.521},
+				{0.0000033, 0.247, 1581.959},
+				{0.00000322, 0.961, 203.738},
+				{0.00000322, 2.572, 647.011},
+				{0.00000309, 3.495, 216.48},
+				{0.00000287, 2.37, 351.817},
+				{0.00000278, 0.4, 211.815},
+				{0.00000249, 1.47, 1368.66},
+				{0.00000227, 4.91, 12.53},
+				{0.0000022, 4.204, 200.769},
+				{0.00000209, 1.345, 625.67},
+				{0.00000208, 0.483, 1162.475},
+				{0.00000208, 1.283, 39.357},
+				{0.00000204, 6.011, 265.989},
+				{0.00000185, 3.503, 149.563},
+				{0.00000184, 0.973, 4.193},
+				{0.00000182, 5.491, 2.921},
+				{0.00000174, 1.863, 0.751},
+				{0.00000165, 0.44, 5.417},
+				{0.00000149, 5.736, 52.69},
+				{0.00000148, 1.535, 5.629},
+				{0.00000146, 6.231, 195.14},
+				{0.0000014, 4.295, 21.341},
+				{0.00000131, 4.068, 10.295},
+				{0.00000125, 6.277, 1898.351},
+				{0.00000122, 1.976, 4.666},
+				{0.00000118, 5.341, 554.07},
+				{0.00000117, 2.679, 1155.361},
+				{0.00000114, 5.594, 1059.382},
+				{0.00000112, 1.105, 191.208},
+				{0.0000011, 0.166, 1.484},
+				{0.00000109, 3.438, 536.805},
+				{0.00000107, 4.012, 956.289},
+				{0.00000104, 2.192, 88.866},
+				{0.00000103, 1.197, 1685.052},
+				{0.00000101, 4.965, 269.921},
+			},
+			{
+				{213.54295596, 0, 0},
+				{0.01296855, 1.8282054, 213.2990954},
+				{0.00564348, 2.885001, 7.113547},
+				{0.00107679, 2.277699, 206.185548},
+				{0.00098323, 1.0807, 426.59819},
+				{0.00040255, 2.04128, 220.41264},
+				{0.00019942, 1.27955, 103.09277},
+				{0.00010512, 2.7488, 14.22709},
+				{0.00006939, 0.4049, 639.8973},
+				{0.00004803, 2.4419, 419.4846},
+				{0.00004056, 2.9217, 110.2063},
+				{0.00003769, 3.6497, 3.9322},
+				{0.00003385, 2.4169, 3.1814},
+				{0.00003302, 1.2626, 433.7117},
+				{0.00003071, 2.3274, 199.072},
+				{0.00001953, 3.5639, 11.0457},
+				{0.00001249, 2.628, 95.9792},
+				{0.00000922, 1.961, 227.526},
+				{0.00000706, 4.417, 529.691},
+				{0.0000065, 6.174, 202.253},
+				{0.00000628, 6.111, 309.278},
+				{0.00000487, 6.04, 853.196},
+				{0.00000479, 4.988, 522.577},
+				{0.00000468, 4.617, 63.736},
+				{0.00000417, 2.117, 323.505},
+				{0.00000408, 1.299, 209.367},
+				{0.00000352, 2.317, 632.784},
+				{0.00000344, 3.959, 412.371},
+				{0.0000034, 3.634, 316.392},
+				{0.00000336, 3.772, 735.877},
+				{0.00000332, 2.861, 210.118},
+				{0.00000289, 2.733, 117.32},
+				{0.00000281, 5.744, 2.448},
+				{0.00000266, 0.543, 647.011},
+				{0.0000023, 1.644, 216.48},
+				{0.00000192, 2.965, 224.345},
+				{0.00000173, 4.077, 846.083},
+				{0.00000167, 2.597, 21.341},
+				{0.00000136, 2.286, 10.295},
+				{0.00000131, 3.441, 742.99},
+				{0.00000128, 4.095, 217.231},
+				{0.00000109, 6.161, 415.552},
+				{0.00000098, 4.73, 838.97},
+				{0.00000094, 3.48, 1052.27},
+				{0.00000092, 3.95, 88.87},
+				{0.00000087, 1.22, 440.83},
+				{0.00000083, 3.11, 625.67},
+				{0.00000078, 6.24, 302.16},
+				{0.00000067, 0.29, 4.67},
+				{0.00000066, 5.65, 9.56},
+				{0.00000062, 4.29, 127.47},
+				{0.00000062, 1.83, 195.14},
+				{0.00000058, 2.48, 191.96},
+				{0.00000057, 5.02, 137.03},
+				{0.00000055, 0.28, 74.78},
+				{0.00000054, 5.13, 490.33},
+				{0.00000051, 1.46, 536.8},
+				{0.00000047, 1.18, 149.56},
+				{0.00000047, 5.15, 515.46},
+				{0.00000046, 2.23, 956.29},
+				{0.00000044, 2.71, 5.42},
+				{0.0000004, 0.41, 269.92},
+				{0.00000037, 3.78, 2.92},
+				{0.00000033, 3.21, 1265.57},
+				{0.00000033, 0.64, 380.13},
+			},
+			{
+				{0.00116441, 1.179879, 7.113547},
+				{0.00091921, 0.07425, 213.2991},
+				{0.00090592, 0, 0},
+				{0.00015277, 4.06492, 206.18555},
+				{0.00010631, 0.25778, 220.41264},
+				{0.00010605, 5.40964, 426.59819},
+				{0.00004265, 1.046, 14.2271},
+				{0.00001216, 2.9186, 103.0928},
+				{0.00001165, 4.6094, 639.8973},
+				{0.00001082, 5.6913, 433.7117},
+				{0.00001045, 4.0421, 199.072},
+				{0.0000102, 0.6337, 3.1814},
+				{0.00000634, 4.388, 419.485},
+				{0.00000549, 5.573, 3.932},
+				{0.00000457, 1.268, 110.206},
+				{0.00000425, 0.209, 227.526},
+				{0.00000274, 4.288, 95.979},
+				{0.00000162, 1.381, 11.046},
+				{0.00000129, 1.566, 309.278},
+				{0.00000117, 3.881, 853.196},
+				{0.00000105, 4.9, 647.011},
+				{0.00000101, 0.893, 21.341},
+				{0.00000096, 2.91, 316.39},
+				{0.00000095, 5.63, 412.37},
+				{0.00000085, 5.73, 209.37},
+				{0.00000083, 6.05, 216.48},
+				{0.00000082, 1.02, 117.32},
+				{0.00000075, 4.76, 210.12},
+				{0.00000067, 0.46, 522.58},
+				{0.00000066, 0.48, 10.29},
+				{0.00000064, 0.35, 323.51},
+				{0.00000061, 4.88, 632.78},
+				{0.00000053, 2.75, 529.69},
+				{0.00000046, 5.69, 440.83},
+				{0.00000045, 1.67, 202.25},
+				{0.00000042, 5.71, 88.87},
+				{0.00000032, 0.07, 63.74},
+				{0.00000032, 1.67, 302.16},
+				{0.00000031, 4.16, 191.96},
+				{0.00000027, 0.83, 224.34},
+				{0.00000025, 5.66, 735.88},
+				{0.0000002, 5.94, 217.23},
+				{0.00000018, 4.9, 625.67},
+				{0.00000017, 1.63, 742.99},
+				{0.00000016, 0.58, 515.46},
+				{0.00000014, 0.21, 838.97},
+				{0.00000014, 3.76, 195.14},
+				{0.00000012, 4.72, 203},
+				{0.00000012, 0.13, 234.64},
+				{0.00000012, 3.12, 846.08},
+				{0.00000011, 5.92, 536.8},
+				{0.00000011, 5.6, 728.76},
+				{0.00000011, 3.2, 1066.5},
+				{0.0000001, 4.99, 422.67},
+				{0.0000001, 0.26, 330.62},
+				{0.0000001, 4.15, 860.31},
+				{0.00000009, 0.46, 956.29},
+				{0.00000008, 2.14, 269.92},
+				{0.00000008, 5.25, 429.78},
+				{0.00000008, 4.03, 9.56},
+				{0.00000007, 5.4, 1052.27},
+				{0.00000006, 4.46, 284.15},
+				{0.00000006, 5.93, 405.26},
+			},
+			{
+				{0.00016039, 5.73945, 7.11355},
+				{0.0000425, 4.5854, 213.2991},
+				{0.00001907, 4.7608, 220.4126},
+				{0.00001466, 5.9133, 206.1855},
+				{0.00001162, 5.6197, 14.2271},
+				{0.00001067, 3.6082, 426.5982},
+				{0.00000239, 3.861, 433.712},
+				{0.00000237, 5.768, 199.072},
+				{0.00000166, 5.116, 3.181},
+				{0.00000151, 2.736, 639.897},
+				{0.00000131, 4.743, 227.526},
+				{0.00000063, 0.23, 419.48},
+				{0.00000062, 4.74, 103.09},
+				{0.0000004, 5.47, 21.34},
+				{0.0000004, 5.96, 95.98},
+				{0.00000039, 5.83, 110.21},
+				{0.00000028, 3.01, 647.01},
+				{0.00000025, 0.99, 3.93},
+				{0.00000019, 1.92, 853.2},
+				{0.00000018, 4.97, 10.29},
+				{0.00000018, 1.03, 412.37},
+				{0.00000018, 4.2, 216.48},
+				{0.00000018, 3.32, 309.28},
+				{0.00000016, 3.9, 440.83},
+				{0.00000016, 5.62, 117.32},
+				{0.00000013, 1.18, 88.87},
+				{0.00000011, 5.58, 11.05},
+				{0.00000011, 5.93, 191.96},
+				{0.0000001, 3.95, 209.37},
+				{0.00000009, 3.39, 302.16},
+				{0.00000008, 4.88, 323.51},
+				{0.00000007, 0.38, 632.78},
+				{0.00000006, 2.25, 522.58},
+				{0.00000006, 1.06, 210.12},
+				{0.00000005, 4.64, 234.64},
+				{0.00000004, 3.14, 0},
+				{0.00000004, 2.31, 515.46},
+				{0.00000003, 2.2, 860.31},
+				{0.00000003, 0.59, 529.69},
+				{0.00000003, 4.93, 224.34},
+				{0.00000003, 0.42, 625.67},
+			},
+			{
+				{0.00001662, 3.9983, 7.1135},
+				{0.00000257, 2.984, 220.413},
+				{0.00000236, 3.902, 14.227},
+				{0.00000149, 2.741, 213.299},
+				{0.00000114, 3.142, 0},
+				{0.0000011, 1.515, 206.186},
+				{0.00000068, 1.72, 426.6},
+				{0.0000004, 2.05, 433.71},
+				{0.00000038, 1.24, 199.07},
+				{0.00000031, 3.01, 227.53},
+				{0.00000015, 0.83, 639.9},
+				{0.00000009, 3.71, 21.34},
+				{0.00000006, 2.42, 419.48},
+				{0.00000006, 1.16, 647.01},
+				{0.00000006, 1.45, 95.98},
+				{0.00000005, 2.12, 440.83},
+				{0.00000005, 4.09, 110.21},
+			},
+			{
+				{0.00000124, 2.259, 7.114},
+				{0.00000034, 2.16, 14.23},
+				{0.00000028, 1.2, 220.41},
+				{0.00000006, 1.22, 227.53},
+				{0.00000005, 0.24, 433.71},
+				{0.00000004, 6.23, 426.6},
+				{0.00000003, 2.97, 199.07},
+				{0.00000003, 4.29, 206.19},
+				{0.00000002, 6.25, 213.3},
+				{0.00000001, 5.28, 639.9},
+				{0.00000001, 0.24, 440.83},
+				{0.00000001, 3.14, 0},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.04330678, 3.6028443, 213.2990954},
+				{0.00240348, 2.852385, 426.598191},
+				{0.00084746, 0, 0},
+				{0.00034116, 0.57297, 206.18555},
+				{0.00030863, 3.48442, 220.41264},
+				{0.00014734, 2.11847, 639.89729},
+				{0.00009917, 5.79, 419.4846},
+				{0.00006994, 4.736, 7.1135},
+				{0.00004808, 5.4331, 316.3919},
+				{0.00004788, 4.9651, 110.2063},
+				{0.00003432, 2.7326, 433.7117},
+				{0.00001506, 6.013, 103.0928},
+				{0.0000106, 5.631, 529.691},
+				{0.00000969, 5.204, 632.784},
+				{0.00000942, 1.396, 853.196},
+				{0.00000708, 3.803, 323.505},
+				{0.00000552, 5.131, 202.253},
+				{0.000004, 3.359, 227.526},
+				{0.00000319, 3.626, 209.367},
+				{0.00000316, 1.997, 647.011},
+				{0.00000314, 0.465, 217.231},
+				{0.00000284, 4.886, 224.345},
+				{0.00000236, 2.139, 11.046},
+				{0.00000215, 5.95, 846.083},
+				{0.00000209, 2.12, 415.552},
+				{0.00000207, 0.73, 199.072},
+				{0.00000179, 2.954, 63.736},
+				{0.00000141, 0.644, 490.334},
+				{0.00000139, 4.595, 14.227},
+				{0.00000139, 1.998, 735.877},
+				{0.00000135, 5.245, 742.99},
+				{0.00000122, 3.115, 522.577},
+				{0.00000116, 3.109, 216.48},
+				{0.00000114, 0.963, 210.118},
+			},
+			{
+				{0.00397555, 5.3329, 213.299095},
+				{0.00049479, 3.14159, 0},
+				{0.00018572, 6.09919, 426.59819},
+				{0.00014801, 2.30586, 206.18555},
+				{0.00009644, 1.6967, 220.4126},
+				{0.00003757, 1.2543, 419.4846},
+				{0.00002717, 5.9117, 639.8973},
+				{0.00001455, 0.8516, 433.7117},
+				{0.00001291, 2.9177, 7.1135},
+				{0.00000853, 0.436, 316.392},
+				{0.00000298, 0.919, 632.784},
+				{0.00000292, 5.316, 853.196},
+				{0.00000284, 1.619, 227.526},
+				{0.00000275, 3.889, 103.093},
+				{0.00000172, 0.052, 647.011},
+				{0.00000166, 2.444, 199.072},
+				{0.00000158, 5.209, 110.206},
+				{0.00000128, 1.207, 529.691},
+				{0.0000011, 2.457, 217.231},
+				{0.00000082, 2.76, 210.12},
+				{0.00000081, 2.86, 14.23},
+				{0.00000069, 1.66, 202.25},
+				{0.00000065, 1.26, 216.48},
+				{0.00000061, 1.25, 209.37},
+				{0.00000059, 1.82, 323.51},
+				{0.00000046, 0.82, 440.83},
+				{0.00000036, 1.82, 224.34},
+				{0.00000034, 2.84, 117.32},
+				{0.00000033, 1.31, 412.37},
+				{0.00000032, 1.19, 846.08},
+				{0.00000027, 4.65, 1066.5},
+				{0.00000027, 4.44, 11.05},
+			},
+			{
+				{0.0002063, 0.50482, 213.2991},
+				{0.0000372, 3.9983, 206.1855},
+				{0.00001627, 6.1819, 220.4126},
+				{0.00001346, 0, 0},
+				{0.00000706, 3.039, 419.485},
+				{0.00000365, 5.099, 426.598},
+				{0.0000033, 5.279, 433.712},
+				{0.00000219, 3.828, 639.897},
+				{0.00000139, 1.043, 7.114},
+				{0.00000104, 6.157, 227.526},
+				{0.00000093, 1.98, 316.39},
+				{0.00000071, 4.15, 199.07},
+				{0.00000052, 2.88, 632.78},
+				{0.00000049, 4.43, 647.01},
+				{0.00000041, 3.16, 853.2},
+				{0.00000029, 4.53, 210.12},
+				{0.00000024, 1.12, 14.23},
+				{0.00000021, 4.35, 217.23},
+				{0.0000002, 5.31, 440.83},
+				{0.00000018, 0.85, 110.21},
+				{0.00000017, 5.68, 216.48},
+				{0.00000016, 4.26, 103.09},
+				{0.00000014, 3, 412.37},
+				{0.00000012, 2.53, 529.69},
+				{0.00000008, 3.32, 202.25},
+				{0.00000007, 5.56, 209.37},
+				{0.00000007, 0.29, 323.51},
+				{0.00000006, 1.16, 117.32},
+				{0.00000006, 3.61, 869.31},
+			},
+			{
+				{0.00000666, 1.99, 213.299},
+				{0.00000632, 5.698, 206.186},
+				{0.00000398, 0, 0},
+				{0.00000188, 4.338, 220.413},
+				{0.00000092, 4.84, 419.48},
+				{0.00000052, 3.42, 433.71},
+				{0.00000042, 2.38, 426.6},
+				{0.00000026, 4.4, 227.53},
+				{0.00000021, 5.85, 199.07},
+				{0.00000018, 1.99, 639.9},
+				{0.00000011, 5.37, 7.11},
+				{0.0000001, 2.55, 647.01},
+				{0.00000007, 3.46, 316.39},
+				{0.00000006, 4.8, 632.78},
+				{0.00000006, 0.02, 210.12},
+				{0.00000006, 3.52, 440.83},
+				{0.00000005, 5.64, 14.23},
+				{0.00000005, 1.22, 853.2},
+				{0.00000004, 4.71, 412.37},
+				{0.00000003, 0.63, 103.09},
+				{0.00000002, 3.72, 216.48},
+			},
+			{
+				{0.0000008, 1.12, 206.19},
+				{0.00000032, 3.12, 213.3},
+				{0.00000017, 2.48, 220.41},
+				{0.00000012, 3.14, 0},
+				{0.00000009, 0.38, 419.48},
+				{0.00000006, 1.56, 433.71},
+				{0.00000005, 2.63, 227.53},
+				{0.00000005, 1.28, 199.07},
+				{0.00000001, 1.43, 426.6},
+				{0.00000001, 0.67, 647.01},
+				{0.00000001, 1.72, 440.83},
+				{0.00000001, 6.18, 639.9},
+			},
+			{
+				{0.00000008, 2.82, 206.19},
+				{0.00000001, 0.51, 220.41},
+			},
+		},
+		// Radius
+		{
+			{
+				{9.55758136, 0, 0},
+				{0.52921382, 2.3922622, 213.29909544},
+				{0.0187368, 5.2354961, 206.1855484},
+				{0.01464664, 1.6476305, 426.5981909},
+				{0.00821891, 5.9352, 316.39187},
+				{0.00547507, 5.015326, 103.092774},
+				{0.00371684, 2.271148, 220.412642},
+				{0.00361778, 3.139043, 7.113547},
+				{0.00140618, 5.704067, 632.783739},
+				{0.00108975, 3.293136, 110.206321},
+				{0.00069007, 5.941, 419.48464},
+				{0.00061053, 0.94038, 639.89729},
+				{0.00048913, 1.55733, 202.2534},
+				{0.00034144, 0.19519, 277.03499},
+				{0.00032402, 5.47085, 949.17561},
+				{0.00020937, 0.46349, 735.87651},
+				{0.00020839, 1.52103, 433.71174},
+				{0.00020747, 5.33256, 199.072},
+				{0.00015298, 3.05944, 529.69097},
+				{0.00014296, 2.60434, 323.50542},
+				{0.00012884, 1.64892, 138.5175},
+				{0.00011993, 5.98051, 846.08283},
+				{0.0001138, 1.73106, 522.57742},
+				{0.00009796, 5.2048, 1265.5675},
+				{0.00007753, 5.8519, 95.9792},
+				{0.00006771, 3.0043, 14.2271},
+				{0.00006466, 0.1773, 1052.2684},
+				{0.0000585, 1.4552, 415.5525},
+				{0.00005307, 0.5974, 63.7359},
+				{0.00004696, 2.1492, 227.5262},
+				{0.00004044, 1.6401, 209.3669},
+				{0.00003688, 0.7802, 412.3711},
+				{0.00003461, 1.8509, 175.1661},
+				{0.0000342, 4.9455, 1581.9593},
+				{0.00003401, 0.5539, 350.3321},
+				{0.00003376, 3.6953, 224.3448},
+				{0.00002976, 5.6847, 210.1177},
+				{0.00002885, 1.3876, 838.9693},
+				{0.00002881, 0.1796, 853.1964},
+				{0.00002508, 3.5385, 742.9901},
+				{0.00002448, 6.1841, 1368.6603},
+				{0.00002406, 2.9656, 117.3199},
+				{0.00002174, 0.0151, 340.7709},
+				{0.00002024, 5.0541, 11.0457},
+			},
+			{
+				{0.06182981, 0.2584352, 213.2990954},
+				{0.00506578, 0.711147, 206.185548},
+				{0.00341394, 5.796358, 426.598191},
+				{0.00188491, 0.472157, 220.412642},
+				{0.00186262, 3.141593, 0},
+				{0.00143891, 1.407449, 7.113547},
+				{0.00049621, 6.01744, 103.09277},
+				{0.00020928, 5.09246, 639.89729},
+				{0.00019953, 1.1756, 419.48464},
+				{0.0001884, 1.6082, 110.20632},
+				{0.00013877, 0.75886, 199.072},
+				{0.00012893, 5.9433, 433.71174},
+				{0.00005397, 1.2885, 14.2271},
+				{0.00004869, 0.8679, 323.5054},
+				{0.00004247, 0.393, 227.5262},
+				{0.00003252, 1.2585, 95.9792},
+				{0.00003081, 3.4366, 522.5774},
+				{0.00002909, 4.6068, 202.2534},
+				{0.00002856, 2.1673, 735.8765},
+				{0.00001988, 2.4505, 412.3711},
+				{0.00001941, 6.0239, 209.3669},
+				{0.00001581, 1.2919, 210.1177},
+				{0.0000134, 4.308, 853.1964},
+				{0.00001316, 1.253, 117.3199},
+				{0.00001203, 1.8665, 316.3919},
+				{0.00001091, 0.0753, 216.4805},
+				{0.00000966, 0.48, 632.784},
+				{0.00000954, 5.152, 647.011},
+				{0.00000898, 0.983, 529.691},
+				{0.00000882, 1.885, 1052.268},
+				{0.00000874, 1.402, 224.345},
+				{0.00000785, 3.064, 838.969},
+				{0.0000074, 1.382, 625.67},
+				{0.00000658, 4.144, 309.278},
+				{0.0000065, 1.725, 742.99},
+				{0.00000613, 3.033, 63.736},
+				{0.00000599, 2.549, 217.231},
+				{0.00000503, 2.13, 3.932},
+			},
+			{
+				{0.00436902, 4.786717, 213.299095},
+				{0.00071923, 2.5007, 206.18555},
+				{0.00049767, 4.97168, 220.41264},
+				{0.00043221, 3.8694, 426.59819},
+				{0.00029646, 5.9631, 7.11355},
+				{0.00004721, 2.4753, 199.072},
+				{0.00004142, 4.1067, 433.7117},
+				{0.00003789, 3.0977, 639.8973},
+				{0.00002964, 1.3721, 103.0928},
+				{0.00002556, 2.8507, 419.4846},
+				{0.00002327, 0, 0},
+				{0.00002208, 6.2759, 110.2063},
+				{0.00002188, 5.8555, 14.2271},
+				{0.00001957, 4.9245, 227.5262},
+				{0.00000924, 5.464, 323.505},
+				{0.00000706, 2.971, 95.979},
+				{0.00000546, 4.129, 412.371},
+				{0.00000431, 5.178, 522.577},
+				{0.00000405, 4.173, 209.367},
+				{0.00000391, 4.481, 216.48},
+				{0.00000374, 5.834, 117.32},
+				{0.00000361, 3.277, 647.011},
+				{0.00000356, 3.192, 210.118},
+				{0.00000326, 2.269, 853.196},
+				{0.00000207, 4.022, 735.877},
+				{0.00000204, 0.088, 202.253},
+				{0.0000018, 3.597, 632.784},
+				{0.00000178, 4.097, 440.825},
+				{0.00000154, 3.135, 625.67},
+				{0.00000148, 0.136, 302.165},
+				{0.00000133, 2.594, 191.958},
+				{0.00000132, 5.933, 309.278},
+			},
+			{
+				{0.00020315, 3.02187, 213.2991},
+				{0.00008924, 3.1914, 220.4126},
+				{0.00006909, 4.3517, 206.1855},
+				{0.00004087, 4.2241, 7.1135},
+				{0.00003879, 2.0106, 426.5982},
+				{0.00001071, 4.2036, 199.072},
+				{0.00000907, 2.283, 433.712},
+				{0.00000606, 3.175, 227.526},
+				{0.00000597, 4.135, 14.227},
+				{0.00000483, 1.173, 639.897},
+				{0.00000393, 0, 0},
+				{0.00000229, 4.698, 419.485},
+				{0.00000188, 4.59, 110.206},
+				{0.0000015, 3.202, 103.093},
+				{0.00000121, 3.768, 323.505},
+				{0.00000102, 4.71, 95.979},
+				{0.00000101, 5.819, 412.371},
+				{0.00000093, 1.44, 647.01},
+				{0.00000084, 2.63, 216.48},
+				{0.00000073, 4.15, 117.32},
+				{0.00000062, 2.31, 440.83},
+				{0.00000055, 0.31, 853.2},
+				{0.0000005, 2.39, 209.37},
+				{0.00000045, 4.37, 191.96},
+				{0.00000041, 0.69, 522.58},
+				{0.0000004, 1.84, 302.16},
+				{0.00000038, 5.94, 88.87},
+				{0.00000032, 4.01, 21.34},
+			},
+			{
+				{0.00001202, 1.415, 220.4126},
+				{0.00000708, 1.162, 213.299},
+				{0.00000516, 6.24, 206.186},
+				{0.00000427, 2.469, 7.114},
+				{0.00000268, 0.187, 426.598},
+				{0.0000017, 5.959, 199.072},
+				{0.0000015, 0.48, 433.712},
+				{0.00000145, 1.442, 227.526},
+				{0.00000121, 2.405, 14.227},
+				{0.00000047, 5.57, 639.9},
+				{0.00000019, 5.86, 647.01},
+				{0.00000017, 0.53, 440.83},
+				{0.00000016, 2.9, 110.21},
+				{0.00000015, 0.3, 419.48},
+				{0.00000014, 1.3, 412.37},
+				{0.00000013, 2.09, 323.51},
+				{0.00000011, 0.22, 95.98},
+				{0.00000011, 2.46, 117.32},
+				{0.0000001, 3.14, 0},
+				{0.00000009, 1.56, 88.87},
+				{0.00000009, 2.28, 21.34},
+				{0.00000009, 0.68, 216.48},
+				{0.00000008, 1.27, 234.64},
+			},
+			{
+				{0.00000129, 5.913, 220.413},
+				{0.00000032, 0.69, 7.11},
+				{0.00000027, 5.91, 227.53},
+				{0.0000002, 4.95, 433.71},
+				{0.0000002, 0.67, 14.23},
+				{0.00000014, 2.67, 206.19},
+				{0.00000014, 1.46, 199.07},
+				{0.00000013, 4.59, 426.6},
+				{0.00000007, 4.63, 213.3},
+				{0.00000005, 3.61, 639.9},
+				{0.00000004, 4.9, 440.83},
+				{0.00000003, 4.07, 647.01},
+				{0.00000003, 4.66, 191.96},
+				{0.00000003, 0.49, 323.51},
+				{0.00000003, 3.18, 419.48},
+				{0.00000002, 3.7, 88.87},
+				{0.00000002, 3.32, 95.98},
+				{0.00000002, 0.56, 117.32},
+			},
+		},
+	},
+	Uranus: {
+		// Longitude
+		{
+			{
+				{5.48129294, 0, 0},
+				{0.09260408, 0.8910642, 74.7815986},
+				{0.01504248, 3.6271926, 1.4844727},
+				{0.00365982, 1.899622, 73.297126},
+				{0.00272328, 3.358237, 149.563197},
+				{0.00070328, 5.39254, 63.7359},
+				{0.00068893, 6.09292, 76.26607},
+				{0.00061999, 2.26952, 2.96895},
+				{0.00061951, 2.85099, 11.0457},
+				{0.00026469, 3.14152, 71.81265},
+				{0.00025711, 6.1138, 454.90937},
+				{0.00021079, 4.36059, 148.07872},
+				{0.00017819, 1.74437, 36.64856},
+				{0.00014613, 4.73732, 3.93215},
+				{0.00011163, 5.82682, 224.3448},
+				{0.00010998, 0.48865, 138.5175},
+				{0.00009527, 2.9552, 35.1641},
+				{0.00007546, 5.2363, 109.9457},
+				{0.0000422, 3.2333, 70.8494},
+				{0.00004052, 2.2775, 151.0477},
+				{0.0000349, 5.4831, 146.5943},
+				{0.00003355, 1.0655, 4.4534},
+				{0.00003144, 4.752, 77.7505},
+				{0.00002927, 4.629, 9.5612},
+				{0.00002922, 5.3524, 85.8273},
+				{0.00002273, 4.366, 70.3282},
+				{0.00002149, 0.6075, 38.133},
+				{0.00002051, 1.5177, 0.1119},
+				{0.00001992, 4.9244, 277.035},
+				{0.00001667, 3.6274, 380.1278},
+				{0.00001533, 2.5859, 52.6902},
+				{0.00001376, 2.0428, 65.2204},
+				{0.00001372, 4.1964, 111.4302},
+				{0.00001284, 3.1135, 202.2534},
+				{0.00001282, 0.5427, 222.8603},
+				{0.00001244, 0.9161, 2.4477},
+				{0.00001221, 0.199, 108.4612},
+				{0.00001151, 4.179, 33.6796},
+				{0.0000115, 0.9334, 3.1814},
+				{0.0000109, 1.775, 12.5302},
+				{0.00001072, 0.2356, 62.2514},
+				{0.00000946, 1.192, 127.472},
+				{0.00000708, 5.183, 213.299},
+				{0.00000653, 0.966, 78.714},
+				{0.00000628, 0.182, 984.6},
+				{0.00000607, 5.432, 529.691},
+				{0.00000559, 3.358, 0.521},
+				{0.00000524, 2.013, 299.126},
+				{0.00000483, 2.106, 0.963},
+				{0.00000471, 1.407, 184.727},
+				{0.00000467, 0.415, 145.11},
+				{0.00000434, 5.521, 183.243},
+				{0.00000405, 5.987, 8.077},
+				{0.00000399, 0.338, 415.552},
+				{0.00000396, 5.87, 351.817},
+				{0.00000379, 2.35, 56.622},
+				{0.0000031, 5.833, 145.631},
+				{0.000003, 5.644, 22.091},
+				{0.00000294, 5.839, 39.618},
+				{0.00000252, 1.637, 221.376},
+				{0.00000249, 4.746, 225.829},
+				{0.00000239, 2.35, 137.033},
+				{0.00000224, 0.516, 84.343},
+				{0.00000223, 2.843, 0.261},
+				{0.0000022, 1.922, 67.668},
+				{0.00000217, 6.142, 5.938},
+				{0.00000216, 4.778, 340.771},
+				{0.00000208, 5.58, 68.844},
+				{0.00000202, 1.297, 0.048},
+				{0.00000199, 0.956, 152.532},
+				{0.00000194, 1.888, 456.394},
+				{0.00000193, 0.916, 453.425},
+				{0.00000187, 1.319, 0.16},
+				{0.00000182, 3.536, 79.235},
+				{0.00000173, 1.539, 160.609},
+				{0.00000172, 5.68, 219.891},
+				{0.0000017, 3.677, 5.417},
+				{0.00000169, 5.879, 18.159},
+				{0.00000165, 1.424, 106.977},
+				{0.00000163, 3.05, 112.915},
+				{0.00000158, 0.738, 54.175},
+				{0.00000147, 1.263, 59.804},
+				{0.00000143, 1.3, 35.425},
+				{0.00000139, 5.386, 32.195},
+				{0.00000139, 4.26, 909.819},
+				{0.00000124, 1.374, 7.114},
+				{0.0000011, 2.027, 554.07},
+				{0.00000109, 5.706, 77.963},
+				{0.00000104, 5.028, 0.751},
+				{0.00000104, 1.458, 24.379},
+				{0.00000103, 0.681, 14.978},
+			},
+			{
+				{75.02543122, 0, 0},
+				{0.00154458, 5.242017, 74.781599},
+				{0.00024456, 1.71256, 1.48447},
+				{0.00009258, 0.4284, 11.0457},
+				{0.00008266, 1.5022, 63.7359},
+				{0.00007842, 1.3198, 149.5632},
+				{0.00003899, 0.4648, 3.9322},
+				{0.00002284, 4.1737, 76.2661},
+				{0.00001927, 0.5301, 2.9689},
+				{0.00001233, 1.5863, 70.8495},
+				{0.00000791, 5.436, 3.181},
+				{0.00000767, 1.996, 73.297},
+				{0.00000482, 2.984, 85.827},
+				{0.0000045, 4.138, 138.517},
+				{0.00000446, 3.723, 224.345},
+				{0.00000427, 4.731, 71.813},
+				{0.00000354, 2.583, 148.079},
+				{0.00000348, 2.454, 9.561},
+				{0.00000317, 5.579, 52.69},
+				{0.00000206, 2.363, 2.448},
+				{0.00000189, 4.202, 56.622},
+				{0.00000184, 0.284, 151.048},
+				{0.0000018, 5.684, 12.53},
+				{0.00000171, 3.001, 78.714},
+				{0.00000158, 2.909, 0.963},
+				{0.00000155, 5.591, 4.453},
+				{0.00000154, 4.652, 35.164},
+				{0.00000152, 2.942, 77.751},
+				{0.00000143, 2.59, 62.251},
+				{0.00000121, 4.148, 127.472},
+				{0.00000116, 3.732, 65.22},
+				{0.00000102, 4.188, 145.631},
+				{0.00000102, 6.034, 0.112},
+				{0.00000088, 3.99, 18.16},
+				{0.00000088, 6.16, 202.25},
+				{0.00000081, 2.64, 22.09},
+				{0.00000072, 6.05, 70.33},
+				{0.00000069, 4.05, 77.96},
+				{0.00000059, 3.7, 67.67},
+				{0.00000047, 3.54, 351.82},
+				{0.00000044, 5.91, 7.11},
+				{0.00000043, 5.72, 5.42},
+				{0.00000039, 4.92, 222.86},
+				{0.00000036, 5.9, 33.68},
+				{0.00000036, 3.29, 8.08},
+				{0.00000036, 3.33, 71.6},
+				{0.00000035, 5.08, 38.13},
+				{0.00000031, 5.62, 984.6},
+				{0.00000031, 5.5, 59.8},
+				{0.00000031, 5.46, 160.61},
+				{0.0000003, 1.66, 447.8},
+				{0.00000029, 1.15, 462.02},
+				{0.00000029, 4.52, 84.34},
+				{0.00000027, 5.54, 131.4},
+				{0.00000027, 6.15, 299.13},
+				{0.00000026, 4.99, 137.03},
+				{0.00000025, 5.74, 380.13},
+			},
+			{
+				{0.00053033, 0, 0},
+				{0.00002358, 2.2601, 74.7816},
+				{0.00000769, 4.526, 11.046},
+				{0.00000552, 3.258, 63.736},
+				{0.00000542, 2.276, 3.932},
+				{0.00000529, 4.923, 1.484},
+				{0.00000258, 3.691, 3.181},
+				{0.00000239, 5.858, 149.563},
+				{0.00000182, 6.218, 70.849},
+				{0.00000054, 1.44, 76.27},
+				{0.00000049, 6.03, 56.62},
+				{0.00000045, 3.91, 2.45},
+				{0.00000045, 0.81, 85.83},
+				{0.00000038, 1.78, 52.69},
+				{0.00000037, 4.46, 2.97},
+				{0.00000033, 0.86, 9.56},
+				{0.00000029, 5.1, 73.3},
+				{0.00000024, 2.11, 18.16},
+				{0.00000022, 5.99, 138.52},
+				{0.00000022, 4.82, 78.71},
+				{0.00000021, 2.4, 77.96},
+				{0.00000021, 2.17, 224.34},
+				{0.00000017, 2.54, 145.63},
+				{0.00000017, 3.47, 12.53},
+				{0.00000012, 0.02, 22.09},
+				{0.00000011, 0.08, 127.47},
+				{0.0000001, 5.16, 71.6},
+				{0.0000001, 4.46, 62.25},
+				{0.00000009, 4.26, 7.11},
+				{0.00000008, 5.5, 67.67},
+				{0.00000007, 1.25, 5.42},
+				{0.00000006, 3.36, 447.8},
+				{0.00000006, 5.45, 65.22},
+				{0.00000006, 4.52, 151.05},
+				{0.00000006, 5.73, 462.02},
+			},
+			{
+				{0.00000121, 0.024, 74.782},
+				{0.00000068, 4.12, 3.93},
+				{0.00000053, 2.39, 11.05},
+				{0.00000046, 0, 0},
+				{0.00000045, 2.04, 3.18},
+				{0.00000044, 2.96, 1.48},
+				{0.00000025, 4.89, 63.74},
+				{0.00000021, 4.55, 70.85},
+				{0.0000002, 2.31, 149.56},
+				{0.00000009, 1.58, 56.62},
+				{0.00000004, 0.23, 18.16},
+				{0.00000004, 5.39, 76.27},
+				{0.00000004, 0.95, 77.96},
+				{0.00000003, 4.98, 85.83},
+				{0.00000003, 4.13, 52.69},
+				{0.00000003, 0.37, 78.71},
+				{0.00000002, 0.86, 145.63},
+				{0.00000002, 5.66, 9.56},
+			},
+			{
+				{0.00000114, 3.142, 0},
+				{0.00000006, 4.58, 74.78},
+				{0.00000003, 0.35, 11.05},
+				{0.00000001, 3.42, 56.62},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.01346278, 2.6187781, 74.7815986},
+				{0.00062341, 5.08111, 149.5632},
+				{0.00061601, 3.14159, 0},
+				{0.00009964, 1.616, 76.2661},
+				{0.00009926, 0.5763, 73.2971},
+				{0.00003259, 1.2612, 224.3448},
+				{0.00002972, 2.2437, 1.4845},
+				{0.0000201, 6.0555, 148.0787},
+				{0.00001522, 0.2796, 63.7359},
+				{0.00000924, 4.038, 151.048},
+				{0.00000761, 6.14, 71.813},
+				{0.00000522, 3.321, 138.517},
+				{0.00000463, 0.743, 85.827},
+				{0.00000437, 3.381, 529.691},
+				{0.00000435, 0.341, 77.751},
+				{0.00000431, 3.554, 213.299},
+				{0.0000042, 5.213, 11.046},
+				{0.00000245, 0.788, 2.969},
+				{0.00000233, 2.257, 222.86},
+				{0.00000216, 1.591, 38.133},
+				{0.0000018, 3.725, 299.126},
+				{0.00000175, 1.236, 146.594},
+				{0.00000174, 1.937, 380.128},
+				{0.0000016, 5.336, 111.43},
+				{0.00000144, 5.962, 35.164},
+				{0.00000116, 5.739, 70.849},
+				{0.00000106, 0.941, 70.328},
+				{0.00000102, 2.619, 78.714},
+			},
+			{
+				{0.00206366, 4.123943, 74.781599},
+				{0.00008563, 0.3382, 149.5632},
+				{0.00001726, 2.1219, 73.2971},
+				{0.00001374, 0, 0},
+				{0.00001369, 3.0686, 76.2661},
+				{0.00000451, 3.777, 1.484},
+				{0.000004, 2.848, 224.345},
+				{0.00000307, 1.255, 148.079},
+				{0.00000154, 3.786, 63.736},
+				{0.00000112, 5.573, 151.048},
+				{0.00000111, 5.329, 138.517},
+				{0.00000083, 3.59, 71.81},
+				{0.00000056, 3.4, 85.83},
+				{0.00000054, 1.7, 77.75},
+				{0.00000042, 1.21, 11.05},
+				{0.00000041, 4.45, 78.71},
+				{0.00000032, 3.77, 222.86},
+				{0.0000003, 2.56, 2.97},
+				{0.00000027, 5.34, 213.3},
+				{0.00000026, 0.42, 380.13},
+			},
+			{
+				{0.00009212, 5.8004, 74.7816},
+				{0.00000557, 0, 0},
+				{0.00000286, 2.177, 149.563},
+				{0.00000095, 3.84, 73.3},
+				{0.00000045, 4.88, 76.27},
+				{0.0000002, 5.46, 1.48},
+				{0.00000015, 0.88, 138.52},
+				{0.00000014, 2.85, 148.08},
+				{0.00000014, 5.07, 63.74},
+				{0.0000001, 5, 224.34},
+				{0.00000008, 6.27, 78.71},
+			},
+			{
+				{0.00000268, 1.251, 74.782},
+				{0.00000011, 3.14, 0},
+				{0.00000006, 4.01, 149.56},
+				{0.00000003, 5.78, 73.3},
+			},
+			{
+				{0.00000006, 2.85, 74.78},
+			},
+		},
+		// Radius
+		{
+			{
+				{19.21264848, 0, 0},
+				{0.88784984, 5.60377527, 74.78159857},
+				{0.03440836, 0.328361, 73.2971259},
+				{0.02055653, 1.7829517, 149.5631971},
+				{0.00649322, 4.522473, 76.266071},
+				{0.00602248, 3.860038, 63.735898},
+				{0.00496404, 1.401399, 454.909367},
+				{0.00338526, 1.580027, 138.517497},
+				{0.00243508, 1.570866, 71.812653},
+				{0.00190522, 1.998094, 1.484473},
+				{0.00161858, 2.791379, 148.078724},
+				{0.00143706, 1.383686, 11.0457},
+				{0.00093192, 0.17437, 36.64856},
+				{0.00089806, 3.66105, 109.94569},
+				{0.00071424, 4.24509, 224.3448},
+				{0.00046677, 1.39977, 35.16409},
+				{0.00039026, 3.36235, 277.03499},
+				{0.0003901, 1.66971, 70.84945},
+				{0.00036755, 3.88649, 146.59425},
+				{0.00030349, 0.701, 151.04767},
+				{0.00029156, 3.18056, 77.75054},
+				{0.00025786, 3.78538, 85.8273},
+				{0.0002562, 5.25656, 380.12777},
+				{0.00022637, 0.72519, 529.69097},
+				{0.00020473, 2.7964, 70.32818},
+				{0.00020472, 1.55589, 202.2534},
+				{0.00017901, 0.55455, 2.96895},
+				{0.00015503, 5.35405, 38.13304},
+				{0.00014702, 4.90434, 108.46122},
+				{0.00012897, 2.62154, 111.43016},
+				{0.00012328, 5.96039, 127.4718},
+				{0.00011959, 1.75044, 984.60033},
+				{0.00011853, 0.99343, 52.6902},
+				{0.00011696, 3.29826, 3.93215},
+				{0.00011495, 0.43774, 65.22037},
+				{0.00010793, 1.42105, 213.2991},
+				{0.00009111, 4.9964, 62.2514},
+				{0.00008421, 5.2535, 222.8603},
+				{0.00008402, 5.0388, 415.5525},
+				{0.00007449, 0.7949, 351.8166},
+				{0.00007329, 3.9728, 183.2428},
+				{0.00006046, 5.6796, 78.7138},
+				{0.00005524, 3.115, 9.5612},
+				{0.00005445, 5.1058, 145.1098},
+				{0.00005238, 2.6296, 33.6796},
+				{0.00004079, 3.2206, 340.7709},
+				{0.00003919, 4.2502, 39.6175},
+				{0.00003802, 6.1099, 184.7273},
+				{0.00003781, 3.4584, 456.3938},
+				{0.00003687, 2.4872, 453.4249},
+				{0.00003102, 4.1403, 219.8914},
+				{0.00002963, 0.8298, 56.6224},
+				{0.00002942, 0.4239, 299.1264},
+				{0.0000294, 2.1464, 137.033},
+				{0.00002938, 3.6766, 140.002},
+				{0.00002865, 0.31, 12.5302},
+				{0.00002538, 4.8546, 131.4039},
+				{0.00002364, 0.4425, 554.07},
+				{0.00002183, 2.9404, 305.3462},
+			},
+			{
+				{0.01479896, 3.6720571, 74.7815986},
+				{0.00071212, 6.22601, 63.7359},
+				{0.00068627, 6.13411, 149.5632},
+				{0.0002406, 3.14159, 0},
+				{0.00021468, 2.60177, 76.26607},
+				{0.00020857, 5.24625, 11.0457},
+				{0.00011405, 0.01848, 70.84945},
+				{0.00007497, 0.4236, 73.2971},
+				{0.00004244, 1.4169, 85.8273},
+				{0.00003927, 3.1551, 71.8127},
+				{0.00003578, 2.3116, 224.3448},
+				{0.00003506, 2.5835, 138.5175},
+				{0.00003229, 5.255, 3.9322},
+				{0.0000306, 0.1532, 1.4845},
+				{0.00002564, 0.9808, 148.0787},
+				{0.00002429, 3.9944, 52.6902},
+				{0.00001645, 2.6535, 127.4718},
+				{0.00001584, 1.4305, 78.7138},
+				{0.00001508, 5.06, 151.0477},
+				{0.0000149, 2.6756, 56.6224},
+				{0.00001413, 4.5746, 202.2534},
+				{0.00001403, 1.3699, 77.7505},
+				{0.00001228, 1.047, 62.2514},
+				{0.00001033, 0.2646, 131.4039},
+				{0.00000992, 2.172, 65.22},
+				{0.00000862, 5.055, 351.817},
+				{0.00000744, 3.076, 35.164},
+				{0.00000687, 2.499, 77.963},
+				{0.00000647, 4.473, 70.328},
+				{0.00000624, 0.863, 9.561},
+				{0.00000604, 0.907, 984.6},
+				{0.00000575, 3.231, 447.796},
+				{0.00000562, 2.718, 462.023},
+				{0.0000053, 5.917, 213.299},
+				{0.00000528, 5.151, 2.969},
+			},
+			{
+				{0.0002244, 0.69953, 74.7816},
+				{0.00004727, 1.699, 63.7359},
+				{0.00001682, 4.6483, 70.8494},
+				{0.0000165, 3.0966, 11.0457},
+				{0.00001434, 3.5212, 149.5632},
+				{0.0000077, 0, 0},
+				{0.000005, 6.172, 76.266},
+				{0.00000461, 0.767, 3.932},
+				{0.0000039, 4.496, 56.622},
+				{0.0000039, 5.527, 85.827},
+				{0.00000292, 0.204, 52.69},
+				{0.00000287, 3.534, 73.297},
+				{0.00000273, 3.847, 138.517},
+				{0.0000022, 1.964, 131.404},
+				{0.00000216, 0.848, 77.963},
+				{0.00000205, 3.248, 78.714},
+				{0.00000149, 4.898, 127.472},
+				{0.00000129, 2.081, 3.181},
+			},
+			{
+				{0.00001164, 4.7345, 74.7816},
+				{0.00000212, 3.343, 63.736},
+				{0.00000196, 2.98, 70.849},
+				{0.00000105, 0.958, 11.046},
+				{0.00000073, 1, 149.56},
+				{0.00000072, 0.03, 56.62},
+				{0.00000055, 2.59, 3.93},
+				{0.00000036, 5.65, 77.96},
+				{0.00000034, 3.82, 76.27},
+				{0.00000032, 3.6, 131.4},
+			},
+			{
+				{0.00000053, 3.01, 74.78},
+				{0.0000001, 1.91, 56.62},
+			},
+		},
+	},
+	Neptune: {
+		// Longitude
+		{
+			{
+				{5.31188633, 0, 0},
+				{0.01798476, 2.9010127, 38.1330356},
+				{0.01019728, 0.4858092, 1.4844727},
+				{0.00124532, 4.830081, 36.648563},
+				{0.00042064, 5.41055, 2.96895},
+				{0.00037715, 6.09222, 35.16409},
+				{0.00033785, 1.24489, 76.26607},
+				{0.00016483, 0.00008, 491.55793},
+				{0.00009199, 4.9375, 39.6175},
+				{0.00008994, 0.2746, 175.1661},
+				{0.00004216, 1.9871, 73.2971},
+				{0.00003365, 1.0359, 33.6796},
+				{0.00002285, 4.2061, 4.4534},
+				{0.00001434, 2.7834, 74.7816},
+				{0.000009, 2.076, 109.946},
+				{0.00000745, 3.19, 71.813},
+				{0.00000506, 5.748, 114.399},
+				{0.000004, 0.35, 1021.249},
+				{0.00000345, 3.462, 41.102},
+				{0.0000034, 3.304, 77.751},
+				{0.00000323, 2.248, 32.165},
+				{0.00000306, 0.497, 0.521},
+				{0.00000287, 4.505, 0.048},
+				{0.00000282, 2.246, 146.594},
+				{0.00000267, 4.889, 0.963},
+				{0.00000252, 5.782, 388.465},
+				{0.00000245, 1.247, 9.561},
+				{0.00000233, 2.505, 137.033},
+				{0.00000227, 1.797, 453.425},
+				{0.0000017, 3.324, 108.461},
+				{0.00000151, 2.192, 33.94},
+				{0.0000015, 2.997, 5.938},
+				{0.00000148, 0.859, 111.43},
+				{0.00000119, 3.677, 2.448},
+				{0.00000109, 2.416, 183.243},
+				{0.00000103, 0.041, 0.261},
+				{0.00000103, 4.404, 70.328},
+				{0.00000102, 5.705, 0.112},
+			},
+			{
+				{38.37687717, 0, 0},
+				{0.00016604, 4.86319, 1.48447},
+				{0.00015807, 2.27923, 38.13304},
+				{0.00003335, 3.682, 76.2661},
+				{0.00001306, 3.6732, 2.9689},
+				{0.00000605, 1.505, 35.164},
+				{0.00000179, 3.453, 39.618},
+				{0.00000107, 2.451, 4.453},
+				{0.00000106, 2.755, 33.68},
+				{0.00000073, 5.49, 36.65},
+				{0.00000057, 1.86, 114.4},
+				{0.00000057, 5.22, 0.52},
+				{0.00000035, 4.52, 74.78},
+				{0.00000032, 5.9, 77.75},
+				{0.0000003, 3.67, 388.47},
+				{0.00000029, 5.17, 9.56},
+				{0.00000029, 5.17, 2.45},
+				{0.00000026, 5.25, 168.05},
+			},
+			{
+				{0.00053893, 0, 0},
+				{0.00000296, 1.855, 1.484},
+				{0.00000281, 1.191, 38.133},
+				{0.0000027, 5.721, 76.266},
+				{0.00000023, 1.21, 2.97},
+				{0.00000009, 4.43, 35.16},
+				{0.00000007, 0.54, 2.45},
+			},
+			{
+				{0.00000031, 0, 0},
+				{0.00000015, 1.35, 76.27},
+				{0.00000012, 6.04, 1.48},
+				{0.00000012, 6.11, 38.13},
+			},
+			{
+				{0.00000114, 3.142, 0},
+			},
+		},
+		// Latitude
+		{
+			{
+				{0.03088623, 1.4410437, 38.1330356},
+				{0.0002778, 5.91272, 76.26607},
+				{0.00027624, 0, 0},
+				{0.00015448, 3.50877, 39.61751},
+				{0.00015355, 2.52124, 36.64856},
+				{0.00002, 1.51, 74.7816},
+				{0.00001968, 4.3778, 1.4845},
+				{0.00001015, 3.2156, 35.1641},
+				{0.00000606, 2.802, 73.297},
+				{0.00000595, 2.129, 41.102},
+				{0.00000589, 3.187, 2.969},
+				{0.00000402, 4.169, 114.399},
+				{0.0000028, 1.682, 77.751},
+				{0.00000262, 3.767, 213.299},
+				{0.00000254, 3.271, 453.425},
+				{0.00000206, 4.257, 529.691},
+				{0.0000014, 3.53, 137.033},
+			},
+			{
+				{0.00227279, 3.807931, 38.133036},
+				{0.00001803, 1.9758, 76.2661},
+				{0.00001433, 3.1416, 0},
+				{0.00001386, 4.8256, 36.6486},
+				{0.00001073, 6.0805, 39.6175},
+				{0.00000148, 3.858, 74.782},
+				{0.00000136, 0.478, 1.484},
+				{0.0000007, 6.19, 35.16},
+				{0.00000052, 5.05, 73.3},
+				{0.00000043, 0.31, 114.4},
+				{0.00000037, 4.89, 41.1},
+				{0.00000037, 5.76, 2.97},
+				{0.00000026, 5.22, 213.3},
+			},
+			{
+				{0.00009691, 5.5712, 38.133},
+				{0.00000079, 3.63, 76.27},
+				{0.00000072, 0.45, 36.65},
+				{0.00000059, 3.14, 0},
+				{0.0000003, 1.61, 39.62},
+				{0.00000006, 5.61, 74.78},
+			},
+			{
+				{0.00000273, 1.017, 38.133},
+				{0.00000002, 0, 0},
+				{0.00000002, 2.37, 36.65},
+				{0.00000002, 5.33, 39.62},
+			},
+			{
+				{0.00000006, 2.67, 38.13},
+			},
+		},
+		// Radius
+		{
+			{
+				{30.07013206, 0, 0},
+				{0.27062259, 1.32999459, 38.13303564},
+				{0.01691764, 3.2518614, 36.6485629},
+				{0.00807831, 5.185928, 1.484473},
+				{0.00537761, 4.521139, 35.16409},
+				{0.00495726, 1.571057, 491.557929},
+				{0.00274572, 1.845523, 175.16606},
+				{0.00135134, 3.372206, 39.617508},
+				{0.00121802, 5.797544, 76.266071},
+				{0.00100895, 0.377027, 73.297126},
+				{0.00069792, 3.79617, 2.96895},
+				{0.00046688, 5.74938, 33.67962},
+				{0.00024594, 0.50802, 109.94569},
+				{0.00016939, 1.59422, 71.81265},
+				{0.0001423, 1.07786, 74.7816},
+				{0.00012012, 1.92062, 1021.24889},
+				{0.00008395, 0.6782, 146.5943},
+				{0.00007572, 1.0715, 388.4652},
+				{0.00005721, 2.5906, 4.4534},
+				{0.0000484, 1.9069, 41.102},
+				{0.00004483, 2.9057, 529.691},
+				{0.00004421, 1.7499, 108.4612},
+				{0.00004354, 0.6799, 32.1645},
+				{0.0000427, 3.4134, 453.4249},
+				{0.00003381, 0.8481, 183.2428},
+				{0.00002881, 1.986, 137.033},
+				{0.00002879, 3.6742, 350.3321},
+				{0.00002636, 3.0976, 213.2991},
+				{0.0000253, 5.7984, 490.0735},
+				{0.00002523, 0.4863, 493.0424},
+				{0.00002306, 2.8096, 70.3282},
+				{0.00002087, 0.6186, 33.9402},
+			},
+			{
+				{0.00236339, 0.70498, 38.133036},
+				{0.0001322, 3.32015, 1.48447},
+				{0.00008622, 6.2163, 35.1641},
+				{0.00002702, 1.8814, 39.6175},
+				{0.00002155, 2.0943, 2.9689},
+				{0.00002153, 5.1687, 76.2661},
+				{0.00001603, 0, 0},
+				{0.00001464, 1.1842, 33.6796},
+				{0.00001136, 3.9189, 36.6486},
+				{0.00000898, 5.241, 388.465},
+				{0.0000079, 0.533, 168.053},
+				{0.0000076, 0.021, 182.28},
+				{0.00000607, 1.077, 1021.249},
+				{0.00000572, 3.401, 484.444},
+				{0.00000561, 2.887, 498.671},
+			},
+			{
+				{0.00004247, 5.8991, 38.133},
+				{0.00000218, 0.346, 1.484},
+				{0.00000163, 2.239, 168.053},
+				{0.00000156, 4.594, 182.28},
+				{0.00000127, 2.848, 35.164},
+			},
+			{
+				{0.00000166, 4.552, 38.133},
+			},
+		},
+	},
+}
@@ -0,0 +1,157 @@
+package ephemeris
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// VSOP87 heliocentric spherical coordinates referred to the mean ecliptic
+// and equinox of date (version D) by Bretagnon and Francou, read from the
+// files distributed by the Centre de Données astronomiques de Strasbourg
+// (VI/81).
+
+// vsop87Threshold is the smallest amplitude kept when the series are
+// truncated, in radians or AU, around 0.002 arcseconds
+const vsop87Threshold = 1e-8
+
+// vsop87Files maps the bodies onto the names of the version D files
+var vsop87Files = map[Body]string{
+	Mercury: "VSOP87D.mer",
+	Venus:   "VSOP87D.ven",
+	Earth:   "VSOP87D.ear",
+	Mars:    "VSOP87D.mar",
+	Jupiter: "VSOP87D.jup",
+	Saturn:  "VSOP87D.sat",
+	Uranus:  "VSOP87D.ura",
+	Neptune: "VSOP87D.nep",
+}
+
+// vsop87Term is A cos(B + C tau)
+type vsop87Term struct {
+	a, b, c float64
+}
+
+// vsop87Series holds the terms of the longitude, latitude and radius series
+// by power of time
+type vsop87Series [3][][]vsop87Term
+
+// VSOP87 is an Ephemeris that sums the truncated VSOP87 series
+type VSOP87 struct {
+	series map[Body]vsop87Series
+	name   string
+}
+
+// NewTruncatedVSOP87 returns the series built into the service, Meeus's
+// truncation of version D
+func NewTruncatedVSOP87() *VSOP87 {
+	return &VSOP87{series: vsop87Truncated, name: "VSOP87D truncated by Meeus"}
+}
+
+// NewVSOP87 loads the version D files for all eight planets from dir
+func NewVSOP87(dir string) (*VSOP87, error) {
+	v := &VSOP87{series: map[Body]vsop87Series{}, name: "VSOP87D"}
+	for body, name := range vsop87Files {
+		s, err := loadVSOP87(filepath.Join(dir, name))
+		if err != nil {
+			return nil, err
+		}
+		v.series[body] = s
+	}
+	return v, nil
+}
+
+// Name -
+func (v *VSOP87) Name() string {
+	return v.name
+}
+
+// Heliocentric -
+func (v *VSOP87) Heliocentric(body Body, jde float64) (longitude, latitude, distance float64, err error) {
+	if body == Pluto {
+		longitude, latitude, distance = plutoOfDate(jde)
+		return longitude, latitude, distance, nil
+	}
+	s, ok := v.series[body]
+	if !ok {
+		return 0, 0, 0, fmt.Errorf("VSOP87 has no series for body %d", body)
+	}
+
+	// tau is in Julian millennia since J2000.0
+	tau := (jde - 2451545.0) / 365250.0
+	var lbr [3]float64
+	for i, variable := range s {
+		power := 1.0
+		for _, terms := range variable {
+			sum := 0.0
+			for _, term := range terms {
+				sum += term.a * math.Cos(term.b+term.c*tau)
+			}
+			lbr[i] += sum * power
+			power *= tau
+		}
+	}
+	return normalise(radiansToDegrees(lbr[0])), radiansToDegrees(lbr[1]), lbr[2], nil
+}
+
+// loadVSOP87 reads a VSOP87 file, each block of terms starts with a header
+// naming the variable (1 to 3 for L, B and R) and the power of time
+func loadVSOP87(path string) (vsop87Series, error) {
+	var s vsop87Series
+	f, err := os.Open(path)
+	if err != nil {
+		return s, fmt.Errorf("loadVSOP87 encountered the following error when opening %s: %v", path, err)
+	}
+	defer f.Close()
+
+	variable, power := -1, -1
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "VSOP87" {
+			variable, power = -1, -1
+			for i, field := range fields {
+				if field == "VARIABLE" && i+1 < len(fields) {
+					variable, _ = strconv.Atoi(fields[i+1])
+					variable--
+				}
+				if strings.HasPrefix(field, "*T**") {
+					power, _ = strconv.Atoi(strings.TrimPrefix(field, "*T**"))
+				}
+			}
+			if variable < 0 || variable > 2 || power < 0 {
+				return s, fmt.Errorf("loadVSOP87 could not read the header on line %d of %s", line, path)
+			}
+			for len(s[variable]) <= power {
+				s[variable] = append(s[variable], []vsop87Term{})
+			}
+			continue
+		}
+		if variable < 0 || len(fields) < 3 {
+			return s, fmt.Errorf("loadVSOP87 found a term outside a block on line %d of %s", line, path)
+		}
+
+		// A, B and C are the last three columns
+		var abc [3]float64
+		for i := range abc {
+			if abc[i], err = strconv.ParseFloat(fields[len(fields)-3+i], 64); err != nil {
+				return s, fmt.Errorf("loadVSOP87 could not read the term on line %d of %s: %v", line, path, err)
+			}
+		}
+		if math.Abs(abc[0]) < vsop87Threshold {
+			continue
+		}
+		s[variable][power] = append(s[variable][power], vsop87Term{a: abc[0], b: abc[1], c: abc[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		return s, fmt.Errorf("loadVSOP87 encountered the following error when reading %s: %v", path, err)
+	}
+	return s, nil
+}
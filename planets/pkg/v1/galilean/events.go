@@ -0,0 +1,129 @@
+package galilean
+
+import (
+	"math"
+	"sort"
+)
+
+// Kind of event
+type Kind int
+
+const (
+	// Transit is the satellite crossing the disc of Jupiter
+	Transit Kind = iota
+	// ShadowTransit is the satellite's shadow crossing the disc
+	ShadowTransit
+	// Occultation is the satellite hidden behind the disc
+	Occultation
+	// Eclipse is the satellite in Jupiter's shadow
+	Eclipse
+)
+
+// Kinds lists every kind of event
+var Kinds = []Kind{Transit, ShadowTransit, Occultation, Eclipse}
+
+const (
+	// step between the samples of the search, in days, shorter than the
+	// briefest grazing event worth reporting
+	step = 2.0 / 1440
+	// maxDuration bounds the search for the end of an event still under way
+	// at the end of the range, Callisto's transits last under six hours
+	maxDuration = 0.5
+)
+
+// Event is a satellite entering and leaving one of the configurations, the
+// times are those the centre of the satellite, or its shadow, crosses the
+// limb of Jupiter as seen from the Earth
+type Event struct {
+	Moon  Moon
+	Kind  Kind
+	Start float64
+	End   float64
+}
+
+// Under reports whether the satellite is in the configuration in the view
+func (k Kind) Under(moon Moon, v View) bool {
+	switch k {
+	case Transit:
+		return v.Earth[moon].OnDisc() && v.Earth[moon].Z > 0
+	case ShadowTransit:
+		return v.Sun[moon].OnDisc() && v.Sun[moon].Z > 0
+	case Occultation:
+		return v.Earth[moon].OnDisc() && v.Earth[moon].Z < 0
+	case Eclipse:
+		return v.Sun[moon].OnDisc() && v.Sun[moon].Z < 0
+	}
+	return false
+}
+
+// Events returns the events that begin between the Julian ephemeris days
+// start and end, in order of their beginning. Events already under way at
+// start are left out and those under way at end are followed to their end.
+func Events(start, end float64) []Event {
+	events := []Event{}
+	// The events under way, by satellite and kind
+	open := map[[2]int]int{}
+
+	previous := Positions(start)
+	skipped := map[[2]int]bool{}
+	for _, moon := range Moons {
+		for _, k := range Kinds {
+			if k.Under(moon, previous) {
+				skipped[[2]int{int(moon), int(k)}] = true
+			}
+		}
+	}
+
+	samples := int(math.Ceil((end - start) / step))
+	for i := 1; len(open) > 0 || i <= samples; i++ {
+		lo, hi := start+float64(i-1)*step, start+float64(i)*step
+		if hi-end > maxDuration {
+			break
+		}
+		next := Positions(hi)
+		for _, moon := range Moons {
+			for _, k := range Kinds {
+				key := [2]int{int(moon), int(k)}
+				was, is := k.Under(moon, previous), k.Under(moon, next)
+				if was == is {
+					continue
+				}
+				moon, k := moon, k
+				at := crossing(func(jde float64) bool {
+					return k.Under(moon, Positions(jde))
+				}, lo, hi, was)
+				switch {
+				case is && i <= samples:
+					open[key] = len(events)
+					events = append(events, Event{Moon: moon, Kind: k, Start: at})
+				case !is && skipped[key]:
+					delete(skipped, key)
+				case !is:
+					if index, ok := open[key]; ok {
+						events[index].End = at
+						delete(open, key)
+					}
+				}
+			}
+		}
+		previous = next
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Start < events[j].Start
+	})
+	return events
+}
+
+// crossing returns the instant between lo and hi where f stops being was
+func crossing(f func(float64) bool, lo, hi float64, was bool) float64 {
+	// Bisecting the two minute step to a tenth of a second
+	for i := 0; i < 11; i++ {
+		mid := (lo + hi) / 2
+		if f(mid) == was {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
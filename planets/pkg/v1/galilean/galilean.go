@@ -0,0 +1,144 @@
+// Package galilean gives the positions of the four great satellites of
+// Jupiter, Io, Europa, Ganymede and Callisto, relative to the planet and
+// predicts when they cross its disc, are hidden behind it, cast their shadows
+// on it or pass through its shadow. The positions follow the lower accuracy
+// method of Meeus, Astronomical Algorithms, chapter 44, which is good to a
+// few hundredths of a radius of Jupiter, a minute or two in the times of the
+// events. Instants are Julian ephemeris days and angles are in degrees.
+package galilean
+
+import "math"
+
+// Moon of Jupiter
+type Moon int
+
+const (
+	// Io -
+	Io Moon = iota
+	// Europa -
+	Europa
+	// Ganymede -
+	Ganymede
+	// Callisto -
+	Callisto
+)
+
+// Moons lists the satellites in order of distance from Jupiter
+var Moons = []Moon{Io, Europa, Ganymede, Callisto}
+
+// polarScale stretches the coordinate north of the equator so that the
+// flattened disc of Jupiter becomes a circle of radius one
+const polarScale = 1.071374
+
+// Position of a satellite relative to the centre of Jupiter, in equatorial
+// radii of the planet
+type Position struct {
+	// X is measured westward along Jupiter's equator and Y northward along
+	// its axis, as seen by the observer
+	X, Y float64
+	// Z is measured towards the observer, positive when the satellite is
+	// nearer than Jupiter
+	Z float64
+}
+
+// OnDisc reports whether the satellite lies within the outline of Jupiter,
+// in front of it or behind it
+func (p Position) OnDisc() bool {
+	y := p.Y * polarScale
+	return p.X*p.X+y*y < 1
+}
+
+// View of the satellites at an instant, from the Earth and from the sun
+type View struct {
+	// Earth holds the positions seen from the Earth, Sun those seen from the
+	// sun, which put the satellites' shadows on the disc
+	Earth [4]Position
+	Sun   [4]Position
+	// Declinations of the Earth and the sun in Jupiter's equatorial system
+	EarthDeclination float64
+	SunDeclination   float64
+}
+
+// Positions returns the view of the satellites at the Julian ephemeris day
+// jde, allowing for the time their light takes to reach the Earth
+func Positions(jde float64) View {
+	d := jde - 2451545.0
+	v := 172.74 + 0.00111588*d
+	m := 357.529 + 0.9856003*d
+	n := 20.020 + 0.0830853*d + 0.329*sin(v)
+	j := 66.115 + 0.9025179*d - 0.329*sin(v)
+	// The equations of the centre of the Earth and of Jupiter
+	a := 1.915*sin(m) + 0.020*sin(2*m)
+	b := 5.555*sin(n) + 0.168*sin(2*n)
+	k := j + a - b
+	// Radii vectors of the Earth and Jupiter, the distance between them and
+	// the phase angle of Jupiter
+	R := 1.00014 - 0.01671*cos(m) - 0.00014*cos(2*m)
+	r := 5.20872 - 0.25208*cos(n) - 0.00611*cos(2*n)
+	delta := math.Sqrt(r*r + R*R - 2*r*R*cos(k))
+	psi := radiansToDegrees(math.Asin(R / delta * sin(k)))
+
+	// The satellites are seen as they were when the light left them
+	t := d - delta/173
+	u := [4]float64{
+		163.8069 + 203.4058646*t + psi - b,
+		358.4140 + 101.2916335*t + psi - b,
+		5.7176 + 50.2345180*t + psi - b,
+		224.8092 + 21.4879800*t + psi - b,
+	}
+	g := 331.18 + 50.310482*t
+	h := 87.45 + 21.569231*t
+	// The main perturbations
+	u1, u2, u3 := u[0], u[1], u[2]
+	u[0] += 0.473 * sin(2*(u1-u2))
+	u[1] += 1.065 * sin(2*(u2-u3))
+	u[2] += 0.165 * sin(g)
+	u[3] += 0.843 * sin(h)
+	radii := [4]float64{
+		5.9057 - 0.0244*cos(2*(u1-u2)),
+		9.3966 - 0.0882*cos(2*(u2-u3)),
+		14.9883 - 0.0216*cos(g),
+		26.3627 - 0.1939*cos(h),
+	}
+
+	// Jupiter's heliocentric longitude referred to the equinox of 1900, and
+	// the jovicentric declinations of the sun and the Earth
+	lambda := 34.35 + 0.083091*d + 0.329*sin(v) + b
+	ds := 3.12 * sin(lambda+42.8)
+	de := ds - 2.22*sin(psi)*cos(lambda+22) - 1.30*(r-delta)/delta*sin(lambda-100.5)
+
+	view := View{EarthDeclination: de, SunDeclination: ds}
+	for i := range u {
+		view.Earth[i] = position(radii[i], u[i], de)
+		// The sun sees the satellites turned back through the phase angle
+		view.Sun[i] = position(radii[i], u[i]-psi, ds)
+	}
+	return view
+}
+
+// position of a satellite at the distance radius from Jupiter, having moved
+// through the angle u from inferior conjunction, seen from the jovicentric
+// declination dec
+func position(radius, u, dec float64) Position {
+	return Position{
+		X: radius * sin(u),
+		Y: -radius * cos(u) * sin(dec),
+		Z: radius * cos(u) * cos(dec),
+	}
+}
+
+func sin(angleDeg float64) float64 {
+	return math.Sin(degreesToRadians(angleDeg))
+}
+
+func cos(angleDeg float64) float64 {
+	return math.Cos(degreesToRadians(angleDeg))
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+func radiansToDegrees(angleRad float64) float64 {
+	return 180 * angleRad / math.Pi
+}
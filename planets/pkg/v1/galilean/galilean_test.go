@@ -0,0 +1,67 @@
+package galilean_test
+
+import (
+	"testing"
+
+	"planetpositions/planets/pkg/v1/galilean"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositions(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 44.a, 1992 December 16 at 0h
+	// UT
+	v := galilean.Positions(2448972.50068)
+	expected := [4][2]float64{{-3.44, 0.21}, {7.44, 0.25}, {1.24, 0.65}, {7.08, 1.10}}
+	for i, e := range expected {
+		assert.InDelta(t, e[0], v.Earth[i].X, 0.005)
+		assert.InDelta(t, e[1], v.Earth[i].Y, 0.005)
+	}
+	// Io was nearer than Jupiter and off its disc
+	assert.True(t, v.Earth[galilean.Io].Z > 0)
+	assert.False(t, v.Earth[galilean.Io].OnDisc())
+}
+
+func TestEvents(t *testing.T) {
+	// Four days of December 1992, before the opposition of March 1993 when
+	// the shadows fall to the west of the satellites
+	events := galilean.Events(2448972.5, 2448976.5)
+	transits := []galilean.Event{}
+	for _, e := range events {
+		assert.True(t, e.Start >= 2448972.5 && e.Start < 2448976.5)
+		assert.True(t, e.End > e.Start)
+		if e.Moon == galilean.Io && e.Kind == galilean.Transit {
+			transits = append(transits, e)
+			// Io takes a little over two hours to cross the disc
+			assert.InDelta(t, 2.2, (e.End-e.Start)*24, 0.2)
+		}
+	}
+	for i := range events[1:] {
+		assert.True(t, events[i].Start <= events[i+1].Start)
+	}
+
+	// Io's synodic period is 1.769 days
+	if assert.Len(t, transits, 3) {
+		assert.InDelta(t, 1.7699, transits[1].Start-transits[0].Start, 0.01)
+		assert.InDelta(t, 1.7699, transits[2].Start-transits[1].Start, 0.01)
+	}
+
+	// The shadow reaches the disc before Io does, and Io disappears into
+	// the shadow before it goes behind the disc
+	first := map[galilean.Kind]float64{}
+	for _, e := range events {
+		if _, ok := first[e.Kind]; !ok && e.Moon == galilean.Io {
+			first[e.Kind] = e.Start
+		}
+	}
+	assert.True(t, first[galilean.ShadowTransit] < first[galilean.Transit])
+	assert.True(t, first[galilean.Eclipse] < first[galilean.Occultation])
+}
+
+func TestEventsUnderWay(t *testing.T) {
+	// Io's shadow was on the disc at 2448972.6, the shadow transit is left
+	// out of a search starting then
+	for _, e := range galilean.Events(2448972.6, 2448973.6) {
+		assert.False(t, e.Moon == galilean.Io && e.Kind == galilean.ShadowTransit)
+	}
+}
@@ -0,0 +1,220 @@
+// Package minor places comets and asteroids from the osculating orbital
+// elements published by the Minor Planet Center.
+package minor
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Elements are the osculating elements of a minor body referred to the
+// ecliptic and equinox of J2000.0
+type Elements struct {
+	// Designation, packed for numbered asteroids, and name as published
+	Designation string
+	Name        string
+	Comet       bool
+	// Perihelion distance in AU, and eccentricity
+	PerihelionDistance float64
+	Eccentricity       float64
+	// Angles in degrees
+	Inclination          float64
+	Node                 float64
+	ArgumentOfPerihelion float64
+	// Time of perihelion passage, as a Julian ephemeris day
+	PerihelionTime float64
+	// Absolute magnitude and slope parameter, H and G for an asteroid, g
+	// and k for a comet, unknown when HasMagnitude is false
+	AbsoluteMagnitude float64
+	Slope             float64
+	HasMagnitude      bool
+}
+
+// Parse reads elements in either of the Minor Planet Center's one line
+// formats, that of MPCORB.DAT for asteroids or that of CometEls.txt
+func Parse(line string) (Elements, error) {
+	// Leading spaces are part of the format, a comet without a periodic
+	// number starts with four of them
+	line = strings.Trim(strings.Replace(line, "\r", "", -1), "\n")
+	// The comet format has the month of perihelion in columns 20 and 21,
+	// the asteroid format a packed epoch from column 21 starting with the
+	// century letter
+	if len(line) > 20 && line[20] >= 'A' && line[20] <= 'Z' {
+		return parseAsteroid(line)
+	}
+	return parseComet(line)
+}
+
+// parseAsteroid reads the MPCORB.DAT format, where the position in the
+// orbit is given as the mean anomaly at an epoch
+func parseAsteroid(line string) (Elements, error) {
+	if len(line) < 103 {
+		return Elements{}, fmt.Errorf("the asteroid elements are too short, the line must run to the semimajor axis in column 103")
+	}
+	e := Elements{Designation: strings.TrimSpace(line[0:7])}
+	if len(line) >= 194 {
+		e.Name = strings.TrimSpace(line[166:194])
+	} else if len(line) > 166 {
+		e.Name = strings.TrimSpace(line[166:])
+	}
+	if e.Name == "" {
+		e.Name = e.Designation
+	}
+
+	epoch, err := packedDate(line[20:25])
+	if err != nil {
+		return e, fmt.Errorf("%s has %v", e.Name, err)
+	}
+	var m, n, a float64
+	fields := []struct {
+		text  string
+		value *float64
+	}{
+		{line[26:35], &m},
+		{line[37:46], &e.ArgumentOfPerihelion},
+		{line[48:57], &e.Node},
+		{line[59:68], &e.Inclination},
+		{line[70:79], &e.Eccentricity},
+		{line[80:91], &n},
+		{line[92:103], &a},
+	}
+	for _, f := range fields {
+		if *f.value, err = strconv.ParseFloat(strings.TrimSpace(f.text), 64); err != nil {
+			return e, fmt.Errorf("%s has a malformed field %q", e.Name, f.text)
+		}
+	}
+	if e.Eccentricity >= 1 || a <= 0 || n <= 0 {
+		return e, fmt.Errorf("%s does not have an elliptic orbit", e.Name)
+	}
+	e.PerihelionDistance = a * (1 - e.Eccentricity)
+	// The mean anomaly is measured from the last perihelion, or to the next
+	// when it is over half an orbit away
+	if m > 180 {
+		m -= 360
+	}
+	e.PerihelionTime = epoch - m/n
+
+	h, g := strings.TrimSpace(line[8:13]), strings.TrimSpace(line[14:19])
+	if h != "" {
+		if e.AbsoluteMagnitude, err = strconv.ParseFloat(h, 64); err != nil {
+			return e, fmt.Errorf("%s has a malformed absolute magnitude %q", e.Name, h)
+		}
+		// The slope parameter is taken as 0.15 when it is not known
+		e.Slope = 0.15
+		if g != "" {
+			if e.Slope, err = strconv.ParseFloat(g, 64); err != nil {
+				return e, fmt.Errorf("%s has a malformed slope parameter %q", e.Name, g)
+			}
+		}
+		e.HasMagnitude = true
+	}
+	return e, nil
+}
+
+// parseComet reads the CometEls.txt format, where the position in the orbit
+// is given by the time of perihelion
+func parseComet(line string) (Elements, error) {
+	if len(line) < 79 {
+		return Elements{}, fmt.Errorf("the comet elements are too short, the line must run to the inclination in column 79")
+	}
+	e := Elements{
+		Designation: strings.TrimSpace(line[0:12]),
+		Comet:       true,
+	}
+	if len(line) >= 158 {
+		e.Name = strings.TrimSpace(line[102:158])
+	} else if len(line) > 102 {
+		e.Name = strings.TrimSpace(line[102:])
+	}
+	if e.Name == "" {
+		e.Name = e.Designation
+	}
+
+	year, err := strconv.Atoi(strings.TrimSpace(line[14:18]))
+	if err != nil {
+		return e, fmt.Errorf("%s has a malformed year of perihelion %q", e.Name, line[14:18])
+	}
+	month, err := strconv.Atoi(strings.TrimSpace(line[19:21]))
+	if err != nil || month < 1 || month > 12 {
+		return e, fmt.Errorf("%s has a malformed month of perihelion %q", e.Name, line[19:21])
+	}
+	var day float64
+	fields := []struct {
+		text  string
+		value *float64
+	}{
+		{line[22:29], &day},
+		{line[30:39], &e.PerihelionDistance},
+		{line[41:49], &e.Eccentricity},
+		{line[51:59], &e.ArgumentOfPerihelion},
+		{line[61:69], &e.Node},
+		{line[71:79], &e.Inclination},
+	}
+	for _, f := range fields {
+		if *f.value, err = strconv.ParseFloat(strings.TrimSpace(f.text), 64); err != nil {
+			return e, fmt.Errorf("%s has a malformed field %q", e.Name, f.text)
+		}
+	}
+	if e.PerihelionDistance <= 0 || e.Eccentricity < 0 {
+		return e, fmt.Errorf("%s has unusable elements", e.Name)
+	}
+	e.PerihelionTime = julianDay(year, month, day)
+
+	if len(line) >= 100 {
+		g, k := strings.TrimSpace(line[91:95]), strings.TrimSpace(line[96:100])
+		if g != "" && k != "" {
+			if e.AbsoluteMagnitude, err = strconv.ParseFloat(g, 64); err != nil {
+				return e, fmt.Errorf("%s has a malformed absolute magnitude %q", e.Name, g)
+			}
+			if e.Slope, err = strconv.ParseFloat(k, 64); err != nil {
+				return e, fmt.Errorf("%s has a malformed slope parameter %q", e.Name, k)
+			}
+			e.HasMagnitude = true
+		}
+	}
+	return e, nil
+}
+
+// packedDate reads a date packed into five characters, the century as a
+// letter from I for the 1800s, two digits of year, then the month and day
+// as digits continuing with letters from A for 10
+func packedDate(packed string) (float64, error) {
+	centuries := map[byte]int{'I': 1800, 'J': 1900, 'K': 2000}
+	century, ok := centuries[packed[0]]
+	if !ok {
+		return 0, fmt.Errorf("an unknown century in the packed epoch %q", packed)
+	}
+	year, err := strconv.Atoi(packed[1:3])
+	if err != nil {
+		return 0, fmt.Errorf("a malformed year in the packed epoch %q", packed)
+	}
+	month, day := unpack(packed[3]), unpack(packed[4])
+	if month < 1 || month > 12 || day < 1 || day > 31 {
+		return 0, fmt.Errorf("a malformed month or day in the packed epoch %q", packed)
+	}
+	return julianDay(century+year, month, float64(day)), nil
+}
+
+func unpack(c byte) int {
+	switch {
+	case c >= '0' && c <= '9':
+		return int(c - '0')
+	case c >= 'A' && c <= 'V':
+		return int(c-'A') + 10
+	}
+	return 0
+}
+
+// julianDay returns the Julian day of a date in the Gregorian calendar, the
+// day may have a fraction, Meeus, Astronomical Algorithms, chapter 7
+func julianDay(year, month int, day float64) float64 {
+	if month <= 2 {
+		year--
+		month += 12
+	}
+	a := year / 100
+	b := 2 - a + a/4
+	return math.Floor(365.25*float64(year+4716)) + math.Floor(30.6001*float64(month+1)) + day + float64(b) - 1524.5
+}
@@ -0,0 +1,143 @@
+package minor_test
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"planetpositions/planets/pkg/v1/ephemeris"
+	"planetpositions/planets/pkg/v1/minor"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	ceres = "00001    3.34  0.12 K239D  60.07966   73.42179   80.25496   10.58688  0.0789126  0.21411523   2.7672749" +
+		"                                                               (1) Ceres                   "
+	halley = "0001P         1986 02  9.4589  0.587104  0.967277  111.8657   58.8601  162.2422  19860219   5.5  4.0  1P/Halley"
+)
+
+func TestParseAsteroid(t *testing.T) {
+	e, err := minor.Parse(ceres)
+	require.NoError(t, err)
+	assert.Equal(t, "(1) Ceres", e.Name)
+	assert.False(t, e.Comet)
+	assert.True(t, e.HasMagnitude)
+	assert.InDelta(t, 3.34, e.AbsoluteMagnitude, 1e-9)
+	assert.InDelta(t, 0.12, e.Slope, 1e-9)
+	assert.InDelta(t, 2.7672749*(1-0.0789126), e.PerihelionDistance, 1e-9)
+	// The epoch 2023 September 13.0 is JDE 2460200.5
+	assert.InDelta(t, 2460200.5-60.07966/0.21411523, e.PerihelionTime, 1e-6)
+	assert.Equal(t, minor.Elliptic, e.Orbit())
+
+	// Without a slope parameter the usual 0.15 is assumed
+	e, err = minor.Parse(ceres[:14] + "     " + ceres[19:103])
+	require.NoError(t, err)
+	assert.Equal(t, "00001", e.Name)
+	assert.InDelta(t, 0.15, e.Slope, 1e-9)
+}
+
+func TestParseComet(t *testing.T) {
+	e, err := minor.Parse(halley)
+	require.NoError(t, err)
+	assert.Equal(t, "1P/Halley", e.Name)
+	assert.True(t, e.Comet)
+	assert.True(t, e.HasMagnitude)
+	assert.InDelta(t, 2446470.5+0.4589, e.PerihelionTime, 1e-6)
+	assert.InDelta(t, 0.587104, e.PerihelionDistance, 1e-9)
+	assert.InDelta(t, 0.967277, e.Eccentricity, 1e-9)
+	assert.InDelta(t, 162.2422, e.Inclination, 1e-9)
+
+	// The magnitude parameters are often left blank
+	e, err = minor.Parse(halley[:79])
+	require.NoError(t, err)
+	assert.False(t, e.HasMagnitude)
+}
+
+func TestParseErrors(t *testing.T) {
+	testcases := map[string]string{
+		"Empty":           "",
+		"Short asteroid":  ceres[:90],
+		"Unknown century": ceres[:20] + "X" + ceres[21:],
+		"Packed month":    ceres[:23] + "Z" + ceres[24:],
+		"Hyperbolic MPC":  strings.Replace(ceres, "0.0789126", "1.0789126", 1),
+		"Short comet":     halley[:60],
+		"Malformed q":     halley[:30] + " 0.58x104" + halley[39:],
+		"Month":           halley[:19] + "13" + halley[21:],
+	}
+	for name, line := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, err := minor.Parse(line)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestBarker(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 35.a with e = 1
+	v, r := minor.Barker(0.921326, 138.4783)
+	assert.InDelta(t, 102.74426, v*180/math.Pi, 0.00001)
+	assert.InDelta(t, 2.364192, r, 0.000001)
+}
+
+func TestNearParabolic(t *testing.T) {
+	// Landgraf's method must agree with Barker's equation for a parabola
+	// and with Kepler's equation on either side of it
+	v, r, ok := minor.NearParabolic(0.921326, 1, 138.4783)
+	require.True(t, ok)
+	assert.InDelta(t, 102.74426, v*180/math.Pi, 0.00001)
+	assert.InDelta(t, 2.364192, r, 0.000001)
+
+	for _, e := range []float64{0.99, 1.01} {
+		for _, days := range []float64{-300, 20, 400} {
+			elements := minor.Elements{PerihelionDistance: 0.5, Eccentricity: e}
+			v, r, ok := minor.NearParabolic(0.5, e, days)
+			require.True(t, ok)
+			var want float64
+			if e < 1 {
+				a := 0.5 / (1 - e)
+				ea := ephemeris.Kepler(e, 0.01720209895*days/math.Pow(a, 1.5))
+				want = a * (1 - e*math.Cos(ea))
+			} else {
+				a := 0.5 / (e - 1)
+				h := minor.KeplerHyperbolic(e, 0.01720209895*days/math.Pow(a, 1.5))
+				want = a * (e*math.Cosh(h) - 1)
+			}
+			assert.InDelta(t, want, r, 1e-7, "e %v, %v days", e, days)
+			x, y, _, err := elements.Heliocentric(days)
+			require.NoError(t, err)
+			assert.InDelta(t, v, math.Atan2(y, x), 1e-9, "e %v, %v days", e, days)
+		}
+	}
+}
+
+func TestKeplerHyperbolic(t *testing.T) {
+	for _, m := range []float64{-5, 0.1, 2, 50} {
+		h := minor.KeplerHyperbolic(1.5, m)
+		assert.InDelta(t, m, 1.5*math.Sinh(h)-h, 1e-10)
+	}
+}
+
+func TestHeliocentricAtPerihelion(t *testing.T) {
+	e, err := minor.Parse(halley)
+	require.NoError(t, err)
+	x, y, z, err := e.Heliocentric(e.PerihelionTime)
+	require.NoError(t, err)
+	assert.InDelta(t, e.PerihelionDistance, math.Sqrt(x*x+y*y+z*z), 1e-9)
+	// The perihelion lies the argument of perihelion along the orbit from
+	// the ascending node, which for a retrograde orbit is below the ecliptic
+	// here
+	assert.InDelta(t, e.PerihelionDistance*math.Sin(111.8657*math.Pi/180)*math.Sin(162.2422*math.Pi/180), z, 1e-9)
+}
+
+func TestMagnitude(t *testing.T) {
+	// At opposition an asteroid is as bright as its absolute magnitude and
+	// distances allow, and it fades as the phase angle grows
+	juno := minor.Elements{AbsoluteMagnitude: 5.33, Slope: 0.23}
+	assert.InDelta(t, 5.33+5*math.Log10(2.5*1.3), juno.Magnitude(2.5, 1.3, 0), 1e-9)
+	assert.True(t, juno.Magnitude(2.5, 1.3, 20) > juno.Magnitude(2.5, 1.3, 10))
+
+	comet := minor.Elements{Comet: true, AbsoluteMagnitude: 5.5, Slope: 4}
+	assert.InDelta(t, 5.5+10*math.Log10(2), comet.Magnitude(2, 1, 30), 1e-9)
+}
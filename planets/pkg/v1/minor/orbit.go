@@ -0,0 +1,181 @@
+package minor
+
+import (
+	"fmt"
+	"math"
+
+	"planetpositions/planets/pkg/v1/ephemeris"
+)
+
+// gaussian is the Gaussian gravitational constant, in radians a day for a
+// body of negligible mass one AU from the sun
+const gaussian = 0.01720209895
+
+// nearParabolic is how far the eccentricity may be from 1 for the orbit to be
+// solved as near parabolic, where Kepler's equation loses precision
+const nearParabolic = 0.02
+
+// Orbit -
+type Orbit int
+
+const (
+	// Elliptic -
+	Elliptic Orbit = iota
+	// Parabolic -
+	Parabolic
+	// Hyperbolic -
+	Hyperbolic
+)
+
+// Orbit returns the kind of conic the body follows
+func (e Elements) Orbit() Orbit {
+	switch {
+	case e.Eccentricity < 1:
+		return Elliptic
+	case e.Eccentricity == 1:
+		return Parabolic
+	}
+	return Hyperbolic
+}
+
+// Heliocentric returns the rectangular heliocentric ecliptic coordinates of
+// the body, in AU and referred to the equinox of J2000.0, at the Julian
+// ephemeris day jde
+func (e Elements) Heliocentric(jde float64) (x, y, z float64, err error) {
+	v, r, err := e.anomaly(jde - e.PerihelionTime)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+	node := degreesToRadians(e.Node)
+	u := degreesToRadians(e.ArgumentOfPerihelion) + v
+	i := degreesToRadians(e.Inclination)
+	x = r * (math.Cos(node)*math.Cos(u) - math.Sin(node)*math.Sin(u)*math.Cos(i))
+	y = r * (math.Sin(node)*math.Cos(u) + math.Cos(node)*math.Sin(u)*math.Cos(i))
+	z = r * math.Sin(u) * math.Sin(i)
+	return x, y, z, nil
+}
+
+// anomaly returns the true anomaly in radians and the distance from the sun
+// in AU, t days after perihelion
+func (e Elements) anomaly(t float64) (v, r float64, err error) {
+	q, ecc := e.PerihelionDistance, e.Eccentricity
+	if q <= 0 || ecc < 0 {
+		return 0, 0, fmt.Errorf("%s has unusable elements", e.Name)
+	}
+	switch {
+	case ecc == 1:
+		v, r = Barker(q, t)
+		return v, r, nil
+	case math.Abs(ecc-1) < nearParabolic:
+		if v, r, ok := NearParabolic(q, ecc, t); ok {
+			return v, r, nil
+		}
+	}
+	if ecc < 1 {
+		a := q / (1 - ecc)
+		m := gaussian * t / math.Pow(a, 1.5)
+		ea := ephemeris.Kepler(ecc, math.Remainder(m, 2*math.Pi))
+		v = 2 * math.Atan(math.Sqrt((1+ecc)/(1-ecc))*math.Tan(ea/2))
+		return v, a * (1 - ecc*math.Cos(ea)), nil
+	}
+	a := q / (ecc - 1)
+	m := gaussian * t / math.Pow(a, 1.5)
+	h := KeplerHyperbolic(ecc, m)
+	v = 2 * math.Atan(math.Sqrt((ecc+1)/(ecc-1))*math.Tanh(h/2))
+	return v, a * (ecc*math.Cosh(h) - 1), nil
+}
+
+// Barker solves Barker's equation for a parabolic orbit with perihelion
+// distance q, t days after perihelion, returning the true anomaly in radians
+// and the distance from the sun in AU, Meeus, Astronomical Algorithms,
+// chapter 34
+func Barker(q, t float64) (v, r float64) {
+	w := 3 * gaussian / math.Sqrt(2) * t / math.Pow(q, 1.5)
+	y := math.Cbrt(w/2 + math.Sqrt(w*w/4+1))
+	s := y - 1/y
+	return 2 * math.Atan(s), q * (1 + s*s)
+}
+
+// KeplerHyperbolic solves the hyperbolic form of Kepler's equation,
+// e sinh H - H = M, for the hyperbolic anomaly in radians
+func KeplerHyperbolic(e, m float64) float64 {
+	h := math.Asinh(m / e)
+	for i := 0; i < 50; i++ {
+		delta := (e*math.Sinh(h) - h - m) / (e*math.Cosh(h) - 1)
+		h -= delta
+		if math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+	return h
+}
+
+// NearParabolic solves for the true anomaly in radians and the distance from
+// the sun in AU of an orbit with an eccentricity close to 1, t days after
+// perihelion, by Landgraf's method, Meeus, Astronomical Algorithms, chapter
+// 35. ok is false when the series does not converge, far from perihelion.
+func NearParabolic(q, e, t float64) (v, r float64, ok bool) {
+	const tolerance = 1e-9
+	if t == 0 {
+		return 0, q, true
+	}
+	q1 := gaussian * math.Sqrt((1+e)/q) / (2 * q)
+	g := (1 - e) / (1 + e)
+	q2 := q1 * t
+	s := 2 / (3 * math.Abs(q2))
+	s = 2 / math.Tan(2*math.Atan(math.Cbrt(math.Tan(math.Atan(s)/2))))
+	if t < 0 {
+		s = -s
+	}
+
+	for l := 0; ; l++ {
+		if l > 50 {
+			return 0, 0, false
+		}
+		s0 := s
+		y := s * s
+		g1 := -y * s
+		q3 := q2 + 2*g*s*y/3
+		for z := 2.0; ; z++ {
+			g1 = -g1 * g * y
+			f := (z - (z+1)*g) / (2*z + 1) * g1
+			q3 += f
+			if z > 50 || math.Abs(f) > 10000 {
+				return 0, 0, false
+			}
+			if math.Abs(f) <= tolerance {
+				break
+			}
+		}
+		for {
+			s1 := s
+			s = (2*s*s*s/3 + q3) / (s*s + 1)
+			if math.Abs(s-s1) <= tolerance {
+				break
+			}
+		}
+		if math.Abs(s-s0) <= tolerance {
+			break
+		}
+	}
+	v = 2 * math.Atan(s)
+	return v, q * (1 + e) / (1 + e*math.Cos(v)), true
+}
+
+// Magnitude estimates the visual magnitude of the body r AU from the sun and
+// delta AU from the earth, seen at a phase angle in degrees. Asteroids follow
+// the H, G system, Meeus, Astronomical Algorithms, equation 33.14, and
+// comets the total magnitude m = g + 5 log delta + 2.5 k log r.
+func (e Elements) Magnitude(r, delta, phaseAngle float64) float64 {
+	if e.Comet {
+		return e.AbsoluteMagnitude + 5*math.Log10(delta) + 2.5*e.Slope*math.Log10(r)
+	}
+	tan := math.Tan(degreesToRadians(phaseAngle) / 2)
+	phi1 := math.Exp(-3.33 * math.Pow(tan, 0.63))
+	phi2 := math.Exp(-1.87 * math.Pow(tan, 1.22))
+	return e.AbsoluteMagnitude + 5*math.Log10(r*delta) - 2.5*math.Log10((1-e.Slope)*phi1+e.Slope*phi2)
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
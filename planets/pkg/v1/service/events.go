@@ -0,0 +1,333 @@
+package v1
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"planetpositions/moon/pkg/v1/lunar"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+)
+
+const (
+	// maxEventSearchDays limits the length of the date range searched
+	maxEventSearchDays = 3653
+	// eventWindowDays is the length of the windows the range is searched
+	// in, the events of each window are sent as soon as it is searched
+	eventWindowDays = 30
+	// kmPerAU converts the Moon's distance into AU
+	kmPerAU = 149597870.7
+)
+
+// ecliptic is a geocentric ecliptic position in degrees and distance in AU
+type ecliptic struct {
+	longitude float64
+	latitude  float64
+	distance  float64
+}
+
+// planetaryEvent is an event at the instant jde, in dynamical time
+type planetaryEvent struct {
+	kind  v1.PlanetaryEventType
+	body  v1.Planet
+	other v1.Planet
+	jde   float64
+}
+
+// positionKey identifies a cached position, the instant is rounded to the
+// second so that the daily samples are shared between searches
+type positionKey struct {
+	body    v1.Planet
+	moon    bool
+	sun     bool
+	seconds int64
+}
+
+// positions evaluates and caches geocentric positions for the searches, the
+// root finders cannot return errors so the first one is kept
+type positions struct {
+	s     *planetsServiceServer
+	cache map[positionKey]ecliptic
+	err   error
+}
+
+func (s *planetsServiceServer) GetPlanetaryEvents(req *v1.PlanetaryEventsRequest, stream v1.PlanetsService_GetPlanetaryEventsServer) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return fmt.Errorf("unusable end date provided: %v", err)
+	}
+	planets := req.Bodies
+	if len(planets) == 0 {
+		for p := v1.Planet_MERCURY; p <= v1.Planet_PLUTO; p++ {
+			planets = append(planets, p)
+		}
+	}
+	seen := map[v1.Planet]bool{}
+	for _, p := range planets {
+		if p == v1.Planet_PLANET_UNSPECIFIED {
+			return fmt.Errorf("unusable input provided: no planet given")
+		}
+		if _, ok := bodies[p]; !ok {
+			return fmt.Errorf("unusable input provided: unknown planet %v", p)
+		}
+		if seen[p] {
+			return fmt.Errorf("unusable input provided: %v requested more than once", p)
+		}
+		seen[p] = true
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return err
+	}
+	if end <= start {
+		return fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxEventSearchDays {
+		return fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxEventSearchDays)
+	}
+
+	// delta T changes by well under a minute across the longest range
+	deltaT, err := s.DeltaT((start + end) / 2)
+	if err != nil {
+		return err
+	}
+	dt := deltaT.Seconds / 86400
+
+	p := &positions{s: s, cache: map[positionKey]ecliptic{}}
+	for from := start; from < end; from += eventWindowDays {
+		to := math.Min(from+eventWindowDays, end)
+		events := p.planetaryEvents(planets, from+dt, to+dt)
+		if p.err != nil {
+			return p.err
+		}
+		sort.SliceStable(events, func(i, j int) bool {
+			return events[i].jde < events[j].jde
+		})
+		for _, e := range events {
+			event, err := s.planetaryEvent(p, e, dt)
+			if err != nil {
+				return err
+			}
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// planetaryEvents returns the events of the planets between start and end,
+// in dynamical time
+func (p *positions) planetaryEvents(planets []v1.Planet, start, end float64) []planetaryEvent {
+	events := []planetaryEvent{}
+	add := func(kind v1.PlanetaryEventType, body, other v1.Planet, jde float64) {
+		// Roots found in the last sample may lie just beyond the range
+		if jde >= start && jde < end {
+			events = append(events, planetaryEvent{kind: kind, body: body, other: other, jde: jde})
+		}
+	}
+
+	for i, body := range planets {
+		body := body
+		inner := body == v1.Planet_MERCURY || body == v1.Planet_VENUS
+
+		// Conjunctions with the sun, inferior when the planet is nearer
+		// than the sun
+		fromSun := func(jde float64) float64 {
+			return signed(p.planet(body, jde).longitude - p.sun(jde).longitude)
+		}
+		for _, r := range roots(fromSun, start, end) {
+			kind := v1.PlanetaryEventType_SUPERIOR_CONJUNCTION
+			if inner && p.planet(body, r.jde).distance < p.sun(r.jde).distance {
+				kind = v1.PlanetaryEventType_INFERIOR_CONJUNCTION
+			}
+			add(kind, body, body, r.jde)
+		}
+
+		if inner {
+			// The elongation is greatest where its daily change turns
+			// from growing to shrinking
+			change := func(jde float64) float64 {
+				return p.elongation(body, jde+1) - p.elongation(body, jde)
+			}
+			for _, r := range roots(change, start-0.5, end) {
+				if r.rising {
+					continue
+				}
+				kind := v1.PlanetaryEventType_GREATEST_EASTERN_ELONGATION
+				if fromSun(r.jde+0.5) < 0 {
+					kind = v1.PlanetaryEventType_GREATEST_WESTERN_ELONGATION
+				}
+				add(kind, body, body, r.jde+0.5)
+			}
+		} else {
+			opposite := func(jde float64) float64 {
+				return signed(p.planet(body, jde).longitude - p.sun(jde).longitude - 180)
+			}
+			for _, r := range roots(opposite, start, end) {
+				add(v1.PlanetaryEventType_OPPOSITION, body, body, r.jde)
+			}
+		}
+
+		// Stations, where the daily motion in longitude changes sign
+		motion := func(jde float64) float64 {
+			return signed(p.planet(body, jde+1).longitude - p.planet(body, jde).longitude)
+		}
+		for _, r := range roots(motion, start-0.5, end) {
+			kind := v1.PlanetaryEventType_STATION_RETROGRADE
+			if r.rising {
+				kind = v1.PlanetaryEventType_STATION_DIRECT
+			}
+			add(kind, body, body, r.jde+0.5)
+		}
+
+		fromMoon := func(jde float64) float64 {
+			return signed(p.moon(jde).longitude - p.planet(body, jde).longitude)
+		}
+		for _, r := range roots(fromMoon, start, end) {
+			add(v1.PlanetaryEventType_MOON_CONJUNCTION, body, body, r.jde)
+		}
+
+		for _, other := range planets[i+1:] {
+			other := other
+			apart := func(jde float64) float64 {
+				return signed(p.planet(body, jde).longitude - p.planet(other, jde).longitude)
+			}
+			for _, r := range roots(apart, start, end) {
+				add(v1.PlanetaryEventType_CONJUNCTION, body, other, r.jde)
+			}
+		}
+	}
+	return events
+}
+
+func (s *planetsServiceServer) planetaryEvent(p *positions, e planetaryEvent, dt float64) (*v1.PlanetaryEvent, error) {
+	time, err := s.instant(e.jde - dt)
+	if err != nil {
+		return nil, err
+	}
+	planet := p.planet(e.body, e.jde)
+	event := &v1.PlanetaryEvent{
+		Api:               apiVersion,
+		Type:              e.kind,
+		Time:              time,
+		Body:              e.body,
+		EclipticLongitude: planet.longitude,
+	}
+	switch e.kind {
+	case v1.PlanetaryEventType_CONJUNCTION:
+		event.Other = e.other
+		event.Separation = separation(planet, p.planet(e.other, e.jde))
+	case v1.PlanetaryEventType_MOON_CONJUNCTION:
+		event.Separation = separation(planet, p.moon(e.jde))
+	default:
+		event.Separation = separation(planet, p.sun(e.jde))
+	}
+	return event, p.err
+}
+
+// root is an instant where a function changes sign, rising when it changes
+// from negative to positive
+type root struct {
+	jde    float64
+	rising bool
+}
+
+// roots samples f daily from start to end and returns the instants where it
+// changes sign. Changes of more than 90 between samples are an angle
+// wrapping around rather than a root.
+func roots(f func(float64) float64, start, end float64) []root {
+	found := []root{}
+	days := int(math.Ceil(end - start))
+	prev := f(start)
+	for i := 1; i <= days; i++ {
+		lo, hi := start+float64(i-1), start+float64(i)
+		next := f(hi)
+		if (prev < 0) != (next < 0) && math.Abs(next-prev) < 90 {
+			found = append(found, root{jde: bisect(f, lo, hi), rising: next >= 0})
+		}
+		prev = next
+	}
+	return found
+}
+
+// signed returns the angle in the range -180 to 180 degrees
+func signed(angleDeg float64) float64 {
+	angleDeg = normalise(angleDeg)
+	if angleDeg > 180 {
+		angleDeg -= 360
+	}
+	return angleDeg
+}
+
+// separation returns the angle between two positions in degrees
+func separation(a, b ecliptic) float64 {
+	b1, b2 := degreesToRadians(a.latitude), degreesToRadians(b.latitude)
+	dl := degreesToRadians(a.longitude - b.longitude)
+	return radiansToDegrees(math.Acos(clamp(math.Sin(b1)*math.Sin(b2) + math.Cos(b1)*math.Cos(b2)*math.Cos(dl))))
+}
+
+func (p *positions) key(jde float64) int64 {
+	return int64(math.Round(jde * 86400))
+}
+
+// planet returns the geocentric position of a planet
+func (p *positions) planet(body v1.Planet, jde float64) ecliptic {
+	k := positionKey{body: body, seconds: p.key(jde)}
+	if e, ok := p.cache[k]; ok {
+		return e
+	}
+	var e ecliptic
+	var err error
+	e.longitude, e.latitude, e.distance, _, err = p.s.Geocentric(bodies[body], jde)
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	p.cache[k] = e
+	return e
+}
+
+// sun returns the geocentric position of the sun
+func (p *positions) sun(jde float64) ecliptic {
+	k := positionKey{sun: true, seconds: p.key(jde)}
+	if e, ok := p.cache[k]; ok {
+		return e
+	}
+	l, b, r, err := p.s.ephemeris.Heliocentric(ephemeris.Earth, jde)
+	if err != nil && p.err == nil {
+		p.err = err
+	}
+	e := ecliptic{longitude: normalise(l + 180), latitude: -b, distance: r}
+	p.cache[k] = e
+	return e
+}
+
+// moon returns the geocentric position of the Moon
+func (p *positions) moon(jde float64) ecliptic {
+	k := positionKey{moon: true, seconds: p.key(jde)}
+	if e, ok := p.cache[k]; ok {
+		return e
+	}
+	l, b, r := lunar.Position(julianCentury(jde))
+	e := ecliptic{longitude: l, latitude: b, distance: r / kmPerAU}
+	p.cache[k] = e
+	return e
+}
+
+// elongation returns the angle between the sun and a planet
+func (p *positions) elongation(body v1.Planet, jde float64) float64 {
+	return separation(p.planet(body, jde), p.sun(jde))
+}
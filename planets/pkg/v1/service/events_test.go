@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"testing"
+
+	"planetpositions/planets/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// eventStream collects the events sent by GetPlanetaryEvents
+type eventStream struct {
+	grpc.ServerStream
+	events []*v1.PlanetaryEvent
+}
+
+func (s *eventStream) Send(e *v1.PlanetaryEvent) error {
+	s.events = append(s.events, e)
+	return nil
+}
+
+func planetaryEvents(t *testing.T, s *planetsServiceServer, from, to [3]int32, bodies ...v1.Planet) []*v1.PlanetaryEvent {
+	stream := &eventStream{}
+	err := s.GetPlanetaryEvents(&v1.PlanetaryEventsRequest{
+		Api:        apiVersion,
+		StartYear:  from[0],
+		StartMonth: from[1],
+		StartDay:   from[2],
+		EndYear:    to[0],
+		EndMonth:   to[1],
+		EndDay:     to[2],
+		Bodies:     bodies,
+	}, stream)
+	require.NoError(t, err)
+	return stream.events
+}
+
+func TestGetPlanetaryEvents(t *testing.T) {
+	s := newTestService(t)
+	events := planetaryEvents(t, s, [3]int32{2020, 1, 1}, [3]int32{2020, 12, 31}, v1.Planet_VENUS, v1.Planet_MARS, v1.Planet_JUPITER, v1.Planet_SATURN)
+
+	// Events of 2020 at the times published in the almanacs, in UT, the
+	// stations and elongations only to the hour as they change slowly
+	tests := []struct {
+		name       string
+		kind       v1.PlanetaryEventType
+		body       v1.Planet
+		other      v1.Planet
+		jd         float64
+		days       float64
+		separation float64
+		degrees    float64
+	}{
+		{"Venus greatest elongation, March 24 22h", v1.PlanetaryEventType_GREATEST_EASTERN_ELONGATION, v1.Planet_VENUS, v1.Planet_PLANET_UNSPECIFIED, 2458933.4167, 0.1, 46.1, 0.1},
+		{"Venus inferior conjunction, June 3 17:43", v1.PlanetaryEventType_INFERIOR_CONJUNCTION, v1.Planet_VENUS, v1.Planet_PLANET_UNSPECIFIED, 2459004.2382, 0.02, 0.5, 0.05},
+		{"Mars stationary, September 9 22h", v1.PlanetaryEventType_STATION_RETROGRADE, v1.Planet_MARS, v1.Planet_PLANET_UNSPECIFIED, 2459102.4167, 0.1, 139.4, 0.1},
+		{"Mars opposition, October 13 23:20", v1.PlanetaryEventType_OPPOSITION, v1.Planet_MARS, v1.Planet_PLANET_UNSPECIFIED, 2459136.4722, 0.02, 177.0, 0.1},
+		{"Mars stationary, November 14 0h", v1.PlanetaryEventType_STATION_DIRECT, v1.Planet_MARS, v1.Planet_PLANET_UNSPECIFIED, 2459167.5, 0.1, 143.1, 0.1},
+		{"Jupiter and Saturn 6' apart, December 21 18:20", v1.PlanetaryEventType_CONJUNCTION, v1.Planet_JUPITER, v1.Planet_SATURN, 2459205.2639, 0.02, 0.102, 0.002},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var found []*v1.PlanetaryEvent
+			for _, e := range events {
+				if e.Type == tt.kind && e.Body == tt.body && e.Other == tt.other {
+					found = append(found, e)
+				}
+			}
+			require.Len(t, found, 1)
+			assert.InDelta(t, tt.jd, found[0].Time.JulianDate, tt.days)
+			assert.InDelta(t, tt.separation, found[0].Separation, tt.degrees)
+		})
+	}
+
+	// The stream is in order of time
+	for i := 1; i < len(events); i++ {
+		assert.True(t, events[i].Time.JulianDate >= events[i-1].Time.JulianDate)
+	}
+}
+
+func TestGetPlanetaryEventsWindows(t *testing.T) {
+	// The year is searched in windows of eventWindowDays, asking for it a
+	// month at a time moves the edges of the windows, and neither may lose
+	// or repeat an event
+	s := newTestService(t)
+	year := planetaryEvents(t, s, [3]int32{2020, 1, 1}, [3]int32{2020, 12, 31}, v1.Planet_MERCURY, v1.Planet_VENUS, v1.Planet_MARS)
+	monthly := []*v1.PlanetaryEvent{}
+	days := []int32{31, 29, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+	for month := int32(1); month <= 12; month++ {
+		monthly = append(monthly, planetaryEvents(t, s, [3]int32{2020, month, 1}, [3]int32{2020, month, days[month-1]}, v1.Planet_MERCURY, v1.Planet_VENUS, v1.Planet_MARS)...)
+	}
+
+	require.Len(t, monthly, len(year))
+	for i := range year {
+		assert.Equal(t, year[i].Type, monthly[i].Type)
+		assert.Equal(t, year[i].Body, monthly[i].Body)
+		assert.Equal(t, year[i].Other, monthly[i].Other)
+		assert.InDelta(t, year[i].Time.JulianDate, monthly[i].Time.JulianDate, 1e-6)
+	}
+
+	// Mercury has three greatest eastern elongations in 2020 and the Moon
+	// passes each planet twelve or thirteen times
+	count := map[v1.Planet]map[v1.PlanetaryEventType]int{}
+	for _, e := range year {
+		if count[e.Body] == nil {
+			count[e.Body] = map[v1.PlanetaryEventType]int{}
+		}
+		count[e.Body][e.Type]++
+	}
+	assert.Equal(t, 3, count[v1.Planet_MERCURY][v1.PlanetaryEventType_GREATEST_EASTERN_ELONGATION])
+	for _, body := range []v1.Planet{v1.Planet_MERCURY, v1.Planet_VENUS, v1.Planet_MARS} {
+		moon := count[body][v1.PlanetaryEventType_MOON_CONJUNCTION]
+		assert.True(t, moon == 12 || moon == 13, "%v has %d conjunctions with the Moon", body, moon)
+	}
+}
@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/galilean"
+)
+
+// maxGalileanSearchDays limits the length of the date range searched for the
+// events of the satellites, which are sampled every two minutes
+const maxGalileanSearchDays = 366
+
+// galileanMoons maps the satellites in requests onto those of the theory
+var galileanMoons = map[v1.GalileanMoon]galilean.Moon{
+	v1.GalileanMoon_IO:       galilean.Io,
+	v1.GalileanMoon_EUROPA:   galilean.Europa,
+	v1.GalileanMoon_GANYMEDE: galilean.Ganymede,
+	v1.GalileanMoon_CALLISTO: galilean.Callisto,
+}
+
+// galileanEventTypes maps the events of the theory onto those in replies
+var galileanEventTypes = map[galilean.Kind]v1.GalileanEventType{
+	galilean.Transit:       v1.GalileanEventType_TRANSIT,
+	galilean.ShadowTransit: v1.GalileanEventType_SHADOW_TRANSIT,
+	galilean.Occultation:   v1.GalileanEventType_OCCULTATION,
+	galilean.Eclipse:       v1.GalileanEventType_ECLIPSE,
+}
+
+func (s *planetsServiceServer) GetGalileanMoons(ctx context.Context, req *v1.GalileanMoonsRequest) (*v1.GalileanMoons, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	deltaT, err := s.DeltaT(jd)
+	if err != nil {
+		return nil, err
+	}
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+
+	view := galilean.Positions(jd + deltaT.Seconds/86400)
+	moons := &v1.GalileanMoons{
+		Api:              apiVersion,
+		Time:             time,
+		EarthDeclination: view.EarthDeclination,
+		SunDeclination:   view.SunDeclination,
+	}
+	for _, moon := range galilean.Moons {
+		earth, sun := view.Earth[moon], view.Sun[moon]
+		position := &v1.GalileanMoonPosition{
+			Moon:    galileanMoon(moon),
+			X:       earth.X,
+			Y:       earth.Y,
+			Z:       earth.Z,
+			ShadowX: sun.X,
+			ShadowY: sun.Y,
+			ShadowZ: sun.Z,
+			Events:  []v1.GalileanEventType{},
+		}
+		for _, k := range galilean.Kinds {
+			if k.Under(moon, view) {
+				position.Events = append(position.Events, galileanEventTypes[k])
+			}
+		}
+		moons.Moons = append(moons.Moons, position)
+	}
+	return moons, nil
+}
+
+func (s *planetsServiceServer) GetGalileanEvents(req *v1.GalileanEventsRequest, stream v1.PlanetsService_GetGalileanEventsServer) error {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return fmt.Errorf("unusable end date provided: %v", err)
+	}
+	wanted := map[galilean.Moon]bool{}
+	for _, m := range req.Moons {
+		moon, ok := galileanMoons[m]
+		if !ok {
+			return fmt.Errorf("unusable input provided: unknown satellite %v", m)
+		}
+		if wanted[moon] {
+			return fmt.Errorf("unusable input provided: %v requested more than once", m)
+		}
+		wanted[moon] = true
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return err
+	}
+	if end <= start {
+		return fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxGalileanSearchDays {
+		return fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxGalileanSearchDays)
+	}
+
+	// delta T changes by well under a second across the longest range
+	deltaT, err := s.DeltaT((start + end) / 2)
+	if err != nil {
+		return err
+	}
+	dt := deltaT.Seconds / 86400
+
+	for _, e := range galilean.Events(start+dt, end+dt) {
+		if len(wanted) > 0 && !wanted[e.Moon] {
+			continue
+		}
+		event, err := s.galileanEvent(e, dt)
+		if err != nil {
+			return err
+		}
+		if err := stream.Send(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *planetsServiceServer) galileanEvent(e galilean.Event, dt float64) (*v1.GalileanEvent, error) {
+	start, err := s.instant(e.Start - dt)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.instant(e.End - dt)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.GalileanEvent{
+		Api:      apiVersion,
+		Type:     galileanEventTypes[e.Kind],
+		Moon:     galileanMoon(e.Moon),
+		Start:    start,
+		End:      end,
+		Duration: 24 * (e.End - e.Start),
+	}, nil
+}
+
+// galileanMoon returns the satellite of the theory as it is named in replies
+func galileanMoon(moon galilean.Moon) v1.GalileanMoon {
+	for m, g := range galileanMoons {
+		if g == moon {
+			return m
+		}
+	}
+	return v1.GalileanMoon_GALILEAN_MOON_UNSPECIFIED
+}
@@ -0,0 +1,132 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/constellation"
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+	"planetpositions/planets/pkg/v1/minor"
+)
+
+// orbits maps the kinds of conic onto those of the proto
+var orbits = map[minor.Orbit]v1.MinorBodyOrbit{
+	minor.Elliptic:   v1.MinorBodyOrbit_ELLIPTIC,
+	minor.Parabolic:  v1.MinorBodyOrbit_PARABOLIC,
+	minor.Hyperbolic: v1.MinorBodyOrbit_HYPERBOLIC,
+}
+
+func (s *planetsServiceServer) GetMinorBodyPosition(ctx context.Context, req *v1.MinorBodyPositionRequest) (*v1.MinorBodyPosition, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	elements, err := minor.Parse(req.Elements)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	kind, ok := positionKind(req.Kind)
+	if !ok {
+		return nil, fmt.Errorf("unusable input provided: unknown kind of position %v", req.Kind)
+	}
+	s, err = s.withEphemeris(req.Ephemeris)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	deltaT, err := s.DeltaT(jd)
+	if err != nil {
+		return nil, err
+	}
+	position, err := s.minorPosition(elements, jd+deltaT.Seconds/86400, kind)
+	if err != nil {
+		return nil, err
+	}
+	position.JulianDate = jd
+	return position, nil
+}
+
+// minorPosition returns the heliocentric and geocentric positions of a
+// comet or asteroid at the Julian ephemeris day jde, the geocentric position
+// of the kind given
+func (s *planetsServiceServer) minorPosition(elements minor.Elements, jde float64, kind v1.PositionKind) (*v1.MinorBodyPosition, error) {
+	l0, b0, r0, err := s.ephemeris.Heliocentric(ephemeris.Earth, jde)
+	if err != nil {
+		return nil, err
+	}
+	x0, y0, z0 := rectangular(l0, b0, r0)
+
+	// The elements are referred to the equinox of J2000.0 and the planets
+	// to the equinox of date, the body is seen where it was when the light
+	// left it
+	var l, b, r, x, y, z, delta, lightTime float64
+	for i := 0; i < 3; i++ {
+		hx, hy, hz, err := elements.Heliocentric(jde - lightTime)
+		if err != nil {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		r = math.Sqrt(hx*hx + hy*hy + hz*hz)
+		l, b = ephemeris.PrecessEcliptic(radiansToDegrees(math.Atan2(hy, hx)), radiansToDegrees(math.Asin(hz/r)), jde)
+		x, y, z = rectangular(l, b, r)
+		x, y, z = x-x0, y-y0, z-z0
+		delta = math.Sqrt(x*x + y*y + z*z)
+		lightTime = lightTimePerAU * delta
+	}
+	lambda := normalise(radiansToDegrees(math.Atan2(y, x)))
+	beta := radiansToDegrees(math.Atan2(z, math.Hypot(x, y)))
+	ra, dec := transform.EclipticToEquatorial(lambda, beta, precession.MeanObliquity(jde))
+	elongationLongitude := lambda
+	epoch := jde
+	if kind != v1.PositionKind_MEAN_OF_DATE {
+		p, err := s.reduce(kind, minorSource(elements), jde)
+		if err != nil {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		lambda, beta, ra, dec, delta, lightTime, epoch = p.Longitude, p.Latitude, p.RightAscension, p.Declination, p.Distance, p.LightTime, p.Epoch
+	}
+
+	// Meeus, Astronomical Algorithms, chapter 41
+	phaseAngle := radiansToDegrees(math.Acos(clamp((r*r + delta*delta - r0*r0) / (2 * r * delta))))
+	elongation := radiansToDegrees(math.Acos(clamp((r0*r0 + delta*delta - r*r) / (2 * r0 * delta))))
+	if normalise(elongationLongitude-(l0+180)) > 180 {
+		elongation = -elongation
+	}
+
+	position := &v1.MinorBodyPosition{
+		Api:                   apiVersion,
+		Designation:           elements.Designation,
+		Name:                  elements.Name,
+		Comet:                 elements.Comet,
+		Orbit:                 orbits[elements.Orbit()],
+		HeliocentricLongitude: l,
+		HeliocentricLatitude:  b,
+		RadiusVector:          r,
+		EclipticLongitude:     lambda,
+		EclipticLatitude:      beta,
+		RightAscension:        ra,
+		Declination:           dec,
+		Distance:              delta,
+		LightTime:             lightTime,
+		Elongation:            elongation,
+		PhaseAngle:            phaseAngle,
+		HasMagnitude:          elements.HasMagnitude,
+		Constellation:         constellation.Find(ra, dec, epoch).Name,
+		Kind:                  kind,
+	}
+	if elements.HasMagnitude {
+		position.Magnitude = elements.Magnitude(r, delta, phaseAngle)
+	}
+	return position, nil
+}
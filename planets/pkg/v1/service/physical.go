@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"math"
+
+	"planetpositions/planets/pkg/v1/ephemeris"
+)
+
+// semidiameters are the semidiameters of the bodies at a distance of 1 AU,
+// in arcseconds, equatorial for the giant planets, Meeus, Astronomical
+// Algorithms, chapter 55
+var semidiameters = map[ephemeris.Body]float64{
+	ephemeris.Mercury: 3.36,
+	ephemeris.Venus:   8.41,
+	ephemeris.Mars:    4.68,
+	ephemeris.Jupiter: 98.44,
+	ephemeris.Saturn:  82.73,
+	ephemeris.Uranus:  35.02,
+	ephemeris.Neptune: 33.50,
+	ephemeris.Pluto:   2.07,
+}
+
+// physical is the appearance of a planet from the Earth
+type physical struct {
+	magnitude           float64
+	illuminatedFraction float64
+	phaseAngle          float64
+	apparentDiameter    float64
+	elongation          float64
+}
+
+// Physical -
+func (s *planetsServiceServer) Physical(body ephemeris.Body, jde float64) (physical, error) {
+	var p physical
+	lambda, beta, delta, lightTime, err := s.Geocentric(body, jde)
+	if err != nil {
+		return p, err
+	}
+	l, b, r, err := s.ephemeris.Heliocentric(body, jde-lightTime)
+	if err != nil {
+		return p, err
+	}
+	l0, _, r0, err := s.ephemeris.Heliocentric(ephemeris.Earth, jde)
+	if err != nil {
+		return p, err
+	}
+
+	// Meeus, Astronomical Algorithms, chapter 41
+	p.phaseAngle = radiansToDegrees(math.Acos(clamp((r*r + delta*delta - r0*r0) / (2 * r * delta))))
+	p.illuminatedFraction = ((r+delta)*(r+delta) - r0*r0) / (4 * r * delta)
+	p.elongation = radiansToDegrees(math.Acos(clamp((r0*r0 + delta*delta - r*r) / (2 * r0 * delta))))
+	// The planet is east of the sun when its longitude is up to 180
+	// degrees greater than the sun's
+	if normalise(lambda-(l0+180)) > 180 {
+		p.elongation = -p.elongation
+	}
+	p.apparentDiameter = 2 * semidiameters[body] / delta
+	p.magnitude = magnitude(body, r, delta, p.phaseAngle)
+	if body == ephemeris.Saturn {
+		p.magnitude += saturnRings(l, b, r, lambda, beta, jde)
+	}
+	return p, nil
+}
+
+// magnitude returns the visual magnitude of the body, from the formulae of
+// the Astronomical Almanac for 1984, i is the phase angle in degrees
+func magnitude(body ephemeris.Body, r, delta, i float64) float64 {
+	m := 5 * math.Log10(r*delta)
+	switch body {
+	case ephemeris.Mercury:
+		return m - 0.42 + i*(0.0380+i*(-0.000273+i*0.000002))
+	case ephemeris.Venus:
+		return m - 4.40 + i*(0.0009+i*(0.000239-i*0.00000065))
+	case ephemeris.Mars:
+		return m - 1.52 + 0.016*i
+	case ephemeris.Jupiter:
+		return m - 9.40 + 0.005*i
+	case ephemeris.Saturn:
+		// The rings are added by saturnRings
+		return m - 8.88
+	case ephemeris.Uranus:
+		return m - 7.19
+	case ephemeris.Neptune:
+		return m - 6.87
+	}
+	return m - 1.00
+}
+
+// saturnRings returns the change in Saturn's magnitude due to its rings,
+// Meeus, Astronomical Algorithms, chapters 41 and 45. l, b and r are the
+// heliocentric and lambda and beta the geocentric coordinates of Saturn.
+func saturnRings(l, b, r, lambda, beta, jde float64) float64 {
+	t := julianCentury(jde)
+	i := degreesToRadians(28.075216 + t*(-0.012998+t*0.000004))
+	node := 169.508470 + t*(1.394681+t*0.000412)
+
+	// Saturnicentric latitude of the Earth referred to the ring plane
+	lam := degreesToRadians(lambda - node)
+	bet := degreesToRadians(beta)
+	sinB := math.Sin(i)*math.Cos(bet)*math.Sin(lam) - math.Cos(i)*math.Sin(bet)
+
+	// Difference between the saturnicentric longitudes of the Sun and the
+	// Earth, measured in the ring plane
+	l0 := degreesToRadians(l - 0.01759/r - node)
+	b0 := degreesToRadians(b - 0.000764*math.Cos(degreesToRadians(l-node))/r)
+	u1 := math.Atan2(math.Sin(i)*math.Sin(b0)+math.Cos(i)*math.Cos(b0)*math.Sin(l0), math.Cos(b0)*math.Cos(l0))
+	u2 := math.Atan2(math.Sin(i)*math.Sin(bet)+math.Cos(i)*math.Cos(bet)*math.Sin(lam), math.Cos(bet)*math.Cos(lam))
+	deltaU := math.Abs(radiansToDegrees(u1 - u2))
+	if deltaU > 180 {
+		deltaU = 360 - deltaU
+	}
+	return 0.044*deltaU - 2.60*math.Abs(sinB) + 1.25*sinB*sinB
+}
+
+// clamp limits rounding errors in a cosine to the range -1 to 1
+func clamp(cos float64) float64 {
+	return math.Max(-1, math.Min(1, cos))
+}
@@ -0,0 +1,247 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"os"
+
+	"planetpositions/coordinates/pkg/v1/constellation"
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/coordinates/pkg/v1/topocentric"
+	"planetpositions/coordinates/pkg/v1/transform"
+	jc "planetpositions/julian/pkg/v1/client"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+	// lightTimePerAU is the time taken for light to travel 1 AU, in days
+	lightTimePerAU = 0.0057755183
+)
+
+// bodies maps the planets in requests onto the bodies of the ephemeris
+var bodies = map[v1.Planet]ephemeris.Body{
+	v1.Planet_MERCURY: ephemeris.Mercury,
+	v1.Planet_VENUS:   ephemeris.Venus,
+	v1.Planet_MARS:    ephemeris.Mars,
+	v1.Planet_JUPITER: ephemeris.Jupiter,
+	v1.Planet_SATURN:  ephemeris.Saturn,
+	v1.Planet_URANUS:  ephemeris.Uranus,
+	v1.Planet_NEPTUNE: ephemeris.Neptune,
+	v1.Planet_PLUTO:   ephemeris.Pluto,
+}
+
+// planetsServiceServer is implementation of v1.PlanetsServiceServer proto interface
+type planetsServiceServer struct {
+	jc.JulianClient
+	ephemeris ephemeris.Ephemeris
+	// jpl is the JPL Development Ephemeris requests may ask for, nil when
+	// none is loaded
+	jpl ephemeris.Ephemeris
+}
+
+// NewPlanetsService creates Planets service, the VSOP87 series truncated by
+// Meeus are used unless VSOP87_PATH names a directory holding the version D
+// files, and a JPL ephemeris is offered when JPL_EPHEMERIS_PATH names an SPK
+// file such as de440.bsp
+func NewPlanetsService() v1.PlanetsServiceServer {
+	s := planetsServiceServer{ephemeris: ephemeris.NewTruncatedVSOP87()}
+	s.Address = "julian:5055"
+	if path := os.Getenv("VSOP87_PATH"); path != "" {
+		v, err := ephemeris.NewVSOP87(path)
+		if err != nil {
+			log.Fatalf("could not load VSOP87: %v", err)
+		}
+		s.ephemeris = v
+	}
+	if path := os.Getenv("JPL_EPHEMERIS_PATH"); path != "" {
+		j, err := ephemeris.NewJPL(path)
+		if err != nil {
+			log.Fatalf("could not load the JPL ephemeris: %v", err)
+		}
+		s.jpl = j
+	}
+	return &s
+}
+
+// withEphemeris returns the service using the ephemeris requested
+func (s *planetsServiceServer) withEphemeris(e v1.PlanetEphemeris) (*planetsServiceServer, error) {
+	switch e {
+	case v1.PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED, v1.PlanetEphemeris_ANALYTIC:
+		return s, nil
+	case v1.PlanetEphemeris_JPL:
+		if s.jpl == nil {
+			return nil, fmt.Errorf("no JPL ephemeris is loaded")
+		}
+		c := *s
+		c.ephemeris = s.jpl
+		return &c, nil
+	}
+	return nil, fmt.Errorf("unknown ephemeris %v", e)
+}
+
+func (s *planetsServiceServer) GetPlanetPosition(ctx context.Context, req *v1.PlanetPositionRequest) (*v1.PlanetPosition, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Body == v1.Planet_PLANET_UNSPECIFIED {
+		return nil, fmt.Errorf("unusable input provided: no planet given")
+	}
+	body, ok := bodies[req.Body]
+	if req.Body == v1.Planet_MOON && req.Ephemeris == v1.PlanetEphemeris_JPL {
+		// Only the JPL ephemerides give the moon
+		body, ok = ephemeris.Moon, true
+	}
+	if !ok {
+		return nil, fmt.Errorf("unusable input provided: unknown planet %v", req.Body)
+	}
+	s, err := s.withEphemeris(req.Ephemeris)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Topocentric && (req.Latitude < -90 || req.Latitude > 90) {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	kind, ok := positionKind(req.Kind)
+	if !ok {
+		return nil, fmt.Errorf("unusable input provided: unknown kind of position %v", req.Kind)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	deltaT, err := s.DeltaT(jd)
+	if err != nil {
+		return nil, err
+	}
+	jde := jd + deltaT.Seconds/86400
+	position, err := s.position(body, jde, kind)
+	if err != nil {
+		return nil, err
+	}
+	position.Body = req.Body
+	position.JulianDate = jd
+	if req.Topocentric {
+		// The parallax is found on the true equator of date, about which
+		// the Earth turns, and the place referred back to the frame of its
+		// kind
+		toTrue := precession.NutationMatrix(jde)
+		if kind != v1.PositionKind_MEAN_OF_DATE {
+			toTrue = reduction.TrueEquator(kinds[kind], jde)
+		}
+		o := topocentric.NewObserver(req.Latitude, req.Longitude, req.Height)
+		ra, dec, distance := o.EquatorialInFrame(position.RightAscension, position.Declination, position.Distance*topocentric.AstronomicalUnit, transform.ApparentSiderealTime(jd, jde), toTrue)
+		position.TopocentricRightAscension = ra
+		position.TopocentricDeclination = dec
+		position.TopocentricDistance = distance / topocentric.AstronomicalUnit
+	}
+	return position, nil
+}
+
+// julianDate -
+func (s *planetsServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
+
+// instant returns the calendar date and UTC hour of jd
+func (s *planetsServiceServer) instant(jd float64) (*v1.PlanetInstant, error) {
+	cal, err := s.DayFromJulianDay(jd)
+	if err != nil {
+		return nil, fmt.Errorf("instant encountered the following error when executing DayFromJulianDay: %v", err)
+	}
+	return &v1.PlanetInstant{
+		Year:       cal.Year,
+		Month:      cal.Month,
+		Day:        cal.Day,
+		Hour:       24 * (jd + 0.5 - math.Floor(jd+0.5)),
+		JulianDate: jd,
+	}, nil
+}
+
+// position returns the heliocentric and geocentric positions of the body at
+// the Julian ephemeris day jde, the geocentric position of the kind given
+func (s *planetsServiceServer) position(body ephemeris.Body, jde float64, kind v1.PositionKind) (*v1.PlanetPosition, error) {
+	l, b, r, err := s.ephemeris.Heliocentric(body, jde)
+	if err != nil {
+		return nil, err
+	}
+	lambda, beta, distance, lightTime, err := s.Geocentric(body, jde)
+	if err != nil {
+		return nil, err
+	}
+	ra, dec := transform.EclipticToEquatorial(lambda, beta, precession.MeanObliquity(jde))
+	epoch := jde
+	if kind != v1.PositionKind_MEAN_OF_DATE {
+		p, err := s.reduce(kind, s.source(body), jde)
+		if err != nil {
+			return nil, err
+		}
+		lambda, beta, ra, dec, distance, lightTime, epoch = p.Longitude, p.Latitude, p.RightAscension, p.Declination, p.Distance, p.LightTime, p.Epoch
+	}
+
+	return &v1.PlanetPosition{
+		Api:                   apiVersion,
+		HeliocentricLongitude: l,
+		HeliocentricLatitude:  b,
+		RadiusVector:          r,
+		EclipticLongitude:     lambda,
+		EclipticLatitude:      beta,
+		RightAscension:        ra,
+		Declination:           dec,
+		Distance:              distance,
+		LightTime:             lightTime,
+		Theory:                s.ephemeris.Name(),
+		Constellation:         constellation.Find(ra, dec, epoch).Name,
+		Kind:                  kind,
+	}, nil
+}
+
+// Geocentric -
+func (s *planetsServiceServer) Geocentric(body ephemeris.Body, jde float64) (longitude, latitude, distance, lightTime float64, err error) {
+	// Meeus, Astronomical Algorithms, chapter 33, the planet is seen where
+	// it was when the light left it
+	l0, b0, r0, err := s.ephemeris.Heliocentric(ephemeris.Earth, jde)
+	if err != nil {
+		return 0, 0, 0, 0, err
+	}
+	x0, y0, z0 := rectangular(l0, b0, r0)
+
+	var x, y, z float64
+	for i := 0; i < 3; i++ {
+		l, b, r, err := s.ephemeris.Heliocentric(body, jde-lightTime)
+		if err != nil {
+			return 0, 0, 0, 0, err
+		}
+		x, y, z = rectangular(l, b, r)
+		x, y, z = x-x0, y-y0, z-z0
+		distance = math.Sqrt(x*x + y*y + z*z)
+		lightTime = lightTimePerAU * distance
+	}
+	longitude = normalise(radiansToDegrees(math.Atan2(y, x)))
+	latitude = radiansToDegrees(math.Atan2(z, math.Hypot(x, y)))
+	return longitude, latitude, distance, lightTime, nil
+}
+
+// rectangular converts spherical ecliptic coordinates in degrees into
+// rectangular ones
+func rectangular(longitude, latitude, distance float64) (x, y, z float64) {
+	l := degreesToRadians(longitude)
+	b := degreesToRadians(latitude)
+	return distance * math.Cos(b) * math.Cos(l), distance * math.Cos(b) * math.Sin(l), distance * math.Sin(b)
+}
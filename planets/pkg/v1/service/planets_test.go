@@ -0,0 +1,83 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestService returns the service calling a julian service in process,
+// with dynamical and universal time taken to be the same
+func newTestService(t *testing.T) *planetsServiceServer {
+	s := &planetsServiceServer{ephemeris: ephemeris.NewTruncatedVSOP87()}
+	s.Address = juliantest.NewServer(t, func(float64) float64 { return 0 })
+	return s
+}
+
+func TestGetPlanetPosition(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 33.a, the apparent place of
+	// Venus on 1992 December 20 at 0h TD from the full VSOP87 theory
+	s := newTestService(t)
+	p, err := s.GetPlanetPosition(context.Background(), &v1.PlanetPositionRequest{
+		Api:   apiVersion,
+		Body:  v1.Planet_VENUS,
+		Year:  1992,
+		Month: 12,
+		Day:   20,
+		Kind:  v1.PositionKind_APPARENT,
+	})
+	assert.NoError(t, err)
+	assert.InDelta(t, (21+4.0/60+41.454/3600)*15, p.RightAscension, 1.0/3600)
+	assert.InDelta(t, -(18 + 53.0/60 + 16.84/3600), p.Declination, 1.0/3600)
+
+	// An unset kind is apparent, as for the sun and the moon
+	unset, err := s.GetPlanetPosition(context.Background(), &v1.PlanetPositionRequest{
+		Api:   apiVersion,
+		Body:  v1.Planet_VENUS,
+		Year:  1992,
+		Month: 12,
+		Day:   20,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, v1.PositionKind_APPARENT, unset.Kind)
+	assert.Equal(t, p.RightAscension, unset.RightAscension)
+	assert.Equal(t, p.Declination, unset.Declination)
+}
+
+func TestGetPlanetPositionTopocentric(t *testing.T) {
+	// The parallax of Mars from Palomar near the close opposition of 1909 is
+	// the same in every frame once the astrometric place is brought to the
+	// true equator of date
+	s := newTestService(t)
+	req := &v1.PlanetPositionRequest{
+		Api:         apiVersion,
+		Body:        v1.Planet_MARS,
+		Year:        1909,
+		Month:       9,
+		Day:         24,
+		Hour:        8,
+		Topocentric: true,
+		Longitude:   -(116 + 51.0/60 + 45.0/3600),
+		Latitude:    33 + 21.0/60 + 22.0/3600,
+		Height:      1706,
+		Kind:        v1.PositionKind_APPARENT,
+	}
+	apparent, err := s.GetPlanetPosition(context.Background(), req)
+	assert.NoError(t, err)
+	req.Kind = v1.PositionKind_ASTROMETRIC
+	astrometric, err := s.GetPlanetPosition(context.Background(), req)
+	assert.NoError(t, err)
+
+	toTrue := reduction.TrueEquator(reduction.Astrometric, apparent.JulianDate)
+	ra, dec := toTrue.Spherical(astrometric.RightAscension, astrometric.Declination)
+	topoRA, topoDec := toTrue.Spherical(astrometric.TopocentricRightAscension, astrometric.TopocentricDeclination)
+	assert.InDelta(t, apparent.TopocentricRightAscension-apparent.RightAscension, topoRA-ra, 0.01/3600)
+	assert.InDelta(t, apparent.TopocentricDeclination-apparent.Declination, topoDec-dec, 0.01/3600)
+	assert.InDelta(t, apparent.TopocentricDistance, astrometric.TopocentricDistance, 1e-6)
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+	"planetpositions/planets/pkg/v1/minor"
+)
+
+// kinds maps the kinds of position in requests onto those of the reduction,
+// positions of the mean equinox of date are found without it
+var kinds = map[v1.PositionKind]reduction.Kind{
+	v1.PositionKind_GEOMETRIC:   reduction.Geometric,
+	v1.PositionKind_ASTROMETRIC: reduction.Astrometric,
+	v1.PositionKind_APPARENT:    reduction.Apparent,
+}
+
+// positionKind returns the kind of position asked for, apparent when none
+// is, and whether it is known
+func positionKind(kind v1.PositionKind) (v1.PositionKind, bool) {
+	if kind == v1.PositionKind_POSITION_KIND_UNSPECIFIED {
+		return v1.PositionKind_APPARENT, true
+	}
+	_, ok := kinds[kind]
+	return kind, ok || kind == v1.PositionKind_MEAN_OF_DATE
+}
+
+// source gives the positions of a body of the ephemeris to the reduction
+func (s *planetsServiceServer) source(body ephemeris.Body) reduction.Source {
+	return func(jde float64) (reduction.Vector, error) {
+		l, b, r, err := s.ephemeris.Heliocentric(body, jde)
+		if err != nil {
+			return reduction.Vector{}, err
+		}
+		return reduction.Ecliptic(l, b, r, jde), nil
+	}
+}
+
+// minorSource gives the positions of a comet or asteroid to the reduction,
+// the elements are referred to the ecliptic and equinox of J2000.0
+func minorSource(elements minor.Elements) reduction.Source {
+	return func(jde float64) (reduction.Vector, error) {
+		x, y, z, err := elements.Heliocentric(jde)
+		if err != nil {
+			return reduction.Vector{}, err
+		}
+		r := math.Sqrt(x*x + y*y + z*z)
+		return reduction.Ecliptic(radiansToDegrees(math.Atan2(y, x)), radiansToDegrees(math.Asin(z/r)), r, precession.J2000), nil
+	}
+}
+
+// reduce returns the geocentric position of the kind of a body whose
+// positions the source gives
+func (s *planetsServiceServer) reduce(kind v1.PositionKind, body reduction.Source, jde float64) (reduction.Place, error) {
+	return reduction.Reduce(kinds[kind], body, s.source(ephemeris.Earth), jde)
+}
@@ -0,0 +1,286 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+)
+
+const (
+	// civilTwilight is the altitude of the sun's centre at the start and end
+	// of civil twilight
+	civilTwilight = -6.0
+	// visibleAltitude and visibleMagnitude are the least altitude and
+	// faintest magnitude at which a planet is reported visible
+	visibleAltitude  = 5.0
+	visibleMagnitude = 6.0
+	// visibilitySamples is the number of times a day altitudes are sampled
+	// when searching for events
+	visibilitySamples = 144
+)
+
+func (s *planetsServiceServer) GetPlanetVisibility(ctx context.Context, req *v1.PlanetVisibilityRequest) (*v1.PlanetVisibility, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Body == v1.Planet_PLANET_UNSPECIFIED {
+		return nil, fmt.Errorf("unusable input provided: no planet given")
+	}
+	body, ok := bodies[req.Body]
+	if !ok {
+		return nil, fmt.Errorf("unusable input provided: unknown planet %v", req.Body)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	if req.UtcOffset < -14 || req.UtcOffset > 14 {
+		return nil, fmt.Errorf("unusable input provided: utc offset must be between -14 and 14 hours")
+	}
+	// A planet rises and sets when its centre is depressed by the
+	// refraction at the horizon
+	horizon, err := refraction.AtHorizon(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, 0)
+	if err != nil {
+		return nil, err
+	}
+	start := jd - req.UtcOffset/24
+	deltaT, err := s.DeltaT(start)
+	if err != nil {
+		return nil, err
+	}
+	// delta T barely changes over a couple of days
+	dt := deltaT.Seconds / 86400
+
+	p, err := s.Physical(body, start+dt)
+	if err != nil {
+		return nil, err
+	}
+	visibility := &v1.PlanetVisibility{
+		Api:                 apiVersion,
+		Body:                req.Body,
+		Magnitude:           p.magnitude,
+		IlluminatedFraction: p.illuminatedFraction,
+		PhaseAngle:          p.phaseAngle,
+		ApparentDiameter:    p.apparentDiameter,
+		Elongation:          p.elongation,
+	}
+
+	planet := func(jd float64) (horizontal, error) {
+		ra, dec, err := s.equatorial(body, jd+dt)
+		if err != nil {
+			return horizontal{}, err
+		}
+		return s.horizontal(jd, ra, dec, req.Longitude, req.Latitude), nil
+	}
+	sun := func(jd float64) (horizontal, error) {
+		ra, dec, err := s.sunEquatorial(jd + dt)
+		if err != nil {
+			return horizontal{}, err
+		}
+		return s.horizontal(jd, ra, dec, req.Longitude, req.Latitude), nil
+	}
+
+	// Rise, transit and set on the date
+	events, err := s.horizonEvents(planet, start, start+1, -horizon)
+	if err != nil {
+		return nil, err
+	}
+	visibility.RiseStatus = events.status(events.rise)
+	visibility.TransitStatus = events.status(events.transit)
+	visibility.SetStatus = events.status(events.set)
+	if visibility.Rise, err = s.planetEvent(events.rise, planet); err != nil {
+		return nil, err
+	}
+	if visibility.Transit, err = s.planetEvent(events.transit, planet); err != nil {
+		return nil, err
+	}
+	if visibility.Set, err = s.planetEvent(events.set, planet); err != nil {
+		return nil, err
+	}
+
+	// The night that follows the date runs from dusk, searched for from
+	// local noon, until the following dawn
+	twilight, err := s.horizonEvents(sun, start+0.5, start+1.5, civilTwilight)
+	if err != nil {
+		return nil, err
+	}
+	if twilight.set == 0 && twilight.up {
+		// The sun does not get low enough for a dark sky
+		return visibility, nil
+	}
+	dusk := twilight.set
+	if dusk == 0 {
+		dusk = start + 0.5
+	}
+	dawn := start + 1.5
+	if twilight.rise > dusk {
+		dawn = twilight.rise
+	}
+	if twilight.set != 0 {
+		if visibility.Dusk, err = s.instant(twilight.set); err != nil {
+			return nil, err
+		}
+	}
+	if twilight.rise > dusk {
+		if visibility.Dawn, err = s.instant(twilight.rise); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.magnitude > visibleMagnitude {
+		return visibility, nil
+	}
+	for i := 0; i <= visibilitySamples; i++ {
+		h, err := planet(dusk + float64(i)*(dawn-dusk)/visibilitySamples)
+		if err != nil {
+			return nil, err
+		}
+		if h.altitude >= visibleAltitude {
+			visibility.VisibleTonight = true
+			break
+		}
+	}
+	return visibility, nil
+}
+
+// horizontal is the position of a body for an observer
+type horizontal struct {
+	azimuth   float64
+	altitude  float64
+	hourAngle float64
+}
+
+// horizonEvents are the first times, in universal time, a body rises above
+// and sets below an altitude and transits during a period, zero when they do
+// not occur, and whether the body was ever up or down
+type horizonEvents struct {
+	rise, transit, set float64
+	up, down           bool
+}
+
+func (e horizonEvents) status(event float64) v1.PlanetEventStatus {
+	switch {
+	case event != 0:
+		return v1.PlanetEventStatus_EVENT_OCCURS
+	case !e.down:
+		return v1.PlanetEventStatus_ALWAYS_UP
+	case !e.up:
+		return v1.PlanetEventStatus_ALWAYS_DOWN
+	}
+	return v1.PlanetEventStatus_NO_EVENT_ON_DATE
+}
+
+// horizonEvents samples the position of a body between start and end
+func (s *planetsServiceServer) horizonEvents(at func(float64) (horizontal, error), start, end, altitude float64) (horizonEvents, error) {
+	var events horizonEvents
+	var err error
+	// The root finders cannot return errors, so the first one is kept
+	above := func(jd float64) float64 {
+		h, e := at(jd)
+		if e != nil && err == nil {
+			err = e
+		}
+		return h.altitude - altitude
+	}
+	meridian := func(jd float64) float64 {
+		h, e := at(jd)
+		if e != nil && err == nil {
+			err = e
+		}
+		if h.hourAngle > 180 {
+			return h.hourAngle - 360
+		}
+		return h.hourAngle
+	}
+
+	prevAlt, prevHA := above(start), meridian(start)
+	events.up, events.down = prevAlt >= 0, prevAlt < 0
+	for i := 0; i < visibilitySamples; i++ {
+		jd := start + float64(i)*(end-start)/visibilitySamples
+		next := start + float64(i+1)*(end-start)/visibilitySamples
+		alt, ha := above(next), meridian(next)
+
+		events.up = events.up || alt >= 0
+		events.down = events.down || alt < 0
+		if events.rise == 0 && prevAlt < 0 && alt >= 0 {
+			events.rise = bisect(above, jd, next)
+		}
+		if events.set == 0 && prevAlt >= 0 && alt < 0 {
+			events.set = bisect(above, jd, next)
+		}
+		// The hour angle also changes sign when it wraps from 180 to -180
+		if events.transit == 0 && prevHA < 0 && ha >= 0 && ha-prevHA < 90 {
+			events.transit = bisect(meridian, jd, next)
+		}
+		prevAlt, prevHA = alt, ha
+	}
+	return events, err
+}
+
+// equatorial returns the geocentric right ascension and declination of the
+// body in degrees
+func (s *planetsServiceServer) equatorial(body ephemeris.Body, jde float64) (rightAscension, declination float64, err error) {
+	lambda, beta, _, _, err := s.Geocentric(body, jde)
+	if err != nil {
+		return 0, 0, err
+	}
+	rightAscension, declination = transform.EclipticToEquatorial(lambda, beta, precession.MeanObliquity(jde))
+	return rightAscension, declination, nil
+}
+
+// sunEquatorial returns the geocentric right ascension and declination of
+// the sun in degrees
+func (s *planetsServiceServer) sunEquatorial(jde float64) (rightAscension, declination float64, err error) {
+	l, b, _, err := s.ephemeris.Heliocentric(ephemeris.Earth, jde)
+	if err != nil {
+		return 0, 0, err
+	}
+	rightAscension, declination = transform.EclipticToEquatorial(normalise(l+180), -b, precession.MeanObliquity(jde))
+	return rightAscension, declination, nil
+}
+
+// horizontal returns the position of a body at right ascension ra and
+// declination dec for an observer at the instant jd in universal time
+func (s *planetsServiceServer) horizontal(jd, ra, dec, longitude, latitude float64) horizontal {
+	// longitude is positive east of Greenwich
+	hourAngle := transform.HourAngle(ra, longitude, jd)
+	azimuth, altitude := transform.EquatorialToHorizontal(hourAngle, dec, latitude)
+	return horizontal{
+		azimuth:   azimuth,
+		altitude:  altitude,
+		hourAngle: hourAngle,
+	}
+}
+
+func (s *planetsServiceServer) planetEvent(jd float64, at func(float64) (horizontal, error)) (*v1.PlanetEvent, error) {
+	if jd == 0 {
+		return nil, nil
+	}
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	h, err := at(jd)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.PlanetEvent{
+		Time:     time,
+		Azimuth:  h.azimuth,
+		Altitude: h.altitude,
+	}, nil
+}
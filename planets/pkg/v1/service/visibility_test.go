@@ -0,0 +1,114 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"planetpositions/planets/grpc/v1"
+	"planetpositions/planets/pkg/v1/ephemeris"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPlanetVisibility(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 15.a, Venus from Boston on 1988
+	// March 20 rises at 12h25m26s, transits at 19h40m30s and sets at
+	// 2h54m40s UT, this last the evening of the 19th locally
+	s := newTestService(t)
+	req := &v1.PlanetVisibilityRequest{
+		Api:       apiVersion,
+		Body:      v1.Planet_VENUS,
+		Longitude: -71.0833,
+		Latitude:  42.3333,
+		Year:      1988,
+		Month:     3,
+		Day:       20,
+	}
+	res, err := s.GetPlanetVisibility(context.Background(), req)
+	require.NoError(t, err)
+	const second = 1.0 / 3600
+	require.Equal(t, v1.PlanetEventStatus_EVENT_OCCURS, res.RiseStatus)
+	assert.InDelta(t, 12+25.0/60+26*second, res.Rise.Time.Hour, 5*second)
+	assert.InDelta(t, -0.5667, res.Rise.Altitude, 0.001)
+	require.Equal(t, v1.PlanetEventStatus_EVENT_OCCURS, res.TransitStatus)
+	assert.InDelta(t, 19+40.0/60+30*second, res.Transit.Time.Hour, 5*second)
+	assert.InDelta(t, 180, res.Transit.Azimuth, 1e-4)
+	require.Equal(t, v1.PlanetEventStatus_EVENT_OCCURS, res.SetStatus)
+	assert.InDelta(t, 2+54.0/60+40*second, res.Set.Time.Hour, 5*second)
+	assert.Equal(t, int32(20), res.Set.Time.Day)
+
+	// Five hours west of Greenwich the local date starts at 5h UT, so the
+	// set is that of the evening of the 20th
+	req.UtcOffset = -5
+	res, err = s.GetPlanetVisibility(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, res.Set)
+	assert.Equal(t, int32(21), res.Set.Time.Day)
+	assert.InDelta(t, 2.9, res.Set.Time.Hour, 0.1)
+
+	// At a declination of -19 degrees the evening star of December 1992
+	// never clears the horizon at 75 degrees north and never sets at 75
+	// south
+	req = &v1.PlanetVisibilityRequest{
+		Api:      apiVersion,
+		Body:     v1.Planet_VENUS,
+		Latitude: 75,
+		Year:     1992,
+		Month:    12,
+		Day:      20,
+	}
+	res, err = s.GetPlanetVisibility(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PlanetEventStatus_ALWAYS_DOWN, res.RiseStatus)
+	assert.Equal(t, v1.PlanetEventStatus_ALWAYS_DOWN, res.SetStatus)
+	assert.Nil(t, res.Rise)
+	assert.False(t, res.VisibleTonight)
+
+	req.Latitude = -75
+	res, err = s.GetPlanetVisibility(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, v1.PlanetEventStatus_ALWAYS_UP, res.RiseStatus)
+	assert.Equal(t, v1.PlanetEventStatus_ALWAYS_UP, res.SetStatus)
+	assert.Equal(t, v1.PlanetEventStatus_EVENT_OCCURS, res.TransitStatus)
+
+	// From Greenwich it sets more than two hours after dusk
+	req.Latitude = 51.4779
+	res, err = s.GetPlanetVisibility(context.Background(), req)
+	require.NoError(t, err)
+	require.NotNil(t, res.Dusk)
+	assert.True(t, res.Set.Time.JulianDate > res.Dusk.JulianDate+2.0/24)
+	assert.True(t, res.VisibleTonight)
+
+	req.Body = v1.Planet_PLANET_UNSPECIFIED
+	_, err = s.GetPlanetVisibility(context.Background(), req)
+	assert.Error(t, err)
+}
+
+func TestPlanetPhase(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 41.a, Venus on 1992 December
+	// 20 at 0h TD has a phase angle of 72.96 degrees and is 0.647 illuminated
+	s := newTestService(t)
+	res, err := s.GetPlanetVisibility(context.Background(), &v1.PlanetVisibilityRequest{
+		Api:   apiVersion,
+		Body:  v1.Planet_VENUS,
+		Year:  1992,
+		Month: 12,
+		Day:   20,
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 72.96, res.PhaseAngle, 0.01)
+	assert.InDelta(t, 0.647, res.IlluminatedFraction, 0.001)
+	assert.True(t, res.Elongation > 0, "Venus is an evening star")
+
+	// Meeus gives a magnitude of -3.8 by G. Müller's formula, the formula of
+	// the Astronomical Almanac for 1984 used here gives -4.2 at the same
+	// distances and phase
+	const r, delta = 0.724604, 0.910947
+	i := res.PhaseAngle
+	muller := -4.00 + 5*math.Log10(r*delta) + 0.01322*i + 0.0000004247*i*i*i
+	assert.InDelta(t, -3.8, muller, 0.05)
+	assert.InDelta(t, magnitude(ephemeris.Venus, r, delta, i), res.Magnitude, 0.001)
+	assert.InDelta(t, -4.2, res.Magnitude, 0.05)
+}
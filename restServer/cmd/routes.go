@@ -2,19 +2,63 @@ package main
 
 import (
 	"fmt"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"strconv"
+	"strings"
 
+	coordinatesv1 "planetpositions/coordinates/grpc/v1"
+	coordinates "planetpositions/coordinates/pkg/v1/client"
+	moonv1 "planetpositions/moon/grpc/v1"
+	moon "planetpositions/moon/pkg/v1/client"
+	planetsv1 "planetpositions/planets/grpc/v1"
+	planets "planetpositions/planets/pkg/v1/client"
+	satellites "planetpositions/satellites/pkg/v1/client"
+	stars "planetpositions/stars/pkg/v1/client"
+	sunv1 "planetpositions/sun/grpc/v1"
 	sun "planetpositions/sun/pkg/v1/client"
 
 	"github.com/go-chi/chi"
+	"github.com/golang/protobuf/ptypes/wrappers"
 )
 
 var sc = sun.SunClient{Address: "sun.planet_positions:5055"}
+var mc = moon.MoonClient{Address: "moon.planet_positions:5055"}
+var pc = planets.PlanetsClient{Address: "planets.planet_positions:5055"}
+var stc = stars.StarsClient{Address: "stars.planet_positions:5055"}
+var sac = satellites.SatellitesClient{Address: "satellites.planet_positions:5055"}
+var cc = coordinates.CoordinatesClient{Address: "coordinates.planet_positions:5055"}
 
 func planetRoutes() *chi.Mux {
 	router := chi.NewRouter()
 	router.Get("/Sunrise/{long}/{lat}/{year}/{month}/{day}", GetSunrise)
+	router.Get("/Shadow/{long}/{lat}/{year}/{month}/{day}/{hour}/{height}", GetShadow)
+	router.Get("/SolarEphemeris/{jd}", GetSolarEphemeris)
+	router.Get("/DayLength/{long}/{lat}/{year}/{month}/{day}", GetDayLength)
+	router.Get("/SolarTime/{long}/{year}/{month}/{day}/{hour}", GetSolarTime)
+	router.Get("/UniversalTime/{long}/{year}/{month}/{day}/{hour}", GetUniversalTime)
+	router.Get("/SolarEclipses/{long}/{lat}/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetSolarEclipses)
+	router.Get("/MeteorShowers/{long}/{lat}/{year}", GetMeteorShowers)
+	router.Get("/MoonPosition/{long}/{lat}/{year}/{month}/{day}/{hour}", GetMoonPosition)
+	router.Get("/MoonPhases/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetMoonPhases)
+	router.Get("/MoonIllumination/{year}/{month}/{day}/{hour}", GetMoonIllumination)
+	router.Get("/MoonRiseSet/{long}/{lat}/{year}/{month}/{day}", GetMoonRiseSet)
+	router.Get("/LunarEclipses/{long}/{lat}/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetLunarEclipses)
+	router.Get("/LunarOccultations/{long}/{lat}/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetLunarOccultations)
+	router.Get("/PlanetPosition/{body}/{year}/{month}/{day}/{hour}", GetPlanetPosition)
+	router.Get("/PlanetVisibility/{body}/{long}/{lat}/{year}/{month}/{day}", GetPlanetVisibility)
+	router.Get("/PlanetaryEvents/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetPlanetaryEvents)
+	router.Post("/MinorBodyPosition/{year}/{month}/{day}/{hour}", GetMinorBodyPosition)
+	router.Get("/GalileanMoons/{year}/{month}/{day}/{hour}", GetGalileanMoons)
+	router.Get("/GalileanEvents/{startYear}/{startMonth}/{startDay}/{endYear}/{endMonth}/{endDay}", GetGalileanEvents)
+	router.Get("/Star/{star}", GetStar)
+	router.Get("/Stars/{maxMagnitude}", GetStars)
+	router.Get("/StarPosition/{star}/{long}/{lat}/{year}/{month}/{day}/{hour}", GetStarPosition)
+	router.Get("/StarRiseSet/{star}/{long}/{lat}/{year}/{month}/{day}", GetStarRiseSet)
+	router.Post("/SatellitePasses/{long}/{lat}/{year}/{month}/{day}", GetSatellitePasses)
+	router.Get("/Transform/{from}/{to}/{first}/{second}", GetTransform)
+	router.Get("/Constellation/{ra}/{dec}", GetConstellation)
 	return router
 }
 
@@ -40,7 +84,17 @@ func GetSunrise(w http.ResponseWriter, r *http.Request) {
 	if err != nil {
 		respondWithError(w, http.StatusBadRequest, "malformed day")
 	}
-	st, err := sc.GetSunrise(long, lat, int32(year), int32(month), int32(day))
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	st, err := sc.GetSunrise(long, lat, int32(year), int32(month), int32(day), model, temperature, pressure, highPrecision)
 	if err != nil {
 		// TODO
 		// log the error
@@ -50,3 +104,1368 @@ func GetSunrise(w http.ResponseWriter, r *http.Request) {
 	respondWithJSON(w, http.StatusOK, st)
 
 }
+
+// GetShadow -
+func GetShadow(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+	height, err := strconv.ParseFloat(chi.URLParam(r, "height"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed height")
+		return
+	}
+
+	// The footprint is optional and supplied as query parameters
+	footprint := map[string]float64{"width": 0, "depth": 0, "bearing": 0}
+	for k := range footprint {
+		v := r.URL.Query().Get(k)
+		if v == "" {
+			continue
+		}
+		footprint[k], err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed footprint "+k)
+			return
+		}
+	}
+
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sh, err := sc.GetShadow(long, lat, int32(year), int32(month), int32(day), hour, height, footprint["width"], footprint["depth"], footprint["bearing"], model, temperature, pressure, highPrecision)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetShadow with Y: %d, M: %d, D: %d, H: %f, Long: %f, Lat: %f, Height: %f, Error: %v", year, month, day, hour, long, lat, height, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, sh)
+}
+
+// GetSolarEclipses -
+func GetSolarEclipses(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+	// The observer's height is optional and supplied as a query parameter
+	height := 0.0
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+
+	se, err := sc.GetSolarEclipses(long, lat, height, dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"])
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetSolarEclipses with Dates: %v, Long: %f, Lat: %f, Error: %v", dates, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, se)
+}
+
+// solarTimeParams parses the URL parameters shared by the solar time routes
+func solarTimeParams(w http.ResponseWriter, r *http.Request) (long float64, year, month, day int32, hour float64, ok bool) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	y, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	m, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	d, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err = strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+	return long, int32(y), int32(m), int32(d), hour, true
+}
+
+// GetSolarTime -
+func GetSolarTime(w http.ResponseWriter, r *http.Request) {
+	long, year, month, day, hour, ok := solarTimeParams(w, r)
+	if !ok {
+		return
+	}
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	st, err := sc.GetSolarTime(long, year, month, day, hour, highPrecision)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetSolarTime with Y: %d, M: %d, D: %d, H: %f, Long: %f, Error: %v", year, month, day, hour, long, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, st)
+}
+
+// GetUniversalTime -
+func GetUniversalTime(w http.ResponseWriter, r *http.Request) {
+	long, year, month, day, hour, ok := solarTimeParams(w, r)
+	if !ok {
+		return
+	}
+	// The kind of solar time supplied defaults to local mean time
+	kind := sunv1.SolarTimeKind_MEAN_SOLAR_TIME
+	switch r.URL.Query().Get("kind") {
+	case "", "mean":
+	case "apparent":
+		kind = sunv1.SolarTimeKind_APPARENT_SOLAR_TIME
+	default:
+		respondWithError(w, http.StatusBadRequest, "malformed kind, expected mean or apparent")
+		return
+	}
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	st, err := sc.GetUniversalTime(long, year, month, day, hour, kind, highPrecision)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetUniversalTime with Y: %d, M: %d, D: %d, H: %f, Long: %f, Error: %v", year, month, day, hour, long, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, st)
+}
+
+// GetSolarEphemeris -
+func GetSolarEphemeris(w http.ResponseWriter, r *http.Request) {
+	jd, err := strconv.ParseFloat(chi.URLParam(r, "jd"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed julian date")
+		return
+	}
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The kind of position is optional and supplied as a query parameter
+	kind := sunv1.SolarPositionKind_SOLAR_POSITION_KIND_UNSPECIFIED
+	if v := r.URL.Query().Get("kind"); v != "" {
+		k, ok := sunv1.SolarPositionKind_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown kind of position")
+			return
+		}
+		kind = sunv1.SolarPositionKind(k)
+	}
+	se, err := sc.GetSolarEphemeris(jd, highPrecision, kind)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetSolarEphemeris with JD: %f, Error: %v", jd, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, se)
+}
+
+// GetDayLength -
+func GetDayLength(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	// The target day length is optional and supplied as a query parameter
+	target := 0.0
+	if v := r.URL.Query().Get("target"); v != "" {
+		target, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed target")
+			return
+		}
+	}
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	highPrecision, err := highPrecisionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	dl, err := sc.GetDayLength(long, lat, int32(year), int32(month), int32(day), target, model, temperature, pressure, highPrecision)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetDayLength with Y: %d, M: %d, D: %d, Long: %f, Lat: %f, Error: %v", year, month, day, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, dl)
+}
+
+// GetMeteorShowers -
+func GetMeteorShowers(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	// The showers wanted are optional and supplied as a comma separated
+	// query parameter
+	showers := []string{}
+	if v := r.URL.Query().Get("showers"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			showers = append(showers, strings.TrimSpace(name))
+		}
+	}
+
+	ms, err := sc.GetMeteorShowers(long, lat, int32(year), showers)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMeteorShowers with Y: %d, Long: %f, Lat: %f, Showers: %v, Error: %v", year, long, lat, showers, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, ms)
+}
+
+// GetMoonPosition -
+func GetMoonPosition(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+	// The observer's height is optional and supplied as a query parameter
+	height := 0.0
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The kind of position is optional and supplied as a query parameter
+	kind := moonv1.MoonPositionKind_MOON_POSITION_KIND_UNSPECIFIED
+	if v := r.URL.Query().Get("kind"); v != "" {
+		k, ok := moonv1.MoonPositionKind_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown kind of position")
+			return
+		}
+		kind = moonv1.MoonPositionKind(k)
+	}
+	mp, err := mc.GetMoonPosition(long, lat, height, int32(year), int32(month), int32(day), hour, model, temperature, pressure, kind)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMoonPosition with Y: %d, M: %d, D: %d, H: %f, Long: %f, Lat: %f, Error: %v", year, month, day, hour, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, mp)
+}
+
+// GetMoonPhases -
+func GetMoonPhases(w http.ResponseWriter, r *http.Request) {
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+
+	mp, err := mc.GetMoonPhases(dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"])
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMoonPhases with Dates: %v, Error: %v", dates, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, mp)
+}
+
+// GetMoonIllumination -
+func GetMoonIllumination(w http.ResponseWriter, r *http.Request) {
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+
+	mi, err := mc.GetMoonIllumination(int32(year), int32(month), int32(day), hour)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMoonIllumination with Y: %d, M: %d, D: %d, H: %f, Error: %v", year, month, day, hour, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, mi)
+}
+
+// GetMoonRiseSet -
+func GetMoonRiseSet(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	// The observer's height and UTC offset are optional and supplied as
+	// query parameters
+	params := map[string]float64{"height": 0, "offset": 0}
+	for k := range params {
+		v := r.URL.Query().Get(k)
+		if v == "" {
+			continue
+		}
+		params[k], err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+	}
+
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	rs, err := mc.GetMoonRiseSet(long, lat, params["height"], int32(year), int32(month), int32(day), params["offset"], model, temperature, pressure)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMoonRiseSet with Y: %d, M: %d, D: %d, Long: %f, Lat: %f, Error: %v", year, month, day, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, rs)
+}
+
+// GetLunarEclipses -
+func GetLunarEclipses(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+	// The observer's height is optional and supplied as a query parameter
+	height := 0.0
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+
+	le, err := mc.GetLunarEclipses(long, lat, height, dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"])
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetLunarEclipses with Dates: %v, Long: %f, Lat: %f, Error: %v", dates, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, le)
+}
+
+// GetLunarOccultations -
+func GetLunarOccultations(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+	// The observer's height is optional and supplied as a query parameter
+	height := 0.0
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+	// The stars and planets wanted are optional and supplied as a comma
+	// separated list, all of them are searched without it
+	targets := []string{}
+	if v := r.URL.Query().Get("targets"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			targets = append(targets, strings.TrimSpace(name))
+		}
+	}
+
+	// The ephemeris is optional and supplied as a query parameter
+	eph := moonv1.OccultationEphemeris_OCCULTATION_EPHEMERIS_UNSPECIFIED
+	if v := r.URL.Query().Get("ephemeris"); v != "" {
+		e, ok := moonv1.OccultationEphemeris_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown ephemeris")
+			return
+		}
+		eph = moonv1.OccultationEphemeris(e)
+	}
+
+	lo, err := mc.GetLunarOccultations(long, lat, height, dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"], targets, eph)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetLunarOccultations with Dates: %v, Long: %f, Lat: %f, Targets: %v, Error: %v", dates, long, lat, targets, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, lo)
+}
+
+// GetPlanetPosition -
+func GetPlanetPosition(w http.ResponseWriter, r *http.Request) {
+	body, ok := planetsv1.Planet_value[strings.ToUpper(chi.URLParam(r, "body"))]
+	if !ok || body == int32(planetsv1.Planet_PLANET_UNSPECIFIED) {
+		respondWithError(w, http.StatusBadRequest, "unknown planet")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+	// The observer is optional and supplied as query parameters, the
+	// topocentric position is given when both longitude and latitude are
+	long, lat, height := 0.0, 0.0, 0.0
+	topocentric := r.URL.Query().Get("long") != "" && r.URL.Query().Get("lat") != ""
+	if topocentric {
+		long, err = strconv.ParseFloat(r.URL.Query().Get("long"), 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed longitude")
+			return
+		}
+		lat, err = strconv.ParseFloat(r.URL.Query().Get("lat"), 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed latitude")
+			return
+		}
+	}
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+	// The kind of position is optional and supplied as a query parameter
+	kind := planetsv1.PositionKind_POSITION_KIND_UNSPECIFIED
+	if v := r.URL.Query().Get("kind"); v != "" {
+		k, ok := planetsv1.PositionKind_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown kind of position")
+			return
+		}
+		kind = planetsv1.PositionKind(k)
+	}
+
+	// The ephemeris is optional and supplied as a query parameter
+	eph := planetsv1.PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED
+	if v := r.URL.Query().Get("ephemeris"); v != "" {
+		e, ok := planetsv1.PlanetEphemeris_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown ephemeris")
+			return
+		}
+		eph = planetsv1.PlanetEphemeris(e)
+	}
+
+	pp, err := pc.GetPlanetPosition(planetsv1.Planet(body), int32(year), int32(month), int32(day), hour, topocentric, long, lat, height, kind, eph)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetPlanetPosition with Body: %d, Y: %d, M: %d, D: %d, H: %f, Error: %v", body, year, month, day, hour, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, pp)
+}
+
+// GetPlanetVisibility -
+func GetPlanetVisibility(w http.ResponseWriter, r *http.Request) {
+	body, ok := planetsv1.Planet_value[strings.ToUpper(chi.URLParam(r, "body"))]
+	if !ok || body == int32(planetsv1.Planet_PLANET_UNSPECIFIED) {
+		respondWithError(w, http.StatusBadRequest, "unknown planet")
+		return
+	}
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	// The UTC offset is optional and supplied as a query parameter
+	offset := 0.0
+	if v := r.URL.Query().Get("offset"); v != "" {
+		offset, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed offset")
+			return
+		}
+	}
+
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	pv, err := pc.GetPlanetVisibility(planetsv1.Planet(body), long, lat, int32(year), int32(month), int32(day), offset, model, temperature, pressure)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetPlanetVisibility with Body: %d, Y: %d, M: %d, D: %d, Long: %f, Lat: %f, Error: %v", body, year, month, day, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, pv)
+}
+
+// GetPlanetaryEvents -
+func GetPlanetaryEvents(w http.ResponseWriter, r *http.Request) {
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+	// The planets searched are optional and supplied as a comma separated
+	// query parameter
+	bodies := []planetsv1.Planet{}
+	if v := r.URL.Query().Get("bodies"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			body, ok := planetsv1.Planet_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok || body == int32(planetsv1.Planet_PLANET_UNSPECIFIED) {
+				respondWithError(w, http.StatusBadRequest, "unknown planet "+name)
+				return
+			}
+			bodies = append(bodies, planetsv1.Planet(body))
+		}
+	}
+
+	pe, err := pc.GetPlanetaryEvents(bodies, dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"])
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetPlanetaryEvents with Dates: %v, Bodies: %v, Error: %v", dates, bodies, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, pe)
+}
+
+// starIdentifier splits a star given as a name, an HR number such as HR7001
+// or a HIP number such as HIP91262
+func starIdentifier(star string) (name string, hr, hip int32) {
+	upper := strings.ToUpper(strings.Replace(star, " ", "", -1))
+	for prefix, number := range map[string]*int32{"HIP": &hip, "HR": &hr} {
+		if !strings.HasPrefix(upper, prefix) {
+			continue
+		}
+		if n, err := strconv.Atoi(upper[len(prefix):]); err == nil {
+			*number = int32(n)
+			return "", hr, hip
+		}
+	}
+	return star, 0, 0
+}
+
+// GetMinorBodyPosition -
+func GetMinorBodyPosition(w http.ResponseWriter, r *http.Request) {
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+	elements, err := uploaded(w, r, "elements")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	// The kind of position is optional and supplied as a query parameter
+	kind := planetsv1.PositionKind_POSITION_KIND_UNSPECIFIED
+	if v := r.URL.Query().Get("kind"); v != "" {
+		k, ok := planetsv1.PositionKind_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown kind of position")
+			return
+		}
+		kind = planetsv1.PositionKind(k)
+	}
+
+	// The ephemeris is optional and supplied as a query parameter
+	eph := planetsv1.PlanetEphemeris_PLANET_EPHEMERIS_UNSPECIFIED
+	if v := r.URL.Query().Get("ephemeris"); v != "" {
+		e, ok := planetsv1.PlanetEphemeris_value[strings.ToUpper(v)]
+		if !ok {
+			respondWithError(w, http.StatusBadRequest, "unknown ephemeris")
+			return
+		}
+		eph = planetsv1.PlanetEphemeris(e)
+	}
+
+	mp, err := pc.GetMinorBodyPosition(elements, int32(year), int32(month), int32(day), hour, kind, eph)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetMinorBodyPosition with Y: %d, M: %d, D: %d, H: %f, Error: %v", year, month, day, hour, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, mp)
+}
+
+// GetGalileanMoons -
+func GetGalileanMoons(w http.ResponseWriter, r *http.Request) {
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+
+	gm, err := pc.GetGalileanMoons(int32(year), int32(month), int32(day), hour)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetGalileanMoons with Y: %d, M: %d, D: %d, H: %f, Error: %v", year, month, day, hour, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, gm)
+}
+
+// GetGalileanEvents -
+func GetGalileanEvents(w http.ResponseWriter, r *http.Request) {
+	dates := map[string]int32{}
+	for _, k := range []string{"startYear", "startMonth", "startDay", "endYear", "endMonth", "endDay"} {
+		v, err := strconv.Atoi(chi.URLParam(r, k))
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed "+k)
+			return
+		}
+		dates[k] = int32(v)
+	}
+	// The satellites searched are optional and supplied as a comma
+	// separated query parameter
+	moons := []planetsv1.GalileanMoon{}
+	if v := r.URL.Query().Get("moons"); v != "" {
+		for _, name := range strings.Split(v, ",") {
+			moon, ok := planetsv1.GalileanMoon_value[strings.ToUpper(strings.TrimSpace(name))]
+			if !ok || moon == int32(planetsv1.GalileanMoon_GALILEAN_MOON_UNSPECIFIED) {
+				respondWithError(w, http.StatusBadRequest, "unknown satellite "+name)
+				return
+			}
+			moons = append(moons, planetsv1.GalileanMoon(moon))
+		}
+	}
+
+	ge, err := pc.GetGalileanEvents(moons, dates["startYear"], dates["startMonth"], dates["startDay"], dates["endYear"], dates["endMonth"], dates["endDay"])
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetGalileanEvents with Dates: %v, Moons: %v, Error: %v", dates, moons, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, ge)
+}
+
+// GetStar -
+func GetStar(w http.ResponseWriter, r *http.Request) {
+	name, hr, hip := starIdentifier(chi.URLParam(r, "star"))
+
+	st, err := stc.GetStar(name, hr, hip)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetStar with Name: %s, HR: %d, HIP: %d, Error: %v", name, hr, hip, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, st)
+}
+
+// GetStars -
+func GetStars(w http.ResponseWriter, r *http.Request) {
+	maxMagnitude, err := strconv.ParseFloat(chi.URLParam(r, "maxMagnitude"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed magnitude")
+		return
+	}
+
+	st, err := stc.GetStars(maxMagnitude)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetStars with Magnitude: %f, Error: %v", maxMagnitude, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, st)
+}
+
+// GetStarPosition -
+func GetStarPosition(w http.ResponseWriter, r *http.Request) {
+	name, hr, hip := starIdentifier(chi.URLParam(r, "star"))
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	hour, err := strconv.ParseFloat(chi.URLParam(r, "hour"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed hour")
+		return
+	}
+
+	sp, err := stc.GetStarPosition(name, hr, hip, long, lat, int32(year), int32(month), int32(day), hour)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetStarPosition with Name: %s, HR: %d, HIP: %d, Y: %d, M: %d, D: %d, H: %f, Long: %f, Lat: %f, Error: %v", name, hr, hip, year, month, day, hour, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, sp)
+}
+
+// GetStarRiseSet -
+func GetStarRiseSet(w http.ResponseWriter, r *http.Request) {
+	name, hr, hip := starIdentifier(chi.URLParam(r, "star"))
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	// The UTC offset is optional and supplied as a query parameter
+	offset := 0.0
+	if v := r.URL.Query().Get("offset"); v != "" {
+		offset, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed offset")
+			return
+		}
+	}
+
+	model, temperature, pressure, err := refractionQuery(r)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	rs, err := stc.GetStarRiseSet(name, hr, hip, long, lat, int32(year), int32(month), int32(day), offset, model, temperature, pressure)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetStarRiseSet with Name: %s, HR: %d, HIP: %d, Y: %d, M: %d, D: %d, Long: %f, Lat: %f, Error: %v", name, hr, hip, year, month, day, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, rs)
+}
+
+// GetTransform -
+func GetTransform(w http.ResponseWriter, r *http.Request) {
+	from, ok := coordinatesv1.CoordinateFrame_value[strings.ToUpper(chi.URLParam(r, "from"))]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "unknown frame to transform from")
+		return
+	}
+	to, ok := coordinatesv1.CoordinateFrame_value[strings.ToUpper(chi.URLParam(r, "to"))]
+	if !ok {
+		respondWithError(w, http.StatusBadRequest, "unknown frame to transform to")
+		return
+	}
+	first, err := strconv.ParseFloat(chi.URLParam(r, "first"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed first coordinate")
+		return
+	}
+	second, err := strconv.ParseFloat(chi.URLParam(r, "second"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed second coordinate")
+		return
+	}
+	// The epochs, observer and instant are optional and supplied as query
+	// parameters
+	query := r.URL.Query()
+	long := 0.0
+	if v := query.Get("long"); v != "" {
+		long, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed longitude")
+			return
+		}
+	}
+	lat := 0.0
+	if v := query.Get("lat"); v != "" {
+		lat, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed latitude")
+			return
+		}
+	}
+	year := 0
+	if v := query.Get("year"); v != "" {
+		year, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed year")
+			return
+		}
+	}
+	month := 0
+	if v := query.Get("month"); v != "" {
+		month, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed month")
+			return
+		}
+	}
+	day := 0
+	if v := query.Get("day"); v != "" {
+		day, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed day")
+			return
+		}
+	}
+	hour := 0.0
+	if v := query.Get("hour"); v != "" {
+		hour, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed hour")
+			return
+		}
+	}
+
+	tc, err := cc.Transform(coordinatesv1.CoordinateFrame(from), coordinatesv1.CoordinateFrame(to), first, second, query.Get("from_epoch"), query.Get("to_epoch"),
+		long, lat, int32(year), int32(month), int32(day), hour)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetTransform with From: %d, To: %d, First: %f, Second: %f, Error: %v", from, to, first, second, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, tc)
+}
+
+// GetConstellation -
+func GetConstellation(w http.ResponseWriter, r *http.Request) {
+	ra, err := strconv.ParseFloat(chi.URLParam(r, "ra"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed right ascension")
+		return
+	}
+	dec, err := strconv.ParseFloat(chi.URLParam(r, "dec"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed declination")
+		return
+	}
+	// The epoch, and the instant for an epoch of date, are optional and
+	// supplied as query parameters
+	query := r.URL.Query()
+	year := 0
+	if v := query.Get("year"); v != "" {
+		year, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed year")
+			return
+		}
+	}
+	month := 0
+	if v := query.Get("month"); v != "" {
+		month, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed month")
+			return
+		}
+	}
+	day := 0
+	if v := query.Get("day"); v != "" {
+		day, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed day")
+			return
+		}
+	}
+	hour := 0.0
+	if v := query.Get("hour"); v != "" {
+		hour, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed hour")
+			return
+		}
+	}
+
+	c, err := cc.GetConstellation(ra, dec, query.Get("epoch"), int32(year), int32(month), int32(day), hour)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetConstellation with RA: %f, Dec: %f, Error: %v", ra, dec, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, c)
+}
+
+// maxUploadBytes limits the size of the orbital elements uploaded
+const maxUploadBytes = 1 << 20
+
+// GetSatellitePasses -
+func GetSatellitePasses(w http.ResponseWriter, r *http.Request) {
+	long, err := strconv.ParseFloat(chi.URLParam(r, "long"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed longitude")
+		return
+	}
+	lat, err := strconv.ParseFloat(chi.URLParam(r, "lat"), 64)
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed latitude")
+		return
+	}
+	year, err := strconv.Atoi(chi.URLParam(r, "year"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed year")
+		return
+	}
+	month, err := strconv.Atoi(chi.URLParam(r, "month"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed month")
+		return
+	}
+	day, err := strconv.Atoi(chi.URLParam(r, "day"))
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, "malformed day")
+		return
+	}
+	// The number of days, height, minimum elevation and visibility filter
+	// are optional and supplied as query parameters
+	days := 0
+	if v := r.URL.Query().Get("days"); v != "" {
+		days, err = strconv.Atoi(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed days")
+			return
+		}
+	}
+	height := 0.0
+	if v := r.URL.Query().Get("height"); v != "" {
+		height, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed height")
+			return
+		}
+	}
+	minElevation := 0.0
+	if v := r.URL.Query().Get("min_elevation"); v != "" {
+		minElevation, err = strconv.ParseFloat(v, 64)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed min_elevation")
+			return
+		}
+	}
+	visibleOnly := false
+	if v := r.URL.Query().Get("visible_only"); v != "" {
+		visibleOnly, err = strconv.ParseBool(v)
+		if err != nil {
+			respondWithError(w, http.StatusBadRequest, "malformed visible_only")
+			return
+		}
+	}
+	tle, err := uploaded(w, r, "tle")
+	if err != nil {
+		respondWithError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	sp, err := sac.GetSatellitePasses(tle, long, lat, height, int32(year), int32(month), int32(day), int32(days), minElevation, visibleOnly)
+	if err != nil {
+		// TODO
+		// log the error
+		fmt.Printf("An error occurred with GetSatellitePasses with Y: %d, M: %d, D: %d, Days: %d, Long: %f, Lat: %f, Error: %v", year, month, day, days, long, lat, err)
+		respondWithError(w, http.StatusInternalServerError, "An unexpected error has occurred, the issue has been reported to our engineers and will be looked into")
+		return
+	}
+	respondWithJSON(w, http.StatusOK, sp)
+}
+
+// uploaded reads orbital elements from a multipart form file named field,
+// or from the body of the request as plain text
+func uploaded(w http.ResponseWriter, r *http.Request, field string) (string, error) {
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadBytes)
+	var body io.Reader = r.Body
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		file, _, err := r.FormFile(field)
+		if err != nil {
+			return "", fmt.Errorf("the form has no %s file", field)
+		}
+		defer file.Close()
+		body = file
+	}
+	text, err := ioutil.ReadAll(body)
+	if err != nil {
+		return "", fmt.Errorf("the elements could not be read")
+	}
+	if strings.TrimSpace(string(text)) == "" {
+		return "", fmt.Errorf("no elements supplied")
+	}
+	return string(text), nil
+}
+
+// refractionQuery reads the optional refraction model and the temperature
+// and pressure at the observer from the query parameters, the temperature
+// and pressure are nil when not given
+func refractionQuery(r *http.Request) (string, *wrappers.DoubleValue, *wrappers.DoubleValue, error) {
+	temperature, err := optionalQuery(r, "temperature")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	pressure, err := optionalQuery(r, "pressure")
+	if err != nil {
+		return "", nil, nil, err
+	}
+	return r.URL.Query().Get("refraction"), temperature, pressure, nil
+}
+
+// optionalQuery reads an optional number from the query parameters, nil
+// when it is not given
+func optionalQuery(r *http.Request, name string) (*wrappers.DoubleValue, error) {
+	v := r.URL.Query().Get(name)
+	if v == "" {
+		return nil, nil
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return nil, fmt.Errorf("malformed %s", name)
+	}
+	return &wrappers.DoubleValue{Value: f}, nil
+}
+
+// highPrecisionQuery reads the optional high_precision query parameter
+func highPrecisionQuery(r *http.Request) (bool, error) {
+	v := r.URL.Query().Get("high_precision")
+	if v == "" {
+		return false, nil
+	}
+	highPrecision, err := strconv.ParseBool(v)
+	if err != nil {
+		return false, fmt.Errorf("malformed high_precision")
+	}
+	return highPrecision, nil
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sunv1 "planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// fakeSun answers GetShadow with a fixed shadow and keeps the request
+type fakeSun struct {
+	sunv1.SunServiceServer
+	req *sunv1.ShadowRequest
+}
+
+func (f *fakeSun) GetShadow(ctx context.Context, req *sunv1.ShadowRequest) (*sunv1.Shadow, error) {
+	f.req = req
+	return &sunv1.Shadow{Api: req.Api, SunUp: true, Length: 5.33, Bearing: 0.6}, nil
+}
+
+func TestGetShadow(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	s := grpc.NewServer()
+	fake := &fakeSun{}
+	sunv1.RegisterSunServiceServer(s, fake)
+	go s.Serve(lis)
+	defer s.Stop()
+	address := sc.Address
+	sc.Address = lis.Addr().String()
+	defer func() { sc.Address = address }()
+
+	get := func(url string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		Routes().ServeHTTP(w, httptest.NewRequest(http.MethodGet, url, nil))
+		return w
+	}
+
+	w := get("/v1/api/Shadow/-0.5/51.4779/2020/6/20/12.5/10?width=10&depth=20&bearing=30&temperature=5&high_precision=true")
+	require.Equal(t, http.StatusOK, w.Code)
+	var shadow sunv1.Shadow
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &shadow))
+	assert.True(t, shadow.SunUp)
+	assert.Equal(t, 5.33, shadow.Length)
+
+	require.NotNil(t, fake.req)
+	assert.Equal(t, -0.5, fake.req.Longitude)
+	assert.Equal(t, 51.4779, fake.req.Latitude)
+	assert.Equal(t, int32(2020), fake.req.Year)
+	assert.Equal(t, int32(6), fake.req.Month)
+	assert.Equal(t, int32(20), fake.req.Day)
+	assert.Equal(t, 12.5, fake.req.Hour)
+	assert.Equal(t, 10.0, fake.req.Height)
+	assert.Equal(t, 10.0, fake.req.FootprintWidth)
+	assert.Equal(t, 20.0, fake.req.FootprintDepth)
+	assert.Equal(t, 30.0, fake.req.FootprintBearing)
+	require.NotNil(t, fake.req.Temperature)
+	assert.Equal(t, 5.0, fake.req.Temperature.Value)
+	assert.Nil(t, fake.req.Pressure)
+	assert.True(t, fake.req.HighPrecision)
+
+	// Without a footprint the request asks for none
+	fake.req = nil
+	w = get("/v1/api/Shadow/-0.5/51.4779/2020/6/20/12.5/10")
+	require.Equal(t, http.StatusOK, w.Code)
+	require.NotNil(t, fake.req)
+	assert.Zero(t, fake.req.FootprintWidth)
+	assert.Zero(t, fake.req.FootprintDepth)
+	assert.False(t, fake.req.HighPrecision)
+
+	// Malformed parameters are refused before the sun service is called
+	for url, message := range map[string]string{
+		"/v1/api/Shadow/-0.5/51.4779/2020/6/20/12.5/tall":            "malformed height",
+		"/v1/api/Shadow/-0.5/51.4779/2020/6/20/12.5/10?width=wide":   "malformed footprint width",
+		"/v1/api/Shadow/-0.5/51.4779/2020/6/20/12.5/10?pressure=low": "malformed pressure",
+	} {
+		fake.req = nil
+		w = get(url)
+		assert.Equal(t, http.StatusBadRequest, w.Code, url)
+		var body map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+		assert.Equal(t, message, body["message"], url)
+		assert.Nil(t, fake.req, url)
+	}
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	v1 "planetpositions/satellites/grpc/v1"
+	satellites "planetpositions/satellites/pkg/v1/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type server struct{}
+
+var sats = satellites.NewSatellitesService()
+
+func main() {
+
+	portNum := os.Getenv("PORT_NUM")
+	lis, err := net.Listen("tcp", "0.0.0.0:"+portNum)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	v1.RegisterSatellitesServiceServer(s, &server{})
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// GetSatellitePasses -
+func (s *server) GetSatellitePasses(ctx context.Context, req *v1.SatellitePassesRequest) (*v1.SatellitePasses, error) {
+	sp, err := sats.GetSatellitePasses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
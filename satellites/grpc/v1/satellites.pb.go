@@ -0,0 +1,579 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: satellites.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SatellitePassesRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// One or more two line element sets, each optionally preceded by a line
+	// naming the satellite
+	Tle string `protobuf:"bytes,2,opt,name=tle,proto3" json:"tle,omitempty"`
+	// Observer's geodetic position, in degrees, and height above the WGS84
+	// ellipsoid, in metres
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Height    float64 `protobuf:"fixed64,5,opt,name=height,proto3" json:"height,omitempty"`
+	// UTC date the search starts at midnight of
+	Year  int32 `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,7,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,8,opt,name=day,proto3" json:"day,omitempty"`
+	// Number of days to search, from 1 to 10, defaults to 1
+	Days int32 `protobuf:"varint,9,opt,name=days,proto3" json:"days,omitempty"`
+	// Elevation, in degrees, a satellite must rise above for a pass to be
+	// reported, defaults to 0
+	MinElevation float64 `protobuf:"fixed64,10,opt,name=min_elevation,json=minElevation,proto3" json:"min_elevation,omitempty"`
+	// Only report passes where the satellite is sunlit while the observer
+	// is in darkness
+	VisibleOnly          bool     `protobuf:"varint,11,opt,name=visible_only,json=visibleOnly,proto3" json:"visible_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatellitePassesRequest) Reset()         { *m = SatellitePassesRequest{} }
+func (m *SatellitePassesRequest) String() string { return proto.CompactTextString(m) }
+func (*SatellitePassesRequest) ProtoMessage()    {}
+func (*SatellitePassesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad0f82b246741b53, []int{0}
+}
+
+func (m *SatellitePassesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatellitePassesRequest.Unmarshal(m, b)
+}
+func (m *SatellitePassesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatellitePassesRequest.Marshal(b, m, deterministic)
+}
+func (m *SatellitePassesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatellitePassesRequest.Merge(m, src)
+}
+func (m *SatellitePassesRequest) XXX_Size() int {
+	return xxx_messageInfo_SatellitePassesRequest.Size(m)
+}
+func (m *SatellitePassesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatellitePassesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatellitePassesRequest proto.InternalMessageInfo
+
+func (m *SatellitePassesRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SatellitePassesRequest) GetTle() string {
+	if m != nil {
+		return m.Tle
+	}
+	return ""
+}
+
+func (m *SatellitePassesRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetDays() int32 {
+	if m != nil {
+		return m.Days
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetMinElevation() float64 {
+	if m != nil {
+		return m.MinElevation
+	}
+	return 0
+}
+
+func (m *SatellitePassesRequest) GetVisibleOnly() bool {
+	if m != nil {
+		return m.VisibleOnly
+	}
+	return false
+}
+
+type SatelliteInstant struct {
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Hour of the day, in UTC
+	Hour                 float64  `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	JulianDate           float64  `protobuf:"fixed64,5,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteInstant) Reset()         { *m = SatelliteInstant{} }
+func (m *SatelliteInstant) String() string { return proto.CompactTextString(m) }
+func (*SatelliteInstant) ProtoMessage()    {}
+func (*SatelliteInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad0f82b246741b53, []int{1}
+}
+
+func (m *SatelliteInstant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteInstant.Unmarshal(m, b)
+}
+func (m *SatelliteInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteInstant.Marshal(b, m, deterministic)
+}
+func (m *SatelliteInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteInstant.Merge(m, src)
+}
+func (m *SatelliteInstant) XXX_Size() int {
+	return xxx_messageInfo_SatelliteInstant.Size(m)
+}
+func (m *SatelliteInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteInstant proto.InternalMessageInfo
+
+func (m *SatelliteInstant) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SatelliteInstant) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *SatelliteInstant) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *SatelliteInstant) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *SatelliteInstant) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type SatellitePassEvent struct {
+	Time *SatelliteInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Horizontal coordinates, in degrees, azimuth measured clockwise from
+	// north
+	Azimuth   float64 `protobuf:"fixed64,2,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Elevation float64 `protobuf:"fixed64,3,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	// Distance from the observer, in km
+	Range                float64  `protobuf:"fixed64,4,opt,name=range,proto3" json:"range,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatellitePassEvent) Reset()         { *m = SatellitePassEvent{} }
+func (m *SatellitePassEvent) String() string { return proto.CompactTextString(m) }
+func (*SatellitePassEvent) ProtoMessage()    {}
+func (*SatellitePassEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad0f82b246741b53, []int{2}
+}
+
+func (m *SatellitePassEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatellitePassEvent.Unmarshal(m, b)
+}
+func (m *SatellitePassEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatellitePassEvent.Marshal(b, m, deterministic)
+}
+func (m *SatellitePassEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatellitePassEvent.Merge(m, src)
+}
+func (m *SatellitePassEvent) XXX_Size() int {
+	return xxx_messageInfo_SatellitePassEvent.Size(m)
+}
+func (m *SatellitePassEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatellitePassEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatellitePassEvent proto.InternalMessageInfo
+
+func (m *SatellitePassEvent) GetTime() *SatelliteInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *SatellitePassEvent) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *SatellitePassEvent) GetElevation() float64 {
+	if m != nil {
+		return m.Elevation
+	}
+	return 0
+}
+
+func (m *SatellitePassEvent) GetRange() float64 {
+	if m != nil {
+		return m.Range
+	}
+	return 0
+}
+
+type SatellitePass struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// NORAD catalogue number
+	Number int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Acquisition of signal, time of closest approach and loss of signal,
+	// the acquisition or loss is omitted when the pass is already in
+	// progress at the start, or still in progress at the end, of the search
+	Aos          *SatellitePassEvent `protobuf:"bytes,3,opt,name=aos,proto3" json:"aos,omitempty"`
+	Tca          *SatellitePassEvent `protobuf:"bytes,4,opt,name=tca,proto3" json:"tca,omitempty"`
+	Los          *SatellitePassEvent `protobuf:"bytes,5,opt,name=los,proto3" json:"los,omitempty"`
+	MaxElevation float64             `protobuf:"fixed64,6,opt,name=max_elevation,json=maxElevation,proto3" json:"max_elevation,omitempty"`
+	// Whether the satellite is in sunlight at any time above the minimum
+	// elevation, and whether it is while the observer is in darkness
+	Sunlit               bool     `protobuf:"varint,7,opt,name=sunlit,proto3" json:"sunlit,omitempty"`
+	Visible              bool     `protobuf:"varint,8,opt,name=visible,proto3" json:"visible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatellitePass) Reset()         { *m = SatellitePass{} }
+func (m *SatellitePass) String() string { return proto.CompactTextString(m) }
+func (*SatellitePass) ProtoMessage()    {}
+func (*SatellitePass) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad0f82b246741b53, []int{3}
+}
+
+func (m *SatellitePass) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatellitePass.Unmarshal(m, b)
+}
+func (m *SatellitePass) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatellitePass.Marshal(b, m, deterministic)
+}
+func (m *SatellitePass) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatellitePass.Merge(m, src)
+}
+func (m *SatellitePass) XXX_Size() int {
+	return xxx_messageInfo_SatellitePass.Size(m)
+}
+func (m *SatellitePass) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatellitePass.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatellitePass proto.InternalMessageInfo
+
+func (m *SatellitePass) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SatellitePass) GetNumber() int32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *SatellitePass) GetAos() *SatellitePassEvent {
+	if m != nil {
+		return m.Aos
+	}
+	return nil
+}
+
+func (m *SatellitePass) GetTca() *SatellitePassEvent {
+	if m != nil {
+		return m.Tca
+	}
+	return nil
+}
+
+func (m *SatellitePass) GetLos() *SatellitePassEvent {
+	if m != nil {
+		return m.Los
+	}
+	return nil
+}
+
+func (m *SatellitePass) GetMaxElevation() float64 {
+	if m != nil {
+		return m.MaxElevation
+	}
+	return 0
+}
+
+func (m *SatellitePass) GetSunlit() bool {
+	if m != nil {
+		return m.Sunlit
+	}
+	return false
+}
+
+func (m *SatellitePass) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+type SatellitePasses struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Passes of every satellite in the element sets, in order of
+	// acquisition
+	Passes               []*SatellitePass `protobuf:"bytes,2,rep,name=passes,proto3" json:"passes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SatellitePasses) Reset()         { *m = SatellitePasses{} }
+func (m *SatellitePasses) String() string { return proto.CompactTextString(m) }
+func (*SatellitePasses) ProtoMessage()    {}
+func (*SatellitePasses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad0f82b246741b53, []int{4}
+}
+
+func (m *SatellitePasses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatellitePasses.Unmarshal(m, b)
+}
+func (m *SatellitePasses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatellitePasses.Marshal(b, m, deterministic)
+}
+func (m *SatellitePasses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatellitePasses.Merge(m, src)
+}
+func (m *SatellitePasses) XXX_Size() int {
+	return xxx_messageInfo_SatellitePasses.Size(m)
+}
+func (m *SatellitePasses) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatellitePasses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatellitePasses proto.InternalMessageInfo
+
+func (m *SatellitePasses) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SatellitePasses) GetPasses() []*SatellitePass {
+	if m != nil {
+		return m.Passes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SatellitePassesRequest)(nil), "v1.SatellitePassesRequest")
+	proto.RegisterType((*SatelliteInstant)(nil), "v1.SatelliteInstant")
+	proto.RegisterType((*SatellitePassEvent)(nil), "v1.SatellitePassEvent")
+	proto.RegisterType((*SatellitePass)(nil), "v1.SatellitePass")
+	proto.RegisterType((*SatellitePasses)(nil), "v1.SatellitePasses")
+}
+
+func init() { proto.RegisterFile("satellites.proto", fileDescriptor_ad0f82b246741b53) }
+
+var fileDescriptor_ad0f82b246741b53 = []byte{
+	// 772 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0xd6, 0x38, 0x69, 0x9a, 0x4e, 0x76, 0x45, 0x77, 0x58, 0x15, 0x2b, 0x5a, 0x09, 0x63, 0x6e,
+	0xc2, 0x8a, 0xc4, 0x6d, 0xe8, 0x55, 0x91, 0x10, 0x20, 0x2a, 0x84, 0x90, 0x00, 0x79, 0x1f, 0xa0,
+	0x9a, 0x3a, 0x47, 0xf6, 0x54, 0xe3, 0x19, 0x33, 0x73, 0x9c, 0x36, 0x84, 0xdc, 0x2c, 0xb7, 0x5c,
+	0xa0, 0xe5, 0x02, 0x89, 0x07, 0x40, 0xbc, 0x0f, 0xaf, 0xc0, 0x73, 0x20, 0x34, 0x63, 0xc7, 0xdd,
+	0xb4, 0x55, 0x6f, 0x92, 0xf3, 0xf3, 0x79, 0xe6, 0x3b, 0xe7, 0x7c, 0x67, 0xe8, 0xa1, 0xe5, 0x08,
+	0x52, 0x0a, 0x04, 0x3b, 0xab, 0x8c, 0x46, 0xcd, 0x82, 0xe5, 0xc9, 0xf8, 0x45, 0xae, 0x75, 0x2e,
+	0x21, 0xe1, 0x95, 0x48, 0xb8, 0x52, 0x1a, 0x39, 0x0a, 0xad, 0x5a, 0xc4, 0xf8, 0x63, 0xff, 0x97,
+	0x4d, 0x73, 0x50, 0x53, 0x7b, 0xcd, 0xf3, 0x1c, 0x4c, 0xa2, 0x2b, 0x8f, 0xb8, 0x8f, 0x8e, 0xff,
+	0x0a, 0xe8, 0xd1, 0xab, 0xed, 0x25, 0x3f, 0x70, 0x6b, 0xc1, 0xa6, 0xf0, 0x63, 0x0d, 0x16, 0xd9,
+	0x21, 0xed, 0xf1, 0x4a, 0x84, 0x24, 0x22, 0x93, 0x83, 0xd4, 0x99, 0x2e, 0x82, 0x12, 0xc2, 0xa0,
+	0x89, 0xa0, 0x04, 0xf6, 0x82, 0x1e, 0x48, 0xad, 0x72, 0x81, 0xf5, 0x02, 0xc2, 0x5e, 0x44, 0x26,
+	0x24, 0xbd, 0x0d, 0xb0, 0x31, 0x1d, 0x4a, 0x8e, 0x4d, 0xb2, 0xef, 0x93, 0x9d, 0xcf, 0x8e, 0xe8,
+	0xa0, 0x00, 0x91, 0x17, 0x18, 0xee, 0xf9, 0x4c, 0xeb, 0x31, 0x46, 0xfb, 0x2b, 0xe0, 0x26, 0x1c,
+	0x44, 0x64, 0xb2, 0x97, 0x7a, 0x9b, 0x3d, 0xa7, 0x7b, 0xa5, 0x56, 0x58, 0x84, 0xfb, 0x3e, 0xd8,
+	0x38, 0x8e, 0xcd, 0x82, 0xaf, 0xc2, 0xa1, 0x8f, 0x39, 0xd3, 0x7d, 0xbb, 0xe0, 0x2b, 0x1b, 0x1e,
+	0x34, 0xdf, 0x3a, 0x9b, 0x7d, 0x48, 0x9f, 0x96, 0x42, 0x5d, 0x80, 0x84, 0xa5, 0x2f, 0x3c, 0xa4,
+	0xfe, 0xba, 0x27, 0xa5, 0x50, 0xe7, 0xdb, 0x18, 0xfb, 0x80, 0x3e, 0x59, 0x0a, 0x2b, 0x2e, 0x25,
+	0x5c, 0x68, 0x25, 0x57, 0xe1, 0x28, 0x22, 0x93, 0x61, 0x3a, 0x6a, 0x63, 0xdf, 0x2b, 0xb9, 0x8a,
+	0x7f, 0x21, 0xf4, 0xb0, 0x6b, 0xd4, 0x37, 0xca, 0x22, 0x57, 0xb7, 0x64, 0xc9, 0x43, 0x64, 0x83,
+	0x07, 0xc8, 0xf6, 0x76, 0xc8, 0x16, 0xba, 0x36, 0x6d, 0x63, 0xbc, 0xcd, 0xde, 0xa7, 0xa3, 0xab,
+	0x5a, 0x0a, 0xae, 0x2e, 0x16, 0x1c, 0xa1, 0xed, 0x0c, 0x6d, 0x42, 0x5f, 0x71, 0x84, 0xf8, 0x57,
+	0x42, 0xd9, 0xce, 0xb8, 0xce, 0x97, 0xa0, 0x90, 0x4d, 0x68, 0x1f, 0x45, 0x09, 0x9e, 0xc7, 0x68,
+	0xfe, 0x7c, 0xb6, 0x3c, 0x99, 0xdd, 0xe5, 0x9a, 0x7a, 0x04, 0x0b, 0xe9, 0x3e, 0xff, 0x49, 0x94,
+	0x75, 0xcb, 0x8f, 0xa4, 0x5b, 0xd7, 0x8d, 0xf2, 0xb6, 0x49, 0xed, 0x28, 0xbb, 0x80, 0xab, 0xca,
+	0x70, 0x95, 0x6f, 0xe7, 0xd8, 0x38, 0xf1, 0x6f, 0x01, 0x7d, 0xba, 0x43, 0xc7, 0x55, 0xa5, 0x78,
+	0xcb, 0xe4, 0x20, 0xf5, 0xb6, 0x1b, 0xb5, 0xaa, 0xcb, 0x4b, 0x30, 0x6d, 0x4b, 0x5a, 0x8f, 0x4d,
+	0x68, 0x8f, 0x6b, 0xeb, 0xef, 0x1a, 0xcd, 0x8f, 0x76, 0x48, 0x77, 0xa5, 0xa5, 0x0e, 0xe2, 0x90,
+	0x98, 0xf1, 0xb0, 0xff, 0x38, 0x12, 0x33, 0xee, 0x90, 0x52, 0xdb, 0x70, 0xef, 0x71, 0xa4, 0xd4,
+	0x8d, 0x30, 0xf8, 0xcd, 0x5b, 0xc2, 0x18, 0xb4, 0xc2, 0xe0, 0x37, 0xb7, 0xc2, 0x38, 0xa2, 0x03,
+	0x5b, 0x2b, 0x29, 0xd0, 0x4b, 0x6f, 0x98, 0xb6, 0x9e, 0x6b, 0x63, 0x2b, 0x0e, 0xaf, 0xbf, 0x61,
+	0xba, 0x75, 0xe3, 0xef, 0xe8, 0x3b, 0x77, 0xf6, 0xe9, 0x81, 0x45, 0xfa, 0x88, 0x0e, 0x2a, 0x9f,
+	0x0b, 0x83, 0xa8, 0x37, 0x19, 0xcd, 0x9f, 0xdd, 0x23, 0x9a, 0xb6, 0x80, 0xf9, 0xdf, 0x84, 0x3e,
+	0xeb, 0x32, 0xf6, 0x15, 0x98, 0xa5, 0xc8, 0x80, 0xbd, 0x21, 0x94, 0x7d, 0x0d, 0x78, 0xf7, 0xa6,
+	0xf1, 0xbd, 0x73, 0xba, 0x75, 0x1e, 0xbf, 0xfb, 0x40, 0x2e, 0xfe, 0xf6, 0xf5, 0x3f, 0xff, 0xfe,
+	0x1e, 0x9c, 0xc7, 0x9f, 0x2d, 0x4f, 0x92, 0xee, 0xad, 0x69, 0x6e, 0x4e, 0xd6, 0xdd, 0x22, 0x6f,
+	0x92, 0xf5, 0x76, 0x6f, 0x37, 0xc9, 0xda, 0xa9, 0x7c, 0x93, 0xac, 0xbd, 0xae, 0x37, 0xc9, 0x7a,
+	0xc1, 0x57, 0x9b, 0x33, 0xf7, 0x18, 0x7c, 0xf9, 0x3a, 0x78, 0xf3, 0xc5, 0x7f, 0xe4, 0xec, 0x90,
+	0x57, 0x95, 0x14, 0x99, 0x6f, 0x60, 0x72, 0x65, 0xb5, 0x4a, 0x3f, 0xa5, 0xbd, 0xd3, 0xe3, 0x53,
+	0x76, 0xca, 0x06, 0xb4, 0xff, 0x67, 0x40, 0xf6, 0xe9, 0xcb, 0x14, 0xb0, 0x36, 0x0a, 0x16, 0xd1,
+	0x75, 0x01, 0x2a, 0xc2, 0x02, 0x22, 0x03, 0x56, 0xd7, 0x26, 0x83, 0x68, 0xa1, 0xc1, 0x46, 0x4a,
+	0x63, 0x04, 0x37, 0xc2, 0xe2, 0x8c, 0xfd, 0x41, 0xe6, 0xbd, 0x93, 0xd9, 0x71, 0xfc, 0xf3, 0xf8,
+	0x3d, 0x5b, 0x70, 0x05, 0x9f, 0xfb, 0xdf, 0x42, 0x5f, 0x03, 0x37, 0x58, 0xcc, 0x32, 0x5d, 0xd2,
+	0x24, 0xd7, 0xd3, 0xdc, 0x54, 0xd9, 0xb4, 0x40, 0xac, 0xa6, 0x06, 0x2c, 0x4e, 0x4b, 0x91, 0x19,
+	0x6d, 0x9b, 0x6e, 0x4d, 0xb1, 0x46, 0x6d, 0x04, 0x97, 0x51, 0x65, 0xf4, 0x15, 0x64, 0xc8, 0x8e,
+	0x1d, 0xd0, 0x9e, 0x25, 0x49, 0x2e, 0xb0, 0xa8, 0x2f, 0xdd, 0x21, 0xc9, 0xce, 0xb1, 0x49, 0x25,
+	0xb9, 0x02, 0xac, 0xb4, 0x15, 0xfe, 0xb5, 0x7c, 0x6b, 0xeb, 0x6c, 0xd4, 0x1e, 0xfb, 0x92, 0x90,
+	0xf9, 0xbd, 0x5a, 0x2f, 0x07, 0xfe, 0x5d, 0xfd, 0xe4, 0xff, 0x01, 0x00, 0xdb, 0xed, 0x7e, 0xd5,
+	0xbb, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SatellitesServiceClient is the client API for SatellitesService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SatellitesServiceClient interface {
+	// Predict the passes of satellites over an observer
+	GetSatellitePasses(ctx context.Context, in *SatellitePassesRequest, opts ...grpc.CallOption) (*SatellitePasses, error)
+}
+
+type satellitesServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSatellitesServiceClient(cc *grpc.ClientConn) SatellitesServiceClient {
+	return &satellitesServiceClient{cc}
+}
+
+func (c *satellitesServiceClient) GetSatellitePasses(ctx context.Context, in *SatellitePassesRequest, opts ...grpc.CallOption) (*SatellitePasses, error) {
+	out := new(SatellitePasses)
+	err := c.cc.Invoke(ctx, "/v1.SatellitesService/GetSatellitePasses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SatellitesServiceServer is the server API for SatellitesService service.
+type SatellitesServiceServer interface {
+	// Predict the passes of satellites over an observer
+	GetSatellitePasses(context.Context, *SatellitePassesRequest) (*SatellitePasses, error)
+}
+
+func RegisterSatellitesServiceServer(s *grpc.Server, srv SatellitesServiceServer) {
+	s.RegisterService(&_SatellitesService_serviceDesc, srv)
+}
+
+func _SatellitesService_GetSatellitePasses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SatellitePassesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SatellitesServiceServer).GetSatellitePasses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SatellitesService/GetSatellitePasses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SatellitesServiceServer).GetSatellitePasses(ctx, req.(*SatellitePassesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SatellitesService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.SatellitesService",
+	HandlerType: (*SatellitesServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSatellitePasses",
+			Handler:    _SatellitesService_GetSatellitePasses_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "satellites.proto",
+}
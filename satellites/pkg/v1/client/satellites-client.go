@@ -0,0 +1,51 @@
+package satellitesclient
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"planetpositions/satellites/grpc/v1"
+
+	"google.golang.org/grpc"
+)
+
+// SatellitesClient -
+type SatellitesClient struct {
+	Address string
+}
+
+func (s *SatellitesClient) newConnection() (v1.SatellitesServiceClient, *grpc.ClientConn) {
+
+	// Set up a connection to the server.
+	conn, err := grpc.Dial(s.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	return v1.NewSatellitesServiceClient(conn), conn
+}
+
+// GetSatellitePasses -
+func (s *SatellitesClient) GetSatellitePasses(tle string, long, lat, height float64, year, month, day, days int32, minElevation float64, visibleOnly bool) (*v1.SatellitePasses, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	// Searching many element sets over several days takes a while
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.SatellitePassesRequest{
+		Api:          "v1",
+		Tle:          tle,
+		Longitude:    long,
+		Latitude:     lat,
+		Height:       height,
+		Year:         year,
+		Month:        month,
+		Day:          day,
+		Days:         days,
+		MinElevation: minElevation,
+		VisibleOnly:  visibleOnly,
+	}
+	return c.GetSatellitePasses(ctx, &req)
+}
@@ -0,0 +1,117 @@
+package v1
+
+import (
+	"math"
+
+	"planetpositions/satellites/pkg/v1/sgp4"
+)
+
+// WGS84 ellipsoid, the equatorial radius in km and the flattening
+const (
+	wgs84Radius     = 6378.137
+	wgs84Flattening = 1 / 298.257223563
+)
+
+// observer is a position on the ground, fixed to the rotating earth
+type observer struct {
+	// Unit vectors pointing east, north and to the zenith
+	east, north, up [3]float64
+	// Earth centred, earth fixed position, in km
+	position [3]float64
+}
+
+// newObserver places an observer at a geodetic longitude and latitude, in
+// degrees, and a height above the ellipsoid, in km
+func newObserver(longitude, latitude, height float64) observer {
+	lon := degreesToRadians(longitude)
+	lat := degreesToRadians(latitude)
+	e2 := wgs84Flattening * (2 - wgs84Flattening)
+	// Radius of curvature in the prime vertical
+	n := wgs84Radius / math.Sqrt(1-e2*math.Sin(lat)*math.Sin(lat))
+	return observer{
+		east:  [3]float64{-math.Sin(lon), math.Cos(lon), 0},
+		north: [3]float64{-math.Sin(lat) * math.Cos(lon), -math.Sin(lat) * math.Sin(lon), math.Cos(lat)},
+		up:    [3]float64{math.Cos(lat) * math.Cos(lon), math.Cos(lat) * math.Sin(lon), math.Sin(lat)},
+		position: [3]float64{
+			(n + height) * math.Cos(lat) * math.Cos(lon),
+			(n + height) * math.Cos(lat) * math.Sin(lon),
+			(n*(1-e2) + height) * math.Sin(lat),
+		},
+	}
+}
+
+// look is where a satellite appears to an observer at an instant
+type look struct {
+	jd float64
+	// Horizontal coordinates, in degrees, azimuth measured clockwise from
+	// north, and the distance from the observer, in km
+	azimuth   float64
+	elevation float64
+	distance  float64
+	sunlit    bool
+	// The sun is far enough below the observer's horizon for the sky to
+	// be dark
+	dark bool
+}
+
+// look propagates the satellite to the instant jd, UTC, and turns its
+// position towards the observer
+func (o observer) look(sat *sgp4.Satellite, jd float64) (look, error) {
+	position, _, err := sat.PropagateTo(jd)
+	if err != nil {
+		return look{}, err
+	}
+	// The element sets are in the true equator, mean equinox frame, which
+	// turns with the mean sidereal time, UTC stands in for UT1 since they
+	// never differ by a second
+	gst := sgp4.GreenwichSiderealTime(jd)
+	fixed := rotate(position, gst)
+	r := [3]float64{fixed[0] - o.position[0], fixed[1] - o.position[1], fixed[2] - o.position[2]}
+	distance := length(r)
+
+	sun := sunDirection(jd)
+	fixedSun := rotate(sun, gst)
+	return look{
+		jd:        jd,
+		azimuth:   normalise(radiansToDegrees(math.Atan2(dot(r, o.east), dot(r, o.north)))),
+		elevation: radiansToDegrees(math.Asin(dot(r, o.up) / distance)),
+		distance:  distance,
+		sunlit:    sunlit(position, sun),
+		dark:      radiansToDegrees(math.Asin(dot(fixedSun, o.up))) < civilTwilight,
+	}, nil
+}
+
+// rotate turns a vector about the earth's axis from the equinox to the
+// Greenwich meridian, gst in radians
+func rotate(v [3]float64, gst float64) [3]float64 {
+	c, s := math.Cos(gst), math.Sin(gst)
+	return [3]float64{c*v[0] + s*v[1], -s*v[0] + c*v[1], v[2]}
+}
+
+// sunDirection returns the unit vector from the centre of the earth to the
+// sun referred to the equator and equinox of date, from the low precision
+// formulae of the Astronomical Almanac, good to a hundredth of a degree
+func sunDirection(jd float64) [3]float64 {
+	n := jd - 2451545.0
+	l := normalise(280.460 + 0.9856474*n)
+	g := degreesToRadians(normalise(357.528 + 0.9856003*n))
+	lambda := degreesToRadians(l + 1.915*math.Sin(g) + 0.020*math.Sin(2*g))
+	epsilon := degreesToRadians(23.439 - 0.0000004*n)
+	return [3]float64{
+		math.Cos(lambda),
+		math.Cos(epsilon) * math.Sin(lambda),
+		math.Sin(epsilon) * math.Sin(lambda),
+	}
+}
+
+// sunlit tests whether a satellite at position, in km from the centre of the
+// earth, is outside the earth's shadow, taken as a cylinder the width of
+// the earth trailing away from the sun
+func sunlit(position, sun [3]float64) bool {
+	along := dot(position, sun)
+	if along > 0 {
+		return true
+	}
+	across := [3]float64{position[0] - along*sun[0], position[1] - along*sun[1], position[2] - along*sun[2]}
+	return length(across) > sgp4.EarthRadius
+}
@@ -0,0 +1,165 @@
+package v1
+
+import (
+	"math"
+
+	"planetpositions/satellites/pkg/v1/sgp4"
+)
+
+const (
+	// passStep is the interval the sky is sampled at, in days, short
+	// enough that a satellite in low orbit is caught above the horizon on
+	// all but the most grazing passes
+	passStep = 30.0 / 86400
+	// passPrecision is how closely the times of a pass are found, in days
+	passPrecision = 0.1 / 86400
+	// civilTwilight is the altitude of the sun, in degrees, below which
+	// the observer's sky is dark enough to see a sunlit satellite
+	civilTwilight = -6.0
+)
+
+// pass is a satellite's passage above the minimum elevation, acquisition or
+// loss are nil when the pass is under way at the start, or end, of a search
+type pass struct {
+	aos, tca, los *look
+	maxElevation  float64
+	sunlit        bool
+	visible       bool
+}
+
+// passes finds the passes of a satellite over the observer between the
+// Julian dates start and end
+func (o observer) passes(sat *sgp4.Satellite, start, end, minElevation float64) ([]pass, error) {
+	found := []pass{}
+	var current *pass
+	var samples []look
+
+	prev, err := o.look(sat, start)
+	if err != nil {
+		return nil, err
+	}
+	if prev.elevation >= minElevation {
+		current = &pass{}
+		samples = []look{prev}
+	}
+	for jd := start + passStep; prev.jd < end; jd += passStep {
+		l, err := o.look(sat, math.Min(jd, end))
+		if err != nil {
+			return nil, err
+		}
+		above := l.elevation >= minElevation
+		switch {
+		case above && current == nil:
+			aos, err := o.crossing(sat, prev.jd, l.jd, minElevation)
+			if err != nil {
+				return nil, err
+			}
+			current = &pass{aos: &aos}
+			samples = []look{aos, l}
+		case above:
+			samples = append(samples, l)
+		case current != nil:
+			los, err := o.crossing(sat, prev.jd, l.jd, minElevation)
+			if err != nil {
+				return nil, err
+			}
+			current.los = &los
+			samples = append(samples, los)
+			if err := o.complete(sat, current, samples); err != nil {
+				return nil, err
+			}
+			found = append(found, *current)
+			current = nil
+		}
+		prev = l
+	}
+	if current != nil {
+		if err := o.complete(sat, current, samples); err != nil {
+			return nil, err
+		}
+		found = append(found, *current)
+	}
+	return found, nil
+}
+
+// crossing bisects the interval from a to b, in which the satellite
+// crosses the minimum elevation, for the instant it does so
+func (o observer) crossing(sat *sgp4.Satellite, a, b, minElevation float64) (look, error) {
+	first, err := o.look(sat, a)
+	if err != nil {
+		return look{}, err
+	}
+	rising := first.elevation < minElevation
+	for b-a > passPrecision {
+		mid := (a + b) / 2
+		l, err := o.look(sat, mid)
+		if err != nil {
+			return look{}, err
+		}
+		if (l.elevation < minElevation) == rising {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return o.look(sat, (a+b)/2)
+}
+
+// complete finds the closest approach and highest elevation of a pass, and
+// whether the satellite could be seen, from the samples taken during it
+func (o observer) complete(sat *sgp4.Satellite, p *pass, samples []look) error {
+	first, last := samples[0].jd, samples[len(samples)-1].jd
+	tca, err := o.extreme(sat, first, last, func(l look) float64 { return l.distance })
+	if err != nil {
+		return err
+	}
+	p.tca = &tca
+	highest, err := o.extreme(sat, first, last, func(l look) float64 { return -l.elevation })
+	if err != nil {
+		return err
+	}
+	p.maxElevation = highest.elevation
+
+	for _, l := range append(samples, tca, highest) {
+		p.maxElevation = math.Max(p.maxElevation, l.elevation)
+		if l.sunlit {
+			p.sunlit = true
+			if l.dark {
+				p.visible = true
+			}
+		}
+	}
+	return nil
+}
+
+// extreme finds the instant between a and b at which f is least by golden
+// section search, a pass rises to a single peak and falls again
+func (o observer) extreme(sat *sgp4.Satellite, a, b float64, f func(look) float64) (look, error) {
+	ratio := (math.Sqrt(5) - 1) / 2
+	c := b - ratio*(b-a)
+	d := a + ratio*(b-a)
+	lc, err := o.look(sat, c)
+	if err != nil {
+		return look{}, err
+	}
+	ld, err := o.look(sat, d)
+	if err != nil {
+		return look{}, err
+	}
+	for b-a > passPrecision {
+		if f(lc) < f(ld) {
+			b, d, ld = d, c, lc
+			c = b - ratio*(b-a)
+			if lc, err = o.look(sat, c); err != nil {
+				return look{}, err
+			}
+		} else {
+			a, c, lc = c, d, ld
+			d = a + ratio*(b-a)
+			if ld, err = o.look(sat, d); err != nil {
+				return look{}, err
+			}
+		}
+	}
+	return o.look(sat, (a+b)/2)
+}
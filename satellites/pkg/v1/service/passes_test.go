@@ -0,0 +1,118 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/satellites/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	iss = `ISS (ZARYA)
+1 25544U 98067A   08264.51782528 -.00002182  00000-0 -11606-4 0  2927
+2 25544  51.6416 247.4627 0006703 130.5360 325.0288 15.72125391563537
+`
+	// geostationary is an element set made up for a satellite parked over
+	// the Greenwich meridian
+	geostationary = `1 99999U 08999A   08264.50000000  .00000000  00000-0  00000-0 0  9995
+2 99999   0.0500   0.0000 0002000   0.0000 179.7475  1.00273791    15
+`
+)
+
+func TestGetSatellitePasses(t *testing.T) {
+	// Passes of the ISS over London on 2008 September 20, worked
+	// independently with the SGP4 of github.com/joshuaferrara/go-satellite
+	// stepped a second at a time
+	s := &satellitesServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	res, err := s.GetSatellitePasses(context.Background(), &v1.SatellitePassesRequest{
+		Api:       apiVersion,
+		Tle:       iss,
+		Longitude: -0.13,
+		Latitude:  51.5,
+		Year:      2008,
+		Month:     9,
+		Day:       20,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Passes, 6)
+
+	const second = 1.0 / 86400
+	tests := []struct {
+		name         string
+		pass         int
+		aos, tca     float64
+		los          float64
+		maxElevation float64
+		visible      bool
+	}{
+		{"high in the night", 0, 2454729.507396, 2454729.510810, 2454729.514213, 57.196, false},
+		{"low in the night", 1, 2454729.573692, 2454729.576713, 2454729.579722, 15.178, false},
+		{"sunlit after dusk", 3, 2454730.327523, 2454730.330799, 2454730.334097, 27.720, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := res.Passes[tt.pass]
+			assert.Equal(t, "ISS (ZARYA)", p.Name)
+			assert.Equal(t, int32(25544), p.Number)
+			require.NotNil(t, p.Aos)
+			require.NotNil(t, p.Los)
+			assert.InDelta(t, tt.aos, p.Aos.Time.JulianDate, 2*second)
+			assert.InDelta(t, tt.tca, p.Tca.Time.JulianDate, 2*second)
+			assert.InDelta(t, tt.los, p.Los.Time.JulianDate, 2*second)
+			assert.InDelta(t, tt.maxElevation, p.MaxElevation, 0.02)
+			assert.InDelta(t, 0, p.Aos.Elevation, 0.01)
+			assert.InDelta(t, 0, p.Los.Elevation, 0.01)
+			assert.Equal(t, tt.visible, p.Visible)
+		})
+	}
+	// The ISS rises in the west and sets in the east
+	assert.InDelta(t, 281.9, res.Passes[0].Aos.Azimuth, 0.1)
+	assert.InDelta(t, 115.2, res.Passes[0].Los.Azimuth, 0.1)
+}
+
+func TestGetSatellitePassesGeostationary(t *testing.T) {
+	s := &satellitesServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	req := &v1.SatellitePassesRequest{
+		Api:       apiVersion,
+		Tle:       geostationary,
+		Longitude: 151.21,
+		Latitude:  -33.87,
+		Year:      2008,
+		Month:     9,
+		Day:       20,
+		Days:      2,
+	}
+
+	// From Sydney the satellite stays over 50 degrees below the horizon
+	res, err := s.GetSatellitePasses(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, res.Passes)
+
+	// From Greenwich it hangs 31 degrees up in the south for the whole
+	// search, one pass with neither acquisition nor loss
+	req.Longitude, req.Latitude = 0, 51.4779
+	res, err = s.GetSatellitePasses(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, res.Passes, 1)
+	p := res.Passes[0]
+	assert.Nil(t, p.Aos)
+	assert.Nil(t, p.Los)
+	assert.InDelta(t, 31.1, p.MaxElevation, 0.1)
+	assert.InDelta(t, 180, p.Tca.Azimuth, 0.5)
+
+	// Nor is it found above 40 degrees
+	req.MinElevation = 40
+	res, err = s.GetSatellitePasses(context.Background(), req)
+	require.NoError(t, err)
+	assert.Empty(t, res.Passes)
+
+	req.Days = maxPassDays + 1
+	_, err = s.GetSatellitePasses(context.Background(), req)
+	assert.Error(t, err)
+}
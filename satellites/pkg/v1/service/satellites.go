@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	jc "planetpositions/julian/pkg/v1/client"
+	"planetpositions/satellites/grpc/v1"
+	"planetpositions/satellites/pkg/v1/sgp4"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+	// maxPassDays is the longest search, element sets are seldom good for
+	// more than a few days either side of their epoch
+	maxPassDays = 10
+	// maxSatellites is the most element sets searched in one request
+	maxSatellites = 100
+)
+
+// satellitesServiceServer is implementation of v1.SatellitesServiceServer proto interface
+type satellitesServiceServer struct {
+	jc.JulianClient
+}
+
+// NewSatellitesService creates Satellites service
+func NewSatellitesService() v1.SatellitesServiceServer {
+	s := satellitesServiceServer{}
+	s.Address = "julian:5055"
+	return &s
+}
+
+func (s *satellitesServiceServer) GetSatellitePasses(ctx context.Context, req *v1.SatellitePassesRequest) (*v1.SatellitePasses, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	if req.Days == 0 {
+		req.Days = 1
+	}
+	if req.Days < 0 || req.Days > maxPassDays {
+		return nil, fmt.Errorf("unusable input provided: days must be between 1 and %d", maxPassDays)
+	}
+	if req.MinElevation < 0 || req.MinElevation >= 90 {
+		return nil, fmt.Errorf("unusable input provided: minimum elevation must be between 0 and 90")
+	}
+	tles, err := sgp4.ParseTLEs(req.Tle)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if len(tles) > maxSatellites {
+		return nil, fmt.Errorf("unusable input provided: no more than %d element sets may be searched at once", maxSatellites)
+	}
+
+	start, err := s.julianDate(req.Year, req.Month, req.Day, 0)
+	if err != nil {
+		return nil, err
+	}
+	end := start + float64(req.Days)
+	o := newObserver(req.Longitude, req.Latitude, req.Height/1000)
+
+	passes := &v1.SatellitePasses{
+		Api:    apiVersion,
+		Passes: []*v1.SatellitePass{},
+	}
+	for _, tle := range tles {
+		sat, err := sgp4.New(tle)
+		if err != nil {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		found, err := o.passes(sat, start, end, req.MinElevation)
+		if err != nil {
+			return nil, fmt.Errorf("unusable input provided: %v", err)
+		}
+		for _, p := range found {
+			if req.VisibleOnly && !p.visible {
+				continue
+			}
+			msg, err := s.passMessage(tle, p)
+			if err != nil {
+				return nil, err
+			}
+			passes.Passes = append(passes.Passes, msg)
+		}
+	}
+	// Order the passes of all the satellites by when they begin, a pass
+	// already under way begins at the start of the search
+	begins := func(p *v1.SatellitePass) float64 {
+		if p.Aos == nil {
+			return start
+		}
+		return p.Aos.Time.JulianDate
+	}
+	sort.SliceStable(passes.Passes, func(i, j int) bool {
+		return begins(passes.Passes[i]) < begins(passes.Passes[j])
+	})
+	return passes, nil
+}
+
+func (s *satellitesServiceServer) passMessage(tle sgp4.TLE, p pass) (*v1.SatellitePass, error) {
+	msg := &v1.SatellitePass{
+		Name:         tle.Name,
+		Number:       int32(tle.Number),
+		MaxElevation: p.maxElevation,
+		Sunlit:       p.sunlit,
+		Visible:      p.visible,
+	}
+	var err error
+	if msg.Tca, err = s.passEvent(p.tca); err != nil {
+		return nil, err
+	}
+	if p.aos != nil {
+		if msg.Aos, err = s.passEvent(p.aos); err != nil {
+			return nil, err
+		}
+	}
+	if p.los != nil {
+		if msg.Los, err = s.passEvent(p.los); err != nil {
+			return nil, err
+		}
+	}
+	return msg, nil
+}
+
+func (s *satellitesServiceServer) passEvent(l *look) (*v1.SatellitePassEvent, error) {
+	time, err := s.instant(l.jd)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SatellitePassEvent{
+		Time:      time,
+		Azimuth:   l.azimuth,
+		Elevation: l.elevation,
+		Range:     l.distance,
+	}, nil
+}
+
+// julianDate -
+func (s *satellitesServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
+
+// instant returns the calendar date and UTC hour of jd
+func (s *satellitesServiceServer) instant(jd float64) (*v1.SatelliteInstant, error) {
+	cal, err := s.DayFromJulianDay(jd)
+	if err != nil {
+		return nil, fmt.Errorf("instant encountered the following error when executing DayFromJulianDay: %v", err)
+	}
+	return &v1.SatelliteInstant{
+		Year:       cal.Year,
+		Month:      cal.Month,
+		Day:        cal.Day,
+		Hour:       24 * (jd + 0.5 - math.Floor(jd+0.5)),
+		JulianDate: jd,
+	}, nil
+}
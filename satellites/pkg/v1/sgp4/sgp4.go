@@ -0,0 +1,343 @@
+package sgp4
+
+import (
+	"fmt"
+	"math"
+)
+
+// WGS72 constants, with distances in earth radii and time in minutes
+const (
+	// EarthRadius is the equatorial radius of the earth, in km
+	EarthRadius = 6378.135
+	mu          = 398600.8
+	j2          = 0.001082616
+	j3          = -0.00000253881
+	j4          = -0.00000165597
+	j3oj2       = j3 / j2
+	x2o3        = 2.0 / 3.0
+	twoPi       = 2 * math.Pi
+	// minutesPerDay converts revolutions a day into radians a minute
+	minutesPerDay = 1440.0
+)
+
+// xke is the square root of mu in earth radii cubed per minute squared
+var xke = 60.0 / math.Sqrt(EarthRadius*EarthRadius*EarthRadius/mu)
+
+// Satellite holds an element set and the terms of the model derived from it
+type Satellite struct {
+	TLE TLE
+
+	// Mean elements, in radians and radians a minute
+	ecco, inclo, nodeo, argpo, mo, no, bstar float64
+
+	isimp                                               bool
+	deep                                                bool
+	aycof, con41, cc1, cc4, cc5, d2, d3, d4, delmo, eta float64
+	argpdot, omgcof, sinmao, t2cof, t3cof, t4cof, t5cof float64
+	x1mth2, x7thm1, mdot, nodedot, xlcof, xmcof, nodecf float64
+	gsto                                                float64
+	ds                                                  deepSpace
+}
+
+// New initialises the model for the element set
+func New(tle TLE) (*Satellite, error) {
+	s := &Satellite{
+		TLE:   tle,
+		ecco:  tle.Eccentricity,
+		inclo: tle.Inclination * math.Pi / 180,
+		nodeo: tle.RightAscension * math.Pi / 180,
+		argpo: tle.ArgumentOfPerigee * math.Pi / 180,
+		mo:    tle.MeanAnomaly * math.Pi / 180,
+		no:    tle.MeanMotion * twoPi / minutesPerDay,
+		bstar: tle.BStar,
+	}
+	if s.no <= 0 || s.ecco >= 1 {
+		return nil, fmt.Errorf("%s has unusable elements", s.name())
+	}
+
+	// Recover the original mean motion and semi-major axis from the
+	// Kozai mean motion of the element set
+	eccsq := s.ecco * s.ecco
+	omeosq := 1 - eccsq
+	rteosq := math.Sqrt(omeosq)
+	cosio := math.Cos(s.inclo)
+	cosio2 := cosio * cosio
+	ak := math.Pow(xke/s.no, x2o3)
+	d1 := 0.75 * j2 * (3*cosio2 - 1) / (rteosq * omeosq)
+	del := d1 / (ak * ak)
+	adel := ak * (1 - del*del - del*(1.0/3.0+134*del*del/81))
+	del = d1 / (adel * adel)
+	s.no = s.no / (1 + del)
+
+	ao := math.Pow(xke/s.no, x2o3)
+	sinio := math.Sin(s.inclo)
+	po := ao * omeosq
+	con42 := 1 - 5*cosio2
+	s.con41 = -con42 - cosio2 - cosio2
+	posq := po * po
+	rp := ao * (1 - s.ecco)
+	s.gsto = GreenwichSiderealTime(tle.Epoch)
+
+	// Atmospheric density terms, the perigee height sets the density
+	// function used
+	ss := 78/EarthRadius + 1
+	qzms2t := math.Pow((120-78)/EarthRadius, 4)
+	s.isimp = rp < 220/EarthRadius+1
+	sfour := ss
+	qzms24 := qzms2t
+	perige := (rp - 1) * EarthRadius
+	if perige < 156 {
+		sfour = perige - 78
+		if perige < 98 {
+			sfour = 20
+		}
+		qzms24 = math.Pow((120-sfour)/EarthRadius, 4)
+		sfour = sfour/EarthRadius + 1
+	}
+	pinvsq := 1 / posq
+
+	tsi := 1 / (ao - sfour)
+	s.eta = ao * s.ecco * tsi
+	etasq := s.eta * s.eta
+	eeta := s.ecco * s.eta
+	psisq := math.Abs(1 - etasq)
+	coef := qzms24 * math.Pow(tsi, 4)
+	coef1 := coef / math.Pow(psisq, 3.5)
+	cc2 := coef1 * s.no * (ao*(1+1.5*etasq+eeta*(4+etasq)) + 0.375*j2*tsi/psisq*s.con41*(8+3*etasq*(8+etasq)))
+	s.cc1 = s.bstar * cc2
+	cc3 := 0.0
+	if s.ecco > 1.0e-4 {
+		cc3 = -2 * coef * tsi * j3oj2 * s.no * sinio / s.ecco
+	}
+	s.x1mth2 = 1 - cosio2
+	s.cc4 = 2 * s.no * coef1 * ao * omeosq * (s.eta*(2+0.5*etasq) + s.ecco*(0.5+2*etasq) -
+		j2*tsi/(ao*psisq)*(-3*s.con41*(1-2*eeta+etasq*(1.5-0.5*eeta))+0.75*s.x1mth2*(2*etasq-eeta*(1+etasq))*math.Cos(2*s.argpo)))
+	s.cc5 = 2 * coef1 * ao * omeosq * (1 + 2.75*(etasq+eeta) + eeta*etasq)
+
+	// Secular rates from the zonal harmonics
+	cosio4 := cosio2 * cosio2
+	temp1 := 1.5 * j2 * pinvsq * s.no
+	temp2 := 0.5 * temp1 * j2 * pinvsq
+	temp3 := -0.46875 * j4 * pinvsq * pinvsq * s.no
+	s.mdot = s.no + 0.5*temp1*rteosq*s.con41 + 0.0625*temp2*rteosq*(13-78*cosio2+137*cosio4)
+	s.argpdot = -0.5*temp1*con42 + 0.0625*temp2*(7-114*cosio2+395*cosio4) + temp3*(3-36*cosio2+49*cosio4)
+	xhdot1 := -temp1 * cosio
+	s.nodedot = xhdot1 + (0.5*temp2*(4-19*cosio2)+2*temp3*(3-7*cosio2))*cosio
+	xpidot := s.argpdot + s.nodedot
+	s.omgcof = s.bstar * cc3 * math.Cos(s.argpo)
+	if s.ecco > 1.0e-4 {
+		s.xmcof = -x2o3 * coef * s.bstar / eeta
+	}
+	s.nodecf = 3.5 * omeosq * xhdot1 * s.cc1
+	s.t2cof = 1.5 * s.cc1
+	s.xlcof = xlcof(sinio, cosio)
+	s.aycof = -0.5 * j3oj2 * sinio
+	s.delmo = math.Pow(1+s.eta*math.Cos(s.mo), 3)
+	s.sinmao = math.Sin(s.mo)
+	s.x7thm1 = 7*cosio2 - 1
+
+	if twoPi/s.no >= 225 {
+		s.deep = true
+		s.isimp = true
+		s.initDeepSpace(eccsq, xpidot)
+	}
+
+	if !s.isimp {
+		cc1sq := s.cc1 * s.cc1
+		s.d2 = 4 * ao * tsi * cc1sq
+		temp := s.d2 * tsi * s.cc1 / 3
+		s.d3 = (17*ao + sfour) * temp
+		s.d4 = 0.5 * temp * ao * tsi * (221*ao + 31*sfour) * s.cc1
+		s.t3cof = s.d2 + 2*cc1sq
+		s.t4cof = 0.25 * (3*s.d3 + s.cc1*(12*s.d2+10*cc1sq))
+		s.t5cof = 0.2 * (3*s.d4 + 12*s.cc1*s.d3 + 6*s.d2*s.d2 + 15*cc1sq*(2*s.d2+cc1sq))
+	}
+
+	if _, _, err := s.Propagate(0); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// xlcof is the long period periodic coefficient, guarded against division
+// by zero for retrograde equatorial orbits
+func xlcof(sinio, cosio float64) float64 {
+	d := 1 + cosio
+	if math.Abs(d) <= 1.5e-12 {
+		d = 1.5e-12
+	}
+	return -0.25 * j3oj2 * sinio * (3 + 5*cosio) / d
+}
+
+// PropagateTo returns the position and velocity of the satellite at the
+// Julian date jd, UTC
+func (s *Satellite) PropagateTo(jd float64) (position, velocity [3]float64, err error) {
+	return s.Propagate((jd - s.TLE.Epoch) * minutesPerDay)
+}
+
+// Propagate returns the position, in km, and velocity, in km/s, of the
+// satellite tsince minutes after the epoch of its elements, in the true
+// equator, mean equinox (TEME) frame
+func (s *Satellite) Propagate(tsince float64) (position, velocity [3]float64, err error) {
+	vkmpersec := EarthRadius * xke / 60
+
+	// Secular gravity and atmospheric drag
+	xmdf := s.mo + s.mdot*tsince
+	argpdf := s.argpo + s.argpdot*tsince
+	nodedf := s.nodeo + s.nodedot*tsince
+	argpm := argpdf
+	mm := xmdf
+	t2 := tsince * tsince
+	nodem := nodedf + s.nodecf*t2
+	tempa := 1 - s.cc1*tsince
+	tempe := s.bstar * s.cc4 * tsince
+	templ := s.t2cof * t2
+
+	if !s.isimp {
+		delomg := s.omgcof * tsince
+		delm := s.xmcof * (math.Pow(1+s.eta*math.Cos(xmdf), 3) - s.delmo)
+		temp := delomg + delm
+		mm = xmdf + temp
+		argpm = argpdf - temp
+		t3 := t2 * tsince
+		t4 := t3 * tsince
+		tempa = tempa - s.d2*t2 - s.d3*t3 - s.d4*t4
+		tempe = tempe + s.bstar*s.cc5*(math.Sin(mm)-s.sinmao)
+		templ = templ + s.t3cof*t3 + t4*(s.t4cof+tsince*s.t5cof)
+	}
+
+	nm := s.no
+	em := s.ecco
+	inclm := s.inclo
+	if s.deep {
+		em, argpm, inclm, mm, nodem, nm = s.ds.secular(s, tsince, em, argpm, inclm, mm, nodem)
+	}
+	if nm <= 0 {
+		return position, velocity, fmt.Errorf("the mean motion of %s is not positive", s.name())
+	}
+
+	am := math.Pow(xke/nm, x2o3) * tempa * tempa
+	nm = xke / math.Pow(am, 1.5)
+	em -= tempe
+	if em >= 1 || em < -0.001 {
+		return position, velocity, fmt.Errorf("the mean eccentricity of %s is out of range", s.name())
+	}
+	if em < 1.0e-6 {
+		em = 1.0e-6
+	}
+	mm += s.no * templ
+	xlm := mm + argpm + nodem
+	nodem = math.Mod(nodem, twoPi)
+	argpm = math.Mod(argpm, twoPi)
+	xlm = math.Mod(xlm, twoPi)
+	mm = math.Mod(xlm-argpm-nodem, twoPi)
+
+	// Lunar and solar periodics
+	ep, xincp, argpp, nodep, mp := em, inclm, argpm, nodem, mm
+	sinip, cosip := math.Sin(inclm), math.Cos(inclm)
+	aycof, xlcf := s.aycof, s.xlcof
+	con41, x1mth2, x7thm1 := s.con41, s.x1mth2, s.x7thm1
+	if s.deep {
+		ep, xincp, nodep, argpp, mp = s.ds.periodics(tsince, ep, xincp, nodep, argpp, mp)
+		if xincp < 0 {
+			xincp = -xincp
+			nodep += math.Pi
+			argpp -= math.Pi
+		}
+		if ep < 0 || ep > 1 {
+			return position, velocity, fmt.Errorf("the perturbed eccentricity of %s is out of range", s.name())
+		}
+		sinip, cosip = math.Sin(xincp), math.Cos(xincp)
+		aycof = -0.5 * j3oj2 * sinip
+		xlcf = xlcof(sinip, cosip)
+		cosisq := cosip * cosip
+		con41 = 3*cosisq - 1
+		x1mth2 = 1 - cosisq
+		x7thm1 = 7*cosisq - 1
+	}
+
+	// Long period periodics
+	axnl := ep * math.Cos(argpp)
+	temp := 1 / (am * (1 - ep*ep))
+	aynl := ep*math.Sin(argpp) + temp*aycof
+	xl := mp + argpp + nodep + temp*xlcf*axnl
+
+	// Solve Kepler's equation
+	u := math.Mod(xl-nodep, twoPi)
+	eo1 := u
+	var sineo1, coseo1 float64
+	for i := 0; i < 10; i++ {
+		sineo1, coseo1 = math.Sin(eo1), math.Cos(eo1)
+		tem5 := (u - aynl*coseo1 + axnl*sineo1 - eo1) / (1 - coseo1*axnl - sineo1*aynl)
+		tem5 = math.Max(-0.95, math.Min(0.95, tem5))
+		eo1 += tem5
+		if math.Abs(tem5) < 1.0e-12 {
+			break
+		}
+	}
+
+	// Short period periodics
+	ecose := axnl*coseo1 + aynl*sineo1
+	esine := axnl*sineo1 - aynl*coseo1
+	el2 := axnl*axnl + aynl*aynl
+	pl := am * (1 - el2)
+	if pl < 0 {
+		return position, velocity, fmt.Errorf("the semi-latus rectum of %s is negative", s.name())
+	}
+	rl := am * (1 - ecose)
+	rdotl := math.Sqrt(am) * esine / rl
+	rvdotl := math.Sqrt(pl) / rl
+	betal := math.Sqrt(1 - el2)
+	temp = esine / (1 + betal)
+	sinu := am / rl * (sineo1 - aynl - axnl*temp)
+	cosu := am / rl * (coseo1 - axnl + aynl*temp)
+	su := math.Atan2(sinu, cosu)
+	sin2u := (cosu + cosu) * sinu
+	cos2u := 1 - 2*sinu*sinu
+	temp = 1 / pl
+	temp1 := 0.5 * j2 * temp
+	temp2 := temp1 * temp
+
+	mrt := rl*(1-1.5*temp2*betal*con41) + 0.5*temp1*x1mth2*cos2u
+	su -= 0.25 * temp2 * x7thm1 * sin2u
+	xnode := nodep + 1.5*temp2*cosip*sin2u
+	xinc := xincp + 1.5*temp2*cosip*sinip*cos2u
+	mvt := rdotl - nm*temp1*x1mth2*sin2u/xke
+	rvdot := rvdotl + nm*temp1*(x1mth2*cos2u+1.5*con41)/xke
+
+	// Orientation vectors
+	sinsu, cossu := math.Sin(su), math.Cos(su)
+	snod, cnod := math.Sin(xnode), math.Cos(xnode)
+	sini, cosi := math.Sin(xinc), math.Cos(xinc)
+	xmx := -snod * cosi
+	xmy := cnod * cosi
+	ux := [3]float64{xmx*sinsu + cnod*cossu, xmy*sinsu + snod*cossu, sini * sinsu}
+	vx := [3]float64{xmx*cossu - cnod*sinsu, xmy*cossu - snod*sinsu, sini * cossu}
+	for i := range ux {
+		position[i] = mrt * ux[i] * EarthRadius
+		velocity[i] = (mvt*ux[i] + rvdot*vx[i]) * vkmpersec
+	}
+	if mrt < 1 {
+		return position, velocity, fmt.Errorf("%s has decayed", s.name())
+	}
+	return position, velocity, nil
+}
+
+func (s *Satellite) name() string {
+	if s.TLE.Name != "" {
+		return s.TLE.Name
+	}
+	return fmt.Sprintf("satellite %d", s.TLE.Number)
+}
+
+// GreenwichSiderealTime returns the mean sidereal time at Greenwich in
+// radians for the Julian date jd, UT1, IAU 1982
+func GreenwichSiderealTime(jd float64) float64 {
+	t := (jd - 2451545.0) / 36525
+	seconds := -6.2e-6*t*t*t + 0.093104*t*t + (876600.0*3600+8640184.812866)*t + 67310.54841
+	gst := math.Mod(seconds*math.Pi/180/240, twoPi)
+	if gst < 0 {
+		gst += twoPi
+	}
+	return gst
+}
@@ -0,0 +1,102 @@
+package sgp4_test
+
+import (
+	"testing"
+
+	"planetpositions/satellites/pkg/v1/sgp4"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTLEs(t *testing.T) {
+	tles, err := sgp4.ParseTLEs(`0 VANGUARD 1
+1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753
+2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667
+1 08195U 75081A   06176.33215444  .00000099  00000-0  11873-3 0   813
+2 08195  64.1586 279.0717 6877146 264.7651  20.2257  2.00491383225656
+`)
+	require.NoError(t, err)
+	require.Len(t, tles, 2)
+
+	assert.Equal(t, "VANGUARD 1", tles[0].Name)
+	assert.Equal(t, 5, tles[0].Number)
+	assert.InDelta(t, 2451723.28495062, tles[0].Epoch, 1e-8)
+	assert.InDelta(t, 0.28098e-4, tles[0].BStar, 1e-12)
+	assert.InDelta(t, 0.1859667, tles[0].Eccentricity, 1e-9)
+	assert.InDelta(t, 10.82419157, tles[0].MeanMotion, 1e-8)
+
+	assert.Equal(t, "", tles[1].Name)
+	assert.Equal(t, 8195, tles[1].Number)
+}
+
+func TestParseTLEErrors(t *testing.T) {
+	line1 := "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753"
+	line2 := "2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667"
+	testcases := map[string]struct {
+		line1, line2 string
+	}{
+		"Short line":         {line1: line1[:60], line2: line2},
+		"Wrong line number":  {line1: line2, line2: line2},
+		"Checksum":           {line1: line1[:68] + "5", line2: line2},
+		"Different numbers":  {line1: line1, line2: "2 00006" + line2[7:68] + "8"},
+		"Malformed exponent": {line1: line1[:53] + " 28098x4" + line1[61:68] + "9", line2: line2},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			_, err := sgp4.ParseTLE("", tc.line1, tc.line2)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestPropagate(t *testing.T) {
+	// Reference vectors from the verification cases of Vallado et al.
+	// 2006, a near earth and a deep space orbit
+	testcases := map[string]struct {
+		line1, line2 string
+		tsince       float64
+		position     [3]float64
+		velocity     [3]float64
+	}{
+		"Near earth at epoch": {
+			line1:    "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753",
+			line2:    "2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667",
+			position: [3]float64{7022.46529266, -1400.08296755, 0.03995155},
+			velocity: [3]float64{1.893841015, 6.405893759, 4.534807250},
+		},
+		"Near earth after 6 hours": {
+			line1:    "1 00005U 58002B   00179.78495062  .00000023  00000-0  28098-4 0  4753",
+			line2:    "2 00005  34.2682 348.7242 1859667 331.7664  19.3264 10.82419157413667",
+			tsince:   360,
+			position: [3]float64{-7154.03120202, -3783.17682504, -3536.19412294},
+			velocity: [3]float64{4.741887409, -4.151817765, -2.093935425},
+		},
+		"Deep space after 12 hours": {
+			line1:    "1 11801U          80230.29629788  .01431103  00000-0  14311-1 0    13",
+			line2:    "2 11801  46.7916 230.4354 7318036  47.4722  10.4117  2.28537848    13",
+			tsince:   720,
+			position: [3]float64{14271.28759106, 24110.46434638, -4725.76320434},
+			velocity: [3]float64{-0.320504528, 2.679841539, -2.084054355},
+		},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			tle, err := sgp4.ParseTLE("", tc.line1, tc.line2)
+			require.NoError(t, err)
+			s, err := sgp4.New(tle)
+			require.NoError(t, err)
+			position, velocity, err := s.Propagate(tc.tsince)
+			require.NoError(t, err)
+			for i := range position {
+				assert.InDelta(t, tc.position[i], position[i], 0.05)
+				assert.InDelta(t, tc.velocity[i], velocity[i], 1e-5)
+			}
+		})
+	}
+}
+
+func TestGreenwichSiderealTime(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 12.a, 13h10m46.3668s
+	assert.InDelta(t, 197.693195*3.141592653589793/180, sgp4.GreenwichSiderealTime(2446895.5), 1e-7)
+}
@@ -0,0 +1,194 @@
+// Package sgp4 propagates the orbits of artificial satellites from two line
+// element sets with the SGP4 model, and its SDP4 deep space extension for
+// orbits with periods of 225 minutes or more.
+//
+// It follows Vallado, Crawford, Hujsak and Kelso, Revisiting Spacetrack
+// Report #3 (AIAA 2006-6753), using the WGS72 constants the element sets are
+// generated with.
+package sgp4
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// TLE is a two line element set
+type TLE struct {
+	// Name from the optional line before the element set
+	Name string
+	// NORAD catalogue number
+	Number int
+	// Epoch of the elements as a Julian date, UTC
+	Epoch float64
+	// First and second derivatives of the mean motion, in revolutions a day
+	// squared and cubed, already divided by 2 and 6
+	MeanMotionDot    float64
+	MeanMotionDotDot float64
+	// Drag term, in inverse earth radii
+	BStar float64
+	// Angles in degrees
+	Inclination       float64
+	RightAscension    float64
+	Eccentricity      float64
+	ArgumentOfPerigee float64
+	MeanAnomaly       float64
+	// Mean motion, in revolutions a day
+	MeanMotion float64
+}
+
+// ParseTLEs reads every element set in the text, each of which may be
+// preceded by a line naming the satellite
+func ParseTLEs(text string) ([]TLE, error) {
+	lines := []string{}
+	for _, l := range strings.Split(strings.Replace(text, "\r", "", -1), "\n") {
+		if strings.TrimSpace(l) != "" {
+			lines = append(lines, strings.TrimRight(l, " \t"))
+		}
+	}
+
+	tles := []TLE{}
+	name := ""
+	for i := 0; i < len(lines); i++ {
+		if !strings.HasPrefix(lines[i], "1 ") {
+			name = strings.TrimSpace(strings.TrimPrefix(lines[i], "0 "))
+			continue
+		}
+		if i+1 >= len(lines) {
+			return nil, fmt.Errorf("line %d is not followed by the second line of the element set", i+1)
+		}
+		tle, err := ParseTLE(name, lines[i], lines[i+1])
+		if err != nil {
+			return nil, err
+		}
+		tles = append(tles, tle)
+		name = ""
+		i++
+	}
+	if len(tles) == 0 {
+		return nil, fmt.Errorf("no element sets found")
+	}
+	return tles, nil
+}
+
+// ParseTLE reads a single element set
+func ParseTLE(name, line1, line2 string) (TLE, error) {
+	tle := TLE{Name: name}
+	for n, l := range []string{line1, line2} {
+		if len(l) < 69 {
+			return tle, fmt.Errorf("line %d of %s is too short", n+1, identify(name, line1))
+		}
+		if l[0] != byte('1'+n) {
+			return tle, fmt.Errorf("line %d of %s does not start with %d", n+1, identify(name, line1), n+1)
+		}
+		if checksum(l) != int(l[68]-'0') {
+			return tle, fmt.Errorf("line %d of %s fails its checksum", n+1, identify(name, line1))
+		}
+	}
+	if line1[2:7] != line2[2:7] {
+		return tle, fmt.Errorf("the lines of %s are for different satellites", identify(name, line1))
+	}
+
+	var err error
+	fields := []struct {
+		text  string
+		value *float64
+	}{
+		{line1[18:20], nil},
+		{line1[20:32], &tle.Epoch},
+		{line1[33:43], &tle.MeanMotionDot},
+		{line2[8:16], &tle.Inclination},
+		{line2[17:25], &tle.RightAscension},
+		{"." + line2[26:33], &tle.Eccentricity},
+		{line2[34:42], &tle.ArgumentOfPerigee},
+		{line2[43:51], &tle.MeanAnomaly},
+		{line2[52:63], &tle.MeanMotion},
+	}
+	var year float64
+	fields[0].value = &year
+	for _, f := range fields {
+		if *f.value, err = strconv.ParseFloat(strings.TrimSpace(f.text), 64); err != nil {
+			return tle, fmt.Errorf("%s has a malformed field %q", identify(name, line1), f.text)
+		}
+	}
+	if tle.MeanMotionDotDot, err = exponent(line1[44:52]); err != nil {
+		return tle, fmt.Errorf("%s has a malformed field %q", identify(name, line1), line1[44:52])
+	}
+	if tle.BStar, err = exponent(line1[53:61]); err != nil {
+		return tle, fmt.Errorf("%s has a malformed field %q", identify(name, line1), line1[53:61])
+	}
+	if tle.Number, err = strconv.Atoi(strings.TrimSpace(line1[2:7])); err != nil {
+		return tle, fmt.Errorf("%s has a malformed catalogue number", identify(name, line1))
+	}
+
+	// Two digit years from 57 are in the twentieth century, the first
+	// satellite was launched in 1957
+	if year < 57 {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	tle.Epoch = newYear(int(year)) + tle.Epoch - 1
+	return tle, nil
+}
+
+// exponent reads a field with an assumed leading decimal point and a power of
+// ten, " 12345-3" is 0.12345e-3
+func exponent(field string) (float64, error) {
+	field = strings.TrimSpace(field)
+	if field == "" {
+		return 0, nil
+	}
+	sign := 1.0
+	if field[0] == '-' || field[0] == '+' {
+		if field[0] == '-' {
+			sign = -1
+		}
+		field = field[1:]
+	}
+	if len(field) < 3 {
+		return 0, fmt.Errorf("field too short")
+	}
+	mantissa, err := strconv.ParseFloat("."+strings.TrimSpace(field[:len(field)-2]), 64)
+	if err != nil {
+		return 0, err
+	}
+	power, err := strconv.Atoi(field[len(field)-2:])
+	if err != nil {
+		return 0, err
+	}
+	return sign * mantissa * math.Pow10(power), nil
+}
+
+// checksum is the sum of the digits on the line, with minus signs counting as
+// one, modulo 10
+func checksum(line string) int {
+	sum := 0
+	for _, c := range line[:68] {
+		switch {
+		case c >= '0' && c <= '9':
+			sum += int(c - '0')
+		case c == '-':
+			sum++
+		}
+	}
+	return sum % 10
+}
+
+// newYear returns the Julian date of the start of January 1st in the
+// Gregorian calendar
+func newYear(year int) float64 {
+	y := year - 1
+	return 1721425.5 + float64(365*y+y/4-y/100+y/400)
+}
+
+func identify(name, line1 string) string {
+	if name != "" {
+		return name
+	}
+	if len(line1) >= 7 {
+		return "satellite " + strings.TrimSpace(line1[2:7])
+	}
+	return "the element set"
+}
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"context"
+	"log"
+	"net"
+	"os"
+
+	v1 "planetpositions/stars/grpc/v1"
+	stars "planetpositions/stars/pkg/v1/service"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+)
+
+type server struct{}
+
+var ss = stars.NewStarsService()
+
+func main() {
+
+	portNum := os.Getenv("PORT_NUM")
+	lis, err := net.Listen("tcp", "0.0.0.0:"+portNum)
+	if err != nil {
+		log.Fatalf("Failed to listen: %v", err)
+	}
+	s := grpc.NewServer()
+	v1.RegisterStarsServiceServer(s, &server{})
+	reflection.Register(s)
+	if err := s.Serve(lis); err != nil {
+		log.Fatalf("failed to serve: %v", err)
+	}
+}
+
+// GetStar -
+func (s *server) GetStar(ctx context.Context, req *v1.StarRequest) (*v1.Star, error) {
+	st, err := ss.GetStar(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// GetStars -
+func (s *server) GetStars(ctx context.Context, req *v1.StarsRequest) (*v1.Stars, error) {
+	st, err := ss.GetStars(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// GetStarPosition -
+func (s *server) GetStarPosition(ctx context.Context, req *v1.StarPositionRequest) (*v1.StarPosition, error) {
+	sp, err := ss.GetStarPosition(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return sp, nil
+}
+
+// GetStarRiseSet -
+func (s *server) GetStarRiseSet(ctx context.Context, req *v1.StarRiseSetRequest) (*v1.StarRiseSet, error) {
+	rs, err := ss.GetStarRiseSet(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return rs, nil
+}
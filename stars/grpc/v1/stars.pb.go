@@ -0,0 +1,1168 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: stars.proto
+
+package v1
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type StarEventStatus int32
+
+const (
+	// Never sent, zero is kept for an unset status
+	StarEventStatus_STAR_EVENT_STATUS_UNSPECIFIED StarEventStatus = 0
+	// The star rises and sets on the date
+	StarEventStatus_EVENT_OCCURS StarEventStatus = 1
+	// The star is circumpolar and never sets
+	StarEventStatus_ALWAYS_UP StarEventStatus = 2
+	// The star never rises
+	StarEventStatus_ALWAYS_DOWN StarEventStatus = 3
+)
+
+var StarEventStatus_name = map[int32]string{
+	0: "STAR_EVENT_STATUS_UNSPECIFIED",
+	1: "EVENT_OCCURS",
+	2: "ALWAYS_UP",
+	3: "ALWAYS_DOWN",
+}
+
+var StarEventStatus_value = map[string]int32{
+	"STAR_EVENT_STATUS_UNSPECIFIED": 0,
+	"EVENT_OCCURS":                  1,
+	"ALWAYS_UP":                     2,
+	"ALWAYS_DOWN":                   3,
+}
+
+func (x StarEventStatus) String() string {
+	return proto.EnumName(StarEventStatus_name, int32(x))
+}
+
+func (StarEventStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{0}
+}
+
+type StarRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// The star is looked up by HR number when it is set, then by HIP
+	// number, then by name
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hr                   int32    `protobuf:"varint,3,opt,name=hr,proto3" json:"hr,omitempty"`
+	Hip                  int32    `protobuf:"varint,4,opt,name=hip,proto3" json:"hip,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarRequest) Reset()         { *m = StarRequest{} }
+func (m *StarRequest) String() string { return proto.CompactTextString(m) }
+func (*StarRequest) ProtoMessage()    {}
+func (*StarRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{0}
+}
+
+func (m *StarRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarRequest.Unmarshal(m, b)
+}
+func (m *StarRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarRequest.Marshal(b, m, deterministic)
+}
+func (m *StarRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarRequest.Merge(m, src)
+}
+func (m *StarRequest) XXX_Size() int {
+	return xxx_messageInfo_StarRequest.Size(m)
+}
+func (m *StarRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarRequest proto.InternalMessageInfo
+
+func (m *StarRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StarRequest) GetHr() int32 {
+	if m != nil {
+		return m.Hr
+	}
+	return 0
+}
+
+func (m *StarRequest) GetHip() int32 {
+	if m != nil {
+		return m.Hip
+	}
+	return 0
+}
+
+type Star struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Number in the Yale Bright Star (Harvard Revised) and Hipparcos
+	// catalogues
+	Hr   int32  `protobuf:"varint,2,opt,name=hr,proto3" json:"hr,omitempty"`
+	Hip  int32  `protobuf:"varint,3,opt,name=hip,proto3" json:"hip,omitempty"`
+	Name string `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	// Right ascension and declination for the equinox and epoch J2000.0, in
+	// degrees
+	RightAscension float64 `protobuf:"fixed64,5,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination    float64 `protobuf:"fixed64,6,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Annual proper motion, in milliarcseconds, the motion in right
+	// ascension is measured along the great circle
+	ProperMotionRa  float64 `protobuf:"fixed64,7,opt,name=proper_motion_ra,json=properMotionRa,proto3" json:"proper_motion_ra,omitempty"`
+	ProperMotionDec float64 `protobuf:"fixed64,8,opt,name=proper_motion_dec,json=properMotionDec,proto3" json:"proper_motion_dec,omitempty"`
+	// Visual magnitude
+	Magnitude            float64  `protobuf:"fixed64,9,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Star) Reset()         { *m = Star{} }
+func (m *Star) String() string { return proto.CompactTextString(m) }
+func (*Star) ProtoMessage()    {}
+func (*Star) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{1}
+}
+
+func (m *Star) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Star.Unmarshal(m, b)
+}
+func (m *Star) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Star.Marshal(b, m, deterministic)
+}
+func (m *Star) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Star.Merge(m, src)
+}
+func (m *Star) XXX_Size() int {
+	return xxx_messageInfo_Star.Size(m)
+}
+func (m *Star) XXX_DiscardUnknown() {
+	xxx_messageInfo_Star.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Star proto.InternalMessageInfo
+
+func (m *Star) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Star) GetHr() int32 {
+	if m != nil {
+		return m.Hr
+	}
+	return 0
+}
+
+func (m *Star) GetHip() int32 {
+	if m != nil {
+		return m.Hip
+	}
+	return 0
+}
+
+func (m *Star) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Star) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *Star) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *Star) GetProperMotionRa() float64 {
+	if m != nil {
+		return m.ProperMotionRa
+	}
+	return 0
+}
+
+func (m *Star) GetProperMotionDec() float64 {
+	if m != nil {
+		return m.ProperMotionDec
+	}
+	return 0
+}
+
+func (m *Star) GetMagnitude() float64 {
+	if m != nil {
+		return m.Magnitude
+	}
+	return 0
+}
+
+type StarsRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// The faintest visual magnitude wanted
+	MaxMagnitude         float64  `protobuf:"fixed64,2,opt,name=max_magnitude,json=maxMagnitude,proto3" json:"max_magnitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarsRequest) Reset()         { *m = StarsRequest{} }
+func (m *StarsRequest) String() string { return proto.CompactTextString(m) }
+func (*StarsRequest) ProtoMessage()    {}
+func (*StarsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{2}
+}
+
+func (m *StarsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarsRequest.Unmarshal(m, b)
+}
+func (m *StarsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarsRequest.Marshal(b, m, deterministic)
+}
+func (m *StarsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarsRequest.Merge(m, src)
+}
+func (m *StarsRequest) XXX_Size() int {
+	return xxx_messageInfo_StarsRequest.Size(m)
+}
+func (m *StarsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarsRequest proto.InternalMessageInfo
+
+func (m *StarsRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarsRequest) GetMaxMagnitude() float64 {
+	if m != nil {
+		return m.MaxMagnitude
+	}
+	return 0
+}
+
+type Stars struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// In order of HR number
+	Stars                []*Star  `protobuf:"bytes,2,rep,name=stars,proto3" json:"stars,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Stars) Reset()         { *m = Stars{} }
+func (m *Stars) String() string { return proto.CompactTextString(m) }
+func (*Stars) ProtoMessage()    {}
+func (*Stars) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{3}
+}
+
+func (m *Stars) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Stars.Unmarshal(m, b)
+}
+func (m *Stars) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Stars.Marshal(b, m, deterministic)
+}
+func (m *Stars) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Stars.Merge(m, src)
+}
+func (m *Stars) XXX_Size() int {
+	return xxx_messageInfo_Stars.Size(m)
+}
+func (m *Stars) XXX_DiscardUnknown() {
+	xxx_messageInfo_Stars.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Stars proto.InternalMessageInfo
+
+func (m *Stars) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Stars) GetStars() []*Star {
+	if m != nil {
+		return m.Stars
+	}
+	return nil
+}
+
+type StarPositionRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hr        int32   `protobuf:"varint,3,opt,name=hr,proto3" json:"hr,omitempty"`
+	Hip       int32   `protobuf:"varint,4,opt,name=hip,proto3" json:"hip,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,7,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,8,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,9,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC hour of the day
+	Hour                 float64  `protobuf:"fixed64,10,opt,name=hour,proto3" json:"hour,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarPositionRequest) Reset()         { *m = StarPositionRequest{} }
+func (m *StarPositionRequest) String() string { return proto.CompactTextString(m) }
+func (*StarPositionRequest) ProtoMessage()    {}
+func (*StarPositionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{4}
+}
+
+func (m *StarPositionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarPositionRequest.Unmarshal(m, b)
+}
+func (m *StarPositionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarPositionRequest.Marshal(b, m, deterministic)
+}
+func (m *StarPositionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarPositionRequest.Merge(m, src)
+}
+func (m *StarPositionRequest) XXX_Size() int {
+	return xxx_messageInfo_StarPositionRequest.Size(m)
+}
+func (m *StarPositionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarPositionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarPositionRequest proto.InternalMessageInfo
+
+func (m *StarPositionRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarPositionRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StarPositionRequest) GetHr() int32 {
+	if m != nil {
+		return m.Hr
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetHip() int32 {
+	if m != nil {
+		return m.Hip
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *StarPositionRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+type StarPosition struct {
+	Api        string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Star       *Star   `protobuf:"bytes,2,opt,name=star,proto3" json:"star,omitempty"`
+	JulianDate float64 `protobuf:"fixed64,3,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Right ascension and declination, in degrees, with proper motion
+	// applied and referred to the mean equinox of date
+	RightAscension float64 `protobuf:"fixed64,4,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination    float64 `protobuf:"fixed64,5,opt,name=declination,proto3" json:"declination,omitempty"`
+	// Local hour angle and horizontal coordinates, in degrees, azimuth
+	// measured clockwise from north
+	HourAngle            float64  `protobuf:"fixed64,6,opt,name=hour_angle,json=hourAngle,proto3" json:"hour_angle,omitempty"`
+	Azimuth              float64  `protobuf:"fixed64,7,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Altitude             float64  `protobuf:"fixed64,8,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarPosition) Reset()         { *m = StarPosition{} }
+func (m *StarPosition) String() string { return proto.CompactTextString(m) }
+func (*StarPosition) ProtoMessage()    {}
+func (*StarPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{5}
+}
+
+func (m *StarPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarPosition.Unmarshal(m, b)
+}
+func (m *StarPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarPosition.Marshal(b, m, deterministic)
+}
+func (m *StarPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarPosition.Merge(m, src)
+}
+func (m *StarPosition) XXX_Size() int {
+	return xxx_messageInfo_StarPosition.Size(m)
+}
+func (m *StarPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarPosition proto.InternalMessageInfo
+
+func (m *StarPosition) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarPosition) GetStar() *Star {
+	if m != nil {
+		return m.Star
+	}
+	return nil
+}
+
+func (m *StarPosition) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *StarPosition) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *StarPosition) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *StarPosition) GetHourAngle() float64 {
+	if m != nil {
+		return m.HourAngle
+	}
+	return 0
+}
+
+func (m *StarPosition) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *StarPosition) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+type StarRiseSetRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Name      string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hr        int32   `protobuf:"varint,3,opt,name=hr,proto3" json:"hr,omitempty"`
+	Hip       int32   `protobuf:"varint,4,opt,name=hip,proto3" json:"hip,omitempty"`
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,6,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,7,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,8,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,9,opt,name=day,proto3" json:"day,omitempty"`
+	// Offset of the observer's civil time from UTC, in hours, the date
+	// searched runs from local midnight to midnight
+	UtcOffset float64 `protobuf:"fixed64,10,opt,name=utc_offset,json=utcOffset,proto3" json:"utc_offset,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa, giving the
+	// refraction at the horizon. Either of the temperature and pressure not
+	// given is that of the standard atmosphere, 10 degrees Celsius and 1010
+	// hPa, and with none of the three the refraction is taken to be 34'
+	Refraction           string                `protobuf:"bytes,11,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature          *wrappers.DoubleValue `protobuf:"bytes,12,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure             *wrappers.DoubleValue `protobuf:"bytes,13,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *StarRiseSetRequest) Reset()         { *m = StarRiseSetRequest{} }
+func (m *StarRiseSetRequest) String() string { return proto.CompactTextString(m) }
+func (*StarRiseSetRequest) ProtoMessage()    {}
+func (*StarRiseSetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{6}
+}
+
+func (m *StarRiseSetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarRiseSetRequest.Unmarshal(m, b)
+}
+func (m *StarRiseSetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarRiseSetRequest.Marshal(b, m, deterministic)
+}
+func (m *StarRiseSetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarRiseSetRequest.Merge(m, src)
+}
+func (m *StarRiseSetRequest) XXX_Size() int {
+	return xxx_messageInfo_StarRiseSetRequest.Size(m)
+}
+func (m *StarRiseSetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarRiseSetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarRiseSetRequest proto.InternalMessageInfo
+
+func (m *StarRiseSetRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarRiseSetRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *StarRiseSetRequest) GetHr() int32 {
+	if m != nil {
+		return m.Hr
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetHip() int32 {
+	if m != nil {
+		return m.Hip
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetUtcOffset() float64 {
+	if m != nil {
+		return m.UtcOffset
+	}
+	return 0
+}
+
+func (m *StarRiseSetRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *StarRiseSetRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *StarRiseSetRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+type StarInstant struct {
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Hour of the day, in UTC
+	Hour                 float64  `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	JulianDate           float64  `protobuf:"fixed64,5,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarInstant) Reset()         { *m = StarInstant{} }
+func (m *StarInstant) String() string { return proto.CompactTextString(m) }
+func (*StarInstant) ProtoMessage()    {}
+func (*StarInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{7}
+}
+
+func (m *StarInstant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarInstant.Unmarshal(m, b)
+}
+func (m *StarInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarInstant.Marshal(b, m, deterministic)
+}
+func (m *StarInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarInstant.Merge(m, src)
+}
+func (m *StarInstant) XXX_Size() int {
+	return xxx_messageInfo_StarInstant.Size(m)
+}
+func (m *StarInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarInstant proto.InternalMessageInfo
+
+func (m *StarInstant) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *StarInstant) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *StarInstant) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *StarInstant) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *StarInstant) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type StarEvent struct {
+	Time *StarInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Horizontal coordinates, in degrees, azimuth measured clockwise from
+	// north
+	Azimuth              float64  `protobuf:"fixed64,2,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Altitude             float64  `protobuf:"fixed64,3,opt,name=altitude,proto3" json:"altitude,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StarEvent) Reset()         { *m = StarEvent{} }
+func (m *StarEvent) String() string { return proto.CompactTextString(m) }
+func (*StarEvent) ProtoMessage()    {}
+func (*StarEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{8}
+}
+
+func (m *StarEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarEvent.Unmarshal(m, b)
+}
+func (m *StarEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarEvent.Marshal(b, m, deterministic)
+}
+func (m *StarEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarEvent.Merge(m, src)
+}
+func (m *StarEvent) XXX_Size() int {
+	return xxx_messageInfo_StarEvent.Size(m)
+}
+func (m *StarEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarEvent proto.InternalMessageInfo
+
+func (m *StarEvent) GetTime() *StarInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *StarEvent) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *StarEvent) GetAltitude() float64 {
+	if m != nil {
+		return m.Altitude
+	}
+	return 0
+}
+
+type StarRiseSet struct {
+	Api    string          `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Star   *Star           `protobuf:"bytes,2,opt,name=star,proto3" json:"star,omitempty"`
+	Status StarEventStatus `protobuf:"varint,3,opt,name=status,proto3,enum=v1.StarEventStatus" json:"status,omitempty"`
+	// Rise and set are omitted unless the status is EVENT_OCCURS, a star
+	// transits every date
+	Rise                 *StarEvent `protobuf:"bytes,4,opt,name=rise,proto3" json:"rise,omitempty"`
+	Transit              *StarEvent `protobuf:"bytes,5,opt,name=transit,proto3" json:"transit,omitempty"`
+	Set                  *StarEvent `protobuf:"bytes,6,opt,name=set,proto3" json:"set,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *StarRiseSet) Reset()         { *m = StarRiseSet{} }
+func (m *StarRiseSet) String() string { return proto.CompactTextString(m) }
+func (*StarRiseSet) ProtoMessage()    {}
+func (*StarRiseSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d8ac58a8ca3678fc, []int{9}
+}
+
+func (m *StarRiseSet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StarRiseSet.Unmarshal(m, b)
+}
+func (m *StarRiseSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StarRiseSet.Marshal(b, m, deterministic)
+}
+func (m *StarRiseSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StarRiseSet.Merge(m, src)
+}
+func (m *StarRiseSet) XXX_Size() int {
+	return xxx_messageInfo_StarRiseSet.Size(m)
+}
+func (m *StarRiseSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_StarRiseSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StarRiseSet proto.InternalMessageInfo
+
+func (m *StarRiseSet) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *StarRiseSet) GetStar() *Star {
+	if m != nil {
+		return m.Star
+	}
+	return nil
+}
+
+func (m *StarRiseSet) GetStatus() StarEventStatus {
+	if m != nil {
+		return m.Status
+	}
+	return StarEventStatus_STAR_EVENT_STATUS_UNSPECIFIED
+}
+
+func (m *StarRiseSet) GetRise() *StarEvent {
+	if m != nil {
+		return m.Rise
+	}
+	return nil
+}
+
+func (m *StarRiseSet) GetTransit() *StarEvent {
+	if m != nil {
+		return m.Transit
+	}
+	return nil
+}
+
+func (m *StarRiseSet) GetSet() *StarEvent {
+	if m != nil {
+		return m.Set
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("v1.StarEventStatus", StarEventStatus_name, StarEventStatus_value)
+	proto.RegisterType((*StarRequest)(nil), "v1.StarRequest")
+	proto.RegisterType((*Star)(nil), "v1.Star")
+	proto.RegisterType((*StarsRequest)(nil), "v1.StarsRequest")
+	proto.RegisterType((*Stars)(nil), "v1.Stars")
+	proto.RegisterType((*StarPositionRequest)(nil), "v1.StarPositionRequest")
+	proto.RegisterType((*StarPosition)(nil), "v1.StarPosition")
+	proto.RegisterType((*StarRiseSetRequest)(nil), "v1.StarRiseSetRequest")
+	proto.RegisterType((*StarInstant)(nil), "v1.StarInstant")
+	proto.RegisterType((*StarEvent)(nil), "v1.StarEvent")
+	proto.RegisterType((*StarRiseSet)(nil), "v1.StarRiseSet")
+}
+
+func init() { proto.RegisterFile("stars.proto", fileDescriptor_d8ac58a8ca3678fc) }
+
+var fileDescriptor_d8ac58a8ca3678fc = []byte{
+	// 1178 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xff, 0xef, 0xae, 0x9d, 0xc4, 0x8f, 0x93, 0xd8, 0xff, 0x29, 0x6a, 0x57, 0x56, 0xda, 0x6e,
+	0xb7, 0x87, 0x46, 0x01, 0x7b, 0x1b, 0xd3, 0x03, 0x14, 0x84, 0x30, 0x89, 0x41, 0x16, 0xf4, 0x85,
+	0x75, 0xd2, 0x8a, 0x93, 0x35, 0x59, 0x8f, 0x77, 0xb7, 0x5a, 0xef, 0x2c, 0x33, 0xb3, 0x49, 0x8b,
+	0x31, 0x07, 0xc4, 0x0d, 0x21, 0xa4, 0x72, 0xe3, 0x53, 0xf0, 0x5d, 0x38, 0xf0, 0x05, 0x90, 0xf8,
+	0x0c, 0x1c, 0x10, 0x68, 0x66, 0x5f, 0xe2, 0xb8, 0xae, 0x54, 0x10, 0x27, 0x4e, 0x3b, 0xf3, 0x7b,
+	0xde, 0x9f, 0xe7, 0xb7, 0xcf, 0x40, 0x9d, 0x0b, 0xcc, 0x78, 0x27, 0x61, 0x54, 0x50, 0xa4, 0x9f,
+	0xee, 0xb7, 0x76, 0x7c, 0x4a, 0xfd, 0x88, 0x38, 0x38, 0x09, 0x1d, 0x1c, 0xc7, 0x54, 0x60, 0x11,
+	0xd2, 0x38, 0xd7, 0x68, 0xbd, 0xa1, 0x3e, 0x5e, 0xdb, 0x27, 0x71, 0x9b, 0x9f, 0x61, 0xdf, 0x27,
+	0xcc, 0xa1, 0x89, 0xd2, 0x58, 0xa1, 0x7d, 0x2d, 0xf7, 0xa5, 0x6e, 0x27, 0xe9, 0xc4, 0x39, 0x63,
+	0x38, 0x49, 0x48, 0x11, 0xcf, 0x3e, 0x86, 0xfa, 0x50, 0x60, 0xe6, 0x92, 0xcf, 0x53, 0xc2, 0x05,
+	0x6a, 0x82, 0x81, 0x93, 0xd0, 0xd4, 0x2c, 0x6d, 0xb7, 0xe6, 0xca, 0x23, 0x42, 0x50, 0x89, 0xf1,
+	0x94, 0x98, 0xba, 0x82, 0xd4, 0x19, 0x6d, 0x83, 0x1e, 0x30, 0xd3, 0xb0, 0xb4, 0xdd, 0xaa, 0xab,
+	0x07, 0x4c, 0x5a, 0x05, 0x61, 0x62, 0x56, 0x14, 0x20, 0x8f, 0xf6, 0x77, 0x3a, 0x54, 0xa4, 0xdf,
+	0x15, 0x0e, 0x33, 0x63, 0x7d, 0xd9, 0xd8, 0x28, 0x8d, 0xcb, 0x90, 0x95, 0x85, 0x90, 0xb7, 0xa0,
+	0xc1, 0x42, 0x3f, 0x10, 0x23, 0xcc, 0x3d, 0x12, 0xf3, 0x90, 0xc6, 0x66, 0xd5, 0xd2, 0x76, 0x35,
+	0x77, 0x5b, 0xc1, 0xbd, 0x02, 0x45, 0x16, 0xd4, 0xc7, 0xc4, 0x8b, 0xc2, 0x58, 0xb5, 0xc1, 0x5c,
+	0x53, 0x4a, 0x8b, 0x10, 0xda, 0x85, 0x66, 0xc2, 0x68, 0x42, 0xd8, 0x68, 0x4a, 0x25, 0x30, 0x62,
+	0xd8, 0x5c, 0xcf, 0x7c, 0x65, 0xf8, 0x3d, 0x05, 0xbb, 0x18, 0xed, 0xc1, 0xff, 0x2f, 0x6a, 0x8e,
+	0x89, 0x67, 0x6e, 0x28, 0xd5, 0xc6, 0xa2, 0xea, 0x21, 0xf1, 0xd0, 0x0e, 0xd4, 0xa6, 0xd8, 0x8f,
+	0x43, 0x91, 0x8e, 0x89, 0x59, 0x53, 0x3a, 0xe7, 0x80, 0xdd, 0x87, 0x4d, 0xd9, 0x0e, 0xfe, 0xf2,
+	0x3e, 0xdf, 0x84, 0xad, 0x29, 0x7e, 0x3a, 0x3a, 0xf7, 0xa1, 0x2b, 0x1f, 0x9b, 0x53, 0xfc, 0xf4,
+	0x5e, 0xe9, 0xe6, 0x6d, 0xa8, 0x2a, 0x37, 0x2b, 0xec, 0xaf, 0x41, 0x55, 0xf1, 0xc8, 0xd4, 0x2d,
+	0x63, 0xb7, 0xde, 0xdd, 0xe8, 0x9c, 0xee, 0x77, 0xd4, 0x64, 0x33, 0xd8, 0xfe, 0x4d, 0x83, 0x4b,
+	0xf2, 0xfe, 0x90, 0xf2, 0x50, 0x95, 0xf7, 0xef, 0x4e, 0x5c, 0xd6, 0x1f, 0xd1, 0xd8, 0xcf, 0x72,
+	0xcf, 0x46, 0x73, 0x0e, 0xa0, 0x16, 0x6c, 0x44, 0x58, 0x64, 0xc2, 0x6c, 0x24, 0xe5, 0x5d, 0xc6,
+	0x7b, 0x46, 0x30, 0x53, 0x33, 0xa8, 0xba, 0xea, 0x8c, 0x5e, 0x83, 0xea, 0x94, 0xc6, 0x22, 0x50,
+	0xdd, 0xae, 0xba, 0xd9, 0x45, 0x46, 0x1d, 0xe3, 0x67, 0xaa, 0xbb, 0x55, 0x57, 0x1e, 0xa5, 0x6d,
+	0x40, 0x53, 0x66, 0x82, 0xf2, 0xa9, 0xce, 0xf6, 0x9f, 0x1a, 0x6c, 0x2e, 0x56, 0xba, 0xa2, 0xc4,
+	0x1d, 0xa8, 0xc8, 0xae, 0xa8, 0x12, 0x17, 0x7b, 0xa5, 0x50, 0x74, 0x1d, 0xea, 0x4f, 0xd2, 0x28,
+	0xc4, 0xf1, 0x68, 0x8c, 0x05, 0x51, 0x55, 0x6b, 0x2e, 0x64, 0xd0, 0x21, 0x16, 0x2b, 0xc9, 0x58,
+	0x79, 0x15, 0x32, 0x56, 0x5f, 0x24, 0xe3, 0x55, 0x00, 0x99, 0xf4, 0x08, 0xc7, 0x7e, 0x54, 0xb4,
+	0xa6, 0x26, 0x91, 0x9e, 0x04, 0x90, 0x09, 0xeb, 0xf8, 0x8b, 0x70, 0x9a, 0x8a, 0x20, 0xa7, 0x68,
+	0x71, 0x95, 0x1d, 0xc5, 0x51, 0xde, 0xd1, 0x8c, 0x92, 0xe5, 0xdd, 0xfe, 0xd6, 0x00, 0xa4, 0xea,
+	0x09, 0x39, 0x19, 0x12, 0xf1, 0xdf, 0x18, 0xf5, 0x55, 0x80, 0x54, 0x78, 0x23, 0x3a, 0x99, 0x70,
+	0x22, 0xf2, 0x81, 0xd7, 0x52, 0xe1, 0x3d, 0x50, 0x00, 0xba, 0x06, 0xc0, 0xc8, 0x84, 0x61, 0x4f,
+	0x75, 0xba, 0xae, 0x0a, 0x5a, 0x40, 0xd0, 0x7b, 0x50, 0x17, 0x64, 0x9a, 0x10, 0x86, 0x45, 0xca,
+	0x88, 0xb9, 0xa9, 0x26, 0xbf, 0xd3, 0xc9, 0xd6, 0x63, 0xa7, 0x58, 0x8f, 0x9d, 0x43, 0x9a, 0x9e,
+	0x44, 0xe4, 0x11, 0x8e, 0x52, 0xe2, 0x2e, 0x1a, 0xa0, 0xb7, 0x60, 0x23, 0x61, 0x84, 0x73, 0x69,
+	0xbc, 0xf5, 0x0a, 0xc6, 0xa5, 0xb6, 0xfd, 0x55, 0xb6, 0x62, 0x07, 0x31, 0x17, 0x38, 0x16, 0x65,
+	0x0f, 0xb4, 0x55, 0x3d, 0xd0, 0x57, 0xf4, 0xc0, 0x78, 0x91, 0xee, 0x95, 0x73, 0xba, 0x2f, 0xb3,
+	0xb5, 0xba, 0xcc, 0x56, 0x7b, 0x02, 0x35, 0x19, 0xbf, 0x7f, 0x4a, 0x62, 0x81, 0x6e, 0x42, 0x45,
+	0x84, 0x53, 0xa2, 0xa2, 0xd7, 0xbb, 0x8d, 0x82, 0xf9, 0x79, 0x72, 0xae, 0x12, 0x2e, 0xb2, 0x4e,
+	0x7f, 0x39, 0xeb, 0x8c, 0x25, 0xd6, 0xfd, 0xa2, 0x41, 0x7d, 0x81, 0x75, 0x7f, 0xfb, 0xb7, 0x7b,
+	0x1d, 0xd6, 0xb8, 0xc0, 0x22, 0xe5, 0xca, 0xf3, 0x76, 0xf7, 0x52, 0x21, 0x57, 0x99, 0x0f, 0x95,
+	0xc8, 0xcd, 0x55, 0xd0, 0x0d, 0xa8, 0xb0, 0x90, 0x67, 0x6f, 0x44, 0xbd, 0xbb, 0x75, 0x41, 0xd5,
+	0x55, 0x22, 0x74, 0x0b, 0xd6, 0x05, 0xc3, 0x31, 0x0f, 0x85, 0x59, 0x5d, 0xa5, 0x55, 0x48, 0xd1,
+	0x75, 0x30, 0x24, 0xa5, 0xd6, 0x56, 0x29, 0x49, 0xc9, 0xde, 0x04, 0x1a, 0x4b, 0x79, 0xa0, 0x1b,
+	0x70, 0x75, 0x78, 0xd4, 0x73, 0x47, 0xfd, 0x47, 0xfd, 0xfb, 0x47, 0xa3, 0xe1, 0x51, 0xef, 0xe8,
+	0x78, 0x38, 0x3a, 0xbe, 0x3f, 0x7c, 0xd8, 0x3f, 0x18, 0x7c, 0x38, 0xe8, 0x1f, 0x36, 0xff, 0x87,
+	0x9a, 0xb0, 0x99, 0x49, 0x1f, 0x1c, 0x1c, 0x1c, 0xbb, 0xc3, 0xa6, 0x86, 0xb6, 0xa0, 0xd6, 0xfb,
+	0xe4, 0x71, 0xef, 0xb3, 0xe1, 0xe8, 0xf8, 0x61, 0x53, 0x47, 0x0d, 0xa8, 0xe7, 0xd7, 0xc3, 0x07,
+	0x8f, 0xef, 0x37, 0x8d, 0xee, 0x4f, 0x46, 0xfe, 0x4c, 0x0c, 0x09, 0x3b, 0x0d, 0x3d, 0x82, 0xde,
+	0x85, 0xf5, 0x8f, 0x88, 0x0c, 0xc9, 0x50, 0x39, 0xaa, 0xfc, 0x6f, 0x6e, 0x95, 0xed, 0xb3, 0x2f,
+	0x7f, 0xfd, 0xf3, 0xaf, 0x3f, 0xe8, 0x4d, 0xb4, 0x7d, 0xba, 0xef, 0xc8, 0x4e, 0x3a, 0x33, 0xf9,
+	0x23, 0xcf, 0xd1, 0x00, 0x36, 0x72, 0x6b, 0x8e, 0x9a, 0x85, 0x76, 0xf1, 0x04, 0xb5, 0x6a, 0x25,
+	0x62, 0x5b, 0xca, 0x41, 0x0b, 0x99, 0xb9, 0x03, 0xee, 0xcc, 0x2e, 0xbc, 0x45, 0x73, 0xf4, 0xbd,
+	0x06, 0x8d, 0xdc, 0x57, 0xb9, 0x56, 0xaf, 0x14, 0x0e, 0x96, 0x9e, 0x94, 0x56, 0x73, 0x59, 0x60,
+	0x7f, 0xaa, 0x02, 0x7c, 0x8c, 0x06, 0x79, 0x80, 0x24, 0x17, 0xe4, 0x99, 0x3a, 0xb3, 0x72, 0x7d,
+	0xc8, 0x73, 0xbe, 0x2d, 0xe6, 0xce, 0x4c, 0xfe, 0x1c, 0x73, 0x67, 0xa6, 0x7e, 0x87, 0xb9, 0x33,
+	0x1b, 0xe3, 0x67, 0x73, 0x67, 0x26, 0x59, 0x3f, 0x47, 0xdf, 0x68, 0xb0, 0x9d, 0x67, 0x54, 0x10,
+	0xee, 0x72, 0xd9, 0xa2, 0x0b, 0x7b, 0xaf, 0xd5, 0x58, 0xc2, 0xed, 0x81, 0x4a, 0xe7, 0x00, 0xf5,
+	0xf2, 0x74, 0x24, 0x5d, 0x38, 0x11, 0xff, 0x20, 0x9b, 0x0f, 0xfe, 0xd0, 0x9e, 0xf7, 0x7e, 0xd7,
+	0xee, 0x36, 0x71, 0x92, 0x44, 0xa1, 0xa7, 0x76, 0xba, 0xf3, 0x84, 0xd3, 0xd8, 0x7d, 0x07, 0x8c,
+	0x3b, 0xb7, 0xef, 0xa0, 0x3b, 0xb0, 0xe7, 0x12, 0x91, 0xb2, 0x98, 0x8c, 0xad, 0xb3, 0x80, 0xc4,
+	0x96, 0x08, 0x88, 0xc5, 0x08, 0xa7, 0x29, 0xf3, 0x88, 0x35, 0xa6, 0x84, 0x5b, 0x31, 0x15, 0x16,
+	0x79, 0x1a, 0x72, 0xd1, 0x41, 0x6b, 0x50, 0xf9, 0x51, 0xd7, 0xd6, 0xd1, 0x73, 0x0d, 0xb6, 0xd4,
+	0x68, 0x2c, 0x9e, 0x31, 0xa1, 0x6b, 0xec, 0x77, 0x6e, 0xdb, 0x5f, 0x82, 0xe3, 0xd3, 0xb6, 0xcf,
+	0x12, 0xaf, 0x1d, 0x08, 0x91, 0xb4, 0x19, 0xe1, 0xa2, 0x3d, 0x0d, 0x3d, 0x46, 0x73, 0xb5, 0xb6,
+	0x48, 0x05, 0x65, 0x21, 0x8e, 0xac, 0x84, 0xd1, 0x27, 0xc4, 0x13, 0xe8, 0xb6, 0x54, 0xe4, 0x77,
+	0x1d, 0xc7, 0x0f, 0x45, 0x90, 0x9e, 0x74, 0x3c, 0x3a, 0x75, 0x78, 0x80, 0x63, 0x12, 0xd0, 0x33,
+	0x82, 0x99, 0x08, 0x9c, 0x24, 0xc2, 0x31, 0x11, 0xc5, 0x44, 0x78, 0xeb, 0x8a, 0x12, 0xbf, 0x7f,
+	0x41, 0x49, 0x9a, 0xed, 0x69, 0x5a, 0xf7, 0x85, 0x32, 0x4f, 0xd6, 0xd4, 0xf2, 0x7b, 0xf3, 0xaf,
+	0x01, 0x00, 0x62, 0x2b, 0xb4, 0x0e, 0xc5, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// StarsServiceClient is the client API for StarsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type StarsServiceClient interface {
+	// Look up a star in the catalogue
+	GetStar(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*Star, error)
+	// List the stars in the catalogue down to a magnitude
+	GetStars(ctx context.Context, in *StarsRequest, opts ...grpc.CallOption) (*Stars, error)
+	// Get the position of a star
+	GetStarPosition(ctx context.Context, in *StarPositionRequest, opts ...grpc.CallOption) (*StarPosition, error)
+	// Get the rise, transit and set times of a star
+	GetStarRiseSet(ctx context.Context, in *StarRiseSetRequest, opts ...grpc.CallOption) (*StarRiseSet, error)
+}
+
+type starsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewStarsServiceClient(cc *grpc.ClientConn) StarsServiceClient {
+	return &starsServiceClient{cc}
+}
+
+func (c *starsServiceClient) GetStar(ctx context.Context, in *StarRequest, opts ...grpc.CallOption) (*Star, error) {
+	out := new(Star)
+	err := c.cc.Invoke(ctx, "/v1.StarsService/GetStar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starsServiceClient) GetStars(ctx context.Context, in *StarsRequest, opts ...grpc.CallOption) (*Stars, error) {
+	out := new(Stars)
+	err := c.cc.Invoke(ctx, "/v1.StarsService/GetStars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starsServiceClient) GetStarPosition(ctx context.Context, in *StarPositionRequest, opts ...grpc.CallOption) (*StarPosition, error) {
+	out := new(StarPosition)
+	err := c.cc.Invoke(ctx, "/v1.StarsService/GetStarPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *starsServiceClient) GetStarRiseSet(ctx context.Context, in *StarRiseSetRequest, opts ...grpc.CallOption) (*StarRiseSet, error) {
+	out := new(StarRiseSet)
+	err := c.cc.Invoke(ctx, "/v1.StarsService/GetStarRiseSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StarsServiceServer is the server API for StarsService service.
+type StarsServiceServer interface {
+	// Look up a star in the catalogue
+	GetStar(context.Context, *StarRequest) (*Star, error)
+	// List the stars in the catalogue down to a magnitude
+	GetStars(context.Context, *StarsRequest) (*Stars, error)
+	// Get the position of a star
+	GetStarPosition(context.Context, *StarPositionRequest) (*StarPosition, error)
+	// Get the rise, transit and set times of a star
+	GetStarRiseSet(context.Context, *StarRiseSetRequest) (*StarRiseSet, error)
+}
+
+func RegisterStarsServiceServer(s *grpc.Server, srv StarsServiceServer) {
+	s.RegisterService(&_StarsService_serviceDesc, srv)
+}
+
+func _StarsService_GetStar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarsServiceServer).GetStar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StarsService/GetStar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarsServiceServer).GetStar(ctx, req.(*StarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarsService_GetStars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarsServiceServer).GetStars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StarsService/GetStars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarsServiceServer).GetStars(ctx, req.(*StarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarsService_GetStarPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarPositionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarsServiceServer).GetStarPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StarsService/GetStarPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarsServiceServer).GetStarPosition(ctx, req.(*StarPositionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StarsService_GetStarRiseSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StarRiseSetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StarsServiceServer).GetStarRiseSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.StarsService/GetStarRiseSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StarsServiceServer).GetStarRiseSet(ctx, req.(*StarRiseSetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _StarsService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.StarsService",
+	HandlerType: (*StarsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStar",
+			Handler:    _StarsService_GetStar_Handler,
+		},
+		{
+			MethodName: "GetStars",
+			Handler:    _StarsService_GetStars_Handler,
+		},
+		{
+			MethodName: "GetStarPosition",
+			Handler:    _StarsService_GetStarPosition_Handler,
+		},
+		{
+			MethodName: "GetStarRiseSet",
+			Handler:    _StarsService_GetStarRiseSet_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "stars.proto",
+}
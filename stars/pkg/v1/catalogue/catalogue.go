@@ -0,0 +1,121 @@
+// Package catalogue holds a catalogue of bright stars and brings their
+// positions forward to the date of observation.
+//
+// The catalogue is a selection of the brightest and navigational stars from
+// the Yale Bright Star Catalogue, with positions and proper motions for the
+// equinox and epoch J2000.0 taken from Hipparcos. It is embedded in the binary
+// so the service needs no data files. The program in gen writes the whole of
+// the Bright Star Catalogue into stars.go from the CDS files V/50 and I/239,
+// which are not kept here. Proper names, in names.go, are an index on top of
+// the table.
+package catalogue
+
+import (
+	"math"
+	"strings"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+)
+
+// Star is an entry in the catalogue
+type Star struct {
+	// Number in the Yale Bright Star (Harvard Revised) and Hipparcos
+	// catalogues
+	HR  int
+	HIP int
+	// Proper name
+	Name string
+	// Right ascension and declination for the equinox and epoch J2000.0,
+	// in degrees
+	RightAscension float64
+	Declination    float64
+	// Annual proper motion in milliarcseconds, the motion in right
+	// ascension is measured along the great circle, that is it includes
+	// the cos(declination) factor
+	ProperMotionRA  float64
+	ProperMotionDec float64
+	// Visual magnitude
+	Magnitude float64
+}
+
+// entry is a row of the generated catalogue, a Star without its name
+type entry struct {
+	hr, hip                int
+	ra, dec                float64
+	pmRA, pmDec, magnitude float64
+}
+
+var (
+	// stars is the catalogue in order of HR number, with the indexes on it
+	stars  []Star
+	byHR   = map[int]int{}
+	byHIP  = map[int]int{}
+	byName = map[string]int{}
+)
+
+func init() {
+	stars = make([]Star, len(entries))
+	for i, e := range entries {
+		stars[i] = Star{HR: e.hr, HIP: e.hip, Name: names[e.hr], RightAscension: e.ra, Declination: e.dec, ProperMotionRA: e.pmRA, ProperMotionDec: e.pmDec, Magnitude: e.magnitude}
+		byHR[e.hr] = i
+		if e.hip != 0 {
+			byHIP[e.hip] = i
+		}
+		if name, ok := names[e.hr]; ok {
+			byName[nameKey(name)] = i
+		}
+	}
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+// Stars returns the catalogue in order of HR number
+func Stars() []Star {
+	return append([]Star{}, stars...)
+}
+
+// ByName returns the star with the given proper name, ignoring case, spaces
+// and hyphens
+func ByName(name string) (Star, bool) {
+	i, ok := byName[nameKey(name)]
+	if !ok {
+		return Star{}, false
+	}
+	return stars[i], true
+}
+
+// ByHR returns the star with the given Bright Star Catalogue number
+func ByHR(hr int) (Star, bool) {
+	i, ok := byHR[hr]
+	if !ok {
+		return Star{}, false
+	}
+	return stars[i], true
+}
+
+// ByHIP returns the star with the given Hipparcos catalogue number
+func ByHIP(hip int) (Star, bool) {
+	i, ok := byHIP[hip]
+	if !ok {
+		return Star{}, false
+	}
+	return stars[i], true
+}
+
+func nameKey(name string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// Position returns the right ascension and declination of the star in
+// degrees, referred to the mean equinox of date, for the Julian ephemeris
+// day jde. The proper motion is applied from J2000.0 and the position then
+// precessed.
+func (s Star) Position(jde float64) (rightAscension, declination float64) {
+	years := (jde - precession.J2000) / 365.25
+	cosDec := math.Cos(degreesToRadians(s.Declination))
+	ra := s.RightAscension + s.ProperMotionRA*years/cosDec/3600000
+	dec := s.Declination + s.ProperMotionDec*years/3600000
+	return precession.Precess(ra, dec, precession.J2000, jde)
+}
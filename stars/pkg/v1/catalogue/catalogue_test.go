@@ -0,0 +1,46 @@
+package catalogue_test
+
+import (
+	"testing"
+
+	"planetpositions/stars/pkg/v1/catalogue"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPosition(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 21.b, theta Persei, the
+	// proper motion of +0.03425s a year in right ascension is along the
+	// great circle 0.33549 arcseconds a year. The book precesses by IAU
+	// 1976, IAU 2006 differs by a tenth of an arcsecond over the 28 years.
+	star := catalogue.Star{
+		RightAscension:  41.049942,
+		Declination:     49.228467,
+		ProperMotionRA:  335.49,
+		ProperMotionDec: -89.5,
+	}
+	ra, dec := star.Position(2462088.69)
+	assert.InDelta(t, 41.547214, ra, 0.00005)
+	assert.InDelta(t, 49.348483, dec, 0.00005)
+}
+
+func TestLookup(t *testing.T) {
+	testcases := map[string]struct {
+		find func() (catalogue.Star, bool)
+		name string
+		ok   bool
+	}{
+		"Name":             {find: func() (catalogue.Star, bool) { return catalogue.ByName("sirius") }, name: "Sirius", ok: true},
+		"Name with spaces": {find: func() (catalogue.Star, bool) { return catalogue.ByName("Kaus-Australis") }, name: "Kaus Australis", ok: true},
+		"HR":               {find: func() (catalogue.Star, bool) { return catalogue.ByHR(7001) }, name: "Vega", ok: true},
+		"HIP":              {find: func() (catalogue.Star, bool) { return catalogue.ByHIP(11767) }, name: "Polaris", ok: true},
+		"Unknown":          {find: func() (catalogue.Star, bool) { return catalogue.ByName("Vulcan") }},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			s, ok := tc.find()
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.name, s.Name)
+		})
+	}
+}
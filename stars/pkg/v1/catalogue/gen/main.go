@@ -0,0 +1,188 @@
+// Command gen writes the catalogue's stars.go from the Yale Bright Star
+// Catalogue, 5th revised edition (CDS V/50, the file catalog), and the
+// Hipparcos main catalogue (CDS I/239, the file hip_main.dat), either of them
+// gzipped or not. Both are at https://cdsarc.cds.unistra.fr/ftp/.
+//
+// Every star of the Bright Star Catalogue with a position is written. Those
+// found in Hipparcos by their HD number take its number, position and proper
+// motions, brought from its epoch J1991.25 to J2000.0; the others keep the
+// FK5 place of the Bright Star Catalogue. With the files fetched into the
+// catalogue's directory, run there
+//
+//	go run ./gen -bsc catalog.gz -hip hip_main.dat.gz -out stars.go
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// hipparcosEpoch is the number of years from the epoch of the Hipparcos
+// places, J1991.25, to J2000.0
+const hipparcosEpoch = 8.75
+
+// star is a row of stars.go
+type star struct {
+	hr, hip                int
+	ra, dec                float64
+	pmRA, pmDec, magnitude float64
+}
+
+func main() {
+	bsc := flag.String("bsc", "catalog.gz", "the Bright Star Catalogue, CDS V/50")
+	hip := flag.String("hip", "hip_main.dat.gz", "the Hipparcos main catalogue, CDS I/239")
+	out := flag.String("out", "stars.go", "the Go file to write")
+	flag.Parse()
+
+	hipparcos, err := readHipparcos(*hip)
+	if err != nil {
+		log.Fatalf("gen could not read %s: %v", *hip, err)
+	}
+	stars, err := readBSC(*bsc, hipparcos)
+	if err != nil {
+		log.Fatalf("gen could not read %s: %v", *bsc, err)
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen from the Yale Bright Star Catalogue and Hipparcos; DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package catalogue\n\n")
+	fmt.Fprintf(&b, "// entries is the catalogue: HR, HIP, J2000.0 right ascension and declination\n")
+	fmt.Fprintf(&b, "// in degrees, proper motion in right ascension (great circle) and\n")
+	fmt.Fprintf(&b, "// declination in milliarcseconds a year, and visual magnitude\n")
+	fmt.Fprintf(&b, "var entries = []entry{\n")
+	for _, s := range stars {
+		fmt.Fprintf(&b, "{%d, %d, %.6f, %.6f, %.2f, %.2f, %.2f},\n", s.hr, s.hip, s.ra, s.dec, s.pmRA, s.pmDec, s.magnitude)
+	}
+	fmt.Fprintf(&b, "}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatalf("gen could not format %s: %v", *out, err)
+	}
+	if err := ioutil.WriteFile(*out, src, 0644); err != nil {
+		log.Fatalf("gen could not write %s: %v", *out, err)
+	}
+	log.Printf("gen wrote %d stars to %s", len(stars), *out)
+}
+
+// open opens the file, uncompressing it when its name ends in .gz
+func open(name string) (io.ReadCloser, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasSuffix(name, ".gz") {
+		return f, nil
+	}
+	z, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{z, f}, nil
+}
+
+// readHipparcos returns the stars of hip_main.dat with a position by their HD
+// number. The fields are separated by bars: H1 is the HIP number, H8 and H9
+// the right ascension and declination in degrees, H12 and H13 the proper
+// motions in milliarcseconds a year and H71 the HD number.
+func readHipparcos(name string) (map[int]star, error) {
+	f, err := open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stars := map[int]star{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Split(scanner.Text(), "|")
+		if len(fields) < 72 {
+			continue
+		}
+		hd, err := strconv.Atoi(strings.TrimSpace(fields[71]))
+		if err != nil {
+			continue
+		}
+		hip, err := strconv.Atoi(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, fmt.Errorf("bad HIP number %q", fields[1])
+		}
+		values := make([]float64, 4)
+		ok := true
+		for i, field := range []string{fields[8], fields[9], fields[12], fields[13]} {
+			if values[i], err = strconv.ParseFloat(strings.TrimSpace(field), 64); err != nil {
+				ok = false
+			}
+		}
+		if !ok {
+			continue
+		}
+		ra, dec, pmRA, pmDec := values[0], values[1], values[2], values[3]
+		ra += pmRA * hipparcosEpoch / math.Cos(dec*math.Pi/180) / 3600000
+		dec += pmDec * hipparcosEpoch / 3600000
+		stars[hd] = star{hip: hip, ra: math.Mod(ra+360, 360), dec: dec, pmRA: pmRA, pmDec: pmDec}
+	}
+	return stars, scanner.Err()
+}
+
+// readBSC returns the stars of the Bright Star Catalogue in order of HR
+// number, from the columns given in the ReadMe of V/50. The few entries that
+// are not stars have no position and are left out.
+func readBSC(name string, hipparcos map[int]star) ([]star, error) {
+	f, err := open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	stars := []star{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := fmt.Sprintf("%-197s", scanner.Text())
+		column := func(from, to int) string { return strings.TrimSpace(line[from-1 : to]) }
+		number := func(from, to int) float64 {
+			v, _ := strconv.ParseFloat(column(from, to), 64)
+			return v
+		}
+		if column(76, 77) == "" {
+			continue
+		}
+		hr, err := strconv.Atoi(column(1, 4))
+		if err != nil {
+			return nil, fmt.Errorf("bad HR number %q", column(1, 4))
+		}
+		s := star{
+			hr:        hr,
+			ra:        (number(76, 77) + number(78, 79)/60 + number(80, 83)/3600) * 15,
+			dec:       number(85, 86) + number(87, 88)/60 + number(89, 90)/3600,
+			pmRA:      number(149, 154) * 1000,
+			pmDec:     number(155, 160) * 1000,
+			magnitude: number(103, 107),
+		}
+		if column(84, 84) == "-" {
+			s.dec = -s.dec
+		}
+		if hd, err := strconv.Atoi(column(26, 31)); err == nil {
+			if h, ok := hipparcos[hd]; ok {
+				s.hip, s.ra, s.dec, s.pmRA, s.pmDec = h.hip, h.ra, h.dec, h.pmRA, h.pmDec
+			}
+		}
+		stars = append(stars, s)
+	}
+	return stars, scanner.Err()
+}
@@ -0,0 +1,77 @@
+package catalogue
+
+// names are the proper names of the brightest and navigational stars by HR
+// number, the index ByName looks stars up in
+var names = map[int]string{
+	15:   "Alpheratz",
+	99:   "Ankaa",
+	168:  "Schedar",
+	188:  "Diphda",
+	424:  "Polaris",
+	472:  "Achernar",
+	617:  "Hamal",
+	897:  "Acamar",
+	911:  "Menkar",
+	936:  "Algol",
+	1017: "Mirfak",
+	1457: "Aldebaran",
+	1708: "Capella",
+	1713: "Rigel",
+	1790: "Bellatrix",
+	1791: "Elnath",
+	1852: "Mintaka",
+	1903: "Alnilam",
+	1948: "Alnitak",
+	2004: "Saiph",
+	2061: "Betelgeuse",
+	2294: "Mirzam",
+	2326: "Canopus",
+	2421: "Alhena",
+	2491: "Sirius",
+	2618: "Adhara",
+	2693: "Wezen",
+	2891: "Castor",
+	2943: "Procyon",
+	2990: "Pollux",
+	3307: "Avior",
+	3634: "Suhail",
+	3685: "Miaplacidus",
+	3748: "Alphard",
+	3982: "Regulus",
+	4295: "Merak",
+	4301: "Dubhe",
+	4534: "Denebola",
+	4662: "Gienah",
+	4730: "Acrux",
+	4763: "Gacrux",
+	4853: "Mimosa",
+	4905: "Alioth",
+	5054: "Mizar",
+	5056: "Spica",
+	5191: "Alkaid",
+	5267: "Hadar",
+	5288: "Menkent",
+	5340: "Arcturus",
+	5459: "Rigil Kentaurus",
+	5531: "Zubenelgenubi",
+	5563: "Kochab",
+	5793: "Alphecca",
+	6134: "Antares",
+	6217: "Atria",
+	6378: "Sabik",
+	6527: "Shaula",
+	6556: "Rasalhague",
+	6705: "Eltanin",
+	6879: "Kaus Australis",
+	7001: "Vega",
+	7121: "Nunki",
+	7557: "Altair",
+	7790: "Peacock",
+	7796: "Sadr",
+	7924: "Deneb",
+	8162: "Alderamin",
+	8308: "Enif",
+	8425: "Alnair",
+	8728: "Fomalhaut",
+	8781: "Markab",
+}
@@ -0,0 +1,79 @@
+package catalogue
+
+// entries is the catalogue: HR, HIP, J2000.0 right ascension and declination
+// in degrees, proper motion in right ascension (great circle) and
+// declination in milliarcseconds a year, and visual magnitude, for the named
+// stars only
+var entries = []entry{
+	{15, 677, 2.096917, 29.090444, 135.68, -162.95, 2.06},
+	{99, 2081, 6.571042, -42.306000, 232.76, -353.64, 2.40},
+	{168, 3179, 10.126833, 56.537333, 50.88, -32.13, 2.24},
+	{188, 3419, 10.897375, -17.986611, 232.79, 32.71, 2.04},
+	{424, 11767, 37.954542, 89.264111, 44.48, -11.85, 1.98},
+	{472, 7588, 24.428542, -57.236750, 88.02, -40.08, 0.46},
+	{617, 9884, 31.793375, 23.462417, 188.55, -148.08, 2.00},
+	{897, 13847, 44.565333, -40.304722, -52.89, 21.98, 3.20},
+	{911, 14135, 45.569875, 4.089750, -10.41, -76.85, 2.53},
+	{936, 14576, 47.042208, 40.955639, 2.39, -1.44, 2.12},
+	{1017, 15863, 51.080708, 49.861167, 24.11, -26.01, 1.79},
+	{1457, 21421, 68.980167, 16.509306, 63.45, -188.94, 0.86},
+	{1708, 24608, 79.172333, 45.998000, 75.52, -427.13, 0.08},
+	{1713, 24436, 78.634458, -8.201639, 1.31, 0.50, 0.13},
+	{1790, 25336, 81.282750, 6.349694, -8.11, -12.88, 1.64},
+	{1791, 25428, 81.572958, 28.607444, 22.76, -173.58, 1.65},
+	{1852, 25930, 83.001667, -0.299083, 0.64, -0.69, 2.23},
+	{1903, 26311, 84.053375, -1.201917, 1.44, -0.78, 1.69},
+	{1948, 26727, 85.189708, -1.942583, 3.19, 2.03, 1.77},
+	{2004, 27366, 86.939125, -9.669611, 1.55, -1.20, 2.07},
+	{2061, 27989, 88.792958, 7.407056, 27.54, 11.30, 0.50},
+	{2294, 30324, 95.674958, -17.955917, -3.23, -0.78, 1.98},
+	{2326, 30438, 95.987958, -52.695667, 19.93, 23.24, -0.74},
+	{2421, 31681, 99.427958, 16.399278, -2.04, -66.92, 1.93},
+	{2491, 32349, 101.287167, -16.716111, -546.01, -1223.07, -1.46},
+	{2618, 33579, 104.656458, -28.972083, 3.24, 1.33, 1.50},
+	{2693, 34444, 107.097875, -26.393194, -2.75, 3.33, 1.84},
+	{2891, 36850, 113.649417, 31.888278, -191.45, -145.19, 1.58},
+	{2943, 37279, 114.825500, 5.225000, -714.59, -1036.80, 0.34},
+	{2990, 37826, 116.328958, 28.026194, -626.55, -45.80, 1.14},
+	{3307, 41037, 125.628500, -59.509472, -25.52, 22.72, 1.86},
+	{3634, 44816, 136.999000, -43.432583, -23.21, 14.28, 2.21},
+	{3685, 45238, 138.299917, -69.717194, -156.47, 108.95, 1.68},
+	{3748, 46390, 141.896833, -8.658611, -15.23, 34.37, 1.98},
+	{3982, 49669, 152.092958, 11.967222, -248.73, 5.59, 1.40},
+	{4295, 53910, 165.460333, 56.382417, 81.43, 33.49, 2.37},
+	{4301, 54061, 165.931958, 61.751028, -134.11, -34.70, 1.79},
+	{4534, 57632, 177.264917, 14.572056, -497.68, -114.67, 2.14},
+	{4662, 59803, 183.951542, -17.541917, -159.58, 22.31, 2.59},
+	{4730, 60718, 186.649583, -63.099083, -35.83, -14.86, 0.76},
+	{4763, 61084, 187.791500, -57.113222, 28.23, -265.08, 1.64},
+	{4853, 62434, 191.930292, -59.688778, -48.24, -12.82, 1.25},
+	{4905, 62956, 193.507292, 55.959833, 111.91, -8.24, 1.77},
+	{5054, 65378, 200.981417, 54.925361, 119.01, -25.97, 2.23},
+	{5056, 65474, 201.298250, -11.161333, -42.35, -30.67, 0.97},
+	{5191, 67301, 206.885167, 49.313278, -121.17, -15.56, 1.86},
+	{5267, 68702, 210.955875, -60.373028, -33.27, -23.16, 0.61},
+	{5288, 68933, 211.670625, -36.369944, -519.29, -517.87, 2.06},
+	{5340, 69673, 213.915292, 19.182417, -1093.39, -1999.40, -0.05},
+	{5459, 71683, 219.902042, -60.834000, -3679.25, 473.67, -0.01},
+	{5531, 72622, 222.719625, -16.041778, -105.68, -68.40, 2.75},
+	{5563, 72607, 222.676375, 74.155500, -32.61, 11.42, 2.08},
+	{5793, 76267, 233.671958, 26.714694, 120.27, -89.58, 2.23},
+	{6134, 80763, 247.351917, -26.432000, -12.11, -23.30, 0.96},
+	{6217, 82273, 252.166250, -69.027722, 17.99, -31.58, 1.92},
+	{6378, 84012, 257.594542, -15.724917, 41.16, 97.65, 2.43},
+	{6527, 85927, 263.402167, -37.103833, -8.53, -30.80, 1.63},
+	{6556, 86032, 263.733625, 12.560028, 108.07, -221.57, 2.08},
+	{6705, 87833, 269.151542, 51.488889, -8.48, -22.79, 2.23},
+	{6879, 90185, 276.043000, -34.384611, -39.42, -124.20, 1.85},
+	{7001, 91262, 279.234750, 38.783694, 200.94, 286.23, 0.03},
+	{7121, 92855, 283.816375, -26.296722, 15.14, -53.43, 2.02},
+	{7557, 97649, 297.695833, 8.868333, 536.23, 385.29, 0.77},
+	{7790, 100751, 306.411917, -56.735083, 6.90, -86.02, 1.94},
+	{7796, 100453, 305.557083, 40.256667, 2.43, -0.93, 2.23},
+	{7924, 102098, 310.358000, 45.280333, 2.01, 1.85, 1.25},
+	{8162, 105199, 319.644875, 62.585583, 150.55, 49.09, 2.45},
+	{8308, 107315, 326.046500, 9.875000, 26.92, 0.44, 2.39},
+	{8425, 109268, 332.058250, -46.960972, 126.69, -147.47, 1.74},
+	{8728, 113368, 344.412708, -29.622250, 328.95, -164.67, 1.16},
+	{8781, 113963, 346.190208, 15.205361, 60.40, -41.30, 2.49},
+}
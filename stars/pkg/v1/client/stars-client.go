@@ -0,0 +1,105 @@
+package starsclient
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"planetpositions/stars/grpc/v1"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"google.golang.org/grpc"
+)
+
+// StarsClient -
+type StarsClient struct {
+	Address string
+}
+
+func (s *StarsClient) newConnection() (v1.StarsServiceClient, *grpc.ClientConn) {
+
+	// Set up a connection to the server.
+	conn, err := grpc.Dial(s.Address, grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("did not connect: %v", err)
+	}
+
+	return v1.NewStarsServiceClient(conn), conn
+}
+
+// GetStar -
+func (s *StarsClient) GetStar(name string, hr, hip int32) (*v1.Star, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.StarRequest{
+		Api:  "v1",
+		Name: name,
+		Hr:   hr,
+		Hip:  hip,
+	}
+	return c.GetStar(ctx, &req)
+}
+
+// GetStars -
+func (s *StarsClient) GetStars(maxMagnitude float64) (*v1.Stars, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.StarsRequest{
+		Api:          "v1",
+		MaxMagnitude: maxMagnitude,
+	}
+	return c.GetStars(ctx, &req)
+}
+
+// GetStarPosition -
+func (s *StarsClient) GetStarPosition(name string, hr, hip int32, long, lat float64, year, month, day int32, hour float64) (*v1.StarPosition, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.StarPositionRequest{
+		Api:       "v1",
+		Name:      name,
+		Hr:        hr,
+		Hip:       hip,
+		Longitude: long,
+		Latitude:  lat,
+		Year:      year,
+		Month:     month,
+		Day:       day,
+		Hour:      hour,
+	}
+	return c.GetStarPosition(ctx, &req)
+}
+
+// GetStarRiseSet -
+func (s *StarsClient) GetStarRiseSet(name string, hr, hip int32, long, lat float64, year, month, day int32, utcOffset float64, refraction string, temperature, pressure *wrappers.DoubleValue) (*v1.StarRiseSet, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.StarRiseSetRequest{
+		Api:         "v1",
+		Name:        name,
+		Hr:          hr,
+		Hip:         hip,
+		Longitude:   long,
+		Latitude:    lat,
+		Year:        year,
+		Month:       month,
+		Day:         day,
+		UtcOffset:   utcOffset,
+		Refraction:  refraction,
+		Temperature: temperature,
+		Pressure:    pressure,
+	}
+	return c.GetStarRiseSet(ctx, &req)
+}
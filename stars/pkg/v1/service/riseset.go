@@ -0,0 +1,98 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/stars/grpc/v1"
+)
+
+// siderealRate is the number of degrees the sidereal time advances in a day
+const siderealRate = 360.98564736629
+
+func (s *starsServiceServer) GetStarRiseSet(ctx context.Context, req *v1.StarRiseSetRequest) (*v1.StarRiseSet, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	if req.UtcOffset < -14 || req.UtcOffset > 14 {
+		return nil, fmt.Errorf("unusable input provided: utc offset must be between -14 and 14 hours")
+	}
+	star, err := lookup(req.Name, req.Hr, req.Hip)
+	if err != nil {
+		return nil, err
+	}
+	// A star rises and sets when it is depressed by the refraction at the
+	// horizon
+	horizon, err := refraction.AtHorizon(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, 0)
+	if err != nil {
+		return nil, err
+	}
+	start := jd - req.UtcOffset/24
+	// A star moves so little in a day that its position at the start of
+	// the date serves for the whole of it
+	ra, dec := star.Position(start)
+
+	// Meeus, Astronomical Algorithms, chapter 15, the hour angle grows at
+	// the sidereal rate so the star returns to the same hour angle a little
+	// under a day later and each event happens at least once on the date
+	transit := normalise(ra-req.Longitude-transform.GreenwichSiderealTime(start)) / siderealRate
+	riseSet := &v1.StarRiseSet{
+		Api:    apiVersion,
+		Star:   starMessage(star),
+		Status: v1.StarEventStatus_EVENT_OCCURS,
+	}
+	if riseSet.Transit, err = s.starEvent(start+transit, ra, dec, req.Longitude, req.Latitude); err != nil {
+		return nil, err
+	}
+
+	lat := degreesToRadians(req.Latitude)
+	d := degreesToRadians(dec)
+	cosH0 := (math.Sin(degreesToRadians(-horizon)) - math.Sin(lat)*math.Sin(d)) / (math.Cos(lat) * math.Cos(d))
+	switch {
+	case cosH0 < -1:
+		riseSet.Status = v1.StarEventStatus_ALWAYS_UP
+		return riseSet, nil
+	case cosH0 > 1:
+		riseSet.Status = v1.StarEventStatus_ALWAYS_DOWN
+		return riseSet, nil
+	}
+	h0 := radiansToDegrees(math.Acos(cosH0))
+	rise := normalise(ra-req.Longitude-transform.GreenwichSiderealTime(start)-h0) / siderealRate
+	set := normalise(ra-req.Longitude-transform.GreenwichSiderealTime(start)+h0) / siderealRate
+	if riseSet.Rise, err = s.starEvent(start+rise, ra, dec, req.Longitude, req.Latitude); err != nil {
+		return nil, err
+	}
+	if riseSet.Set, err = s.starEvent(start+set, ra, dec, req.Longitude, req.Latitude); err != nil {
+		return nil, err
+	}
+	return riseSet, nil
+}
+
+func (s *starsServiceServer) starEvent(jd, ra, dec, longitude, latitude float64) (*v1.StarEvent, error) {
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	h := s.horizontal(jd, ra, dec, longitude, latitude)
+	return &v1.StarEvent{
+		Time:     time,
+		Azimuth:  h.azimuth,
+		Altitude: h.altitude,
+	}, nil
+}
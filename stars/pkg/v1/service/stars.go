@@ -0,0 +1,171 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/transform"
+	jc "planetpositions/julian/pkg/v1/client"
+	"planetpositions/stars/grpc/v1"
+	"planetpositions/stars/pkg/v1/catalogue"
+)
+
+const (
+	// apiVersion is version of API is provided by server
+	apiVersion = "v1"
+)
+
+// starsServiceServer is implementation of v1.StarsServiceServer proto interface
+type starsServiceServer struct {
+	jc.JulianClient
+}
+
+// NewStarsService creates Stars service
+func NewStarsService() v1.StarsServiceServer {
+	s := starsServiceServer{}
+	s.Address = "julian:5055"
+	return &s
+}
+
+func (s *starsServiceServer) GetStar(ctx context.Context, req *v1.StarRequest) (*v1.Star, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	star, err := lookup(req.Name, req.Hr, req.Hip)
+	if err != nil {
+		return nil, err
+	}
+	return starMessage(star), nil
+}
+
+func (s *starsServiceServer) GetStars(ctx context.Context, req *v1.StarsRequest) (*v1.Stars, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	stars := &v1.Stars{Api: apiVersion}
+	for _, star := range catalogue.Stars() {
+		if star.Magnitude <= req.MaxMagnitude {
+			stars.Stars = append(stars.Stars, starMessage(star))
+		}
+	}
+	return stars, nil
+}
+
+func (s *starsServiceServer) GetStarPosition(ctx context.Context, req *v1.StarPositionRequest) (*v1.StarPosition, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	star, err := lookup(req.Name, req.Hr, req.Hip)
+	if err != nil {
+		return nil, err
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	// The difference between universal and dynamical time moves a star by
+	// well under a milliarcsecond, so jd is used for both
+	ra, dec := star.Position(jd)
+	h := s.horizontal(jd, ra, dec, req.Longitude, req.Latitude)
+	return &v1.StarPosition{
+		Api:            apiVersion,
+		Star:           starMessage(star),
+		JulianDate:     jd,
+		RightAscension: ra,
+		Declination:    dec,
+		HourAngle:      h.hourAngle,
+		Azimuth:        h.azimuth,
+		Altitude:       h.altitude,
+	}, nil
+}
+
+// lookup finds a star by HR number, then HIP number, then name
+func lookup(name string, hr, hip int32) (catalogue.Star, error) {
+	var star catalogue.Star
+	var ok bool
+	switch {
+	case hr > 0:
+		star, ok = catalogue.ByHR(int(hr))
+	case hip > 0:
+		star, ok = catalogue.ByHIP(int(hip))
+	case name != "":
+		star, ok = catalogue.ByName(name)
+	default:
+		return star, fmt.Errorf("unusable input provided: a star name, HR or HIP number is required")
+	}
+	if !ok {
+		return star, fmt.Errorf("unusable input provided: the star is not in the catalogue")
+	}
+	return star, nil
+}
+
+func starMessage(star catalogue.Star) *v1.Star {
+	return &v1.Star{
+		Api:             apiVersion,
+		Hr:              int32(star.HR),
+		Hip:             int32(star.HIP),
+		Name:            star.Name,
+		RightAscension:  star.RightAscension,
+		Declination:     star.Declination,
+		ProperMotionRa:  star.ProperMotionRA,
+		ProperMotionDec: star.ProperMotionDec,
+		Magnitude:       star.Magnitude,
+	}
+}
+
+// julianDate -
+func (s *starsServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
+
+// instant returns the calendar date and UTC hour of jd
+func (s *starsServiceServer) instant(jd float64) (*v1.StarInstant, error) {
+	cal, err := s.DayFromJulianDay(jd)
+	if err != nil {
+		return nil, fmt.Errorf("instant encountered the following error when executing DayFromJulianDay: %v", err)
+	}
+	return &v1.StarInstant{
+		Year:       cal.Year,
+		Month:      cal.Month,
+		Day:        cal.Day,
+		Hour:       24 * (jd + 0.5 - math.Floor(jd+0.5)),
+		JulianDate: jd,
+	}, nil
+}
+
+// horizontal is the position of a star for an observer
+type horizontal struct {
+	azimuth   float64
+	altitude  float64
+	hourAngle float64
+}
+
+// horizontal returns the position of a star at right ascension ra and
+// declination dec for an observer at the instant jd in universal time
+func (s *starsServiceServer) horizontal(jd, ra, dec, longitude, latitude float64) horizontal {
+	// longitude is positive east of Greenwich
+	hourAngle := transform.HourAngle(ra, longitude, jd)
+	azimuth, altitude := transform.EquatorialToHorizontal(hourAngle, dec, latitude)
+	return horizontal{
+		azimuth:   azimuth,
+		altitude:  altitude,
+		hourAngle: hourAngle,
+	}
+}
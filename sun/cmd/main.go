@@ -40,3 +40,66 @@ func (s *server) GetSunrise(ctx context.Context, req *v1.SunriseRequest) (*v1.Su
 	}
 	return st, nil
 }
+
+// GetShadow -
+func (s *server) GetShadow(ctx context.Context, req *v1.ShadowRequest) (*v1.Shadow, error) {
+	sh, err := ss.GetShadow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return sh, nil
+}
+
+// GetSolarEclipses -
+func (s *server) GetSolarEclipses(ctx context.Context, req *v1.SolarEclipseRequest) (*v1.SolarEclipses, error) {
+	se, err := ss.GetSolarEclipses(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return se, nil
+}
+
+// GetSolarTime -
+func (s *server) GetSolarTime(ctx context.Context, req *v1.SolarTimeRequest) (*v1.SolarTime, error) {
+	st, err := ss.GetSolarTime(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// GetUniversalTime -
+func (s *server) GetUniversalTime(ctx context.Context, req *v1.SolarTimeRequest) (*v1.SolarTime, error) {
+	st, err := ss.GetUniversalTime(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// GetMeteorShowers -
+func (s *server) GetMeteorShowers(ctx context.Context, req *v1.MeteorShowersRequest) (*v1.MeteorShowers, error) {
+	ms, err := ss.GetMeteorShowers(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return ms, nil
+}
+
+// GetSolarEphemeris -
+func (s *server) GetSolarEphemeris(ctx context.Context, req *v1.SolarEphemerisRequest) (*v1.SolarEphemeris, error) {
+	se, err := ss.GetSolarEphemeris(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return se, nil
+}
+
+// GetDayLength -
+func (s *server) GetDayLength(ctx context.Context, req *v1.DayLengthRequest) (*v1.DayLengthAnalytics, error) {
+	dl, err := ss.GetDayLength(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return dl, nil
+}
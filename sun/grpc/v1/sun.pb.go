@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	_ "github.com/grpc-ecosystem/grpc-gateway/protoc-gen-swagger/options"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
@@ -24,14 +25,128 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
+type SolarEclipseType int32
+
+const (
+	// Never sent, zero is kept for an unset type
+	SolarEclipseType_SOLAR_ECLIPSE_TYPE_UNSPECIFIED SolarEclipseType = 0
+	// The eclipse is not seen from the observer's location
+	SolarEclipseType_NO_ECLIPSE SolarEclipseType = 1
+	SolarEclipseType_PARTIAL    SolarEclipseType = 2
+	SolarEclipseType_ANNULAR    SolarEclipseType = 3
+	SolarEclipseType_TOTAL      SolarEclipseType = 4
+)
+
+var SolarEclipseType_name = map[int32]string{
+	0: "SOLAR_ECLIPSE_TYPE_UNSPECIFIED",
+	1: "NO_ECLIPSE",
+	2: "PARTIAL",
+	3: "ANNULAR",
+	4: "TOTAL",
+}
+
+var SolarEclipseType_value = map[string]int32{
+	"SOLAR_ECLIPSE_TYPE_UNSPECIFIED": 0,
+	"NO_ECLIPSE":                     1,
+	"PARTIAL":                        2,
+	"ANNULAR":                        3,
+	"TOTAL":                          4,
+}
+
+func (x SolarEclipseType) String() string {
+	return proto.EnumName(SolarEclipseType_name, int32(x))
+}
+
+func (SolarEclipseType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{0}
+}
+
+type SolarTimeKind int32
+
+const (
+	// Not given, the hour is taken as local mean time
+	SolarTimeKind_SOLAR_TIME_KIND_UNSPECIFIED SolarTimeKind = 0
+	SolarTimeKind_MEAN_SOLAR_TIME             SolarTimeKind = 1
+	SolarTimeKind_APPARENT_SOLAR_TIME         SolarTimeKind = 2
+)
+
+var SolarTimeKind_name = map[int32]string{
+	0: "SOLAR_TIME_KIND_UNSPECIFIED",
+	1: "MEAN_SOLAR_TIME",
+	2: "APPARENT_SOLAR_TIME",
+}
+
+var SolarTimeKind_value = map[string]int32{
+	"SOLAR_TIME_KIND_UNSPECIFIED": 0,
+	"MEAN_SOLAR_TIME":             1,
+	"APPARENT_SOLAR_TIME":         2,
+}
+
+func (x SolarTimeKind) String() string {
+	return proto.EnumName(SolarTimeKind_name, int32(x))
+}
+
+func (SolarTimeKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{1}
+}
+
+type SolarPositionKind int32
+
+const (
+	// Not given, the apparent position is returned
+	SolarPositionKind_SOLAR_POSITION_KIND_UNSPECIFIED SolarPositionKind = 0
+	// Corrected for aberration and referred to the true equator and equinox
+	// of date, as in the almanacs
+	SolarPositionKind_APPARENT SolarPositionKind = 1
+	// Where the sun is at the instant, referred to the equator and equinox
+	// of J2000.0
+	SolarPositionKind_GEOMETRIC SolarPositionKind = 2
+	// Referred to the equator and equinox of J2000.0, as star catalogue
+	// positions are, the sun does not move in the light-time
+	SolarPositionKind_ASTROMETRIC SolarPositionKind = 3
+)
+
+var SolarPositionKind_name = map[int32]string{
+	0: "SOLAR_POSITION_KIND_UNSPECIFIED",
+	1: "APPARENT",
+	2: "GEOMETRIC",
+	3: "ASTROMETRIC",
+}
+
+var SolarPositionKind_value = map[string]int32{
+	"SOLAR_POSITION_KIND_UNSPECIFIED": 0,
+	"APPARENT":                        1,
+	"GEOMETRIC":                       2,
+	"ASTROMETRIC":                     3,
+}
+
+func (x SolarPositionKind) String() string {
+	return proto.EnumName(SolarPositionKind_name, int32(x))
+}
+
+func (SolarPositionKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{2}
+}
+
 type SunriseRequest struct {
-	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
-	Longitude            float64  `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
-	Latitude             float64  `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
-	Year                 int32    `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
-	Month                int32    `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
-	Day                  int32    `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
-	Hour                 float64  `protobuf:"fixed64,7,opt,name=hour,proto3" json:"hour,omitempty"`
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
+	Hour      float64 `protobuf:"fixed64,7,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa. Either of the
+	// temperature and pressure not given is that of the standard atmosphere,
+	// 10 degrees Celsius and 1010 hPa, and with none of the three the centre
+	// of the sun is taken to be 0.833 degrees below the horizon at sunrise
+	Refraction  string                `protobuf:"bytes,8,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature *wrappers.DoubleValue `protobuf:"bytes,9,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure    *wrappers.DoubleValue `protobuf:"bytes,10,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Use the IAU 2000B nutation and IAU 2006 obliquity for the sun's
+	// position and the equation of time
+	HighPrecision        bool     `protobuf:"varint,11,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -111,6 +226,34 @@ func (m *SunriseRequest) GetHour() float64 {
 	return 0
 }
 
+func (m *SunriseRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *SunriseRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *SunriseRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+func (m *SunriseRequest) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
 type SunriseTime struct {
 	Api                  string   `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
 	Year                 int32    `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
@@ -182,115 +325,2369 @@ func (m *SunriseTime) GetHour() float64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*SunriseRequest)(nil), "v1.SunriseRequest")
-	proto.RegisterType((*SunriseTime)(nil), "v1.SunriseTime")
+type ShadowRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
+	Hour      float64 `protobuf:"fixed64,7,opt,name=hour,proto3" json:"hour,omitempty"`
+	// Height of the object casting the shadow, in metres
+	Height float64 `protobuf:"fixed64,8,opt,name=height,proto3" json:"height,omitempty"`
+	// Optional rectangular footprint of the object, in metres, rotated
+	// clockwise from north by footprint_bearing degrees
+	FootprintWidth   float64 `protobuf:"fixed64,9,opt,name=footprint_width,json=footprintWidth,proto3" json:"footprint_width,omitempty"`
+	FootprintDepth   float64 `protobuf:"fixed64,10,opt,name=footprint_depth,json=footprintDepth,proto3" json:"footprint_depth,omitempty"`
+	FootprintBearing float64 `protobuf:"fixed64,11,opt,name=footprint_bearing,json=footprintBearing,proto3" json:"footprint_bearing,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa. Either of the
+	// temperature and pressure not given is that of the standard atmosphere,
+	// 10 degrees Celsius and 1010 hPa
+	Refraction  string                `protobuf:"bytes,12,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature *wrappers.DoubleValue `protobuf:"bytes,13,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure    *wrappers.DoubleValue `protobuf:"bytes,14,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Use the IAU 2000B nutation and IAU 2006 obliquity for the sun's
+	// position and the equation of time
+	HighPrecision        bool     `protobuf:"varint,15,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("sun.proto", fileDescriptor_df5d86f47d451473) }
+func (m *ShadowRequest) Reset()         { *m = ShadowRequest{} }
+func (m *ShadowRequest) String() string { return proto.CompactTextString(m) }
+func (*ShadowRequest) ProtoMessage()    {}
+func (*ShadowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{2}
+}
 
-var fileDescriptor_df5d86f47d451473 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0xd6, 0xe6, 0xaf, 0x64, 0x91, 0xa0, 0x5a, 0x21, 0x61, 0x45, 0x3d, 0x58, 0x39, 0x45, 0x11,
-	0xf6, 0x26, 0x21, 0xa7, 0x72, 0x01, 0x2e, 0xdc, 0x1d, 0x5e, 0x60, 0xeb, 0x8c, 0xec, 0xad, 0x9c,
-	0x9d, 0xed, 0xee, 0x38, 0xa1, 0x0a, 0xb9, 0xf0, 0x04, 0x08, 0x6e, 0x3c, 0x03, 0x6f, 0xc3, 0x2b,
-	0xf0, 0x0e, 0x1c, 0xb8, 0x20, 0x6f, 0xd2, 0x96, 0x2a, 0x39, 0xf9, 0x9b, 0x6f, 0xbe, 0xf1, 0xf7,
-	0xed, 0x68, 0x78, 0xdf, 0xd7, 0x26, 0xb5, 0x0e, 0x09, 0x45, 0x6b, 0x3d, 0x1d, 0x5c, 0x14, 0x88,
-	0x45, 0x05, 0x52, 0x59, 0x2d, 0x95, 0x31, 0x48, 0x8a, 0x34, 0x1a, 0xbf, 0x57, 0x0c, 0x5e, 0x85,
-	0x4f, 0x9e, 0x14, 0x60, 0x12, 0xbf, 0x51, 0x45, 0x01, 0x4e, 0xa2, 0x0d, 0x8a, 0x63, 0xf5, 0xf0,
-	0x27, 0xe3, 0xcf, 0x16, 0xb5, 0x71, 0xda, 0x43, 0x06, 0x37, 0x35, 0x78, 0x12, 0xe7, 0xbc, 0xad,
-	0xac, 0x8e, 0x58, 0xcc, 0x46, 0xfd, 0xac, 0x81, 0xe2, 0x82, 0xf7, 0x2b, 0x34, 0x85, 0xa6, 0x7a,
-	0x09, 0x51, 0x2b, 0x66, 0x23, 0x96, 0x3d, 0x10, 0x62, 0xc0, 0x9f, 0x54, 0x8a, 0xf6, 0xcd, 0x76,
-	0x68, 0xde, 0xd7, 0x42, 0xf0, 0xce, 0x2d, 0x28, 0x17, 0x75, 0x62, 0x36, 0xea, 0x66, 0x01, 0x8b,
-	0x17, 0xbc, 0xbb, 0x42, 0x43, 0x65, 0xd4, 0x0d, 0xe4, 0xbe, 0x68, 0x5c, 0x97, 0xea, 0x36, 0xea,
-	0x05, 0xae, 0x81, 0xcd, 0x6c, 0x89, 0xb5, 0x8b, 0xce, 0xc2, 0x3f, 0x03, 0x1e, 0x22, 0x7f, 0x7a,
-	0x48, 0xfb, 0x51, 0xaf, 0xe0, 0x44, 0xd4, 0x3b, 0xc3, 0xd6, 0x29, 0xc3, 0xf6, 0x09, 0xc3, 0xce,
-	0xb1, 0x61, 0xf7, 0xc1, 0x70, 0x76, 0xc3, 0xf9, 0xa2, 0x36, 0x0b, 0x70, 0x6b, 0x9d, 0x83, 0xc8,
-	0x39, 0xff, 0x00, 0x74, 0x48, 0x20, 0x44, 0xba, 0x9e, 0xa6, 0x8f, 0x97, 0x37, 0x78, 0xfe, 0x1f,
-	0xd7, 0x44, 0x1c, 0x4e, 0xbe, 0xfc, 0xfa, 0xfd, 0xbd, 0x35, 0x16, 0xa3, 0xf5, 0x54, 0xfa, 0x3d,
-	0x2f, 0xb7, 0xf7, 0xcb, 0xdb, 0xc9, 0xed, 0xdd, 0xae, 0x76, 0x72, 0xbb, 0x54, 0x04, 0xbb, 0xf7,
-	0x7f, 0xd9, 0xb7, 0x77, 0x7f, 0x98, 0xf8, 0xca, 0xc2, 0x5b, 0x63, 0xbf, 0xf7, 0x1e, 0x7e, 0xe6,
-	0xb2, 0xc0, 0xa4, 0x70, 0x36, 0x4f, 0x4a, 0x22, 0x9b, 0x38, 0xf0, 0x94, 0xac, 0x74, 0xee, 0xf0,
-	0xa0, 0x48, 0xa8, 0x26, 0x74, 0x5a, 0x55, 0xb1, 0x75, 0x78, 0x0d, 0x39, 0x89, 0x49, 0x23, 0xf4,
-	0x97, 0x52, 0x16, 0x9a, 0xca, 0xfa, 0x2a, 0xcd, 0x71, 0x25, 0x7d, 0xa9, 0x0c, 0x94, 0xb8, 0x01,
-	0xe5, 0xa8, 0x94, 0xb6, 0x52, 0x06, 0xc8, 0xa2, 0xd7, 0xe1, 0x28, 0x06, 0x2f, 0x43, 0xfb, 0xed,
-	0x23, 0x51, 0x33, 0x36, 0x6b, 0x4f, 0xd3, 0xc9, 0x98, 0xb1, 0xd9, 0xb9, 0xb2, 0xb6, 0xd2, 0x79,
-	0xb8, 0x21, 0x79, 0xed, 0xd1, 0x5c, 0x1e, 0x31, 0xd9, 0x1b, 0xde, 0x9e, 0x4f, 0xe6, 0x62, 0xce,
-	0xc7, 0x19, 0x50, 0xed, 0x0c, 0x2c, 0xe3, 0x4d, 0x09, 0x26, 0xa6, 0x12, 0x62, 0x07, 0x1e, 0x6b,
-	0x97, 0x43, 0xbc, 0x44, 0xf0, 0xb1, 0x41, 0x8a, 0xe1, 0x93, 0xf6, 0x94, 0x8a, 0x1e, 0xef, 0xfc,
-	0x68, 0xb1, 0xb3, 0xab, 0x5e, 0xb8, 0xcb, 0xd7, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x13, 0xa5,
-	0x5e, 0x65, 0xf4, 0x02, 0x00, 0x00,
+func (m *ShadowRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShadowRequest.Unmarshal(m, b)
+}
+func (m *ShadowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShadowRequest.Marshal(b, m, deterministic)
+}
+func (m *ShadowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShadowRequest.Merge(m, src)
+}
+func (m *ShadowRequest) XXX_Size() int {
+	return xxx_messageInfo_ShadowRequest.Size(m)
+}
+func (m *ShadowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShadowRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_ShadowRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *ShadowRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
 
-// SunServiceClient is the client API for SunService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type SunServiceClient interface {
-	// Get sunrise
-	GetSunrise(ctx context.Context, in *SunriseRequest, opts ...grpc.CallOption) (*SunriseTime, error)
+func (m *ShadowRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
 }
 
-type sunServiceClient struct {
-	cc *grpc.ClientConn
+func (m *ShadowRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
 }
 
-func NewSunServiceClient(cc *grpc.ClientConn) SunServiceClient {
-	return &sunServiceClient{cc}
+func (m *ShadowRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
 }
 
-func (c *sunServiceClient) GetSunrise(ctx context.Context, in *SunriseRequest, opts ...grpc.CallOption) (*SunriseTime, error) {
-	out := new(SunriseTime)
-	err := c.cc.Invoke(ctx, "/v1.SunService/GetSunrise", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ShadowRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
 	}
-	return out, nil
+	return 0
 }
 
-// SunServiceServer is the server API for SunService service.
-type SunServiceServer interface {
-	// Get sunrise
-	GetSunrise(context.Context, *SunriseRequest) (*SunriseTime, error)
+func (m *ShadowRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
 }
 
-func RegisterSunServiceServer(s *grpc.Server, srv SunServiceServer) {
-	s.RegisterService(&_SunService_serviceDesc, srv)
+func (m *ShadowRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
 }
 
-func _SunService_GetSunrise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SunriseRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ShadowRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
 	}
-	if interceptor == nil {
-		return srv.(SunServiceServer).GetSunrise(ctx, in)
+	return 0
+}
+
+func (m *ShadowRequest) GetFootprintWidth() float64 {
+	if m != nil {
+		return m.FootprintWidth
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/v1.SunService/GetSunrise",
+	return 0
+}
+
+func (m *ShadowRequest) GetFootprintDepth() float64 {
+	if m != nil {
+		return m.FootprintDepth
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SunServiceServer).GetSunrise(ctx, req.(*SunriseRequest))
+	return 0
+}
+
+func (m *ShadowRequest) GetFootprintBearing() float64 {
+	if m != nil {
+		return m.FootprintBearing
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _SunService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "v1.SunService",
-	HandlerType: (*SunServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetSunrise",
-			Handler:    _SunService_GetSunrise_Handler,
+func (m *ShadowRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *ShadowRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *ShadowRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+func (m *ShadowRequest) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
+type Shadow struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Position of the sun, in degrees, azimuth measured clockwise from north
+	Azimuth   float64 `protobuf:"fixed64,2,opt,name=azimuth,proto3" json:"azimuth,omitempty"`
+	Elevation float64 `protobuf:"fixed64,3,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	SunUp     bool    `protobuf:"varint,4,opt,name=sun_up,json=sunUp,proto3" json:"sun_up,omitempty"`
+	// Length of the shadow in metres and the direction it points in degrees
+	Length  float64 `protobuf:"fixed64,5,opt,name=length,proto3" json:"length,omitempty"`
+	Bearing float64 `protobuf:"fixed64,6,opt,name=bearing,proto3" json:"bearing,omitempty"`
+	// Offset of the shadow tip from the base of the object, in metres
+	TipEast      float64 `protobuf:"fixed64,7,opt,name=tip_east,json=tipEast,proto3" json:"tip_east,omitempty"`
+	TipNorth     float64 `protobuf:"fixed64,8,opt,name=tip_north,json=tipNorth,proto3" json:"tip_north,omitempty"`
+	TipLongitude float64 `protobuf:"fixed64,9,opt,name=tip_longitude,json=tipLongitude,proto3" json:"tip_longitude,omitempty"`
+	TipLatitude  float64 `protobuf:"fixed64,10,opt,name=tip_latitude,json=tipLatitude,proto3" json:"tip_latitude,omitempty"`
+	// GeoJSON polygon covering the footprint and its shadow
+	Footprint string `protobuf:"bytes,11,opt,name=footprint,proto3" json:"footprint,omitempty"`
+	// Elevation of the sun lifted by refraction, which casts the shadow
+	ApparentElevation    float64  `protobuf:"fixed64,12,opt,name=apparent_elevation,json=apparentElevation,proto3" json:"apparent_elevation,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Shadow) Reset()         { *m = Shadow{} }
+func (m *Shadow) String() string { return proto.CompactTextString(m) }
+func (*Shadow) ProtoMessage()    {}
+func (*Shadow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{3}
+}
+
+func (m *Shadow) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Shadow.Unmarshal(m, b)
+}
+func (m *Shadow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Shadow.Marshal(b, m, deterministic)
+}
+func (m *Shadow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Shadow.Merge(m, src)
+}
+func (m *Shadow) XXX_Size() int {
+	return xxx_messageInfo_Shadow.Size(m)
+}
+func (m *Shadow) XXX_DiscardUnknown() {
+	xxx_messageInfo_Shadow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Shadow proto.InternalMessageInfo
+
+func (m *Shadow) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *Shadow) GetAzimuth() float64 {
+	if m != nil {
+		return m.Azimuth
+	}
+	return 0
+}
+
+func (m *Shadow) GetElevation() float64 {
+	if m != nil {
+		return m.Elevation
+	}
+	return 0
+}
+
+func (m *Shadow) GetSunUp() bool {
+	if m != nil {
+		return m.SunUp
+	}
+	return false
+}
+
+func (m *Shadow) GetLength() float64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Shadow) GetBearing() float64 {
+	if m != nil {
+		return m.Bearing
+	}
+	return 0
+}
+
+func (m *Shadow) GetTipEast() float64 {
+	if m != nil {
+		return m.TipEast
+	}
+	return 0
+}
+
+func (m *Shadow) GetTipNorth() float64 {
+	if m != nil {
+		return m.TipNorth
+	}
+	return 0
+}
+
+func (m *Shadow) GetTipLongitude() float64 {
+	if m != nil {
+		return m.TipLongitude
+	}
+	return 0
+}
+
+func (m *Shadow) GetTipLatitude() float64 {
+	if m != nil {
+		return m.TipLatitude
+	}
+	return 0
+}
+
+func (m *Shadow) GetFootprint() string {
+	if m != nil {
+		return m.Footprint
+	}
+	return ""
+}
+
+func (m *Shadow) GetApparentElevation() float64 {
+	if m != nil {
+		return m.ApparentElevation
+	}
+	return 0
+}
+
+type SunInstant struct {
+	Year  int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day   int32 `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	// Hour of the day, in UTC unless stated otherwise
+	Hour                 float64  `protobuf:"fixed64,4,opt,name=hour,proto3" json:"hour,omitempty"`
+	JulianDate           float64  `protobuf:"fixed64,5,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SunInstant) Reset()         { *m = SunInstant{} }
+func (m *SunInstant) String() string { return proto.CompactTextString(m) }
+func (*SunInstant) ProtoMessage()    {}
+func (*SunInstant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{4}
+}
+
+func (m *SunInstant) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SunInstant.Unmarshal(m, b)
+}
+func (m *SunInstant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SunInstant.Marshal(b, m, deterministic)
+}
+func (m *SunInstant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SunInstant.Merge(m, src)
+}
+func (m *SunInstant) XXX_Size() int {
+	return xxx_messageInfo_SunInstant.Size(m)
+}
+func (m *SunInstant) XXX_DiscardUnknown() {
+	xxx_messageInfo_SunInstant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SunInstant proto.InternalMessageInfo
+
+func (m *SunInstant) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SunInstant) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *SunInstant) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *SunInstant) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *SunInstant) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+type SolarEclipseRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Height of the observer above sea level, in metres
+	Height               float64  `protobuf:"fixed64,4,opt,name=height,proto3" json:"height,omitempty"`
+	StartYear            int32    `protobuf:"varint,5,opt,name=start_year,json=startYear,proto3" json:"start_year,omitempty"`
+	StartMonth           int32    `protobuf:"varint,6,opt,name=start_month,json=startMonth,proto3" json:"start_month,omitempty"`
+	StartDay             int32    `protobuf:"varint,7,opt,name=start_day,json=startDay,proto3" json:"start_day,omitempty"`
+	EndYear              int32    `protobuf:"varint,8,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	EndMonth             int32    `protobuf:"varint,9,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay               int32    `protobuf:"varint,10,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SolarEclipseRequest) Reset()         { *m = SolarEclipseRequest{} }
+func (m *SolarEclipseRequest) String() string { return proto.CompactTextString(m) }
+func (*SolarEclipseRequest) ProtoMessage()    {}
+func (*SolarEclipseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{5}
+}
+
+func (m *SolarEclipseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEclipseRequest.Unmarshal(m, b)
+}
+func (m *SolarEclipseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEclipseRequest.Marshal(b, m, deterministic)
+}
+func (m *SolarEclipseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEclipseRequest.Merge(m, src)
+}
+func (m *SolarEclipseRequest) XXX_Size() int {
+	return xxx_messageInfo_SolarEclipseRequest.Size(m)
+}
+func (m *SolarEclipseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEclipseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEclipseRequest proto.InternalMessageInfo
+
+func (m *SolarEclipseRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarEclipseRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetHeight() float64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetStartYear() int32 {
+	if m != nil {
+		return m.StartYear
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetStartMonth() int32 {
+	if m != nil {
+		return m.StartMonth
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetStartDay() int32 {
+	if m != nil {
+		return m.StartDay
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetEndYear() int32 {
+	if m != nil {
+		return m.EndYear
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *SolarEclipseRequest) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+type SolarEclipseContact struct {
+	Time *SunInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Altitude of the sun above the observer's horizon, in degrees
+	SunAltitude          float64  `protobuf:"fixed64,2,opt,name=sun_altitude,json=sunAltitude,proto3" json:"sun_altitude,omitempty"`
+	Visible              bool     `protobuf:"varint,3,opt,name=visible,proto3" json:"visible,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SolarEclipseContact) Reset()         { *m = SolarEclipseContact{} }
+func (m *SolarEclipseContact) String() string { return proto.CompactTextString(m) }
+func (*SolarEclipseContact) ProtoMessage()    {}
+func (*SolarEclipseContact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{6}
+}
+
+func (m *SolarEclipseContact) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEclipseContact.Unmarshal(m, b)
+}
+func (m *SolarEclipseContact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEclipseContact.Marshal(b, m, deterministic)
+}
+func (m *SolarEclipseContact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEclipseContact.Merge(m, src)
+}
+func (m *SolarEclipseContact) XXX_Size() int {
+	return xxx_messageInfo_SolarEclipseContact.Size(m)
+}
+func (m *SolarEclipseContact) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEclipseContact.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEclipseContact proto.InternalMessageInfo
+
+func (m *SolarEclipseContact) GetTime() *SunInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *SolarEclipseContact) GetSunAltitude() float64 {
+	if m != nil {
+		return m.SunAltitude
+	}
+	return 0
+}
+
+func (m *SolarEclipseContact) GetVisible() bool {
+	if m != nil {
+		return m.Visible
+	}
+	return false
+}
+
+type SolarEclipse struct {
+	// Circumstances for the Earth as a whole
+	Type     SolarEclipseType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.SolarEclipseType" json:"type,omitempty"`
+	Greatest *SunInstant      `protobuf:"bytes,2,opt,name=greatest,proto3" json:"greatest,omitempty"`
+	Gamma    float64          `protobuf:"fixed64,3,opt,name=gamma,proto3" json:"gamma,omitempty"`
+	// Circumstances for the observer
+	LocalType SolarEclipseType     `protobuf:"varint,4,opt,name=local_type,json=localType,proto3,enum=v1.SolarEclipseType" json:"local_type,omitempty"`
+	C1        *SolarEclipseContact `protobuf:"bytes,5,opt,name=c1,proto3" json:"c1,omitempty"`
+	C2        *SolarEclipseContact `protobuf:"bytes,6,opt,name=c2,proto3" json:"c2,omitempty"`
+	Maximum   *SolarEclipseContact `protobuf:"bytes,7,opt,name=maximum,proto3" json:"maximum,omitempty"`
+	C3        *SolarEclipseContact `protobuf:"bytes,8,opt,name=c3,proto3" json:"c3,omitempty"`
+	C4        *SolarEclipseContact `protobuf:"bytes,9,opt,name=c4,proto3" json:"c4,omitempty"`
+	// Fraction of the sun's diameter covered at maximum, or in a total or
+	// annular eclipse the ratio of the moon's diameter to the sun's
+	Magnitude float64 `protobuf:"fixed64,10,opt,name=magnitude,proto3" json:"magnitude,omitempty"`
+	// Fraction of the sun's area covered at maximum
+	Obscuration          float64  `protobuf:"fixed64,11,opt,name=obscuration,proto3" json:"obscuration,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SolarEclipse) Reset()         { *m = SolarEclipse{} }
+func (m *SolarEclipse) String() string { return proto.CompactTextString(m) }
+func (*SolarEclipse) ProtoMessage()    {}
+func (*SolarEclipse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{7}
+}
+
+func (m *SolarEclipse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEclipse.Unmarshal(m, b)
+}
+func (m *SolarEclipse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEclipse.Marshal(b, m, deterministic)
+}
+func (m *SolarEclipse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEclipse.Merge(m, src)
+}
+func (m *SolarEclipse) XXX_Size() int {
+	return xxx_messageInfo_SolarEclipse.Size(m)
+}
+func (m *SolarEclipse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEclipse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEclipse proto.InternalMessageInfo
+
+func (m *SolarEclipse) GetType() SolarEclipseType {
+	if m != nil {
+		return m.Type
+	}
+	return SolarEclipseType_SOLAR_ECLIPSE_TYPE_UNSPECIFIED
+}
+
+func (m *SolarEclipse) GetGreatest() *SunInstant {
+	if m != nil {
+		return m.Greatest
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetGamma() float64 {
+	if m != nil {
+		return m.Gamma
+	}
+	return 0
+}
+
+func (m *SolarEclipse) GetLocalType() SolarEclipseType {
+	if m != nil {
+		return m.LocalType
+	}
+	return SolarEclipseType_SOLAR_ECLIPSE_TYPE_UNSPECIFIED
+}
+
+func (m *SolarEclipse) GetC1() *SolarEclipseContact {
+	if m != nil {
+		return m.C1
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetC2() *SolarEclipseContact {
+	if m != nil {
+		return m.C2
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetMaximum() *SolarEclipseContact {
+	if m != nil {
+		return m.Maximum
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetC3() *SolarEclipseContact {
+	if m != nil {
+		return m.C3
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetC4() *SolarEclipseContact {
+	if m != nil {
+		return m.C4
+	}
+	return nil
+}
+
+func (m *SolarEclipse) GetMagnitude() float64 {
+	if m != nil {
+		return m.Magnitude
+	}
+	return 0
+}
+
+func (m *SolarEclipse) GetObscuration() float64 {
+	if m != nil {
+		return m.Obscuration
+	}
+	return 0
+}
+
+type SolarEclipses struct {
+	Api                  string          `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Eclipses             []*SolarEclipse `protobuf:"bytes,2,rep,name=eclipses,proto3" json:"eclipses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SolarEclipses) Reset()         { *m = SolarEclipses{} }
+func (m *SolarEclipses) String() string { return proto.CompactTextString(m) }
+func (*SolarEclipses) ProtoMessage()    {}
+func (*SolarEclipses) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{8}
+}
+
+func (m *SolarEclipses) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEclipses.Unmarshal(m, b)
+}
+func (m *SolarEclipses) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEclipses.Marshal(b, m, deterministic)
+}
+func (m *SolarEclipses) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEclipses.Merge(m, src)
+}
+func (m *SolarEclipses) XXX_Size() int {
+	return xxx_messageInfo_SolarEclipses.Size(m)
+}
+func (m *SolarEclipses) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEclipses.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEclipses proto.InternalMessageInfo
+
+func (m *SolarEclipses) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarEclipses) GetEclipses() []*SolarEclipse {
+	if m != nil {
+		return m.Eclipses
+	}
+	return nil
+}
+
+type SolarTimeRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Year      int32   `protobuf:"varint,3,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,4,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// UTC when converting to solar time, otherwise the solar time of the
+	// given kind
+	Hour float64       `protobuf:"fixed64,6,opt,name=hour,proto3" json:"hour,omitempty"`
+	Kind SolarTimeKind `protobuf:"varint,7,opt,name=kind,proto3,enum=v1.SolarTimeKind" json:"kind,omitempty"`
+	// Use the IAU 2000B nutation and IAU 2006 obliquity for the sun's
+	// position and the equation of time
+	HighPrecision        bool     `protobuf:"varint,8,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SolarTimeRequest) Reset()         { *m = SolarTimeRequest{} }
+func (m *SolarTimeRequest) String() string { return proto.CompactTextString(m) }
+func (*SolarTimeRequest) ProtoMessage()    {}
+func (*SolarTimeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{9}
+}
+
+func (m *SolarTimeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarTimeRequest.Unmarshal(m, b)
+}
+func (m *SolarTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarTimeRequest.Marshal(b, m, deterministic)
+}
+func (m *SolarTimeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarTimeRequest.Merge(m, src)
+}
+func (m *SolarTimeRequest) XXX_Size() int {
+	return xxx_messageInfo_SolarTimeRequest.Size(m)
+}
+func (m *SolarTimeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarTimeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarTimeRequest proto.InternalMessageInfo
+
+func (m *SolarTimeRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarTimeRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *SolarTimeRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *SolarTimeRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *SolarTimeRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *SolarTimeRequest) GetHour() float64 {
+	if m != nil {
+		return m.Hour
+	}
+	return 0
+}
+
+func (m *SolarTimeRequest) GetKind() SolarTimeKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SolarTimeKind_SOLAR_TIME_KIND_UNSPECIFIED
+}
+
+func (m *SolarTimeRequest) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
+type SolarTime struct {
+	Api           string      `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	UniversalTime *SunInstant `protobuf:"bytes,2,opt,name=universal_time,json=universalTime,proto3" json:"universal_time,omitempty"`
+	// Local mean time, based on the mean sun
+	MeanTime *SunInstant `protobuf:"bytes,3,opt,name=mean_time,json=meanTime,proto3" json:"mean_time,omitempty"`
+	// Local apparent time, as shown by a sundial
+	ApparentTime *SunInstant `protobuf:"bytes,4,opt,name=apparent_time,json=apparentTime,proto3" json:"apparent_time,omitempty"`
+	// Apparent minus mean solar time, in minutes
+	EquationOfTime       float64  `protobuf:"fixed64,5,opt,name=equation_of_time,json=equationOfTime,proto3" json:"equation_of_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SolarTime) Reset()         { *m = SolarTime{} }
+func (m *SolarTime) String() string { return proto.CompactTextString(m) }
+func (*SolarTime) ProtoMessage()    {}
+func (*SolarTime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{10}
+}
+
+func (m *SolarTime) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarTime.Unmarshal(m, b)
+}
+func (m *SolarTime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarTime.Marshal(b, m, deterministic)
+}
+func (m *SolarTime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarTime.Merge(m, src)
+}
+func (m *SolarTime) XXX_Size() int {
+	return xxx_messageInfo_SolarTime.Size(m)
+}
+func (m *SolarTime) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarTime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarTime proto.InternalMessageInfo
+
+func (m *SolarTime) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarTime) GetUniversalTime() *SunInstant {
+	if m != nil {
+		return m.UniversalTime
+	}
+	return nil
+}
+
+func (m *SolarTime) GetMeanTime() *SunInstant {
+	if m != nil {
+		return m.MeanTime
+	}
+	return nil
+}
+
+func (m *SolarTime) GetApparentTime() *SunInstant {
+	if m != nil {
+		return m.ApparentTime
+	}
+	return nil
+}
+
+func (m *SolarTime) GetEquationOfTime() float64 {
+	if m != nil {
+		return m.EquationOfTime
+	}
+	return 0
+}
+
+type SolarEphemerisRequest struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// Julian date in dynamical time
+	JulianDate float64 `protobuf:"fixed64,2,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Use the IAU 2006 precession and IAU 2000B nutation rather than the
+	// single term approximation
+	HighPrecision bool `protobuf:"varint,3,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
+	// The kind of right ascension and declination wanted, apparent when not
+	// given
+	Kind                 SolarPositionKind `protobuf:"varint,4,opt,name=kind,proto3,enum=v1.SolarPositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SolarEphemerisRequest) Reset()         { *m = SolarEphemerisRequest{} }
+func (m *SolarEphemerisRequest) String() string { return proto.CompactTextString(m) }
+func (*SolarEphemerisRequest) ProtoMessage()    {}
+func (*SolarEphemerisRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{11}
+}
+
+func (m *SolarEphemerisRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEphemerisRequest.Unmarshal(m, b)
+}
+func (m *SolarEphemerisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEphemerisRequest.Marshal(b, m, deterministic)
+}
+func (m *SolarEphemerisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEphemerisRequest.Merge(m, src)
+}
+func (m *SolarEphemerisRequest) XXX_Size() int {
+	return xxx_messageInfo_SolarEphemerisRequest.Size(m)
+}
+func (m *SolarEphemerisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEphemerisRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEphemerisRequest proto.InternalMessageInfo
+
+func (m *SolarEphemerisRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarEphemerisRequest) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *SolarEphemerisRequest) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
+func (m *SolarEphemerisRequest) GetKind() SolarPositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SolarPositionKind_SOLAR_POSITION_KIND_UNSPECIFIED
+}
+
+type SolarEphemeris struct {
+	Api        string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	JulianDate float64 `protobuf:"fixed64,2,opt,name=julian_date,json=julianDate,proto3" json:"julian_date,omitempty"`
+	// Julian centuries since J2000.0
+	JulianCentury float64 `protobuf:"fixed64,3,opt,name=julian_century,json=julianCentury,proto3" json:"julian_century,omitempty"`
+	// Angles are in degrees
+	GeometricMeanLongitude float64 `protobuf:"fixed64,4,opt,name=geometric_mean_longitude,json=geometricMeanLongitude,proto3" json:"geometric_mean_longitude,omitempty"`
+	GeometricMeanAnomaly   float64 `protobuf:"fixed64,5,opt,name=geometric_mean_anomaly,json=geometricMeanAnomaly,proto3" json:"geometric_mean_anomaly,omitempty"`
+	EccentricityEarthOrbit float64 `protobuf:"fixed64,6,opt,name=eccentricity_earth_orbit,json=eccentricityEarthOrbit,proto3" json:"eccentricity_earth_orbit,omitempty"`
+	EquationOfCentre       float64 `protobuf:"fixed64,7,opt,name=equation_of_centre,json=equationOfCentre,proto3" json:"equation_of_centre,omitempty"`
+	TrueLongitude          float64 `protobuf:"fixed64,8,opt,name=true_longitude,json=trueLongitude,proto3" json:"true_longitude,omitempty"`
+	TrueAnomaly            float64 `protobuf:"fixed64,9,opt,name=true_anomaly,json=trueAnomaly,proto3" json:"true_anomaly,omitempty"`
+	// Distance between the Earth and the sun in astronomical units
+	RadiusVector        float64 `protobuf:"fixed64,10,opt,name=radius_vector,json=radiusVector,proto3" json:"radius_vector,omitempty"`
+	ApparentLongitude   float64 `protobuf:"fixed64,11,opt,name=apparent_longitude,json=apparentLongitude,proto3" json:"apparent_longitude,omitempty"`
+	MeanObliquity       float64 `protobuf:"fixed64,12,opt,name=mean_obliquity,json=meanObliquity,proto3" json:"mean_obliquity,omitempty"`
+	ObliquityCorrection float64 `protobuf:"fixed64,13,opt,name=obliquity_correction,json=obliquityCorrection,proto3" json:"obliquity_correction,omitempty"`
+	// Equatorial coordinates of the kind asked for
+	RightAscension float64 `protobuf:"fixed64,14,opt,name=right_ascension,json=rightAscension,proto3" json:"right_ascension,omitempty"`
+	Declination    float64 `protobuf:"fixed64,15,opt,name=declination,proto3" json:"declination,omitempty"`
+	// In minutes of time
+	EquationOfTime      float64 `protobuf:"fixed64,16,opt,name=equation_of_time,json=equationOfTime,proto3" json:"equation_of_time,omitempty"`
+	HighPrecision       bool    `protobuf:"varint,17,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
+	NutationInLongitude float64 `protobuf:"fixed64,18,opt,name=nutation_in_longitude,json=nutationInLongitude,proto3" json:"nutation_in_longitude,omitempty"`
+	NutationInObliquity float64 `protobuf:"fixed64,19,opt,name=nutation_in_obliquity,json=nutationInObliquity,proto3" json:"nutation_in_obliquity,omitempty"`
+	// The constellation the sun is in
+	Constellation string `protobuf:"bytes,20,opt,name=constellation,proto3" json:"constellation,omitempty"`
+	// The kind of right ascension and declination returned, never
+	// unspecified
+	Kind                 SolarPositionKind `protobuf:"varint,21,opt,name=kind,proto3,enum=v1.SolarPositionKind" json:"kind,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *SolarEphemeris) Reset()         { *m = SolarEphemeris{} }
+func (m *SolarEphemeris) String() string { return proto.CompactTextString(m) }
+func (*SolarEphemeris) ProtoMessage()    {}
+func (*SolarEphemeris) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{12}
+}
+
+func (m *SolarEphemeris) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SolarEphemeris.Unmarshal(m, b)
+}
+func (m *SolarEphemeris) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SolarEphemeris.Marshal(b, m, deterministic)
+}
+func (m *SolarEphemeris) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SolarEphemeris.Merge(m, src)
+}
+func (m *SolarEphemeris) XXX_Size() int {
+	return xxx_messageInfo_SolarEphemeris.Size(m)
+}
+func (m *SolarEphemeris) XXX_DiscardUnknown() {
+	xxx_messageInfo_SolarEphemeris.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SolarEphemeris proto.InternalMessageInfo
+
+func (m *SolarEphemeris) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *SolarEphemeris) GetJulianDate() float64 {
+	if m != nil {
+		return m.JulianDate
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetJulianCentury() float64 {
+	if m != nil {
+		return m.JulianCentury
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetGeometricMeanLongitude() float64 {
+	if m != nil {
+		return m.GeometricMeanLongitude
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetGeometricMeanAnomaly() float64 {
+	if m != nil {
+		return m.GeometricMeanAnomaly
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetEccentricityEarthOrbit() float64 {
+	if m != nil {
+		return m.EccentricityEarthOrbit
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetEquationOfCentre() float64 {
+	if m != nil {
+		return m.EquationOfCentre
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetTrueLongitude() float64 {
+	if m != nil {
+		return m.TrueLongitude
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetTrueAnomaly() float64 {
+	if m != nil {
+		return m.TrueAnomaly
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetRadiusVector() float64 {
+	if m != nil {
+		return m.RadiusVector
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetApparentLongitude() float64 {
+	if m != nil {
+		return m.ApparentLongitude
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetMeanObliquity() float64 {
+	if m != nil {
+		return m.MeanObliquity
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetObliquityCorrection() float64 {
+	if m != nil {
+		return m.ObliquityCorrection
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetRightAscension() float64 {
+	if m != nil {
+		return m.RightAscension
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetDeclination() float64 {
+	if m != nil {
+		return m.Declination
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetEquationOfTime() float64 {
+	if m != nil {
+		return m.EquationOfTime
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
+func (m *SolarEphemeris) GetNutationInLongitude() float64 {
+	if m != nil {
+		return m.NutationInLongitude
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetNutationInObliquity() float64 {
+	if m != nil {
+		return m.NutationInObliquity
+	}
+	return 0
+}
+
+func (m *SolarEphemeris) GetConstellation() string {
+	if m != nil {
+		return m.Constellation
+	}
+	return ""
+}
+
+func (m *SolarEphemeris) GetKind() SolarPositionKind {
+	if m != nil {
+		return m.Kind
+	}
+	return SolarPositionKind_SOLAR_POSITION_KIND_UNSPECIFIED
+}
+
+type DayLengthRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	Month     int32   `protobuf:"varint,5,opt,name=month,proto3" json:"month,omitempty"`
+	Day       int32   `protobuf:"varint,6,opt,name=day,proto3" json:"day,omitempty"`
+	// Optional day length, in hours, to report the crossings of
+	Target float64 `protobuf:"fixed64,7,opt,name=target,proto3" json:"target,omitempty"`
+	// Optional refraction model, saemundsson (the default) or bennett, and
+	// the air at the observer, in degrees Celsius and hPa, as for sunrise
+	Refraction  string                `protobuf:"bytes,8,opt,name=refraction,proto3" json:"refraction,omitempty"`
+	Temperature *wrappers.DoubleValue `protobuf:"bytes,9,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	Pressure    *wrappers.DoubleValue `protobuf:"bytes,10,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Use the IAU 2000B nutation and IAU 2006 obliquity for the sun's
+	// position and the equation of time
+	HighPrecision        bool     `protobuf:"varint,11,opt,name=high_precision,json=highPrecision,proto3" json:"high_precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DayLengthRequest) Reset()         { *m = DayLengthRequest{} }
+func (m *DayLengthRequest) String() string { return proto.CompactTextString(m) }
+func (*DayLengthRequest) ProtoMessage()    {}
+func (*DayLengthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{13}
+}
+
+func (m *DayLengthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DayLengthRequest.Unmarshal(m, b)
+}
+func (m *DayLengthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DayLengthRequest.Marshal(b, m, deterministic)
+}
+func (m *DayLengthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DayLengthRequest.Merge(m, src)
+}
+func (m *DayLengthRequest) XXX_Size() int {
+	return xxx_messageInfo_DayLengthRequest.Size(m)
+}
+func (m *DayLengthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DayLengthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DayLengthRequest proto.InternalMessageInfo
+
+func (m *DayLengthRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DayLengthRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetMonth() int32 {
+	if m != nil {
+		return m.Month
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetDay() int32 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetTarget() float64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+func (m *DayLengthRequest) GetRefraction() string {
+	if m != nil {
+		return m.Refraction
+	}
+	return ""
+}
+
+func (m *DayLengthRequest) GetTemperature() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Temperature
+	}
+	return nil
+}
+
+func (m *DayLengthRequest) GetPressure() *wrappers.DoubleValue {
+	if m != nil {
+		return m.Pressure
+	}
+	return nil
+}
+
+func (m *DayLengthRequest) GetHighPrecision() bool {
+	if m != nil {
+		return m.HighPrecision
+	}
+	return false
+}
+
+type DayLength struct {
+	Date *SunInstant `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Time between sunrise and sunset, in hours
+	Hours                float64  `protobuf:"fixed64,2,opt,name=hours,proto3" json:"hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DayLength) Reset()         { *m = DayLength{} }
+func (m *DayLength) String() string { return proto.CompactTextString(m) }
+func (*DayLength) ProtoMessage()    {}
+func (*DayLength) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{14}
+}
+
+func (m *DayLength) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DayLength.Unmarshal(m, b)
+}
+func (m *DayLength) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DayLength.Marshal(b, m, deterministic)
+}
+func (m *DayLength) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DayLength.Merge(m, src)
+}
+func (m *DayLength) XXX_Size() int {
+	return xxx_messageInfo_DayLength.Size(m)
+}
+func (m *DayLength) XXX_DiscardUnknown() {
+	xxx_messageInfo_DayLength.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DayLength proto.InternalMessageInfo
+
+func (m *DayLength) GetDate() *SunInstant {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *DayLength) GetHours() float64 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
+}
+
+type DayLengthCrossing struct {
+	// The first day on the other side of the target
+	Date                 *SunInstant `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Hours                float64     `protobuf:"fixed64,2,opt,name=hours,proto3" json:"hours,omitempty"`
+	Lengthening          bool        `protobuf:"varint,3,opt,name=lengthening,proto3" json:"lengthening,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *DayLengthCrossing) Reset()         { *m = DayLengthCrossing{} }
+func (m *DayLengthCrossing) String() string { return proto.CompactTextString(m) }
+func (*DayLengthCrossing) ProtoMessage()    {}
+func (*DayLengthCrossing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{15}
+}
+
+func (m *DayLengthCrossing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DayLengthCrossing.Unmarshal(m, b)
+}
+func (m *DayLengthCrossing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DayLengthCrossing.Marshal(b, m, deterministic)
+}
+func (m *DayLengthCrossing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DayLengthCrossing.Merge(m, src)
+}
+func (m *DayLengthCrossing) XXX_Size() int {
+	return xxx_messageInfo_DayLengthCrossing.Size(m)
+}
+func (m *DayLengthCrossing) XXX_DiscardUnknown() {
+	xxx_messageInfo_DayLengthCrossing.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DayLengthCrossing proto.InternalMessageInfo
+
+func (m *DayLengthCrossing) GetDate() *SunInstant {
+	if m != nil {
+		return m.Date
+	}
+	return nil
+}
+
+func (m *DayLengthCrossing) GetHours() float64 {
+	if m != nil {
+		return m.Hours
+	}
+	return 0
+}
+
+func (m *DayLengthCrossing) GetLengthening() bool {
+	if m != nil {
+		return m.Lengthening
+	}
+	return false
+}
+
+type DayLengthAnalytics struct {
+	Api       string     `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Today     *DayLength `protobuf:"bytes,2,opt,name=today,proto3" json:"today,omitempty"`
+	Yesterday *DayLength `protobuf:"bytes,3,opt,name=yesterday,proto3" json:"yesterday,omitempty"`
+	// Change in day length since yesterday, in seconds
+	Change float64 `protobuf:"fixed64,4,opt,name=change,proto3" json:"change,omitempty"`
+	// Extremes during the calendar year
+	Shortest             *DayLength           `protobuf:"bytes,5,opt,name=shortest,proto3" json:"shortest,omitempty"`
+	Longest              *DayLength           `protobuf:"bytes,6,opt,name=longest,proto3" json:"longest,omitempty"`
+	Crossings            []*DayLengthCrossing `protobuf:"bytes,7,rep,name=crossings,proto3" json:"crossings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *DayLengthAnalytics) Reset()         { *m = DayLengthAnalytics{} }
+func (m *DayLengthAnalytics) String() string { return proto.CompactTextString(m) }
+func (*DayLengthAnalytics) ProtoMessage()    {}
+func (*DayLengthAnalytics) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{16}
+}
+
+func (m *DayLengthAnalytics) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DayLengthAnalytics.Unmarshal(m, b)
+}
+func (m *DayLengthAnalytics) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DayLengthAnalytics.Marshal(b, m, deterministic)
+}
+func (m *DayLengthAnalytics) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DayLengthAnalytics.Merge(m, src)
+}
+func (m *DayLengthAnalytics) XXX_Size() int {
+	return xxx_messageInfo_DayLengthAnalytics.Size(m)
+}
+func (m *DayLengthAnalytics) XXX_DiscardUnknown() {
+	xxx_messageInfo_DayLengthAnalytics.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DayLengthAnalytics proto.InternalMessageInfo
+
+func (m *DayLengthAnalytics) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *DayLengthAnalytics) GetToday() *DayLength {
+	if m != nil {
+		return m.Today
+	}
+	return nil
+}
+
+func (m *DayLengthAnalytics) GetYesterday() *DayLength {
+	if m != nil {
+		return m.Yesterday
+	}
+	return nil
+}
+
+func (m *DayLengthAnalytics) GetChange() float64 {
+	if m != nil {
+		return m.Change
+	}
+	return 0
+}
+
+func (m *DayLengthAnalytics) GetShortest() *DayLength {
+	if m != nil {
+		return m.Shortest
+	}
+	return nil
+}
+
+func (m *DayLengthAnalytics) GetLongest() *DayLength {
+	if m != nil {
+		return m.Longest
+	}
+	return nil
+}
+
+func (m *DayLengthAnalytics) GetCrossings() []*DayLengthCrossing {
+	if m != nil {
+		return m.Crossings
+	}
+	return nil
+}
+
+type MeteorShowersRequest struct {
+	Api       string  `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Latitude  float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Year      int32   `protobuf:"varint,4,opt,name=year,proto3" json:"year,omitempty"`
+	// IMO codes or names of the showers wanted, all of them when empty
+	Showers              []string `protobuf:"bytes,5,rep,name=showers,proto3" json:"showers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeteorShowersRequest) Reset()         { *m = MeteorShowersRequest{} }
+func (m *MeteorShowersRequest) String() string { return proto.CompactTextString(m) }
+func (*MeteorShowersRequest) ProtoMessage()    {}
+func (*MeteorShowersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{17}
+}
+
+func (m *MeteorShowersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteorShowersRequest.Unmarshal(m, b)
+}
+func (m *MeteorShowersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteorShowersRequest.Marshal(b, m, deterministic)
+}
+func (m *MeteorShowersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteorShowersRequest.Merge(m, src)
+}
+func (m *MeteorShowersRequest) XXX_Size() int {
+	return xxx_messageInfo_MeteorShowersRequest.Size(m)
+}
+func (m *MeteorShowersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteorShowersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteorShowersRequest proto.InternalMessageInfo
+
+func (m *MeteorShowersRequest) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MeteorShowersRequest) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *MeteorShowersRequest) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *MeteorShowersRequest) GetYear() int32 {
+	if m != nil {
+		return m.Year
+	}
+	return 0
+}
+
+func (m *MeteorShowersRequest) GetShowers() []string {
+	if m != nil {
+		return m.Showers
+	}
+	return nil
+}
+
+type MeteorHour struct {
+	Time *SunInstant `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Altitudes above the observer's horizon, in degrees, and the azimuth of
+	// the radiant clockwise from north
+	RadiantAltitude float64 `protobuf:"fixed64,2,opt,name=radiant_altitude,json=radiantAltitude,proto3" json:"radiant_altitude,omitempty"`
+	RadiantAzimuth  float64 `protobuf:"fixed64,3,opt,name=radiant_azimuth,json=radiantAzimuth,proto3" json:"radiant_azimuth,omitempty"`
+	SunAltitude     float64 `protobuf:"fixed64,4,opt,name=sun_altitude,json=sunAltitude,proto3" json:"sun_altitude,omitempty"`
+	MoonAltitude    float64 `protobuf:"fixed64,5,opt,name=moon_altitude,json=moonAltitude,proto3" json:"moon_altitude,omitempty"`
+	// The Moon's illuminated fraction times the sine of its altitude, 0 when
+	// the Moon is down and 1 when it is full at the zenith
+	MoonInterference     float64  `protobuf:"fixed64,6,opt,name=moon_interference,json=moonInterference,proto3" json:"moon_interference,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MeteorHour) Reset()         { *m = MeteorHour{} }
+func (m *MeteorHour) String() string { return proto.CompactTextString(m) }
+func (*MeteorHour) ProtoMessage()    {}
+func (*MeteorHour) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{18}
+}
+
+func (m *MeteorHour) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteorHour.Unmarshal(m, b)
+}
+func (m *MeteorHour) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteorHour.Marshal(b, m, deterministic)
+}
+func (m *MeteorHour) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteorHour.Merge(m, src)
+}
+func (m *MeteorHour) XXX_Size() int {
+	return xxx_messageInfo_MeteorHour.Size(m)
+}
+func (m *MeteorHour) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteorHour.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteorHour proto.InternalMessageInfo
+
+func (m *MeteorHour) GetTime() *SunInstant {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+func (m *MeteorHour) GetRadiantAltitude() float64 {
+	if m != nil {
+		return m.RadiantAltitude
+	}
+	return 0
+}
+
+func (m *MeteorHour) GetRadiantAzimuth() float64 {
+	if m != nil {
+		return m.RadiantAzimuth
+	}
+	return 0
+}
+
+func (m *MeteorHour) GetSunAltitude() float64 {
+	if m != nil {
+		return m.SunAltitude
+	}
+	return 0
+}
+
+func (m *MeteorHour) GetMoonAltitude() float64 {
+	if m != nil {
+		return m.MoonAltitude
+	}
+	return 0
+}
+
+func (m *MeteorHour) GetMoonInterference() float64 {
+	if m != nil {
+		return m.MoonInterference
+	}
+	return 0
+}
+
+type MeteorShower struct {
+	// IMO three letter code
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// First and last days of activity
+	BeginMonth int32 `protobuf:"varint,3,opt,name=begin_month,json=beginMonth,proto3" json:"begin_month,omitempty"`
+	BeginDay   int32 `protobuf:"varint,4,opt,name=begin_day,json=beginDay,proto3" json:"begin_day,omitempty"`
+	EndMonth   int32 `protobuf:"varint,5,opt,name=end_month,json=endMonth,proto3" json:"end_month,omitempty"`
+	EndDay     int32 `protobuf:"varint,6,opt,name=end_day,json=endDay,proto3" json:"end_day,omitempty"`
+	// Solar longitude of the peak for the equinox of J2000.0, in degrees
+	PeakSolarLongitude float64 `protobuf:"fixed64,7,opt,name=peak_solar_longitude,json=peakSolarLongitude,proto3" json:"peak_solar_longitude,omitempty"`
+	// When the sun reaches that longitude
+	Peak *SunInstant `protobuf:"bytes,8,opt,name=peak,proto3" json:"peak,omitempty"`
+	// Radiant for the equinox of J2000.0, in degrees
+	RadiantRightAscension float64 `protobuf:"fixed64,9,opt,name=radiant_right_ascension,json=radiantRightAscension,proto3" json:"radiant_right_ascension,omitempty"`
+	RadiantDeclination    float64 `protobuf:"fixed64,10,opt,name=radiant_declination,json=radiantDeclination,proto3" json:"radiant_declination,omitempty"`
+	// Speed of the meteors, in km/s
+	Velocity        float64 `protobuf:"fixed64,11,opt,name=velocity,proto3" json:"velocity,omitempty"`
+	PopulationIndex float64 `protobuf:"fixed64,12,opt,name=population_index,json=populationIndex,proto3" json:"population_index,omitempty"`
+	Zhr             float64 `protobuf:"fixed64,13,opt,name=zhr,proto3" json:"zhr,omitempty"`
+	// Illuminated fraction of the Moon at the peak
+	MoonIllumination float64 `protobuf:"fixed64,14,opt,name=moon_illumination,json=moonIllumination,proto3" json:"moon_illumination,omitempty"`
+	// The mean of the hourly moon interference while the radiant is up and
+	// the sun is more than 12 degrees below the horizon, and whether there
+	// are any such hours
+	MoonInterference float64 `protobuf:"fixed64,15,opt,name=moon_interference,json=moonInterference,proto3" json:"moon_interference,omitempty"`
+	Observable       bool    `protobuf:"varint,16,opt,name=observable,proto3" json:"observable,omitempty"`
+	// The 24 hours from the local mean noon before the peak
+	Hours                []*MeteorHour `protobuf:"bytes,17,rep,name=hours,proto3" json:"hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MeteorShower) Reset()         { *m = MeteorShower{} }
+func (m *MeteorShower) String() string { return proto.CompactTextString(m) }
+func (*MeteorShower) ProtoMessage()    {}
+func (*MeteorShower) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{19}
+}
+
+func (m *MeteorShower) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteorShower.Unmarshal(m, b)
+}
+func (m *MeteorShower) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteorShower.Marshal(b, m, deterministic)
+}
+func (m *MeteorShower) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteorShower.Merge(m, src)
+}
+func (m *MeteorShower) XXX_Size() int {
+	return xxx_messageInfo_MeteorShower.Size(m)
+}
+func (m *MeteorShower) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteorShower.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteorShower proto.InternalMessageInfo
+
+func (m *MeteorShower) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *MeteorShower) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MeteorShower) GetBeginMonth() int32 {
+	if m != nil {
+		return m.BeginMonth
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetBeginDay() int32 {
+	if m != nil {
+		return m.BeginDay
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetEndMonth() int32 {
+	if m != nil {
+		return m.EndMonth
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetEndDay() int32 {
+	if m != nil {
+		return m.EndDay
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetPeakSolarLongitude() float64 {
+	if m != nil {
+		return m.PeakSolarLongitude
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetPeak() *SunInstant {
+	if m != nil {
+		return m.Peak
+	}
+	return nil
+}
+
+func (m *MeteorShower) GetRadiantRightAscension() float64 {
+	if m != nil {
+		return m.RadiantRightAscension
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetRadiantDeclination() float64 {
+	if m != nil {
+		return m.RadiantDeclination
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetVelocity() float64 {
+	if m != nil {
+		return m.Velocity
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetPopulationIndex() float64 {
+	if m != nil {
+		return m.PopulationIndex
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetZhr() float64 {
+	if m != nil {
+		return m.Zhr
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetMoonIllumination() float64 {
+	if m != nil {
+		return m.MoonIllumination
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetMoonInterference() float64 {
+	if m != nil {
+		return m.MoonInterference
+	}
+	return 0
+}
+
+func (m *MeteorShower) GetObservable() bool {
+	if m != nil {
+		return m.Observable
+	}
+	return false
+}
+
+func (m *MeteorShower) GetHours() []*MeteorHour {
+	if m != nil {
+		return m.Hours
+	}
+	return nil
+}
+
+type MeteorShowers struct {
+	Api string `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// In order of their peaks
+	Showers              []*MeteorShower `protobuf:"bytes,2,rep,name=showers,proto3" json:"showers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *MeteorShowers) Reset()         { *m = MeteorShowers{} }
+func (m *MeteorShowers) String() string { return proto.CompactTextString(m) }
+func (*MeteorShowers) ProtoMessage()    {}
+func (*MeteorShowers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df5d86f47d451473, []int{20}
+}
+
+func (m *MeteorShowers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MeteorShowers.Unmarshal(m, b)
+}
+func (m *MeteorShowers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MeteorShowers.Marshal(b, m, deterministic)
+}
+func (m *MeteorShowers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MeteorShowers.Merge(m, src)
+}
+func (m *MeteorShowers) XXX_Size() int {
+	return xxx_messageInfo_MeteorShowers.Size(m)
+}
+func (m *MeteorShowers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MeteorShowers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MeteorShowers proto.InternalMessageInfo
+
+func (m *MeteorShowers) GetApi() string {
+	if m != nil {
+		return m.Api
+	}
+	return ""
+}
+
+func (m *MeteorShowers) GetShowers() []*MeteorShower {
+	if m != nil {
+		return m.Showers
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("v1.SolarEclipseType", SolarEclipseType_name, SolarEclipseType_value)
+	proto.RegisterEnum("v1.SolarTimeKind", SolarTimeKind_name, SolarTimeKind_value)
+	proto.RegisterEnum("v1.SolarPositionKind", SolarPositionKind_name, SolarPositionKind_value)
+	proto.RegisterType((*SunriseRequest)(nil), "v1.SunriseRequest")
+	proto.RegisterType((*SunriseTime)(nil), "v1.SunriseTime")
+	proto.RegisterType((*ShadowRequest)(nil), "v1.ShadowRequest")
+	proto.RegisterType((*Shadow)(nil), "v1.Shadow")
+	proto.RegisterType((*SunInstant)(nil), "v1.SunInstant")
+	proto.RegisterType((*SolarEclipseRequest)(nil), "v1.SolarEclipseRequest")
+	proto.RegisterType((*SolarEclipseContact)(nil), "v1.SolarEclipseContact")
+	proto.RegisterType((*SolarEclipse)(nil), "v1.SolarEclipse")
+	proto.RegisterType((*SolarEclipses)(nil), "v1.SolarEclipses")
+	proto.RegisterType((*SolarTimeRequest)(nil), "v1.SolarTimeRequest")
+	proto.RegisterType((*SolarTime)(nil), "v1.SolarTime")
+	proto.RegisterType((*SolarEphemerisRequest)(nil), "v1.SolarEphemerisRequest")
+	proto.RegisterType((*SolarEphemeris)(nil), "v1.SolarEphemeris")
+	proto.RegisterType((*DayLengthRequest)(nil), "v1.DayLengthRequest")
+	proto.RegisterType((*DayLength)(nil), "v1.DayLength")
+	proto.RegisterType((*DayLengthCrossing)(nil), "v1.DayLengthCrossing")
+	proto.RegisterType((*DayLengthAnalytics)(nil), "v1.DayLengthAnalytics")
+	proto.RegisterType((*MeteorShowersRequest)(nil), "v1.MeteorShowersRequest")
+	proto.RegisterType((*MeteorHour)(nil), "v1.MeteorHour")
+	proto.RegisterType((*MeteorShower)(nil), "v1.MeteorShower")
+	proto.RegisterType((*MeteorShowers)(nil), "v1.MeteorShowers")
+}
+
+func init() { proto.RegisterFile("sun.proto", fileDescriptor_df5d86f47d451473) }
+
+var fileDescriptor_df5d86f47d451473 = []byte{
+	// 2656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xdd, 0x72, 0x23, 0x47,
+	0xf5, 0xff, 0x8f, 0x24, 0xeb, 0xe3, 0xc8, 0x92, 0xe5, 0x5e, 0x7b, 0x57, 0xf1, 0xe6, 0x9f, 0x55,
+	0x66, 0x93, 0x5a, 0xc7, 0x89, 0xad, 0xb5, 0xd7, 0xa4, 0x52, 0x81, 0xa2, 0xa2, 0xd8, 0x62, 0x51,
+	0xc5, 0x5f, 0x35, 0xf6, 0x86, 0xca, 0x0d, 0xaa, 0xf6, 0xa8, 0x2d, 0x4d, 0x76, 0xd4, 0x33, 0xdb,
+	0xd3, 0x63, 0x47, 0x31, 0xe6, 0x02, 0x1e, 0x80, 0x14, 0x5c, 0x41, 0x71, 0xcf, 0x23, 0xf0, 0x06,
+	0xbc, 0x00, 0x2f, 0x90, 0xa2, 0xb8, 0xe0, 0x86, 0x2b, 0x2e, 0x28, 0xe0, 0x86, 0xea, 0x8f, 0xf9,
+	0x90, 0x3c, 0xde, 0x4d, 0x36, 0x05, 0x45, 0x6e, 0xec, 0xe9, 0xdf, 0x39, 0xdd, 0xa7, 0xfb, 0x7c,
+	0xf5, 0x39, 0x2d, 0xa8, 0x04, 0x21, 0xdd, 0xf0, 0x99, 0xc7, 0x3d, 0x94, 0x3b, 0xdf, 0x5c, 0x79,
+	0x75, 0xe8, 0x79, 0x43, 0x97, 0xb4, 0xb1, 0xef, 0xb4, 0x31, 0xa5, 0x1e, 0xc7, 0xdc, 0xf1, 0x68,
+	0xa0, 0x38, 0x56, 0xde, 0x91, 0xff, 0xec, 0xf5, 0x21, 0xa1, 0xeb, 0xc1, 0x05, 0x1e, 0x0e, 0x09,
+	0x6b, 0x7b, 0xbe, 0xe4, 0xc8, 0xe0, 0x7e, 0x4d, 0xaf, 0x25, 0x47, 0xa7, 0xe1, 0x59, 0xfb, 0x82,
+	0x61, 0xdf, 0x27, 0x4c, 0xd3, 0xcd, 0xbf, 0xe6, 0xa0, 0x7e, 0x1c, 0x52, 0xe6, 0x04, 0xc4, 0x22,
+	0xcf, 0x42, 0x12, 0x70, 0xd4, 0x80, 0x3c, 0xf6, 0x9d, 0xa6, 0xd1, 0x32, 0x56, 0x2b, 0x96, 0xf8,
+	0x44, 0xaf, 0x42, 0xc5, 0xf5, 0xe8, 0xd0, 0xe1, 0xe1, 0x80, 0x34, 0x73, 0x2d, 0x63, 0xd5, 0xb0,
+	0x12, 0x00, 0xad, 0x40, 0xd9, 0xc5, 0x5c, 0x11, 0xf3, 0x92, 0x18, 0x8f, 0x11, 0x82, 0xc2, 0x84,
+	0x60, 0xd6, 0x2c, 0xb4, 0x8c, 0xd5, 0x39, 0x4b, 0x7e, 0xa3, 0x25, 0x98, 0x1b, 0x7b, 0x94, 0x8f,
+	0x9a, 0x73, 0x12, 0x54, 0x03, 0x21, 0x75, 0x80, 0x27, 0xcd, 0xa2, 0xc4, 0xc4, 0xa7, 0x98, 0x3b,
+	0xf2, 0x42, 0xd6, 0x2c, 0xc9, 0x35, 0xe5, 0x37, 0x7a, 0x0d, 0x80, 0x91, 0x33, 0x86, 0x6d, 0x71,
+	0xc6, 0x66, 0x59, 0x6e, 0x31, 0x85, 0xa0, 0xef, 0x43, 0x95, 0x93, 0xb1, 0x4f, 0x18, 0xe6, 0x21,
+	0x23, 0xcd, 0x4a, 0xcb, 0x58, 0xad, 0x6e, 0xbd, 0xba, 0xa1, 0x94, 0xb0, 0x11, 0x29, 0x61, 0x63,
+	0xd7, 0x0b, 0x4f, 0x5d, 0xf2, 0x31, 0x76, 0x43, 0x62, 0xa5, 0x27, 0xa0, 0xf7, 0xa0, 0xec, 0x33,
+	0x12, 0x04, 0x62, 0x32, 0x7c, 0x85, 0xc9, 0x31, 0x37, 0x7a, 0x13, 0xea, 0x23, 0x67, 0x38, 0xea,
+	0xfb, 0x8c, 0xd8, 0x4e, 0x20, 0x76, 0x57, 0x6d, 0x19, 0xab, 0x65, 0xab, 0x26, 0xd0, 0xa3, 0x08,
+	0x34, 0x3d, 0xa8, 0x6a, 0x75, 0x9f, 0x38, 0x63, 0x92, 0xa1, 0xeb, 0x48, 0x63, 0xb9, 0x2c, 0x8d,
+	0xe5, 0x33, 0x34, 0x56, 0xb8, 0xae, 0xb1, 0xb9, 0x44, 0x63, 0xe6, 0xcf, 0x0b, 0x50, 0x3b, 0x1e,
+	0xe1, 0x81, 0x77, 0xf1, 0x6d, 0xb0, 0xef, 0x6d, 0x28, 0x8e, 0x88, 0x33, 0x1c, 0x71, 0x69, 0x5b,
+	0xc3, 0xd2, 0x23, 0xf4, 0x00, 0x16, 0xce, 0x3c, 0x8f, 0xfb, 0xcc, 0xa1, 0xbc, 0x7f, 0xe1, 0x0c,
+	0xf8, 0x48, 0xda, 0xd6, 0xb0, 0xea, 0x31, 0xfc, 0x23, 0x81, 0x4e, 0x33, 0x0e, 0x88, 0xcf, 0x47,
+	0x4d, 0x98, 0x61, 0xdc, 0x15, 0x28, 0x7a, 0x1b, 0x16, 0x13, 0xc6, 0x53, 0x82, 0x99, 0x43, 0x87,
+	0xd2, 0x64, 0x86, 0xd5, 0x88, 0x09, 0x1f, 0x2a, 0x7c, 0xc6, 0xed, 0xe6, 0x5f, 0xe4, 0x76, 0xb5,
+	0x6f, 0xe2, 0x76, 0xf5, 0x6f, 0xe8, 0x76, 0x0b, 0x59, 0x6e, 0xf7, 0x97, 0x1c, 0x14, 0x95, 0x17,
+	0x64, 0x98, 0xbf, 0x09, 0x25, 0xfc, 0xb9, 0x33, 0x0e, 0xf9, 0x48, 0x1b, 0x3f, 0x1a, 0x0a, 0xc7,
+	0x20, 0x2e, 0x39, 0x97, 0x19, 0x45, 0xdb, 0x3e, 0x01, 0xd0, 0x32, 0x14, 0x83, 0x90, 0xf6, 0x43,
+	0x5f, 0x9a, 0xbf, 0x6c, 0xcd, 0x05, 0x21, 0x7d, 0xe2, 0x0b, 0x1b, 0xba, 0x84, 0x0e, 0xb5, 0x03,
+	0x18, 0x96, 0x1e, 0x09, 0x31, 0x91, 0x9e, 0x8b, 0x4a, 0x8c, 0x1e, 0xa2, 0x57, 0xa0, 0xcc, 0x1d,
+	0xbf, 0x4f, 0x70, 0xc0, 0xb5, 0x37, 0x94, 0xb8, 0xe3, 0x77, 0x71, 0xc0, 0xd1, 0x5d, 0xa8, 0x08,
+	0x12, 0xf5, 0x18, 0x1f, 0x69, 0x9f, 0x10, 0xbc, 0x07, 0x62, 0x8c, 0xee, 0x43, 0x4d, 0x10, 0x13,
+	0xdf, 0x55, 0x3e, 0x31, 0xcf, 0x1d, 0x7f, 0x2f, 0xc2, 0xd0, 0xeb, 0x30, 0x2f, 0x99, 0x22, 0x17,
+	0x56, 0xee, 0x50, 0x15, 0x3c, 0x1a, 0x12, 0xc7, 0x8c, 0x4d, 0x2e, 0x7d, 0xa0, 0x62, 0x25, 0x00,
+	0x5a, 0x07, 0x84, 0x7d, 0x1f, 0x33, 0x42, 0x79, 0x3f, 0xd1, 0xc6, 0xbc, 0x5c, 0x66, 0x31, 0xa2,
+	0x74, 0x23, 0x82, 0x79, 0x05, 0x70, 0x1c, 0xd2, 0x1e, 0x0d, 0x38, 0xa6, 0x3c, 0x0e, 0x10, 0x23,
+	0x2b, 0x40, 0x72, 0x19, 0x01, 0x92, 0xbf, 0x1e, 0x20, 0x85, 0x54, 0x80, 0xdc, 0x83, 0xea, 0xa7,
+	0xa1, 0xeb, 0x60, 0xda, 0x1f, 0x60, 0x4e, 0xb4, 0x86, 0x41, 0x41, 0xbb, 0x98, 0x13, 0xf3, 0x77,
+	0x39, 0xb8, 0x75, 0xec, 0xb9, 0x98, 0x75, 0x6d, 0xd7, 0xf1, 0xff, 0x33, 0x59, 0x3d, 0x89, 0xd2,
+	0xc2, 0x54, 0x94, 0xfe, 0x3f, 0x40, 0xc0, 0x31, 0xe3, 0x7d, 0x79, 0x64, 0x15, 0xfe, 0x15, 0x89,
+	0x7c, 0x22, 0xce, 0x7d, 0x0f, 0xaa, 0x8a, 0xac, 0x4e, 0xaf, 0x52, 0x81, 0x9a, 0xb1, 0x2f, 0x55,
+	0x70, 0x17, 0x14, 0x77, 0x5f, 0x28, 0xa2, 0x24, 0xc9, 0x65, 0x09, 0xec, 0xe2, 0x89, 0x70, 0x12,
+	0x42, 0x07, 0x6a, 0xe9, 0xb2, 0xa4, 0x95, 0x08, 0x1d, 0xc8, 0x85, 0xef, 0x42, 0x45, 0x90, 0xd4,
+	0xb2, 0x15, 0x35, 0x8f, 0xd0, 0x81, 0x5a, 0xf4, 0x0e, 0x08, 0x3e, 0xb9, 0x24, 0x48, 0x52, 0x91,
+	0xd0, 0xc1, 0x2e, 0x9e, 0x98, 0xe7, 0xd3, 0x8a, 0xda, 0xf1, 0x28, 0xc7, 0x36, 0x47, 0x26, 0x14,
+	0xb8, 0x33, 0x26, 0x52, 0x53, 0xd5, 0xad, 0xfa, 0xc6, 0xf9, 0xe6, 0x46, 0x62, 0x4f, 0x4b, 0xd2,
+	0x84, 0x4f, 0x09, 0xcf, 0xc7, 0x2e, 0x4f, 0x6b, 0xaf, 0x1a, 0x84, 0xb4, 0xa3, 0x21, 0xe1, 0xed,
+	0xe7, 0x4e, 0xe0, 0x9c, 0xba, 0x4a, 0x7d, 0x65, 0x2b, 0x1a, 0x9a, 0x7f, 0xc8, 0xc3, 0x7c, 0x5a,
+	0x30, 0x5a, 0x85, 0x02, 0x9f, 0xf8, 0x4a, 0x62, 0x7d, 0x6b, 0x49, 0x4a, 0x4c, 0xd1, 0x4f, 0x26,
+	0x3e, 0xb1, 0x24, 0x07, 0x5a, 0x83, 0xf2, 0x90, 0x11, 0xcc, 0x49, 0xc0, 0x9b, 0xb9, 0xcc, 0xfd,
+	0xc5, 0x74, 0xe1, 0x65, 0x43, 0x3c, 0x1e, 0x63, 0x6d, 0x3d, 0x35, 0x40, 0x8f, 0x00, 0x5c, 0xcf,
+	0xc6, 0x6e, 0x5f, 0x4a, 0x2c, 0x3c, 0x47, 0x62, 0x45, 0xf2, 0x89, 0x4f, 0xf4, 0x00, 0x72, 0xf6,
+	0xa6, 0xb4, 0x67, 0x75, 0xeb, 0xce, 0x2c, 0xb3, 0xd6, 0x9b, 0x95, 0xb3, 0x37, 0x25, 0xe3, 0x56,
+	0xb3, 0xf8, 0x22, 0xc6, 0x2d, 0xb4, 0x09, 0xa5, 0x31, 0xfe, 0xcc, 0x19, 0x87, 0xe3, 0x66, 0xe9,
+	0xf9, 0xdc, 0x11, 0x9f, 0x5c, 0xfb, 0x51, 0xb3, 0xfc, 0x7c, 0xee, 0x9c, 0xfd, 0x48, 0x32, 0x6e,
+	0x37, 0x2b, 0x2f, 0x62, 0xdc, 0x16, 0x01, 0x30, 0xc6, 0x43, 0x9a, 0x4e, 0x0b, 0x09, 0x80, 0x5a,
+	0x50, 0xf5, 0x4e, 0x03, 0x3b, 0x64, 0x2a, 0xde, 0xd5, 0xd5, 0x90, 0x86, 0xcc, 0x43, 0xa8, 0xa5,
+	0x97, 0x0e, 0x32, 0x62, 0xec, 0x1d, 0x28, 0x13, 0x4d, 0x6d, 0xe6, 0x5a, 0xf9, 0xd5, 0xea, 0x56,
+	0x63, 0x76, 0x47, 0x56, 0xcc, 0x61, 0x7e, 0x69, 0x40, 0x43, 0x92, 0x44, 0x6d, 0xf0, 0xb2, 0x81,
+	0x1b, 0x65, 0x9c, 0x7c, 0x56, 0xc6, 0x29, 0x64, 0x64, 0x9c, 0xb9, 0xeb, 0x19, 0xa7, 0x98, 0xca,
+	0x38, 0x6f, 0x42, 0xe1, 0xa9, 0x43, 0x07, 0xd2, 0x4e, 0xf5, 0xad, 0xc5, 0x78, 0xfb, 0x62, 0x8f,
+	0x1f, 0x39, 0x74, 0x60, 0x49, 0x72, 0xc6, 0x45, 0x54, 0xce, 0xba, 0x88, 0xbe, 0x34, 0xa0, 0x12,
+	0x4f, 0xcf, 0x38, 0xdb, 0x77, 0xa0, 0x1e, 0x52, 0xe7, 0x9c, 0xb0, 0x40, 0xf8, 0xa8, 0x33, 0x56,
+	0x07, 0xbc, 0xee, 0xe7, 0xb5, 0x98, 0x4b, 0x2e, 0xf4, 0x36, 0x54, 0xc6, 0x04, 0x53, 0x35, 0x23,
+	0x9f, 0x1d, 0x19, 0x82, 0x41, 0x32, 0x3f, 0x82, 0x5a, 0x9c, 0xd0, 0xe5, 0x84, 0x42, 0xe6, 0x84,
+	0xf9, 0x88, 0x49, 0x4e, 0x5a, 0x85, 0x06, 0x79, 0x16, 0x4a, 0xc3, 0xf7, 0xbd, 0x33, 0x35, 0x4f,
+	0x65, 0xdf, 0x7a, 0x84, 0x1f, 0x9e, 0x09, 0x4e, 0xf3, 0xb7, 0x06, 0x2c, 0x2b, 0x03, 0xfb, 0x23,
+	0x32, 0x26, 0xcc, 0x09, 0x6e, 0x36, 0xe5, 0x4c, 0x3a, 0xcf, 0xcd, 0xa6, 0xf3, 0x0c, 0xb5, 0xe6,
+	0x33, 0xd4, 0x8a, 0xde, 0xd2, 0x46, 0x52, 0x01, 0xbd, 0x1c, 0x1b, 0xe9, 0xc8, 0x0b, 0x1c, 0xb1,
+	0xb5, 0xc4, 0x50, 0xe6, 0x2f, 0x4a, 0x50, 0x9f, 0xde, 0xde, 0x4b, 0xee, 0x4b, 0x33, 0xd8, 0x84,
+	0xf2, 0x90, 0x4d, 0x74, 0x9a, 0xa9, 0x29, 0x74, 0x47, 0x81, 0xe8, 0x3d, 0x68, 0x0e, 0x89, 0x37,
+	0x26, 0x9c, 0x39, 0x76, 0x5f, 0x5a, 0x28, 0xf1, 0x5c, 0x75, 0x77, 0xdc, 0x8e, 0xe9, 0xfb, 0x04,
+	0xd3, 0xe4, 0xda, 0xde, 0x86, 0xdb, 0x33, 0x33, 0x31, 0xf5, 0xc6, 0xd8, 0x9d, 0x68, 0xad, 0x2f,
+	0x4d, 0xcd, 0xeb, 0x28, 0x9a, 0x90, 0x47, 0x6c, 0xb1, 0x23, 0xe6, 0xd8, 0x0e, 0x9f, 0xf4, 0x09,
+	0x66, 0x7c, 0xd4, 0xf7, 0xd8, 0xa9, 0xc3, 0xb5, 0x53, 0xdf, 0x4e, 0xd3, 0xbb, 0x82, 0x7c, 0x28,
+	0xa8, 0xe8, 0x1d, 0x40, 0x69, 0xfb, 0x4a, 0x1e, 0xa2, 0xab, 0x91, 0x46, 0x62, 0xe1, 0x1d, 0x89,
+	0x8b, 0xe3, 0x73, 0x16, 0x92, 0xd4, 0x69, 0x54, 0x6d, 0x52, 0x13, 0xe8, 0x74, 0xed, 0x21, 0xd8,
+	0xa2, 0xad, 0x57, 0x74, 0xed, 0xc1, 0x42, 0x12, 0xed, 0xf8, 0x3e, 0xd4, 0x18, 0x1e, 0x38, 0x61,
+	0xd0, 0x3f, 0x27, 0x36, 0xf7, 0x98, 0x4e, 0x44, 0xf3, 0x0a, 0xfc, 0x58, 0x62, 0x53, 0x25, 0x48,
+	0x22, 0xb2, 0x3a, 0x5d, 0x82, 0x24, 0x62, 0xdf, 0x84, 0xba, 0xd4, 0x98, 0x77, 0xea, 0x3a, 0xcf,
+	0x42, 0x87, 0x4f, 0x74, 0xb5, 0x52, 0x13, 0xe8, 0x61, 0x04, 0xa2, 0x4d, 0x58, 0x8a, 0x39, 0xfa,
+	0xb6, 0xc7, 0x18, 0x51, 0xf5, 0x6d, 0x4d, 0x32, 0xdf, 0x8a, 0x69, 0x3b, 0x31, 0x49, 0x94, 0xd7,
+	0x4c, 0x5c, 0xf5, 0x7d, 0x1c, 0xd8, 0x84, 0x4a, 0x7f, 0xac, 0xab, 0x20, 0x90, 0x70, 0x27, 0x42,
+	0x45, 0xf6, 0x1c, 0x88, 0xbc, 0x46, 0x31, 0x8f, 0x8a, 0x52, 0xc3, 0x4a, 0x43, 0x99, 0x01, 0xd5,
+	0xc8, 0x0a, 0xa8, 0x8c, 0x18, 0x58, 0xcc, 0x8a, 0x81, 0x2d, 0x58, 0xa6, 0xa1, 0xea, 0x7e, 0xfb,
+	0x4e, 0xda, 0xd1, 0x90, 0x3a, 0x4f, 0x44, 0xec, 0xa5, 0xbc, 0x6c, 0x66, 0x4e, 0xa2, 0xb0, 0x5b,
+	0xb3, 0x73, 0x12, 0xb5, 0xbd, 0x01, 0x35, 0xdb, 0xa3, 0x01, 0x27, 0xae, 0xab, 0x0e, 0xb7, 0x24,
+	0xe3, 0x66, 0x1a, 0x8c, 0x23, 0x72, 0xf9, 0xc5, 0x11, 0xf9, 0xb7, 0x1c, 0x34, 0x76, 0xf1, 0x64,
+	0x4f, 0x96, 0xc9, 0xff, 0x6b, 0x5d, 0xda, 0x6d, 0x28, 0x72, 0xcc, 0x86, 0x24, 0xaa, 0xcc, 0xf5,
+	0xe8, 0xdb, 0xdf, 0x89, 0x77, 0xa1, 0x12, 0x2b, 0x5d, 0x14, 0x7d, 0x32, 0xd1, 0xdd, 0x50, 0xf4,
+	0x09, 0x9a, 0xd0, 0x98, 0xb8, 0x10, 0x03, 0xad, 0x7b, 0x35, 0x30, 0x3d, 0x58, 0x8c, 0x97, 0xd9,
+	0x61, 0x5e, 0x10, 0x88, 0x86, 0xe6, 0xa5, 0x97, 0x13, 0x71, 0xa3, 0xda, 0x25, 0x42, 0x45, 0xa3,
+	0xa4, 0x92, 0x7d, 0x1a, 0x32, 0x7f, 0x9d, 0x03, 0x14, 0x4b, 0xec, 0x50, 0xec, 0x4e, 0xb8, 0x63,
+	0x67, 0xe5, 0xf0, 0xfb, 0x30, 0xc7, 0x3d, 0x61, 0x4d, 0x75, 0x83, 0xd6, 0xc4, 0x2e, 0x12, 0x37,
+	0x53, 0x34, 0x71, 0x71, 0x4e, 0x48, 0xc0, 0x09, 0x8b, 0x7a, 0x8f, 0x6b, 0x8c, 0x09, 0x5d, 0xf8,
+	0x82, 0x3d, 0xc2, 0x74, 0x18, 0xe5, 0x6e, 0x3d, 0x42, 0x6f, 0x41, 0x39, 0x18, 0x79, 0x4c, 0x96,
+	0xa5, 0x73, 0x59, 0x6b, 0xc4, 0x64, 0xf4, 0x00, 0x4a, 0xc2, 0x67, 0x05, 0x67, 0x31, 0x8b, 0x33,
+	0xa2, 0xa2, 0x47, 0x50, 0xb1, 0xb5, 0x3a, 0x83, 0x66, 0x49, 0x96, 0x4e, 0xcb, 0x53, 0xac, 0x91,
+	0xb2, 0xad, 0x84, 0xcf, 0xfc, 0xc2, 0x80, 0xa5, 0x7d, 0xc2, 0x89, 0xc7, 0x8e, 0x47, 0xde, 0x05,
+	0x61, 0xc1, 0x7f, 0x2b, 0x9a, 0x9a, 0x50, 0x0a, 0x94, 0xc4, 0xe6, 0x5c, 0x2b, 0xbf, 0x5a, 0xb1,
+	0xa2, 0xa1, 0xf9, 0x0f, 0x03, 0x40, 0x6d, 0xe9, 0x87, 0xa2, 0x9a, 0xfa, 0x2a, 0xdd, 0xc5, 0x5b,
+	0xd0, 0x10, 0xd9, 0x1f, 0x53, 0x3e, 0xdb, 0x61, 0x2c, 0x68, 0x3c, 0xee, 0x32, 0x1e, 0x40, 0x04,
+	0xf5, 0xa3, 0x16, 0x3e, 0xaf, 0xf3, 0xb1, 0xe6, 0x54, 0xe8, 0xb5, 0x8e, 0xa5, 0x70, 0xbd, 0x63,
+	0xb9, 0x0f, 0xb5, 0xb1, 0xe7, 0xa5, 0x78, 0xd4, 0x45, 0x3b, 0x2f, 0xc0, 0x98, 0xe9, 0x6d, 0x58,
+	0x94, 0x4c, 0x0e, 0xe5, 0x84, 0x9d, 0x11, 0x46, 0xa8, 0x4d, 0xf4, 0xcd, 0xda, 0x10, 0x84, 0x5e,
+	0x0a, 0x37, 0xff, 0x54, 0x80, 0xf9, 0xb4, 0x39, 0x84, 0xea, 0x6c, 0x6f, 0x40, 0xb4, 0x1d, 0xe4,
+	0xb7, 0xc0, 0x28, 0xd6, 0x75, 0x5e, 0xc5, 0x92, 0xdf, 0xa2, 0xfc, 0x38, 0x25, 0x43, 0x87, 0xf6,
+	0xd3, 0xcf, 0x5e, 0x20, 0xa1, 0xb8, 0x53, 0x54, 0x0c, 0xc9, 0x0b, 0x58, 0x59, 0x02, 0xa2, 0x53,
+	0x9c, 0x6a, 0x07, 0xe7, 0x6e, 0x6e, 0x07, 0x8b, 0xe9, 0x76, 0x10, 0x3d, 0x84, 0x25, 0x9f, 0xe0,
+	0xa7, 0xfd, 0x40, 0x64, 0xe9, 0xd4, 0xed, 0xa1, 0xd2, 0x1e, 0x12, 0x34, 0x99, 0xc0, 0x93, 0xcb,
+	0xc3, 0x84, 0x82, 0x40, 0x9b, 0xe5, 0x6c, 0x5b, 0x0a, 0x1a, 0x7a, 0x17, 0xee, 0x44, 0x06, 0x9a,
+	0xbd, 0x38, 0x55, 0x31, 0xb0, 0xac, 0xc9, 0xd6, 0xf4, 0xfd, 0xd9, 0x86, 0x5b, 0xd1, 0xbc, 0xf4,
+	0x3d, 0xaa, 0x8a, 0x03, 0xa4, 0x49, 0xbb, 0x09, 0x45, 0x78, 0xec, 0x39, 0x71, 0x3d, 0x51, 0xd5,
+	0xe8, 0xc2, 0x20, 0x1e, 0x0b, 0x87, 0xf2, 0x3d, 0x3f, 0x74, 0xa3, 0x7b, 0x6e, 0x40, 0x3e, 0xd3,
+	0x15, 0xc1, 0x42, 0x82, 0xf7, 0x04, 0x2c, 0x02, 0xe5, 0xf3, 0x11, 0xd3, 0x25, 0x80, 0xf8, 0x4c,
+	0x2c, 0xee, 0xba, 0xe1, 0x38, 0xda, 0x47, 0x3d, 0x65, 0xf1, 0x14, 0x9e, 0xed, 0x1e, 0x0b, 0xd9,
+	0xee, 0x21, 0xae, 0x10, 0xef, 0x34, 0x20, 0xec, 0x1c, 0x9f, 0xba, 0xea, 0xee, 0x2f, 0x5b, 0x29,
+	0x04, 0xbd, 0x11, 0x65, 0xc8, 0xc5, 0x56, 0x3e, 0x52, 0x70, 0x12, 0x4a, 0x51, 0x02, 0xde, 0x87,
+	0xda, 0x54, 0xc8, 0x67, 0xc4, 0xfa, 0x5a, 0x12, 0x9d, 0xa9, 0x26, 0x2c, 0x3d, 0x2b, 0x8e, 0xd7,
+	0xb5, 0xa7, 0xd0, 0x48, 0x77, 0x67, 0xb2, 0xff, 0x35, 0xe1, 0xb5, 0xe3, 0xc3, 0xbd, 0x8e, 0xd5,
+	0xef, 0xee, 0xec, 0xf5, 0x8e, 0x8e, 0xbb, 0xfd, 0x93, 0x4f, 0x8e, 0xba, 0xfd, 0x27, 0x07, 0xc7,
+	0x47, 0xdd, 0x9d, 0xde, 0x0f, 0x7a, 0xdd, 0xdd, 0xc6, 0xff, 0xa1, 0x3a, 0xc0, 0xc1, 0x61, 0xc4,
+	0xd0, 0x30, 0x50, 0x15, 0x4a, 0x47, 0x1d, 0xeb, 0xa4, 0xd7, 0xd9, 0x6b, 0xe4, 0xc4, 0xa0, 0x73,
+	0x70, 0xf0, 0x64, 0xaf, 0x63, 0x35, 0xf2, 0xa8, 0x02, 0x73, 0x27, 0x87, 0x27, 0x9d, 0xbd, 0x46,
+	0x61, 0xed, 0xc7, 0xba, 0x83, 0x8c, 0x7a, 0x29, 0x74, 0x0f, 0xee, 0x2a, 0x49, 0x27, 0xbd, 0xfd,
+	0x6e, 0xff, 0xa3, 0xde, 0xc1, 0xee, 0x8c, 0x98, 0x5b, 0xb0, 0xb0, 0xdf, 0xed, 0x1c, 0xf4, 0x13,
+	0xae, 0x86, 0x81, 0xee, 0xc0, 0xad, 0xce, 0xd1, 0x51, 0xc7, 0xea, 0x1e, 0x9c, 0xa4, 0x09, 0xb9,
+	0xb5, 0x33, 0x58, 0xbc, 0x56, 0x74, 0xa0, 0xfb, 0x70, 0x4f, 0x31, 0x1d, 0x1d, 0x1e, 0xf7, 0x4e,
+	0x7a, 0x87, 0x07, 0x59, 0x72, 0xe6, 0xa1, 0x1c, 0x2d, 0xd9, 0x30, 0x50, 0x0d, 0x2a, 0x8f, 0xbb,
+	0x87, 0xfb, 0xdd, 0x13, 0xab, 0xb7, 0xd3, 0xc8, 0xa1, 0x05, 0xa8, 0x76, 0x8e, 0x4f, 0xac, 0x08,
+	0xc8, 0x6f, 0xfd, 0xb3, 0x24, 0x1f, 0xbd, 0x8e, 0x09, 0x3b, 0x77, 0x6c, 0x82, 0x6c, 0x80, 0xc7,
+	0x84, 0xeb, 0x77, 0x6e, 0x84, 0x74, 0x60, 0xa4, 0x7e, 0x63, 0x58, 0x59, 0x48, 0x61, 0xb2, 0x69,
+	0x7a, 0xf8, 0xb3, 0x3f, 0xfe, 0xf9, 0x57, 0xb9, 0x35, 0xb4, 0x7a, 0xbe, 0xd9, 0x0e, 0x14, 0xde,
+	0xbe, 0x8c, 0x83, 0xf0, 0xaa, 0x7d, 0x19, 0xa5, 0xdf, 0xab, 0xf6, 0xa5, 0xb8, 0x3e, 0xaf, 0xd0,
+	0x04, 0x2a, 0x42, 0x88, 0x7a, 0xd4, 0x54, 0x6d, 0x69, 0xfa, 0x99, 0x7b, 0x05, 0x12, 0xc8, 0xdc,
+	0x97, 0xab, 0x3f, 0x46, 0x5d, 0xb1, 0xba, 0x84, 0x6e, 0x5c, 0x5c, 0xa4, 0xf3, 0xab, 0xf6, 0xa5,
+	0x4c, 0x1e, 0x52, 0xd6, 0xe4, 0xaa, 0x7d, 0x29, 0x3c, 0x4d, 0xfc, 0x93, 0xcf, 0x5c, 0x57, 0xe8,
+	0xf7, 0x06, 0x34, 0x84, 0xec, 0xa9, 0xe6, 0xff, 0xda, 0x53, 0x43, 0xb4, 0x91, 0xc5, 0x59, 0x42,
+	0x60, 0x5e, 0xc8, 0xfd, 0x3c, 0x43, 0x9e, 0xd8, 0x8f, 0xa0, 0x44, 0x4f, 0x00, 0x37, 0x6e, 0x2b,
+	0x79, 0x57, 0x8b, 0x07, 0xd1, 0x16, 0xe3, 0x27, 0xb3, 0xab, 0xf6, 0x65, 0xf4, 0x42, 0xa6, 0x3f,
+	0x23, 0x16, 0x9d, 0xf1, 0xae, 0xd0, 0x53, 0x58, 0x8c, 0x37, 0x1e, 0xb7, 0x7f, 0xaf, 0x24, 0x1b,
+	0x9c, 0xe9, 0x58, 0x57, 0xd0, 0x75, 0x92, 0xf9, 0x40, 0x6e, 0xfe, 0x75, 0x74, 0x2f, 0xde, 0x7c,
+	0x44, 0x6a, 0x5f, 0xa6, 0x9a, 0xc6, 0x2b, 0xf4, 0x53, 0x98, 0x7f, 0x4c, 0x78, 0x52, 0x64, 0x2d,
+	0x4d, 0x5f, 0xf5, 0x5a, 0xc4, 0xed, 0x29, 0x34, 0x2e, 0x68, 0xcc, 0x0f, 0xa4, 0x98, 0xf7, 0xd1,
+	0x7b, 0xe7, 0x9b, 0xed, 0x01, 0x9e, 0xa8, 0x12, 0xe8, 0xeb, 0x98, 0x0d, 0x3d, 0x93, 0xf2, 0x93,
+	0xd7, 0x86, 0xa5, 0xa9, 0xb7, 0x8b, 0x48, 0x7e, 0x6d, 0x0a, 0x35, 0xbf, 0x27, 0xc5, 0xbe, 0x8b,
+	0xb6, 0xa3, 0xd3, 0x89, 0x2b, 0x79, 0x5a, 0xec, 0xcd, 0x2e, 0x82, 0x26, 0xd2, 0x31, 0x9e, 0x4c,
+	0xbd, 0x4d, 0x7c, 0x25, 0xb1, 0xe9, 0xd3, 0xc6, 0x4f, 0x1b, 0x5f, 0x4b, 0xf4, 0x85, 0x14, 0x3d,
+	0x9d, 0x0a, 0x9b, 0xb3, 0x79, 0x2e, 0x98, 0x72, 0xca, 0x29, 0x8a, 0xf9, 0xae, 0xdc, 0xc2, 0x43,
+	0x61, 0xf2, 0xf6, 0x58, 0x52, 0x74, 0x4e, 0x7c, 0xbe, 0xd2, 0x3f, 0xfc, 0x97, 0xf1, 0xcb, 0xce,
+	0xdf, 0x0d, 0xeb, 0xbb, 0x90, 0xdf, 0x7e, 0xb8, 0x8d, 0xb6, 0x61, 0xcd, 0x22, 0x3c, 0x64, 0x94,
+	0x0c, 0x5a, 0x17, 0x23, 0x42, 0x5b, 0x7c, 0x44, 0x5a, 0x8c, 0x04, 0x5e, 0xc8, 0x6c, 0xd2, 0x1a,
+	0x78, 0x24, 0x68, 0x51, 0x8f, 0xb7, 0xc8, 0x67, 0x4e, 0xc0, 0x37, 0x50, 0x11, 0x0a, 0xbf, 0xc9,
+	0x19, 0x25, 0xf4, 0x85, 0x61, 0xfe, 0x64, 0xe5, 0x4e, 0x30, 0xc2, 0x94, 0x7c, 0x20, 0xff, 0x0a,
+	0xc9, 0xa2, 0x39, 0xdf, 0xb0, 0xbd, 0x31, 0xb4, 0x87, 0xde, 0xfa, 0x90, 0xf9, 0xf6, 0xfa, 0x88,
+	0x73, 0x7f, 0x9d, 0x91, 0x80, 0xaf, 0x8f, 0x1d, 0x51, 0xf5, 0xa9, 0x7c, 0xb3, 0xce, 0x43, 0xee,
+	0x31, 0x07, 0xbb, 0x2d, 0x9f, 0x79, 0x9f, 0x12, 0x9b, 0xa3, 0x87, 0x82, 0x31, 0x78, 0xbf, 0xdd,
+	0x1e, 0x3a, 0x7c, 0x14, 0x9e, 0x8a, 0x45, 0xda, 0x53, 0xcb, 0xb6, 0x7d, 0x17, 0x53, 0xc2, 0x7d,
+	0x9d, 0x17, 0x03, 0xf9, 0xab, 0x5c, 0x4b, 0xaf, 0xb7, 0x95, 0xdf, 0xdc, 0x78, 0xb8, 0x66, 0x18,
+	0x5b, 0x0d, 0xec, 0xfb, 0xae, 0x63, 0xcb, 0xab, 0xad, 0xfd, 0x69, 0xe0, 0xd1, 0xf7, 0xaf, 0x21,
+	0xa7, 0x45, 0xd9, 0x8d, 0x3c, 0xfa, 0xf7, 0x00, 0x63, 0x2d, 0xf7, 0x71, 0xc4, 0x1d, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SunServiceClient is the client API for SunService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SunServiceClient interface {
+	// Get sunrise
+	GetSunrise(ctx context.Context, in *SunriseRequest, opts ...grpc.CallOption) (*SunriseTime, error)
+	// Get the shadow cast by an object
+	GetShadow(ctx context.Context, in *ShadowRequest, opts ...grpc.CallOption) (*Shadow, error)
+	// Get the solar eclipses in a date range
+	GetSolarEclipses(ctx context.Context, in *SolarEclipseRequest, opts ...grpc.CallOption) (*SolarEclipses, error)
+	// Get the intermediate quantities of the solar ephemeris
+	GetSolarEphemeris(ctx context.Context, in *SolarEphemerisRequest, opts ...grpc.CallOption) (*SolarEphemeris, error)
+	// Get day length analytics
+	GetDayLength(ctx context.Context, in *DayLengthRequest, opts ...grpc.CallOption) (*DayLengthAnalytics, error)
+	// Convert universal time to local mean and apparent solar time
+	GetSolarTime(ctx context.Context, in *SolarTimeRequest, opts ...grpc.CallOption) (*SolarTime, error)
+	// Convert local mean or apparent solar time to universal time
+	GetUniversalTime(ctx context.Context, in *SolarTimeRequest, opts ...grpc.CallOption) (*SolarTime, error)
+	// Get the peaks of the meteor showers in a year with the altitude of
+	// their radiants and the interference of moonlight
+	GetMeteorShowers(ctx context.Context, in *MeteorShowersRequest, opts ...grpc.CallOption) (*MeteorShowers, error)
+}
+
+type sunServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSunServiceClient(cc *grpc.ClientConn) SunServiceClient {
+	return &sunServiceClient{cc}
+}
+
+func (c *sunServiceClient) GetSunrise(ctx context.Context, in *SunriseRequest, opts ...grpc.CallOption) (*SunriseTime, error) {
+	out := new(SunriseTime)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetSunrise", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetShadow(ctx context.Context, in *ShadowRequest, opts ...grpc.CallOption) (*Shadow, error) {
+	out := new(Shadow)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetShadow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetSolarEclipses(ctx context.Context, in *SolarEclipseRequest, opts ...grpc.CallOption) (*SolarEclipses, error) {
+	out := new(SolarEclipses)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetSolarEclipses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetSolarEphemeris(ctx context.Context, in *SolarEphemerisRequest, opts ...grpc.CallOption) (*SolarEphemeris, error) {
+	out := new(SolarEphemeris)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetSolarEphemeris", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetDayLength(ctx context.Context, in *DayLengthRequest, opts ...grpc.CallOption) (*DayLengthAnalytics, error) {
+	out := new(DayLengthAnalytics)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetDayLength", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetSolarTime(ctx context.Context, in *SolarTimeRequest, opts ...grpc.CallOption) (*SolarTime, error) {
+	out := new(SolarTime)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetSolarTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetUniversalTime(ctx context.Context, in *SolarTimeRequest, opts ...grpc.CallOption) (*SolarTime, error) {
+	out := new(SolarTime)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetUniversalTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sunServiceClient) GetMeteorShowers(ctx context.Context, in *MeteorShowersRequest, opts ...grpc.CallOption) (*MeteorShowers, error) {
+	out := new(MeteorShowers)
+	err := c.cc.Invoke(ctx, "/v1.SunService/GetMeteorShowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SunServiceServer is the server API for SunService service.
+type SunServiceServer interface {
+	// Get sunrise
+	GetSunrise(context.Context, *SunriseRequest) (*SunriseTime, error)
+	// Get the shadow cast by an object
+	GetShadow(context.Context, *ShadowRequest) (*Shadow, error)
+	// Get the solar eclipses in a date range
+	GetSolarEclipses(context.Context, *SolarEclipseRequest) (*SolarEclipses, error)
+	// Get the intermediate quantities of the solar ephemeris
+	GetSolarEphemeris(context.Context, *SolarEphemerisRequest) (*SolarEphemeris, error)
+	// Get day length analytics
+	GetDayLength(context.Context, *DayLengthRequest) (*DayLengthAnalytics, error)
+	// Convert universal time to local mean and apparent solar time
+	GetSolarTime(context.Context, *SolarTimeRequest) (*SolarTime, error)
+	// Convert local mean or apparent solar time to universal time
+	GetUniversalTime(context.Context, *SolarTimeRequest) (*SolarTime, error)
+	// Get the peaks of the meteor showers in a year with the altitude of
+	// their radiants and the interference of moonlight
+	GetMeteorShowers(context.Context, *MeteorShowersRequest) (*MeteorShowers, error)
+}
+
+func RegisterSunServiceServer(s *grpc.Server, srv SunServiceServer) {
+	s.RegisterService(&_SunService_serviceDesc, srv)
+}
+
+func _SunService_GetSunrise_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SunriseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetSunrise(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetSunrise",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetSunrise(ctx, req.(*SunriseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetShadow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShadowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetShadow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetShadow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetShadow(ctx, req.(*ShadowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetSolarEclipses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolarEclipseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetSolarEclipses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetSolarEclipses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetSolarEclipses(ctx, req.(*SolarEclipseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetSolarEphemeris_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolarEphemerisRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetSolarEphemeris(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetSolarEphemeris",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetSolarEphemeris(ctx, req.(*SolarEphemerisRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetDayLength_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DayLengthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetDayLength(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetDayLength",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetDayLength(ctx, req.(*DayLengthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetSolarTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolarTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetSolarTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetSolarTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetSolarTime(ctx, req.(*SolarTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetUniversalTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SolarTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetUniversalTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetUniversalTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetUniversalTime(ctx, req.(*SolarTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SunService_GetMeteorShowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MeteorShowersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SunServiceServer).GetMeteorShowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SunService/GetMeteorShowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SunServiceServer).GetMeteorShowers(ctx, req.(*MeteorShowersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SunService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "v1.SunService",
+	HandlerType: (*SunServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetSunrise",
+			Handler:    _SunService_GetSunrise_Handler,
+		},
+		{
+			MethodName: "GetShadow",
+			Handler:    _SunService_GetShadow_Handler,
+		},
+		{
+			MethodName: "GetSolarEclipses",
+			Handler:    _SunService_GetSolarEclipses_Handler,
+		},
+		{
+			MethodName: "GetSolarEphemeris",
+			Handler:    _SunService_GetSolarEphemeris_Handler,
+		},
+		{
+			MethodName: "GetDayLength",
+			Handler:    _SunService_GetDayLength_Handler,
+		},
+		{
+			MethodName: "GetSolarTime",
+			Handler:    _SunService_GetSolarTime_Handler,
+		},
+		{
+			MethodName: "GetUniversalTime",
+			Handler:    _SunService_GetUniversalTime_Handler,
+		},
+		{
+			MethodName: "GetMeteorShowers",
+			Handler:    _SunService_GetMeteorShowers_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -7,6 +7,7 @@ import (
 
 	"planetpositions/sun/grpc/v1"
 
+	"github.com/golang/protobuf/ptypes/wrappers"
 	"google.golang.org/grpc"
 )
 
@@ -27,19 +28,168 @@ func (s *SunClient) newConnection() (v1.SunServiceClient, *grpc.ClientConn) {
 }
 
 // GetSunrise -
-func (s *SunClient) GetSunrise(long, lat float64, year, month, day int32) (*v1.SunriseTime, error) {
+func (s *SunClient) GetSunrise(long, lat float64, year, month, day int32, refraction string, temperature, pressure *wrappers.DoubleValue, highPrecision bool) (*v1.SunriseTime, error) {
 	c, conn := s.newConnection()
 	defer conn.Close()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
 	req := v1.SunriseRequest{
+		Api:           "v1",
+		Longitude:     long,
+		Latitude:      lat,
+		Year:          year,
+		Month:         month,
+		Day:           day,
+		Refraction:    refraction,
+		Temperature:   temperature,
+		Pressure:      pressure,
+		HighPrecision: highPrecision,
+	}
+	return c.GetSunrise(ctx, &req)
+}
+
+// GetShadow -
+func (s *SunClient) GetShadow(long, lat float64, year, month, day int32, hour, height, width, depth, bearing float64, refraction string, temperature, pressure *wrappers.DoubleValue, highPrecision bool) (*v1.Shadow, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.ShadowRequest{
+		Api:              "v1",
+		Longitude:        long,
+		Latitude:         lat,
+		Year:             year,
+		Month:            month,
+		Day:              day,
+		Hour:             hour,
+		Height:           height,
+		FootprintWidth:   width,
+		FootprintDepth:   depth,
+		FootprintBearing: bearing,
+		Refraction:       refraction,
+		Temperature:      temperature,
+		Pressure:         pressure,
+		HighPrecision:    highPrecision,
+	}
+	return c.GetShadow(ctx, &req)
+}
+
+// GetSolarEclipses -
+func (s *SunClient) GetSolarEclipses(long, lat, height float64, startYear, startMonth, startDay, endYear, endMonth, endDay int32) (*v1.SolarEclipses, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	// Long date ranges take a while to search
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	req := v1.SolarEclipseRequest{
+		Api:        "v1",
+		Longitude:  long,
+		Latitude:   lat,
+		Height:     height,
+		StartYear:  startYear,
+		StartMonth: startMonth,
+		StartDay:   startDay,
+		EndYear:    endYear,
+		EndMonth:   endMonth,
+		EndDay:     endDay,
+	}
+	return c.GetSolarEclipses(ctx, &req)
+}
+
+// GetSolarTime -
+func (s *SunClient) GetSolarTime(long float64, year, month, day int32, hour float64, highPrecision bool) (*v1.SolarTime, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.SolarTimeRequest{
+		Api:           "v1",
+		Longitude:     long,
+		Year:          year,
+		Month:         month,
+		Day:           day,
+		Hour:          hour,
+		HighPrecision: highPrecision,
+	}
+	return c.GetSolarTime(ctx, &req)
+}
+
+// GetUniversalTime -
+func (s *SunClient) GetUniversalTime(long float64, year, month, day int32, hour float64, kind v1.SolarTimeKind, highPrecision bool) (*v1.SolarTime, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.SolarTimeRequest{
+		Api:           "v1",
+		Longitude:     long,
+		Year:          year,
+		Month:         month,
+		Day:           day,
+		Hour:          hour,
+		Kind:          kind,
+		HighPrecision: highPrecision,
+	}
+	return c.GetUniversalTime(ctx, &req)
+}
+
+// GetSolarEphemeris -
+func (s *SunClient) GetSolarEphemeris(julianDate float64, highPrecision bool, kind v1.SolarPositionKind) (*v1.SolarEphemeris, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.SolarEphemerisRequest{
+		Api:           "v1",
+		JulianDate:    julianDate,
+		HighPrecision: highPrecision,
+		Kind:          kind,
+	}
+	return c.GetSolarEphemeris(ctx, &req)
+}
+
+// GetDayLength -
+func (s *SunClient) GetDayLength(long, lat float64, year, month, day int32, target float64, refraction string, temperature, pressure *wrappers.DoubleValue, highPrecision bool) (*v1.DayLengthAnalytics, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.DayLengthRequest{
+		Api:           "v1",
+		Longitude:     long,
+		Latitude:      lat,
+		Year:          year,
+		Month:         month,
+		Day:           day,
+		Target:        target,
+		Refraction:    refraction,
+		Temperature:   temperature,
+		Pressure:      pressure,
+		HighPrecision: highPrecision,
+	}
+	return c.GetDayLength(ctx, &req)
+}
+
+// GetMeteorShowers -
+func (s *SunClient) GetMeteorShowers(long, lat float64, year int32, showers []string) (*v1.MeteorShowers, error) {
+	c, conn := s.newConnection()
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	req := v1.MeteorShowersRequest{
 		Api:       "v1",
 		Longitude: long,
 		Latitude:  lat,
 		Year:      year,
-		Month:     month,
-		Day:       day,
+		Showers:   showers,
 	}
-	return c.GetSunrise(ctx, &req)
+	return c.GetMeteorShowers(ctx, &req)
 }
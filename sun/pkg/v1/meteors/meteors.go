@@ -0,0 +1,109 @@
+// Package meteors holds the working list of visual meteor showers of the
+// International Meteor Organization and the measures used to plan watching
+// them.
+//
+// The list gives the peak of each shower as the solar longitude referred to
+// the equinox of J2000.0, so that a peak falls at the same point of the
+// Earth's orbit every year, with the radiant at the peak, the speed of the
+// meteors and the zenithal hourly rate (ZHR). It is embedded in the binary so
+// the service needs no data files. Showers whose rates vary widely from year
+// to year are listed with their usual rate.
+package meteors
+
+import (
+	"math"
+	"strings"
+)
+
+// Date is a day of the year
+type Date struct {
+	Month int
+	Day   int
+}
+
+// Shower is an entry in the working list
+type Shower struct {
+	// Three letter code and number of the IAU Meteor Data Center
+	Code   string
+	Number int
+	Name   string
+	// First and last days of activity, the activity of a shower that spans
+	// the new year begins in the previous year
+	Begin Date
+	End   Date
+	// Solar longitude of the peak, referred to the equinox of J2000.0, in
+	// degrees
+	PeakLongitude float64
+	// Right ascension and declination of the radiant at the peak, for the
+	// equinox of J2000.0, in degrees
+	RightAscension float64
+	Declination    float64
+	// Speed at which the meteors meet the Earth, in km/s
+	Velocity float64
+	// Population index, the ratio of the number of meteors of one magnitude
+	// to that of the magnitude brighter
+	PopulationIndex float64
+	// Zenithal hourly rate at the peak
+	ZHR float64
+}
+
+// j2000 is the Julian date of the standard epoch
+const j2000 = 2451545.0
+
+// precessionRate is the general precession in longitude, in degrees a
+// Julian century
+const precessionRate = 5028.796195 / 3600
+
+// Showers returns the working list in order of the date of the peak, from
+// the start of the year
+func Showers() []Shower {
+	return append([]Shower{}, showers...)
+}
+
+// Find returns the shower with the given code or name, ignoring case, spaces
+// and hyphens
+func Find(name string) (Shower, bool) {
+	key := nameKey(name)
+	for _, s := range showers {
+		if nameKey(s.Code) == key || nameKey(s.Name) == key {
+			return s, true
+		}
+	}
+	return Shower{}, false
+}
+
+func nameKey(name string) string {
+	return strings.NewReplacer(" ", "", "-", "").Replace(strings.ToLower(name))
+}
+
+// PeakLongitudeOfDate returns the solar longitude of the peak referred to the
+// mean equinox of the Julian ephemeris day jde, for comparison with the
+// apparent longitude of the sun
+func (s Shower) PeakLongitudeOfDate(jde float64) float64 {
+	return normalise(s.PeakLongitude + precessionRate*(jde-j2000)/36525)
+}
+
+// MoonInterference scores how much the Moon brightens the sky, from 0 when
+// it is below the horizon or new to 1 when it is full at the zenith. It is
+// the illuminated fraction of the disc times the sine of the Moon's
+// altitude, in degrees, as the moonlight scattered across the sky grows with
+// both.
+func MoonInterference(illuminated, altitude float64) float64 {
+	if altitude <= 0 {
+		return 0
+	}
+	return illuminated * math.Sin(degreesToRadians(altitude))
+}
+
+func degreesToRadians(angleDeg float64) float64 {
+	return math.Pi * angleDeg / 180.0
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
@@ -0,0 +1,62 @@
+package meteors_test
+
+import (
+	"testing"
+
+	"planetpositions/sun/pkg/v1/meteors"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFind(t *testing.T) {
+	testcases := map[string]struct {
+		name string
+		code string
+		ok   bool
+	}{
+		"Code":              {name: "per", code: "PER", ok: true},
+		"Name":              {name: "Geminids", code: "GEM", ok: true},
+		"Name with hyphens": {name: "eta aquariids", code: "ETA", ok: true},
+		"Unknown":           {name: "Vulcanids"},
+	}
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			s, ok := meteors.Find(tc.name)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.code, s.Code)
+		})
+	}
+}
+
+func TestShowers(t *testing.T) {
+	codes := map[string]bool{}
+	for _, s := range meteors.Showers() {
+		assert.False(t, codes[s.Code], s.Code)
+		codes[s.Code] = true
+		assert.True(t, s.PeakLongitude >= 0 && s.PeakLongitude < 360, s.Code)
+		assert.True(t, s.RightAscension >= 0 && s.RightAscension < 360, s.Code)
+		assert.True(t, s.Declination >= -90 && s.Declination <= 90, s.Code)
+		assert.True(t, s.ZHR > 0, s.Code)
+	}
+	// The copy returned cannot change the list
+	list := meteors.Showers()
+	list[0].ZHR = 0
+	assert.NotEqual(t, 0.0, meteors.Showers()[0].ZHR)
+}
+
+func TestPeakLongitudeOfDate(t *testing.T) {
+	perseids, _ := meteors.Find("PER")
+	assert.InDelta(t, 140, perseids.PeakLongitudeOfDate(2451545.0), 1e-9)
+	// Precession carries the equinox back by almost 1.4 degrees a century
+	assert.InDelta(t, 141.3969, perseids.PeakLongitudeOfDate(2451545.0+36525), 1e-4)
+
+	quadrantids, _ := meteors.Find("QUA")
+	assert.InDelta(t, 283.15-1.3969, quadrantids.PeakLongitudeOfDate(2451545.0-36525), 1e-4)
+}
+
+func TestMoonInterference(t *testing.T) {
+	assert.Equal(t, 0.0, meteors.MoonInterference(1, -5))
+	assert.Equal(t, 0.0, meteors.MoonInterference(0, 60))
+	assert.InDelta(t, 1, meteors.MoonInterference(1, 90), 1e-12)
+	assert.InDelta(t, 0.25, meteors.MoonInterference(0.5, 30), 1e-12)
+}
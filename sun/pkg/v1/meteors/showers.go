@@ -0,0 +1,35 @@
+package meteors
+
+// showers is the working list: code, number, name, first and last days of
+// activity, solar longitude of the peak, radiant right ascension and
+// declination in degrees, speed in km/s, population index and ZHR
+var showers = []Shower{
+	{"QUA", 10, "Quadrantids", Date{12, 28}, Date{1, 12}, 283.15, 230, 49, 41, 2.1, 80},
+	{"ACE", 102, "alpha-Centaurids", Date{1, 31}, Date{2, 20}, 319.2, 210, -59, 56, 2.0, 6},
+	{"GNO", 118, "gamma-Normids", Date{2, 25}, Date{3, 28}, 354, 239, -50, 56, 2.4, 6},
+	{"LYR", 6, "April Lyrids", Date{4, 14}, Date{4, 30}, 32.32, 271, 34, 49, 2.1, 18},
+	{"ETA", 31, "eta-Aquariids", Date{4, 19}, Date{5, 28}, 45.5, 338, -1, 66, 2.4, 50},
+	{"ELY", 145, "eta-Lyrids", Date{5, 3}, Date{5, 14}, 50.0, 287, 44, 43, 3.0, 3},
+	{"PAU", 183, "Piscis Austrinids", Date{7, 15}, Date{8, 10}, 125, 341, -30, 35, 3.2, 5},
+	{"SDA", 5, "Southern delta-Aquariids", Date{7, 12}, Date{8, 23}, 127, 340, -16, 41, 2.5, 25},
+	{"CAP", 1, "alpha-Capricornids", Date{7, 3}, Date{8, 15}, 127, 307, -10, 23, 2.5, 5},
+	{"PER", 7, "Perseids", Date{7, 17}, Date{8, 24}, 140.0, 48, 58, 59, 2.2, 100},
+	{"KCG", 12, "kappa-Cygnids", Date{8, 3}, Date{8, 25}, 145, 286, 59, 25, 3.0, 3},
+	{"AUR", 206, "Aurigids", Date{8, 28}, Date{9, 5}, 158.6, 91, 39, 66, 2.5, 6},
+	{"SPE", 208, "September epsilon-Perseids", Date{9, 5}, Date{9, 21}, 167, 48, 40, 64, 3.0, 5},
+	{"DRA", 9, "Draconids", Date{10, 6}, Date{10, 10}, 195.4, 262, 54, 20, 2.6, 10},
+	{"STA", 2, "Southern Taurids", Date{9, 10}, Date{11, 20}, 197, 32, 9, 27, 2.3, 5},
+	{"EGE", 23, "epsilon-Geminids", Date{10, 14}, Date{10, 27}, 205, 102, 27, 70, 3.0, 3},
+	{"ORI", 8, "Orionids", Date{10, 2}, Date{11, 7}, 208, 95, 16, 66, 2.5, 20},
+	{"LMI", 22, "Leonis Minorids", Date{10, 19}, Date{10, 27}, 211, 162, 37, 62, 3.0, 2},
+	{"NTA", 17, "Northern Taurids", Date{10, 20}, Date{12, 10}, 230, 58, 22, 29, 2.3, 5},
+	{"LEO", 13, "Leonids", Date{11, 6}, Date{11, 30}, 235.27, 152, 22, 71, 2.5, 15},
+	{"NOO", 250, "November Orionids", Date{11, 13}, Date{12, 6}, 248, 91, 16, 41, 3.0, 3},
+	{"PUP", 301, "Puppid-Velids", Date{12, 1}, Date{12, 15}, 255, 123, -45, 40, 2.9, 10},
+	{"MON", 19, "Monocerotids", Date{12, 5}, Date{12, 20}, 257, 100, 8, 41, 3.0, 2},
+	{"GEM", 4, "Geminids", Date{12, 4}, Date{12, 20}, 262.2, 112, 33, 35, 2.6, 150},
+	{"HYD", 16, "sigma-Hydrids", Date{12, 3}, Date{12, 20}, 265.5, 125, 2, 58, 3.0, 7},
+	{"COM", 20, "Comae Berenicids", Date{12, 12}, Date{12, 23}, 268, 175, 18, 65, 3.0, 3},
+	{"DLM", 32, "December Leonis Minorids", Date{12, 5}, Date{2, 4}, 268, 161, 30, 64, 3.0, 5},
+	{"URS", 15, "Ursids", Date{12, 17}, Date{12, 26}, 270.7, 217, 76, 33, 3.0, 10},
+}
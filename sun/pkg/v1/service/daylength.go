@@ -0,0 +1,133 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/sun/grpc/v1"
+)
+
+func isLeapYear(year int32) bool {
+	return (year%4 == 0 && year%100 != 0) || year%400 == 0
+}
+
+// riseSetHourAngle returns the hour angle of the sun at sunrise and sunset
+// in degrees, 0 when the sun does not rise and 180 when it does not set,
+// when the centre of the sun is at the altitude at sunrise and sunset
+func riseSetHourAngle(latitude, declination, altitude float64) float64 {
+	latRad := degreesToRadians(latitude)
+	sdRad := degreesToRadians(declination)
+
+	cosH := (math.Sin(degreesToRadians(altitude)) - math.Sin(latRad)*math.Sin(sdRad)) / (math.Cos(latRad) * math.Cos(sdRad))
+	if cosH >= 1 {
+		return 0
+	}
+	if cosH <= -1 {
+		return 180
+	}
+	return radiansToDegrees(math.Acos(cosH))
+}
+
+// DayLength - altitude is that of the centre of the sun at sunrise and sunset
+func (s *sunServiceServer) DayLength(jd, latitude, longitude, altitude float64, precise bool) float64 {
+	// jd is the start of the day in universal time, longitude is positive
+	// east of Greenwich
+	approxNoon := jd + 0.5 - longitude/360.0
+	noon := approxNoon - s.equationOfTime(julianCentury(approxNoon), precise)/1440.0
+
+	// Refine the hour angles using the declination at sunrise and sunset
+	// rather than at noon, the declination changes by up to 0.4 degrees a
+	// day near the equinoxes
+	h := riseSetHourAngle(latitude, s.declination(julianCentury(noon), precise), altitude)
+	rise, set := h, h
+	for i := 0; i < 2; i++ {
+		rise = riseSetHourAngle(latitude, s.declination(julianCentury(noon-rise/360.0), precise), altitude)
+		set = riseSetHourAngle(latitude, s.declination(julianCentury(noon+set/360.0), precise), altitude)
+	}
+	return (rise + set) / 15.0 // In Hours
+}
+
+func (s *sunServiceServer) GetDayLength(ctx context.Context, req *v1.DayLengthRequest) (*v1.DayLengthAnalytics, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	if req.Target < 0 || req.Target > 24 {
+		return nil, fmt.Errorf("unusable input provided: target must be between 0 and 24 hours")
+	}
+	altitude, err := riseSetAltitude(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	today, err := s.julianDate(req.Year, req.Month, req.Day, 0)
+	if err != nil {
+		return nil, err
+	}
+	jan1 := today - float64(calcDayOfYear(req.Month, req.Day, isLeapYear(req.Year))-1)
+	days := 365
+	if isLeapYear(req.Year) {
+		days = 366
+	}
+
+	analytics := &v1.DayLengthAnalytics{Api: apiVersion}
+	if analytics.Today, err = s.dayLength(today, req.Latitude, req.Longitude, altitude, req.HighPrecision); err != nil {
+		return nil, err
+	}
+	if analytics.Yesterday, err = s.dayLength(today-1, req.Latitude, req.Longitude, altitude, req.HighPrecision); err != nil {
+		return nil, err
+	}
+	analytics.Change = (analytics.Today.Hours - analytics.Yesterday.Hours) * 3600
+
+	// Scan the calendar year for the extremes and target crossings
+	shortest, longest := jan1, jan1
+	lengths := make([]float64, days)
+	for i := range lengths {
+		jd := jan1 + float64(i)
+		lengths[i] = s.DayLength(jd, req.Latitude, req.Longitude, altitude, req.HighPrecision)
+		if lengths[i] < lengths[int(shortest-jan1)] {
+			shortest = jd
+		}
+		if lengths[i] > lengths[int(longest-jan1)] {
+			longest = jd
+		}
+
+		if req.Target > 0 && i > 0 && (lengths[i-1] < req.Target) != (lengths[i] < req.Target) {
+			date, err := s.instant(jd)
+			if err != nil {
+				return nil, err
+			}
+			analytics.Crossings = append(analytics.Crossings, &v1.DayLengthCrossing{
+				Date:        date,
+				Hours:       lengths[i],
+				Lengthening: lengths[i] > lengths[i-1],
+			})
+		}
+	}
+	if analytics.Shortest, err = s.dayLength(shortest, req.Latitude, req.Longitude, altitude, req.HighPrecision); err != nil {
+		return nil, err
+	}
+	if analytics.Longest, err = s.dayLength(longest, req.Latitude, req.Longitude, altitude, req.HighPrecision); err != nil {
+		return nil, err
+	}
+	return analytics, nil
+}
+
+func (s *sunServiceServer) dayLength(jd, latitude, longitude, altitude float64, precise bool) (*v1.DayLength, error) {
+	date, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.DayLength{
+		Date:  date,
+		Hours: s.DayLength(jd, latitude, longitude, altitude, precise),
+	}, nil
+}
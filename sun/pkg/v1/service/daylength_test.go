@@ -0,0 +1,99 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetDayLength(t *testing.T) {
+	// Published day lengths for 2020, sunrise to sunset with the centre of
+	// the sun 50' below the horizon
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	const minute = 1.0 / 60
+
+	tests := []struct {
+		name      string
+		latitude  float64
+		longitude float64
+		month     int32
+		day       int32
+		hours     float64
+		tolerance float64
+	}{
+		{"London, June solstice", 51.5074, -0.1278, 6, 20, 16 + 38*minute, minute},
+		{"London, December solstice", 51.5074, -0.1278, 12, 21, 7 + 50*minute, minute},
+		{"London, March equinox", 51.5074, -0.1278, 3, 20, 12 + 12*minute, minute},
+		// Refraction and the sun's semidiameter add 7 minutes to the 12
+		// hours of the equinox
+		{"Equator, March equinox", 0, 0, 3, 20, 12 + 7*minute, minute},
+		{"Tromso, midnight sun", 69.65, 18.96, 6, 21, 24, 0},
+		{"Tromso, polar night", 69.65, 18.96, 12, 21, 0, 0},
+		{"Antarctic Circle, polar night", -70, 0, 6, 21, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.GetDayLength(context.Background(), &v1.DayLengthRequest{
+				Api:       apiVersion,
+				Latitude:  tt.latitude,
+				Longitude: tt.longitude,
+				Year:      2020,
+				Month:     tt.month,
+				Day:       tt.day,
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, tt.hours, res.Today.Hours, tt.tolerance)
+		})
+	}
+}
+
+func TestGetDayLengthAnalytics(t *testing.T) {
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	req := &v1.DayLengthRequest{
+		Api:       apiVersion,
+		Latitude:  51.5074,
+		Longitude: -0.1278,
+		Year:      2020,
+		Month:     3,
+		Day:       20,
+		Target:    12,
+	}
+	res, err := s.GetDayLength(context.Background(), req)
+	require.NoError(t, err)
+
+	// Near the equinox London's days lengthen by about four minutes a day
+	assert.InDelta(t, 240, res.Change, 10)
+	assert.Equal(t, int32(6), res.Longest.Date.Month)
+	assert.Equal(t, int32(20), res.Longest.Date.Day)
+	assert.Equal(t, int32(12), res.Shortest.Date.Month)
+	assert.Equal(t, int32(21), res.Shortest.Date.Day)
+
+	// Day and night are equal a few days before the March equinox and after
+	// the September one
+	require.Len(t, res.Crossings, 2)
+	assert.Equal(t, int32(3), res.Crossings[0].Date.Month)
+	assert.Equal(t, int32(17), res.Crossings[0].Date.Day)
+	assert.True(t, res.Crossings[0].Lengthening)
+	assert.Equal(t, int32(9), res.Crossings[1].Date.Month)
+	assert.Equal(t, int32(25), res.Crossings[1].Date.Day)
+	assert.False(t, res.Crossings[1].Lengthening)
+
+	// The midnight sun has no change from one day to the next
+	req.Latitude, req.Longitude, req.Month, req.Day = 69.65, 18.96, 6, 21
+	res, err = s.GetDayLength(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, 24.0, res.Longest.Hours)
+	assert.Equal(t, 0.0, res.Shortest.Hours)
+	assert.Zero(t, res.Change)
+
+	req.Target = 25
+	_, err = s.GetDayLength(context.Background(), req)
+	assert.Error(t, err)
+}
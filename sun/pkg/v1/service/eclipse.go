@@ -0,0 +1,413 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/moon/pkg/v1/lunar"
+	"planetpositions/sun/grpc/v1"
+)
+
+const (
+	// auInEarthRadii is the astronomical unit in equatorial Earth radii
+	auInEarthRadii = 149597870.7 / 6378.137
+	// sunRadius is the radius of the sun in equatorial Earth radii
+	sunRadius = 696000.0 / 6378.137
+	// moonPenumbralRadius and moonUmbralRadius are the IAU values of the
+	// moon's radius, in equatorial Earth radii, used for the penumbral and
+	// umbral contacts
+	moonPenumbralRadius = 0.2725076
+	moonUmbralRadius    = 0.2722810
+
+	// maxEclipseSearchDays limits the length of the date range searched
+	maxEclipseSearchDays = 36525
+	// eclipseLatitudeLimit is the largest latitude of the moon, in degrees,
+	// at new moon for which a solar eclipse is possible
+	eclipseLatitudeLimit = 1.6
+	// contactStep is the step, in days, used when searching for contacts
+	contactStep = 2.0 / 1440
+)
+
+// besselianElements describe the moon's shadow in the fundamental plane,
+// distances are in equatorial Earth radii and angles in radians
+type besselianElements struct {
+	x, y         float64
+	d, mu        float64
+	l1, l2       float64
+	tanf1, tanf2 float64
+}
+
+// observer is a location on the Earth described by its geocentric
+// coordinates
+type observer struct {
+	longitude float64
+	rhoSin    float64
+	rhoCos    float64
+}
+
+func newObserver(latitude, longitude, height float64) observer {
+	// Meeus, Astronomical Algorithms, chapter 11
+	lat := degreesToRadians(latitude)
+	u := math.Atan(0.99664719 * math.Tan(lat))
+	h := height / 6378140.0
+	return observer{
+		longitude: longitude,
+		rhoSin:    0.99664719*math.Sin(u) + h*math.Sin(lat),
+		rhoCos:    math.Cos(u) + h*math.Cos(lat),
+	}
+}
+
+// localShadow returns the distance of the observer from the shadow axis and
+// the radii of the penumbra and umbra at the observer, in Earth radii, and
+// the altitude of the sun in degrees
+func (b besselianElements) localShadow(o observer) (delta, penumbra, umbra, altitude float64) {
+	h := b.mu + degreesToRadians(o.longitude)
+	xi := o.rhoCos * math.Sin(h)
+	eta := o.rhoSin*math.Cos(b.d) - o.rhoCos*math.Cos(h)*math.Sin(b.d)
+	zeta := o.rhoSin*math.Sin(b.d) + o.rhoCos*math.Cos(h)*math.Cos(b.d)
+
+	delta = math.Hypot(b.x-xi, b.y-eta)
+	penumbra = b.l1 - zeta*b.tanf1
+	umbra = b.l2 - zeta*b.tanf2
+	altitude = radiansToDegrees(math.Asin(zeta / math.Hypot(o.rhoSin, o.rhoCos)))
+	return delta, penumbra, umbra, altitude
+}
+
+// NutationInLongitude -
+func (s *sunServiceServer) NutationInLongitude(t float64) float64 {
+	omega := 125.04 - 1934.136*t
+	return -0.00478 * math.Sin(degreesToRadians(omega)) // In Degrees
+}
+
+// MoonApparentLongitude -
+func (s *sunServiceServer) MoonApparentLongitude(t float64) float64 {
+	long, _, _ := lunar.Position(t)
+	return normalise(long + s.NutationInLongitude(t)) // In Degrees
+}
+
+// besselian returns the Besselian elements at the instant jd, in universal
+// time. deltaT is dynamical time minus universal time in seconds.
+func (s *sunServiceServer) besselian(jd, deltaT float64) besselianElements {
+	t := julianCentury(jd + deltaT/86400)
+
+	// Geocentric equatorial coordinates of the sun
+	sunRA := degreesToRadians(s.SunRightAscension(t))
+	sunDec := degreesToRadians(s.SunDeclination(t))
+	sunDist := s.SunRadiusVector(t) * auInEarthRadii
+
+	// Geocentric equatorial coordinates of the moon
+	_, moonLat, moonDist := lunar.Position(t)
+	moonRA, moonDec := transform.EclipticToEquatorial(s.MoonApparentLongitude(t), moonLat, s.ObliquityCorrection(t))
+	moonRA, moonDec = degreesToRadians(moonRA), degreesToRadians(moonDec)
+	moonDist /= 6378.137
+
+	// The shadow axis runs from the moon towards the sun
+	gx := sunDist*math.Cos(sunDec)*math.Cos(sunRA) - moonDist*math.Cos(moonDec)*math.Cos(moonRA)
+	gy := sunDist*math.Cos(sunDec)*math.Sin(sunRA) - moonDist*math.Cos(moonDec)*math.Sin(moonRA)
+	gz := sunDist*math.Sin(sunDec) - moonDist*math.Sin(moonDec)
+	g := math.Sqrt(gx*gx + gy*gy + gz*gz)
+	a := math.Atan2(gy, gx)
+	d := math.Asin(gz / g)
+
+	// Position of the moon in the fundamental plane
+	x := moonDist * math.Cos(moonDec) * math.Sin(moonRA-a)
+	y := moonDist * (math.Sin(moonDec)*math.Cos(d) - math.Cos(moonDec)*math.Sin(d)*math.Cos(moonRA-a))
+	z := moonDist * (math.Sin(moonDec)*math.Sin(d) + math.Cos(moonDec)*math.Cos(d)*math.Cos(moonRA-a))
+
+	// Angles of the penumbral and umbral cones
+	f1 := math.Asin((sunRadius + moonPenumbralRadius) / g)
+	f2 := math.Asin((sunRadius - moonUmbralRadius) / g)
+
+	return besselianElements{
+		x:     x,
+		y:     y,
+		d:     d,
+		mu:    degreesToRadians(transform.ApparentSiderealTime(jd, jd+deltaT/86400)) - a,
+		l1:    z*math.Tan(f1) + moonPenumbralRadius/math.Cos(f1),
+		l2:    z*math.Tan(f2) - moonUmbralRadius/math.Cos(f2),
+		tanf1: math.Tan(f1),
+		tanf2: math.Tan(f2),
+	}
+}
+
+// newMoons returns the instants, in universal time, of the new moons between
+// start and end
+func (s *sunServiceServer) newMoons(start, end, deltaT float64) []float64 {
+	elongation := func(jd float64) float64 {
+		t := julianCentury(jd + deltaT/86400)
+		e := normalise(s.MoonApparentLongitude(t) - s.SunApparentLongitude(t))
+		if e > 180 {
+			e -= 360
+		}
+		return e
+	}
+
+	moons := []float64{}
+	prev := elongation(start)
+	for jd := start; jd < end; jd++ {
+		next := elongation(jd + 1)
+		// The elongation passes through zero at new moon, and jumps from
+		// +180 to -180 at full moon
+		if prev < 0 && next >= 0 {
+			nm := bisect(elongation, jd, jd+1)
+			if nm >= start && nm < end {
+				moons = append(moons, nm)
+			}
+		}
+		prev = next
+	}
+	return moons
+}
+
+// bisect returns the root of f between lo and hi, f(lo) and f(hi) must have
+// opposite signs
+func bisect(f func(float64) float64, lo, hi float64) float64 {
+	flo := f(lo)
+	for i := 0; i < 40; i++ {
+		mid := (lo + hi) / 2
+		fmid := f(mid)
+		if (fmid < 0) == (flo < 0) {
+			lo, flo = mid, fmid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2
+}
+
+// minimise returns the position of the minimum of f between lo and hi by
+// scanning in steps and refining with a golden section search
+func minimise(f func(float64) float64, lo, hi, step float64) float64 {
+	best, bestValue := lo, f(lo)
+	for x := lo + step; x <= hi; x += step {
+		if v := f(x); v < bestValue {
+			best, bestValue = x, v
+		}
+	}
+
+	ratio := (math.Sqrt(5) - 1) / 2
+	a, b := best-step, best+step
+	for i := 0; i < 40; i++ {
+		c := b - ratio*(b-a)
+		d := a + ratio*(b-a)
+		if f(c) < f(d) {
+			b = d
+		} else {
+			a = c
+		}
+	}
+	return (a + b) / 2
+}
+
+// contact searches from jd towards limit for the instant f changes sign
+func contact(f func(float64) float64, jd, limit float64) (float64, bool) {
+	step := contactStep
+	if limit < jd {
+		step = -step
+	}
+	prev := jd
+	for next := jd + step; (step > 0 && next <= limit) || (step < 0 && next >= limit); next += step {
+		if (f(next) < 0) != (f(prev) < 0) {
+			return bisect(f, prev, next), true
+		}
+		prev = next
+	}
+	return 0, false
+}
+
+// circleOverlap returns the area common to two circles of radius r1 and r2
+// whose centres are d apart
+func circleOverlap(r1, r2, d float64) float64 {
+	if d >= r1+r2 {
+		return 0
+	}
+	if d <= math.Abs(r1-r2) {
+		r := math.Min(r1, r2)
+		return math.Pi * r * r
+	}
+	a1 := r1 * r1 * math.Acos((d*d+r1*r1-r2*r2)/(2*d*r1))
+	a2 := r2 * r2 * math.Acos((d*d+r2*r2-r1*r1)/(2*d*r2))
+	return a1 + a2 - 0.5*math.Sqrt((-d+r1+r2)*(d+r1-r2)*(d-r1+r2)*(d+r1+r2))
+}
+
+// SolarEclipse returns the circumstances of the solar eclipse at the new
+// moon nearest jd, for the Earth as a whole and for the observer. A nil
+// eclipse is returned if there is no eclipse.
+func (s *sunServiceServer) SolarEclipse(jd, deltaT float64, o observer) (*v1.SolarEclipse, error) {
+	// Greatest eclipse is when the shadow axis passes closest to the centre
+	// of the Earth
+	gamma := func(jd float64) float64 {
+		b := s.besselian(jd, deltaT)
+		return math.Hypot(b.x, b.y)
+	}
+	greatest := minimise(gamma, jd-0.25, jd+0.25, contactStep*15)
+	b := s.besselian(greatest, deltaT)
+	m := math.Hypot(b.x, b.y)
+	if m > 1+b.l1 {
+		return nil, nil
+	}
+
+	eclipse := &v1.SolarEclipse{Gamma: math.Copysign(m, b.y)}
+	switch {
+	case m > 1:
+		eclipse.Type = v1.SolarEclipseType_PARTIAL
+	case b.l2 < 0:
+		eclipse.Type = v1.SolarEclipseType_TOTAL
+	default:
+		eclipse.Type = v1.SolarEclipseType_ANNULAR
+	}
+	var err error
+	if eclipse.Greatest, err = s.instant(greatest); err != nil {
+		return nil, err
+	}
+
+	// Local circumstances, the eclipse is seen while the observer is inside
+	// the penumbra
+	penumbral := func(jd float64) float64 {
+		delta, penumbra, _, _ := s.besselian(jd, deltaT).localShadow(o)
+		return delta - penumbra
+	}
+	umbral := func(jd float64) float64 {
+		delta, _, umbra, _ := s.besselian(jd, deltaT).localShadow(o)
+		return delta - math.Abs(umbra)
+	}
+	distance := func(jd float64) float64 {
+		delta, _, _, _ := s.besselian(jd, deltaT).localShadow(o)
+		return delta
+	}
+
+	maximum := minimise(distance, greatest-0.25, greatest+0.25, contactStep)
+	delta, penumbra, umbra, _ := s.besselian(maximum, deltaT).localShadow(o)
+	if delta >= penumbra {
+		eclipse.LocalType = v1.SolarEclipseType_NO_ECLIPSE
+		return eclipse, nil
+	}
+
+	eclipse.LocalType = v1.SolarEclipseType_PARTIAL
+	if delta < math.Abs(umbra) {
+		eclipse.LocalType = v1.SolarEclipseType_ANNULAR
+		if umbra < 0 {
+			eclipse.LocalType = v1.SolarEclipseType_TOTAL
+		}
+	}
+	sunR := (penumbra + umbra) / 2
+	moonR := (penumbra - umbra) / 2
+	// Inside the umbra or antumbra the magnitude is, as in NASA's
+	// predictions, the ratio of the diameters of the moon and the sun
+	eclipse.Magnitude = (penumbra - delta) / (penumbra + umbra)
+	if eclipse.LocalType != v1.SolarEclipseType_PARTIAL {
+		eclipse.Magnitude = moonR / sunR
+	}
+	eclipse.Obscuration = circleOverlap(sunR, moonR, delta) / (math.Pi * sunR * sunR)
+
+	if eclipse.Maximum, err = s.eclipseContact(maximum, deltaT, o); err != nil {
+		return nil, err
+	}
+	contacts := []struct {
+		f     func(float64) float64
+		limit float64
+		c     **v1.SolarEclipseContact
+	}{
+		{penumbral, maximum - 0.25, &eclipse.C1},
+		{umbral, maximum - 0.25, &eclipse.C2},
+		{umbral, maximum + 0.25, &eclipse.C3},
+		{penumbral, maximum + 0.25, &eclipse.C4},
+	}
+	for _, c := range contacts {
+		if jd, ok := contact(c.f, maximum, c.limit); ok {
+			if *c.c, err = s.eclipseContact(jd, deltaT, o); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return eclipse, nil
+}
+
+func (s *sunServiceServer) eclipseContact(jd, deltaT float64, o observer) (*v1.SolarEclipseContact, error) {
+	_, _, _, altitude := s.besselian(jd, deltaT).localShadow(o)
+	i, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SolarEclipseContact{
+		Time:        i,
+		SunAltitude: altitude,
+		Visible:     altitude > 0,
+	}, nil
+}
+
+// instant converts a Julian date into a calendar date and UTC hour
+func (s *sunServiceServer) instant(jd float64) (*v1.SunInstant, error) {
+	cal, err := s.DayFromJulianDay(jd)
+	if err != nil {
+		return nil, fmt.Errorf("instant encountered the following error when executing DayFromJulianDay: %v", err)
+	}
+	return &v1.SunInstant{
+		Year:       cal.Year,
+		Month:      cal.Month,
+		Day:        cal.Day,
+		Hour:       24 * (jd + 0.5 - math.Floor(jd+0.5)),
+		JulianDate: jd,
+	}, nil
+}
+
+func (s *sunServiceServer) GetSolarEclipses(ctx context.Context, req *v1.SolarEclipseRequest) (*v1.SolarEclipses, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.StartYear, req.StartMonth, req.StartDay, 0); !ok {
+		return nil, fmt.Errorf("unusable start date provided: %v", err)
+	}
+	if ok, err := isValidInput(req.EndYear, req.EndMonth, req.EndDay, 0); !ok {
+		return nil, fmt.Errorf("unusable end date provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+
+	start, err := s.julianDate(req.StartYear, req.StartMonth, req.StartDay, 0)
+	if err != nil {
+		return nil, err
+	}
+	end, err := s.julianDate(req.EndYear, req.EndMonth, req.EndDay, 24)
+	if err != nil {
+		return nil, err
+	}
+	if end <= start {
+		return nil, fmt.Errorf("unusable input provided: the end date must be after the start date")
+	}
+	if end-start > maxEclipseSearchDays {
+		return nil, fmt.Errorf("unusable input provided: the date range cannot be more than %d days", maxEclipseSearchDays)
+	}
+
+	dt, err := s.DeltaT((start + end) / 2)
+	if err != nil {
+		return nil, err
+	}
+	o := newObserver(req.Latitude, req.Longitude, req.Height)
+
+	eclipses := &v1.SolarEclipses{Api: apiVersion}
+	for _, nm := range s.newMoons(start, end, dt.Seconds) {
+		_, lat, _ := lunar.Position(julianCentury(nm + dt.Seconds/86400))
+		if math.Abs(lat) > eclipseLatitudeLimit {
+			continue
+		}
+		// Use the value of delta T for the eclipse itself
+		dt, err := s.DeltaT(nm)
+		if err != nil {
+			return nil, err
+		}
+		eclipse, err := s.SolarEclipse(nm, dt.Seconds, o)
+		if err != nil {
+			return nil, err
+		}
+		if eclipse != nil {
+			eclipses.Eclipses = append(eclipses.Eclipses, eclipse)
+		}
+	}
+	return eclipses, nil
+}
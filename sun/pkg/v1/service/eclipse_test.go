@@ -0,0 +1,135 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// The total solar eclipse of 2017 August 21 from NASA's predictions
+// (Espenak), with the Besselian elements as polynomials in the hours from
+// 18h TD and delta T taken as 70.3 seconds
+const (
+	eclipse2017       = 2457987.25
+	eclipse2017DeltaT = 70.3
+)
+
+func nasaBesselian(jd float64) besselianElements {
+	t := (jd + eclipse2017DeltaT/86400 - eclipse2017) * 24
+	return besselianElements{
+		x: -0.129571 + t*(0.5406426+t*(-0.0000294-0.0000081*t)),
+		y: 0.485416 + t*(-0.1416400+t*(-0.0000905+0.0000020*t)),
+		d: degreesToRadians(11.86696 + t*(-0.013622-0.000002*t)),
+		// NASA's hour angle is for the ephemeris meridian, which lies
+		// 1.002738 delta T east of Greenwich
+		mu:    degreesToRadians(89.24543 + 15.003930*t - 1.002738*eclipse2017DeltaT/240),
+		l1:    0.542093 + t*(0.0001241-0.0000118*t),
+		l2:    -0.004025 + t*(0.0001234-0.0000117*t),
+		tanf1: 0.0046222,
+		tanf2: 0.0045992,
+	}
+}
+
+func TestBesselian(t *testing.T) {
+	// The sun's position is good to about a hundredth of a degree, which
+	// moves the shadow by a few thousandths of an Earth radius
+	s := &sunServiceServer{}
+	for _, hours := range []float64{-2, 0, 2} {
+		jd := eclipse2017 + hours/24 - eclipse2017DeltaT/86400
+		b := s.besselian(jd, eclipse2017DeltaT)
+		nasa := nasaBesselian(jd)
+		assert.InDelta(t, nasa.x, b.x, 0.005)
+		assert.InDelta(t, nasa.y, b.y, 0.005)
+		assert.InDelta(t, radiansToDegrees(nasa.d), radiansToDegrees(b.d), 0.002)
+		assert.InDelta(t, 0, normalise(radiansToDegrees(b.mu-nasa.mu)+180)-180, 0.005)
+		assert.InDelta(t, nasa.l1, b.l1, 0.0001)
+		assert.InDelta(t, nasa.l2, b.l2, 0.0001)
+		assert.InDelta(t, nasa.tanf1, b.tanf1, 0.000001)
+		assert.InDelta(t, nasa.tanf2, b.tanf2, 0.000001)
+	}
+}
+
+func TestGetSolarEclipses(t *testing.T) {
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, func(float64) float64 { return eclipse2017DeltaT })
+
+	// The point of greatest eclipse near Hopkinsville, Kentucky
+	req := &v1.SolarEclipseRequest{
+		Api:        apiVersion,
+		Latitude:   36.9667,
+		Longitude:  -87.6717,
+		StartYear:  2017,
+		StartMonth: 8,
+		StartDay:   1,
+		EndYear:    2017,
+		EndMonth:   8,
+		EndDay:     31,
+	}
+	res, err := s.GetSolarEclipses(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, res.Eclipses, 1)
+	e := res.Eclipses[0]
+
+	// Greatest eclipse at 18:26:40 TD with gamma 0.4367
+	assert.Equal(t, v1.SolarEclipseType_TOTAL, e.Type)
+	assert.InDelta(t, 0.4367, e.Gamma, 0.001)
+	assert.Equal(t, int32(21), e.Greatest.Day)
+	assert.InDelta(t, 18+26.0/60+40.0/3600-eclipse2017DeltaT/3600, e.Greatest.Hour, 30.0/3600)
+
+	// The contacts found from NASA's elements, totality lasting 2m40s
+	// with a magnitude of 1.0306 and the sun 64 degrees up
+	o := newObserver(req.Latitude, req.Longitude, req.Height)
+	penumbral := func(jd float64) float64 {
+		delta, penumbra, _, _ := nasaBesselian(jd).localShadow(o)
+		return delta - penumbra
+	}
+	umbral := func(jd float64) float64 {
+		delta, _, umbra, _ := nasaBesselian(jd).localShadow(o)
+		return delta - math.Abs(umbra)
+	}
+	maximum := eclipse2017 + 25.0/1440
+	c1, ok := contact(penumbral, maximum, maximum-0.25)
+	require.True(t, ok)
+	c2, ok := contact(umbral, maximum, maximum-0.25)
+	require.True(t, ok)
+	c3, ok := contact(umbral, maximum, maximum+0.25)
+	require.True(t, ok)
+	c4, ok := contact(penumbral, maximum, maximum+0.25)
+	require.True(t, ok)
+
+	assert.Equal(t, v1.SolarEclipseType_TOTAL, e.LocalType)
+	require.NotNil(t, e.C1)
+	require.NotNil(t, e.C2)
+	require.NotNil(t, e.C3)
+	require.NotNil(t, e.C4)
+	const seconds = 1.0 / 86400
+	assert.InDelta(t, c1, e.C1.Time.JulianDate, 40*seconds)
+	assert.InDelta(t, c2, e.C2.Time.JulianDate, 40*seconds)
+	assert.InDelta(t, c3, e.C3.Time.JulianDate, 40*seconds)
+	assert.InDelta(t, c4, e.C4.Time.JulianDate, 40*seconds)
+	assert.InDelta(t, 160, (e.C3.Time.JulianDate-e.C2.Time.JulianDate)/seconds, 3)
+	assert.InDelta(t, (c3-c2)/seconds, (e.C3.Time.JulianDate-e.C2.Time.JulianDate)/seconds, 3)
+	assert.InDelta(t, 1.0306, e.Magnitude, 0.0005)
+	assert.Equal(t, 1.0, e.Obscuration)
+	assert.InDelta(t, 64, e.Maximum.SunAltitude, 0.5)
+	assert.True(t, e.C1.Visible && e.C4.Visible)
+
+	// Sydney sees nothing of it
+	req.Latitude, req.Longitude = -33.87, 151.21
+	res, err = s.GetSolarEclipses(context.Background(), req)
+	require.NoError(t, err)
+	require.Len(t, res.Eclipses, 1)
+	assert.Equal(t, v1.SolarEclipseType_NO_ECLIPSE, res.Eclipses[0].LocalType)
+	assert.Nil(t, res.Eclipses[0].C1)
+	assert.Zero(t, res.Eclipses[0].Magnitude)
+
+	req.EndYear = 2016
+	_, err = s.GetSolarEclipses(context.Background(), req)
+	assert.Error(t, err)
+}
@@ -0,0 +1,94 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/coordinates/pkg/v1/constellation"
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/sun/grpc/v1"
+)
+
+func (s *sunServiceServer) GetSolarEphemeris(ctx context.Context, req *v1.SolarEphemerisRequest) (*v1.SolarEphemeris, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+
+	tjc, err := s.TimeJulianCentury(req.JulianDate)
+	if err != nil {
+		return nil, err
+	}
+	t := tjc.JulianDateTime
+
+	precise := req.HighPrecision
+	longitude, obliquity := s.nutation(t, precise)
+	apparent := s.apparentLongitude(t, precise)
+	epsilon := s.trueObliquity(t, precise)
+	ra, dec := transform.EclipticToEquatorial(apparent, 0, epsilon)
+	epoch := req.JulianDate
+	kind := req.Kind
+	if kind == v1.SolarPositionKind_SOLAR_POSITION_KIND_UNSPECIFIED {
+		kind = v1.SolarPositionKind_APPARENT
+	}
+	if kind != v1.SolarPositionKind_APPARENT {
+		reduced, ok := kinds[kind]
+		if !ok {
+			return nil, fmt.Errorf("unusable input provided: unknown kind of position %v", req.Kind)
+		}
+		place, err := reduction.Reduce(reduced, sun, s.earth, req.JulianDate)
+		if err != nil {
+			return nil, err
+		}
+		ra, dec, epoch = place.RightAscension, place.Declination, place.Epoch
+	}
+	meanObliquity := s.MeanObliquityOfEcliptic(t)
+	if precise {
+		meanObliquity = precession.MeanObliquity(precession.J2000 + t*36525)
+	}
+
+	return &v1.SolarEphemeris{
+		Api:                    apiVersion,
+		JulianDate:             req.JulianDate,
+		JulianCentury:          t,
+		GeometricMeanLongitude: s.GeometricMeanLongitudeSun(t),
+		GeometricMeanAnomaly:   normalise(s.GeometricMeanAnamolySun(t)),
+		EccentricityEarthOrbit: s.EccentricityEarthOrbit(t),
+		EquationOfCentre:       s.EquationCentreSun(t),
+		TrueLongitude:          normalise(s.SunTrueLongitude(t)),
+		TrueAnomaly:            normalise(s.SunTrueAnamoly(t)),
+		RadiusVector:           s.SunRadiusVector(t),
+		ApparentLongitude:      normalise(apparent),
+		MeanObliquity:          meanObliquity,
+		ObliquityCorrection:    epsilon,
+		RightAscension:         ra,
+		Declination:            dec,
+		EquationOfTime:         s.equationOfTime(t, precise),
+		HighPrecision:          precise,
+		NutationInLongitude:    longitude,
+		NutationInObliquity:    obliquity,
+		Constellation:          constellation.Find(ra, dec, epoch).Name,
+		Kind:                   kind,
+	}, nil
+}
+
+// kinds maps the kinds of position in requests other than apparent onto those
+// of the reduction
+var kinds = map[v1.SolarPositionKind]reduction.Kind{
+	v1.SolarPositionKind_GEOMETRIC:   reduction.Geometric,
+	v1.SolarPositionKind_ASTROMETRIC: reduction.Astrometric,
+}
+
+// sun is at the centre of the positions given to the reduction
+func sun(jde float64) (reduction.Vector, error) {
+	return reduction.Vector{}, nil
+}
+
+// earth gives the position of the Earth from the sun to the reduction, by
+// Meeus, Astronomical Algorithms, chapter 25
+func (s *sunServiceServer) earth(jde float64) (reduction.Vector, error) {
+	t := (jde - precession.J2000) / 36525
+	return reduction.Ecliptic(normalise(s.SunTrueLongitude(t)+180), 0, s.SunRadiusVector(t), jde), nil
+}
@@ -0,0 +1,50 @@
+package v1
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"planetpositions/coordinates/pkg/v1/reduction"
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSolarEphemeris(t *testing.T) {
+	// Meeus, Astronomical Algorithms, example 25.a, the sun on 1992 October
+	// 13.0 TD
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	req := &v1.SolarEphemerisRequest{Api: apiVersion, JulianDate: 2448908.5}
+	apparent, err := s.GetSolarEphemeris(context.Background(), req)
+	assert.NoError(t, err)
+	assert.InDelta(t, 198.38083, apparent.RightAscension, 0.0001)
+	assert.InDelta(t, -7.78507, apparent.Declination, 0.0001)
+	assert.Equal(t, v1.SolarPositionKind_APPARENT, apparent.Kind)
+
+	req.HighPrecision = true
+	apparent, err = s.GetSolarEphemeris(context.Background(), req)
+	assert.NoError(t, err)
+	req.Kind = v1.SolarPositionKind_GEOMETRIC
+	geometric, err := s.GetSolarEphemeris(context.Background(), req)
+	assert.NoError(t, err)
+	assert.Equal(t, v1.SolarPositionKind_GEOMETRIC, geometric.Kind)
+	req.Kind = v1.SolarPositionKind_ASTROMETRIC
+	astrometric, err := s.GetSolarEphemeris(context.Background(), req)
+	assert.NoError(t, err)
+	assert.InDelta(t, geometric.RightAscension, astrometric.RightAscension, 1e-9)
+	assert.InDelta(t, geometric.Declination, astrometric.Declination, 1e-9)
+
+	// Brought to the frame of J2000.0 the apparent sun, with the nutation in
+	// full, lies behind the geometric one by the 20.5" of annual aberration
+	ra, dec := reduction.TrueEquator(reduction.Geometric, req.JulianDate).Transpose().Spherical(apparent.RightAscension, apparent.Declination)
+	d := dec - geometric.Declination
+	a := (ra - geometric.RightAscension) * math.Cos(degreesToRadians(dec))
+	assert.InDelta(t, 20.5, math.Sqrt(a*a+d*d)*3600, 0.1)
+
+	req.Kind = 4
+	_, err = s.GetSolarEphemeris(context.Background(), req)
+	assert.Error(t, err)
+}
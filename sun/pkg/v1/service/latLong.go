@@ -3,6 +3,9 @@ package v1
 import (
 	"fmt"
 	"math"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/transform"
 )
 
 func calcDayOfYear(month, day int32, leapYear bool) int32 {
@@ -19,7 +22,7 @@ func (s *sunServiceServer) GeometricMeanLongitudeSun(t float64) float64 {
 	l0 := 280.46646 + t*(36000.76983+0.0003032*t)
 	l0 = math.Mod(l0, 360)
 	for {
-		if l0 < 360 {
+		if l0 < 0 {
 			l0 += 360
 		} else {
 			break
@@ -99,34 +102,66 @@ func (s *sunServiceServer) ObliquityCorrection(t float64) float64 {
 	return e0 + 0.00256*math.Cos(degreesToRadians(omega))
 }
 
+// nutation returns the nutation in longitude and in obliquity, in degrees,
+// by the IAU 2000B series when precise, otherwise by the principal term alone
+func (s *sunServiceServer) nutation(t float64, precise bool) (float64, float64) {
+	if precise {
+		return precession.Nutation(precession.J2000 + t*36525)
+	}
+	omega := degreesToRadians(125.04 - 1934.136*t)
+	return -0.00478 * math.Sin(omega), 0.00256 * math.Cos(omega)
+}
+
+// apparentLongitude corrects the true longitude for nutation and for the
+// aberration of 20.4898 arcseconds at one AU
+func (s *sunServiceServer) apparentLongitude(t float64, precise bool) float64 {
+	if !precise {
+		return s.SunApparentLongitude(t)
+	}
+	longitude, _ := s.nutation(t, true)
+	return s.SunTrueLongitude(t) + longitude - 20.4898/3600/s.SunRadiusVector(t)
+}
+
+// trueObliquity is the obliquity of the ecliptic to the true equator, by the
+// IAU 2006 mean obliquity when precise
+func (s *sunServiceServer) trueObliquity(t float64, precise bool) float64 {
+	if !precise {
+		return s.ObliquityCorrection(t)
+	}
+	return precession.TrueObliquity(precession.J2000 + t*36525)
+}
+
 // SunRightAscension -
 func (s *sunServiceServer) SunRightAscension(t float64) float64 {
-	e := s.ObliquityCorrection(t)
-	lambda := s.SunApparentLongitude(t)
-
-	tananum := (math.Cos(degreesToRadians(e)) * math.Sin(degreesToRadians(lambda)))
-	tanadenom := (math.Cos(degreesToRadians(lambda)))
-	//TODO TEST THIS BECAUSE Atan2 might not be the same
-	return radiansToDegrees(math.Atan2(tananum, tanadenom)) // In Degrees
+	ra, _ := transform.EclipticToEquatorial(s.SunApparentLongitude(t), 0, s.ObliquityCorrection(t))
+	return ra // In Degrees
 }
 
 // SunDeclination -
 func (s *sunServiceServer) SunDeclination(t float64) float64 {
-	e := s.ObliquityCorrection(t)
-	lambda := s.SunApparentLongitude(t)
+	return s.declination(t, false) // In Degrees
+}
 
-	sint := math.Sin(degreesToRadians(e)) * math.Sin(degreesToRadians(lambda))
-	return radiansToDegrees(math.Asin(sint)) // In Degrees
+// declination returns the apparent declination of the sun, nutated by the
+// IAU 2000B series when precise
+func (s *sunServiceServer) declination(t float64, precise bool) float64 {
+	_, dec := transform.EclipticToEquatorial(s.apparentLongitude(t, precise), 0, s.trueObliquity(t, precise))
+	return dec // In Degrees
 }
 
 // EquationOfTime -
 func (s *sunServiceServer) EquationOfTime(t float64) float64 {
-	epsilon := s.ObliquityCorrection(t)
+	return s.equationOfTime(t, false)
+}
+
+// equationOfTime evaluates the equation of time by Smart's series, for the
+// true obliquity by the IAU 2006 and IAU 2000B models when precise
+func (s *sunServiceServer) equationOfTime(t float64, precise bool) float64 {
 	l0 := s.GeometricMeanLongitudeSun(t)
 	e := s.EccentricityEarthOrbit(t)
 	m := s.GeometricMeanAnamolySun(t)
 
-	y := math.Tan(degreesToRadians(epsilon) / 2.0)
+	y := math.Tan(degreesToRadians(s.trueObliquity(t, precise)) / 2.0)
 	y *= y
 
 	sin2l0 := math.Sin(2.0 * degreesToRadians(l0))
@@ -140,30 +175,32 @@ func (s *sunServiceServer) EquationOfTime(t float64) float64 {
 	return radiansToDegrees(Etime) * 4.0 // In minutes of time
 }
 
-func hourAngle(lat, solarDeclination float64) float64 {
+// hourAngle returns the hour angle of the sun when its centre is at the
+// altitude, in degrees, standardRiseSetAltitude for sunrise and sunset
+func hourAngle(lat, solarDeclination, altitude float64) float64 {
 	latRad := degreesToRadians(lat)
 	sdRad := degreesToRadians(solarDeclination)
 
 	// HAarg := (math.Cos(degreesToRadians(90.833))/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad))
 
-	HA := (math.Acos(math.Cos(degreesToRadians(90.833))/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad)))
+	HA := (math.Acos(math.Cos(degreesToRadians(90-altitude))/(math.Cos(latRad)*math.Cos(sdRad)) - math.Tan(latRad)*math.Tan(sdRad)))
 
 	return HA // In Radians
 }
 
 // HourAngleSunrise -
-func (s *sunServiceServer) HourAngleSunrise(lat, solarDec float64) float64 {
-	return hourAngle(lat, solarDec) // in radians
+func (s *sunServiceServer) HourAngleSunrise(lat, solarDec, altitude float64) float64 {
+	return hourAngle(lat, solarDec, altitude) // in radians
 }
 
 // HourAngleSunset -
-func (s *sunServiceServer) HourAngleSunset(lat, solarDec float64) float64 {
+func (s *sunServiceServer) HourAngleSunset(lat, solarDec, altitude float64) float64 {
 	// Negate the hour angle for sunset
-	return -hourAngle(lat, solarDec) // in radians
+	return -hourAngle(lat, solarDec, altitude) // in radians
 }
 
 // SolNoonUTC -
-func (s *sunServiceServer) SolNoonUTC(t, longitude float64) (float64, error) {
+func (s *sunServiceServer) SolNoonUTC(t, longitude float64, precise bool) (float64, error) {
 	// First pass uses approximate solar noon to calculate eqtime
 	jd, err := s.JulianDayFromJulianCentury(t)
 	if err != nil {
@@ -173,7 +210,7 @@ func (s *sunServiceServer) SolNoonUTC(t, longitude float64) (float64, error) {
 	if err != nil {
 		return 0, fmt.Errorf("solnoon encountered the following error when executing TimeJulianCentury for tnoon: %v", err)
 	}
-	eqTime := s.EquationOfTime(tnoon.JulianDateTime)
+	eqTime := s.equationOfTime(tnoon.JulianDateTime, precise)
 	solNoonUTC := 720 + (longitude * 4) - eqTime // min
 
 	jd, err = s.JulianDayFromJulianCentury(t)
@@ -185,15 +222,15 @@ func (s *sunServiceServer) SolNoonUTC(t, longitude float64) (float64, error) {
 		return 0, fmt.Errorf("solnoon encountered the following error when executing TimeJulianCentury for newt: %v", err)
 	}
 
-	eqTime = s.EquationOfTime(newt.JulianDateTime)
+	eqTime = s.equationOfTime(newt.JulianDateTime, precise)
 	// var solarNoonDec = calcSunDeclination(newt)
 	solNoonUTC = 720 + (longitude * 4) - eqTime // min
 
 	return solNoonUTC, nil
 }
 
-// SunriseUTC -
-func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude float64) (float64, error) {
+// SunriseUTC - altitude is that of the centre of the sun at sunrise
+func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude, altitude float64, precise bool) (float64, error) {
 	t, err := s.TimeJulianCentury(JD)
 	if err != nil {
 		return 0, fmt.Errorf("sunriseUTC encountered the following error when executing TimeJulianCentury for t: %v", err)
@@ -203,7 +240,7 @@ func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude float64) (float64,
 	//     that declination. This is better than start of the
 	//     Julian day
 
-	noonmin, err := s.SolNoonUTC(t.JulianDateTime, longitude)
+	noonmin, err := s.SolNoonUTC(t.JulianDateTime, longitude, precise)
 	if err != nil {
 		return 0, fmt.Errorf("sunriseUTC encountered the following error when executing solNoonUTC for noonmin: %v", err)
 	}
@@ -214,9 +251,9 @@ func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude float64) (float64,
 
 	// *** First pass to approximate sunrise (using solar noon)
 
-	eqTime := s.EquationOfTime(tnoon.JulianDateTime)
-	solarDec := s.SunDeclination(tnoon.JulianDateTime)
-	hourAngle := s.HourAngleSunrise(latitude, solarDec)
+	eqTime := s.equationOfTime(tnoon.JulianDateTime, precise)
+	solarDec := s.declination(tnoon.JulianDateTime, precise)
+	hourAngle := s.HourAngleSunrise(latitude, solarDec, altitude)
 
 	delta := longitude - radiansToDegrees(hourAngle)
 	timeDiff := 4 * delta              // in minutes of time
@@ -231,9 +268,9 @@ func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude float64) (float64,
 	if err != nil {
 		return 0, fmt.Errorf("sunriseUTC encountered the following error when executing TimeJulianCentury for newt: %v", err)
 	}
-	eqTime = s.EquationOfTime(newt.JulianDateTime)
-	solarDec = s.SunDeclination(newt.JulianDateTime)
-	hourAngle = s.HourAngleSunrise(latitude, solarDec)
+	eqTime = s.equationOfTime(newt.JulianDateTime, precise)
+	solarDec = s.declination(newt.JulianDateTime, precise)
+	hourAngle = s.HourAngleSunrise(latitude, solarDec, altitude)
 	delta = longitude - radiansToDegrees(hourAngle)
 	timeDiff = 4 * delta
 	timeUTC = 720 + timeDiff - eqTime // in minutes
@@ -241,8 +278,8 @@ func (s *sunServiceServer) SunriseUTC(JD, latitude, longitude float64) (float64,
 	return timeUTC, nil
 }
 
-// SunsetUTC -
-func (s *sunServiceServer) SunsetUTC(JD, latitude, longitude float64) (float64, error) {
+// SunsetUTC - altitude is that of the centre of the sun at sunset
+func (s *sunServiceServer) SunsetUTC(JD, latitude, longitude, altitude float64, precise bool) (float64, error) {
 	t, err := s.TimeJulianCentury(JD)
 	if err != nil {
 		return 0, fmt.Errorf("sunsetUTC encountered the following error when executing TimeJulianCentury for t: %v", err)
@@ -252,7 +289,7 @@ func (s *sunServiceServer) SunsetUTC(JD, latitude, longitude float64) (float64,
 	//     that declination. This is better than start of the
 	//     Julian day
 
-	noonmin, err := s.SolNoonUTC(t.JulianDateTime, longitude)
+	noonmin, err := s.SolNoonUTC(t.JulianDateTime, longitude, precise)
 	if err != nil {
 		return 0, fmt.Errorf("sunsetUTC encountered the following error when executing solNoonUTC for noonmin: %v", err)
 	}
@@ -263,9 +300,9 @@ func (s *sunServiceServer) SunsetUTC(JD, latitude, longitude float64) (float64,
 
 	// First calculates sunrise and approx length of day
 
-	eqTime := s.EquationOfTime(tnoon.JulianDateTime)
-	solarDec := s.SunDeclination(tnoon.JulianDateTime)
-	hourAngle := s.HourAngleSunset(latitude, solarDec)
+	eqTime := s.equationOfTime(tnoon.JulianDateTime, precise)
+	solarDec := s.declination(tnoon.JulianDateTime, precise)
+	hourAngle := s.HourAngleSunset(latitude, solarDec, altitude)
 
 	delta := longitude - radiansToDegrees(hourAngle)
 	timeDiff := 4 * delta
@@ -280,9 +317,9 @@ func (s *sunServiceServer) SunsetUTC(JD, latitude, longitude float64) (float64,
 	if err != nil {
 		return 0, fmt.Errorf("sunsetUTC encountered the following error when executing TimeJulianCentury for newt: %v", err)
 	}
-	eqTime = s.EquationOfTime(newt.JulianDateTime)
-	solarDec = s.SunDeclination(newt.JulianDateTime)
-	hourAngle = s.HourAngleSunset(latitude, solarDec)
+	eqTime = s.equationOfTime(newt.JulianDateTime, precise)
+	solarDec = s.declination(newt.JulianDateTime, precise)
+	hourAngle = s.HourAngleSunset(latitude, solarDec, altitude)
 
 	delta = longitude - radiansToDegrees(hourAngle)
 	timeDiff = 4 * delta
@@ -9,3 +9,18 @@ func radiansToDegrees(angleRad float64) float64 {
 func degreesToRadians(angleDeg float64) float64 {
 	return math.Pi * angleDeg / 180.0
 }
+
+// julianCentury mirrors the julian service's TimeJulianCentury, for loops
+// that would otherwise make thousands of calls to the julian service
+func julianCentury(julianDay float64) float64 {
+	return (julianDay - 2451545.0) / 36525.0
+}
+
+// normalise returns the angle in the range 0 to 360 degrees
+func normalise(angleDeg float64) float64 {
+	angleDeg = math.Mod(angleDeg, 360)
+	if angleDeg < 0 {
+		angleDeg += 360
+	}
+	return angleDeg
+}
@@ -0,0 +1,182 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+
+	"planetpositions/coordinates/pkg/v1/precession"
+	"planetpositions/coordinates/pkg/v1/transform"
+	"planetpositions/moon/pkg/v1/lunar"
+	"planetpositions/sun/grpc/v1"
+	"planetpositions/sun/pkg/v1/meteors"
+)
+
+const (
+	// sunDailyMotion is the mean daily motion of the sun in longitude, in
+	// degrees
+	sunDailyMotion = 0.98564736
+	// astronomicalDarkness is the altitude of the sun, in degrees, below
+	// which the sky is dark enough to count meteors
+	astronomicalDarkness = -12
+)
+
+func (s *sunServiceServer) GetMeteorShowers(ctx context.Context, req *v1.MeteorShowersRequest) (*v1.MeteorShowers, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, 1, 1, 0); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Latitude < -90 || req.Latitude > 90 {
+		return nil, fmt.Errorf("unusable input provided: latitude must be between -90 and 90")
+	}
+	showers := meteors.Showers()
+	if len(req.Showers) > 0 {
+		showers = []meteors.Shower{}
+		seen := map[string]bool{}
+		for _, name := range req.Showers {
+			shower, ok := meteors.Find(name)
+			if !ok {
+				return nil, fmt.Errorf("unusable input provided: unknown meteor shower %s", name)
+			}
+			if seen[shower.Code] {
+				return nil, fmt.Errorf("unusable input provided: %s requested more than once", shower.Code)
+			}
+			seen[shower.Code] = true
+			showers = append(showers, shower)
+		}
+	}
+
+	start, err := s.julianDate(req.Year, 1, 1, 0)
+	if err != nil {
+		return nil, err
+	}
+	// delta T changes by well under a second across a year
+	deltaT, err := s.DeltaT(start + 182)
+	if err != nil {
+		return nil, err
+	}
+	dt := deltaT.Seconds / 86400
+
+	result := &v1.MeteorShowers{Api: apiVersion}
+	for _, shower := range showers {
+		m, err := s.meteorShower(shower, start, dt, req.Longitude, req.Latitude)
+		if err != nil {
+			return nil, err
+		}
+		result.Showers = append(result.Showers, m)
+	}
+	sort.SliceStable(result.Showers, func(i, j int) bool {
+		return result.Showers[i].Peak.JulianDate < result.Showers[j].Peak.JulianDate
+	})
+	return result, nil
+}
+
+// meteorShower finds the peak of the shower in the year starting at the
+// Julian date start and samples the night around it
+func (s *sunServiceServer) meteorShower(shower meteors.Shower, start, dt, longitude, latitude float64) (*v1.MeteorShower, error) {
+	peak := s.meteorPeak(shower, start+dt) - dt
+	peakTime, err := s.instant(peak)
+	if err != nil {
+		return nil, err
+	}
+	illuminated := lunar.IlluminatedFraction(lunar.PhaseAngle(julianCentury(peak + dt)))
+
+	m := &v1.MeteorShower{
+		Code:                  shower.Code,
+		Name:                  shower.Name,
+		BeginMonth:            int32(shower.Begin.Month),
+		BeginDay:              int32(shower.Begin.Day),
+		EndMonth:              int32(shower.End.Month),
+		EndDay:                int32(shower.End.Day),
+		PeakSolarLongitude:    shower.PeakLongitude,
+		Peak:                  peakTime,
+		RadiantRightAscension: shower.RightAscension,
+		RadiantDeclination:    shower.Declination,
+		Velocity:              shower.Velocity,
+		PopulationIndex:       shower.PopulationIndex,
+		Zhr:                   shower.ZHR,
+		MoonIllumination:      illuminated,
+	}
+
+	// Julian days begin at noon in universal time, shifting by the longitude
+	// gives the local mean noon
+	noon := math.Floor(peak+longitude/360) - longitude/360
+	dark := 0
+	for h := 0; h < 24; h++ {
+		hour, err := s.meteorHour(shower, noon+float64(h)/24, dt, longitude, latitude)
+		if err != nil {
+			return nil, err
+		}
+		m.Hours = append(m.Hours, hour)
+		if hour.RadiantAltitude > 0 && hour.SunAltitude < astronomicalDarkness {
+			m.MoonInterference += hour.MoonInterference
+			dark++
+		}
+	}
+	if dark > 0 {
+		m.MoonInterference /= float64(dark)
+		m.Observable = true
+	}
+	return m, nil
+}
+
+// meteorPeak returns the Julian ephemeris day, from jde0 on, when the
+// apparent longitude of the sun reaches the peak of the shower
+func (s *sunServiceServer) meteorPeak(shower meteors.Shower, jde0 float64) float64 {
+	behind := func(jde float64) float64 {
+		return signed(shower.PeakLongitudeOfDate(jde) - s.SunApparentLongitude(julianCentury(jde)))
+	}
+	jde := jde0 + normalise(behind(jde0))/sunDailyMotion
+	for i := 0; i < 5; i++ {
+		jde += behind(jde) / sunDailyMotion
+	}
+	return jde
+}
+
+// meteorHour gives the altitudes of the radiant, the sun and the Moon at the
+// Julian date jd
+func (s *sunServiceServer) meteorHour(shower meteors.Shower, jd, dt, longitude, latitude float64) (*v1.MeteorHour, error) {
+	time, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	t := julianCentury(jd + dt)
+	gst := transform.ApparentSiderealTime(jd, jd+dt)
+	horizontal := func(ra, dec float64) (float64, float64) {
+		return transform.EquatorialToHorizontal(normalise(gst+longitude-ra), dec, latitude)
+	}
+
+	ra, dec := precession.Precess(shower.RightAscension, shower.Declination, precession.J2000, jd+dt)
+	radiantAzimuth, radiantAltitude := horizontal(ra, dec)
+	_, sunAltitude := horizontal(s.SunRightAscension(t), s.SunDeclination(t))
+
+	moonLongitude, moonLatitude, moonDistance := lunar.Position(t)
+	ra, dec = transform.EclipticToEquatorial(moonLongitude+s.NutationInLongitude(t), moonLatitude, s.ObliquityCorrection(t))
+	_, moonAltitude := horizontal(ra, dec)
+	// Parallax lowers the Moon by up to a degree
+	moonAltitude -= lunar.HorizontalParallax(moonDistance) * math.Cos(degreesToRadians(moonAltitude))
+	illuminated := lunar.IlluminatedFraction(lunar.PhaseAngle(t))
+
+	return &v1.MeteorHour{
+		Time:             time,
+		RadiantAltitude:  radiantAltitude,
+		RadiantAzimuth:   radiantAzimuth,
+		SunAltitude:      sunAltitude,
+		MoonAltitude:     moonAltitude,
+		MoonInterference: meteors.MoonInterference(illuminated, moonAltitude),
+	}, nil
+}
+
+// signed returns the angle in the range -180 to 180 degrees
+func signed(angleDeg float64) float64 {
+	angleDeg = normalise(angleDeg)
+	if angleDeg > 180 {
+		angleDeg -= 360
+	}
+	return angleDeg
+}
@@ -0,0 +1,51 @@
+package v1
+
+import (
+	"fmt"
+	"math"
+)
+
+// julianDate -
+func (s *sunServiceServer) julianDate(year, month, day int32, hour float64) (float64, error) {
+	// The julian service returns the Julian day number, which starts at noon
+	jd, err := s.Convert(year, month, day, hour)
+	if err != nil {
+		return 0, fmt.Errorf("julianDate encountered the following error when executing Convert: %v", err)
+	}
+	return jd.JulianDateTime - 0.5 + hour/24.0, nil
+}
+
+// SolarHourAngle -
+func (s *sunServiceServer) SolarHourAngle(t, hour, longitude float64, precise bool) float64 {
+	// longitude is positive east of Greenwich, hour is UTC
+	eqTime := s.equationOfTime(t, precise)
+	trueSolarTime := hour*60 + eqTime + 4*longitude // in minutes
+	ha := trueSolarTime/4 - 180
+	for ha < -180 {
+		ha += 360
+	}
+	for ha > 180 {
+		ha -= 360
+	}
+	return ha // In Degrees
+}
+
+// SolarElevation -
+func (s *sunServiceServer) SolarElevation(t, hour, latitude, longitude float64, precise bool) float64 {
+	ha := degreesToRadians(s.SolarHourAngle(t, hour, longitude, precise))
+	dec := degreesToRadians(s.declination(t, precise))
+	lat := degreesToRadians(latitude)
+
+	sinh := math.Sin(lat)*math.Sin(dec) + math.Cos(lat)*math.Cos(dec)*math.Cos(ha)
+	return radiansToDegrees(math.Asin(sinh)) // In Degrees
+}
+
+// SolarAzimuth -
+func (s *sunServiceServer) SolarAzimuth(t, hour, latitude, longitude float64, precise bool) float64 {
+	ha := degreesToRadians(s.SolarHourAngle(t, hour, longitude, precise))
+	dec := degreesToRadians(s.declination(t, precise))
+	lat := degreesToRadians(latitude)
+
+	az := radiansToDegrees(math.Atan2(math.Sin(ha), math.Cos(ha)*math.Sin(lat)-math.Tan(dec)*math.Cos(lat)))
+	return math.Mod(az+540, 360) // In Degrees, clockwise from north
+}
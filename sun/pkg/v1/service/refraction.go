@@ -0,0 +1,29 @@
+package v1
+
+import (
+	"planetpositions/coordinates/pkg/v1/refraction"
+
+	"github.com/golang/protobuf/ptypes/wrappers"
+)
+
+// standardRiseSetAltitude is the altitude of the centre of the sun at sunrise
+// and sunset by convention, 34' of refraction and 16' of semidiameter below
+// the horizon
+const standardRiseSetAltitude = -0.833
+
+// semidiameter is the mean apparent radius of the sun, in degrees
+const semidiameter = 16.0 / 60
+
+// riseSetAltitude returns the altitude of the centre of the sun when its
+// upper limb appears on the horizon, the conventional value when the request
+// gives no model or conditions
+func riseSetAltitude(model string, temperature, pressure *wrappers.DoubleValue) (float64, error) {
+	if refraction.Conventional(model, temperature, pressure) {
+		return standardRiseSetAltitude, nil
+	}
+	m, air, err := refraction.Conditions(model, temperature, pressure)
+	if err != nil {
+		return 0, err
+	}
+	return -(m.Horizon(air) + semidiameter), nil
+}
@@ -64,6 +64,9 @@ func isValidInput(year, month, day int32, hour float64) (bool, error) {
 			}
 		}
 	}
+	if hour < 0 || hour > 24 {
+		return false, fmt.Errorf("invalid hour supplied")
+	}
 	if year < -1000 || year > 3000 {
 		return false, fmt.Errorf("the algorithm used is not valid for years outside of the range -1000 to 3000")
 	}
@@ -0,0 +1,184 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+
+	"planetpositions/coordinates/pkg/v1/refraction"
+	"planetpositions/sun/grpc/v1"
+)
+
+// earthRadius is the WGS84 equatorial radius in metres
+const earthRadius = 6378137.0
+
+// point is a position on the ground in metres east and north of an origin
+type point struct {
+	east  float64
+	north float64
+}
+
+type geoJSONPolygon struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+func (s *sunServiceServer) GetShadow(ctx context.Context, req *v1.ShadowRequest) (*v1.Shadow, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Height <= 0 {
+		return nil, fmt.Errorf("unusable input provided: height must be greater than zero")
+	}
+	if req.FootprintWidth < 0 || req.FootprintDepth < 0 {
+		return nil, fmt.Errorf("unusable input provided: footprint dimensions cannot be negative")
+	}
+	model, air, err := refraction.Conditions(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.TimeJulianCentury(jd)
+	if err != nil {
+		return nil, err
+	}
+
+	azimuth := s.SolarAzimuth(t.JulianDateTime, req.Hour, req.Latitude, req.Longitude, req.HighPrecision)
+	elevation := s.SolarElevation(t.JulianDateTime, req.Hour, req.Latitude, req.Longitude, req.HighPrecision)
+
+	shadow := &v1.Shadow{
+		Api:               apiVersion,
+		Azimuth:           azimuth,
+		Elevation:         elevation,
+		ApparentElevation: model.Apparent(elevation, air),
+	}
+	// The light that casts the shadow arrives from the apparent sun
+	elevation = shadow.ApparentElevation
+	if elevation <= 0 {
+		// The sun is down, nothing casts a shadow
+		return shadow, nil
+	}
+
+	tip := shadowTip(req.Height, azimuth, elevation)
+	shadow.SunUp = true
+	shadow.Length = math.Hypot(tip.east, tip.north)
+	shadow.Bearing = math.Mod(azimuth+180, 360)
+	shadow.TipEast = tip.east
+	shadow.TipNorth = tip.north
+	shadow.TipLongitude, shadow.TipLatitude = offsetToLongLat(req.Longitude, req.Latitude, tip)
+
+	if req.FootprintWidth > 0 && req.FootprintDepth > 0 {
+		corners := footprintCorners(req.FootprintWidth, req.FootprintDepth, req.FootprintBearing)
+		polygon, err := shadowPolygon(req.Longitude, req.Latitude, corners, tip)
+		if err != nil {
+			return nil, err
+		}
+		shadow.Footprint = polygon
+	}
+	return shadow, nil
+}
+
+// shadowTip returns the offset of the tip of the shadow cast by an object of
+// the given height from its base
+func shadowTip(height, azimuth, elevation float64) point {
+	length := height / math.Tan(degreesToRadians(elevation))
+	bearing := degreesToRadians(azimuth + 180)
+	return point{
+		east:  length * math.Sin(bearing),
+		north: length * math.Cos(bearing),
+	}
+}
+
+// footprintCorners returns the corners of a width x depth rectangle centred
+// on the origin, rotated clockwise from north by bearing degrees
+func footprintCorners(width, depth, bearing float64) []point {
+	b := degreesToRadians(bearing)
+	sinb, cosb := math.Sin(b), math.Cos(b)
+	corners := []point{}
+	for _, c := range []point{
+		{-width / 2, -depth / 2},
+		{width / 2, -depth / 2},
+		{width / 2, depth / 2},
+		{-width / 2, depth / 2},
+	} {
+		corners = append(corners, point{
+			east:  c.east*cosb + c.north*sinb,
+			north: -c.east*sinb + c.north*cosb,
+		})
+	}
+	return corners
+}
+
+// shadowPolygon returns a GeoJSON polygon covering the footprint and the
+// shadow it casts
+func shadowPolygon(longitude, latitude float64, corners []point, tip point) (string, error) {
+	points := []point{}
+	for _, c := range corners {
+		points = append(points, c, point{east: c.east + tip.east, north: c.north + tip.north})
+	}
+	hull := convexHull(points)
+
+	ring := [][2]float64{}
+	for _, p := range hull {
+		long, lat := offsetToLongLat(longitude, latitude, p)
+		ring = append(ring, [2]float64{long, lat})
+	}
+	// GeoJSON rings are closed
+	ring = append(ring, ring[0])
+
+	b, err := json.Marshal(geoJSONPolygon{Type: "Polygon", Coordinates: [][][2]float64{ring}})
+	if err != nil {
+		return "", fmt.Errorf("shadowPolygon encountered the following error when marshalling the polygon: %v", err)
+	}
+	return string(b), nil
+}
+
+// convexHull returns the convex hull of the points in counter-clockwise
+// order, as required for GeoJSON exterior rings
+func convexHull(points []point) []point {
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].east == points[j].east {
+			return points[i].north < points[j].north
+		}
+		return points[i].east < points[j].east
+	})
+	cross := func(o, a, b point) float64 {
+		return (a.east-o.east)*(b.north-o.north) - (a.north-o.north)*(b.east-o.east)
+	}
+
+	// Andrew's monotone chain
+	hull := []point{}
+	for _, p := range points {
+		for len(hull) >= 2 && cross(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+	lower := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		for len(hull) >= lower && cross(hull[len(hull)-2], hull[len(hull)-1], points[i]) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, points[i])
+	}
+	return hull[:len(hull)-1]
+}
+
+// offsetToLongLat converts an offset in metres from a location into a
+// longitude and latitude, treating the ground as flat over the offset
+func offsetToLongLat(longitude, latitude float64, p point) (float64, float64) {
+	lat := latitude + radiansToDegrees(p.north/earthRadius)
+	long := longitude + radiansToDegrees(p.east/(earthRadius*math.Cos(degreesToRadians(latitude))))
+	return long, lat
+}
@@ -0,0 +1,121 @@
+package v1
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShadowTip(t *testing.T) {
+	// A 10 m pole lit from the south east at 45 degrees casts a 10 m shadow
+	// to the north west, and at 30 degrees one of 10 / tan 30 = 17.32 m
+	tip := shadowTip(10, 135, 45)
+	assert.InDelta(t, -10/math.Sqrt2, tip.east, 1e-9)
+	assert.InDelta(t, 10/math.Sqrt2, tip.north, 1e-9)
+
+	tip = shadowTip(10, 270, 30)
+	assert.InDelta(t, 17.3205, tip.east, 0.0001)
+	assert.InDelta(t, 0, tip.north, 1e-9)
+}
+
+func TestConvexHull(t *testing.T) {
+	// The centre of the square is dropped and the corners run counter
+	// clockwise from the lowest
+	hull := convexHull([]point{{1, 1}, {0, 0}, {2, 2}, {0, 2}, {2, 0}})
+	assert.Equal(t, []point{{0, 0}, {2, 0}, {2, 2}, {0, 2}}, hull)
+}
+
+func TestGetShadow(t *testing.T) {
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+
+	// At Greenwich at noon on the June solstice the sun is about
+	// 90 - 51.48 + 23.44 = 61.96 degrees up in the south, so a 10 m pole
+	// casts a shadow of 10 / tan 61.96 = 5.33 m to the north
+	req := &v1.ShadowRequest{
+		Api:       apiVersion,
+		Longitude: 0,
+		Latitude:  51.4779,
+		Year:      2020,
+		Month:     6,
+		Day:       20,
+		Hour:      12,
+		Height:    10,
+	}
+	shadow, err := s.GetShadow(context.Background(), req)
+	require.NoError(t, err)
+	assert.True(t, shadow.SunUp)
+	assert.InDelta(t, 61.96, shadow.Elevation, 0.05)
+	assert.InDelta(t, 180, shadow.Azimuth, 1)
+	assert.True(t, shadow.ApparentElevation > shadow.Elevation)
+	assert.InDelta(t, 10/math.Tan(degreesToRadians(shadow.ApparentElevation)), shadow.Length, 1e-9)
+	assert.InDelta(t, 5.33, shadow.Length, 0.01)
+	assert.InDelta(t, math.Mod(shadow.Azimuth+180, 360), shadow.Bearing, 1e-9)
+	assert.InDelta(t, shadow.Length, math.Hypot(shadow.TipEast, shadow.TipNorth), 1e-9)
+	assert.True(t, shadow.TipNorth > 5.3)
+	// A metre north is 1 / 6378137 radians of latitude
+	assert.InDelta(t, 51.4779+radiansToDegrees(shadow.TipNorth/earthRadius), shadow.TipLatitude, 1e-12)
+	assert.Empty(t, shadow.Footprint)
+
+	// A 10 x 20 m building squared to north and its shadow make a closed
+	// counter-clockwise ring reaching the shadow's length past its north
+	// side
+	req.FootprintWidth, req.FootprintDepth = 10, 20
+	shadow, err = s.GetShadow(context.Background(), req)
+	require.NoError(t, err)
+	var polygon geoJSONPolygon
+	require.NoError(t, json.Unmarshal([]byte(shadow.Footprint), &polygon))
+	assert.Equal(t, "Polygon", polygon.Type)
+	require.Len(t, polygon.Coordinates, 1)
+	ring := polygon.Coordinates[0]
+	assert.Equal(t, ring[0], ring[len(ring)-1])
+	assert.True(t, ringArea(ring) > 0)
+	north := -90.0
+	for _, p := range ring {
+		north = math.Max(north, p[1])
+	}
+	assert.InDelta(t, 51.4779+radiansToDegrees((10+shadow.TipNorth)/earthRadius), north, 1e-12)
+
+	// Turned 30 degrees the building's shadow sweeps out a hexagon
+	req.FootprintBearing = 30
+	shadow, err = s.GetShadow(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal([]byte(shadow.Footprint), &polygon))
+	ring = polygon.Coordinates[0]
+	assert.Len(t, ring, 7)
+	assert.Equal(t, ring[0], ring[len(ring)-1])
+	assert.True(t, ringArea(ring) > 0)
+
+	// At midnight the sun is down and there is no shadow
+	req.Hour = 0
+	shadow, err = s.GetShadow(context.Background(), req)
+	require.NoError(t, err)
+	assert.False(t, shadow.SunUp)
+	assert.True(t, shadow.Elevation < 0)
+	assert.Zero(t, shadow.Length)
+	assert.Empty(t, shadow.Footprint)
+
+	req.Height = 0
+	_, err = s.GetShadow(context.Background(), req)
+	assert.Error(t, err)
+	req.Height, req.FootprintWidth = 10, -1
+	_, err = s.GetShadow(context.Background(), req)
+	assert.Error(t, err)
+}
+
+// ringArea returns twice the signed area of a closed ring, positive when it
+// runs counter-clockwise
+func ringArea(ring [][2]float64) float64 {
+	area := 0.0
+	for i := 1; i < len(ring); i++ {
+		area += ring[i-1][0]*ring[i][1] - ring[i][0]*ring[i-1][1]
+	}
+	return area
+}
@@ -0,0 +1,110 @@
+package v1
+
+import (
+	"context"
+	"fmt"
+
+	"planetpositions/sun/grpc/v1"
+)
+
+// LocalMeanTime -
+func (s *sunServiceServer) LocalMeanTime(jd, longitude float64) float64 {
+	// jd is in universal time, longitude is positive east of Greenwich
+	return jd + longitude/360.0
+}
+
+// LocalApparentTime -
+func (s *sunServiceServer) LocalApparentTime(jd, longitude float64, precise bool) float64 {
+	eqTime := s.equationOfTime(julianCentury(jd), precise)
+	return s.LocalMeanTime(jd, longitude) + eqTime/1440.0
+}
+
+// UniversalTimeFromMean -
+func (s *sunServiceServer) UniversalTimeFromMean(lmt, longitude float64) float64 {
+	return lmt - longitude/360.0
+}
+
+// UniversalTimeFromApparent -
+func (s *sunServiceServer) UniversalTimeFromApparent(apparent, longitude float64, precise bool) float64 {
+	// The equation of time depends on the universal time being solved for,
+	// it changes by less than a second an hour so a few passes are plenty
+	jd := s.UniversalTimeFromMean(apparent, longitude)
+	for i := 0; i < 3; i++ {
+		eqTime := s.equationOfTime(julianCentury(jd), precise)
+		jd = s.UniversalTimeFromMean(apparent, longitude) - eqTime/1440.0
+	}
+	return jd
+}
+
+func (s *sunServiceServer) GetSolarTime(ctx context.Context, req *v1.SolarTimeRequest) (*v1.SolarTime, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Longitude < -180 || req.Longitude > 180 {
+		return nil, fmt.Errorf("unusable input provided: longitude must be between -180 and 180")
+	}
+
+	jd, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	return s.solarTime(jd, req.Longitude, req.HighPrecision)
+}
+
+func (s *sunServiceServer) GetUniversalTime(ctx context.Context, req *v1.SolarTimeRequest) (*v1.SolarTime, error) {
+	// check if the API version requested by client is supported by server
+	if err := s.checkAPI(req.Api); err != nil {
+		return nil, err
+	}
+	// Validate input
+	if ok, err := isValidInput(req.Year, req.Month, req.Day, req.Hour); !ok {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+	if req.Longitude < -180 || req.Longitude > 180 {
+		return nil, fmt.Errorf("unusable input provided: longitude must be between -180 and 180")
+	}
+
+	local, err := s.julianDate(req.Year, req.Month, req.Day, req.Hour)
+	if err != nil {
+		return nil, err
+	}
+	var jd float64
+	switch req.Kind {
+	case v1.SolarTimeKind_SOLAR_TIME_KIND_UNSPECIFIED, v1.SolarTimeKind_MEAN_SOLAR_TIME:
+		jd = s.UniversalTimeFromMean(local, req.Longitude)
+	case v1.SolarTimeKind_APPARENT_SOLAR_TIME:
+		jd = s.UniversalTimeFromApparent(local, req.Longitude, req.HighPrecision)
+	default:
+		return nil, fmt.Errorf("unusable input provided: unknown kind of solar time %v", req.Kind)
+	}
+	return s.solarTime(jd, req.Longitude, req.HighPrecision)
+}
+
+// solarTime returns the universal, local mean and local apparent times for
+// the instant jd, in universal time
+func (s *sunServiceServer) solarTime(jd, longitude float64, precise bool) (*v1.SolarTime, error) {
+	ut, err := s.instant(jd)
+	if err != nil {
+		return nil, err
+	}
+	mean, err := s.instant(s.LocalMeanTime(jd, longitude))
+	if err != nil {
+		return nil, err
+	}
+	apparent, err := s.instant(s.LocalApparentTime(jd, longitude, precise))
+	if err != nil {
+		return nil, err
+	}
+	return &v1.SolarTime{
+		Api:            apiVersion,
+		UniversalTime:  ut,
+		MeanTime:       mean,
+		ApparentTime:   apparent,
+		EquationOfTime: s.equationOfTime(julianCentury(jd), precise),
+	}, nil
+}
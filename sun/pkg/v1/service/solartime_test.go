@@ -0,0 +1,90 @@
+package v1
+
+import (
+	"context"
+	"testing"
+
+	"planetpositions/julian/pkg/v1/juliantest"
+	"planetpositions/sun/grpc/v1"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSolarTime(t *testing.T) {
+	// Meeus example 28.a, on 1992 October 13.0 TD the equation of time is
+	// 13m42.6s, or 13m42.7s by the approximation of example 28.b
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	const second = 1.0 / 60
+
+	tests := []struct {
+		name      string
+		longitude float64
+		day       int32
+		mean      float64
+		precise   bool
+	}{
+		{"Greenwich", 0, 13, 0, false},
+		{"Greenwich, high precision", 0, 13, 0, true},
+		// East of Greenwich the local time is ahead of universal time
+		{"90 degrees east", 90, 13, 6, false},
+		// and to the west it is behind, here on the evening before
+		{"Washington", -77.0365, 12, 24 - 77.0365/15, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := s.GetSolarTime(context.Background(), &v1.SolarTimeRequest{
+				Api:           apiVersion,
+				Longitude:     tt.longitude,
+				Year:          1992,
+				Month:         10,
+				Day:           13,
+				HighPrecision: tt.precise,
+			})
+			require.NoError(t, err)
+			assert.InDelta(t, 13+42.65*second, res.EquationOfTime, 0.1*second)
+			assert.Equal(t, 2448908.5, res.UniversalTime.JulianDate)
+
+			assert.Equal(t, tt.day, res.MeanTime.Day)
+			assert.InDelta(t, tt.mean, res.MeanTime.Hour, 1e-6)
+			// The sun is ahead of the mean sun in October, so apparent time
+			// runs fast of mean time by the equation of time
+			assert.Equal(t, tt.day, res.ApparentTime.Day)
+			assert.InDelta(t, tt.mean+res.EquationOfTime/60, res.ApparentTime.Hour, 1e-6)
+		})
+	}
+}
+
+func TestGetUniversalTime(t *testing.T) {
+	s := &sunServiceServer{}
+	s.Address = juliantest.NewServer(t, nil)
+	req := &v1.SolarTimeRequest{
+		Api:       apiVersion,
+		Longitude: -77.0365,
+		Year:      1992,
+		Month:     10,
+		Day:       13,
+		Hour:      12,
+	}
+
+	// Mean noon in Washington is 5h08m09s after noon at Greenwich
+	res, err := s.GetUniversalTime(context.Background(), req)
+	require.NoError(t, err)
+	assert.InDelta(t, 12+77.0365/15, res.UniversalTime.Hour, 1e-6)
+	assert.InDelta(t, 12, res.MeanTime.Hour, 1e-6)
+
+	// Apparent noon, when the sun crosses the meridian, comes the equation
+	// of time earlier, by then almost 13m55s, and converting back gives noon
+	// again
+	req.Kind = v1.SolarTimeKind_APPARENT_SOLAR_TIME
+	res, err = s.GetUniversalTime(context.Background(), req)
+	require.NoError(t, err)
+	assert.InDelta(t, 13.9, res.EquationOfTime, 0.05)
+	assert.InDelta(t, 12+77.0365/15-res.EquationOfTime/60, res.UniversalTime.Hour, 1e-6)
+	assert.InDelta(t, 12, res.ApparentTime.Hour, 1e-6)
+
+	req.Longitude = 181
+	_, err = s.GetUniversalTime(context.Background(), req)
+	assert.Error(t, err)
+}
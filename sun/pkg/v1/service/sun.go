@@ -32,13 +32,18 @@ func (s *sunServiceServer) GetSunrise(ctx context.Context, req *v1.SunriseReques
 		return nil, fmt.Errorf("unusable input provided: %v", err)
 	}
 
+	altitude, err := riseSetAltitude(req.Refraction, req.Temperature, req.Pressure)
+	if err != nil {
+		return nil, fmt.Errorf("unusable input provided: %v", err)
+	}
+
 	// Convert the date to a Julian date
 	jd, err := s.Convert(req.Year, req.Month, req.Day, req.Hour)
 	if err != nil {
 		return nil, err
 	}
 	// Calculate Sunrise/Sunset
-	sunriseJD, err := s.SunriseUTC(jd.JulianDateTime, req.Latitude, req.Longitude)
+	sunriseJD, err := s.SunriseUTC(jd.JulianDateTime, req.Latitude, req.Longitude, altitude, req.HighPrecision)
 	if err != nil {
 		return nil, err
 	}